@@ -30,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	metricsservice "github.com/radius-project/radius/pkg/metrics/service"
+	"github.com/radius-project/radius/pkg/portableresources/drift"
 	profilerservice "github.com/radius-project/radius/pkg/profiler/service"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 	"github.com/radius-project/radius/pkg/server"
@@ -97,17 +98,30 @@ func main() {
 		hostingSvc = append(hostingSvc, data.NewEmbeddedETCDService(data.EmbeddedETCDServiceOptions{ClientConfigSink: client}))
 	}
 
-	builders, err := builders(options)
+	recipeControllerConfig, err := controllerconfig.New(options)
 	if err != nil {
 		log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
 	}
 
+	builders := builders(recipeControllerConfig)
+
 	hostingSvc = append(
 		hostingSvc,
 		server.NewAPIService(options, builders),
 		server.NewAsyncWorker(options, builders),
 	)
 
+	if options.Config.DriftDetection.Enabled {
+		hostingSvc = append(hostingSvc, drift.NewService(drift.ServiceOptions{
+			Config:                 options.Config.DriftDetection,
+			StorageProviderOptions: options.Config.StorageProvider,
+			QueueProviderOptions:   options.Config.QueueProvider,
+			Location:               options.Config.Env.RoleLocation,
+			Engine:                 recipeControllerConfig.Engine,
+			ConfigurationLoader:    recipeControllerConfig.ConfigLoader,
+		}))
+	}
+
 	tracerOpts := options.Config.TracerProvider
 	tracerOpts.ServiceName = serviceName
 	hostingSvc = append(hostingSvc, &trace.Service{Options: tracerOpts})
@@ -129,17 +143,12 @@ func main() {
 	}
 }
 
-func builders(options hostoptions.HostOptions) ([]builder.Builder, error) {
-	config, err := controllerconfig.New(options)
-	if err != nil {
-		return nil, err
-	}
-
+func builders(config *controllerconfig.RecipeControllerConfig) []builder.Builder {
 	return []builder.Builder{
 		corerp_setup.SetupNamespace(config).GenerateBuilder(),
 		daprrp_setup.SetupNamespace(config).GenerateBuilder(),
 		msgrp_setup.SetupNamespace(config).GenerateBuilder(),
 		dsrp_setup.SetupNamespace(config).GenerateBuilder(),
 		// Add resource provider builders...
-	}, nil
+	}
 }
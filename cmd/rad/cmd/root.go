@@ -50,12 +50,18 @@ import (
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
+	recipe_plan "github.com/radius-project/radius/pkg/cli/cmd/recipe/plan"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
 	recipe_show "github.com/radius-project/radius/pkg/cli/cmd/recipe/show"
 	recipe_unregister "github.com/radius-project/radius/pkg/cli/cmd/recipe/unregister"
+	resource_cancel "github.com/radius-project/radius/pkg/cli/cmd/resource/cancel"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
+	resource_history "github.com/radius-project/radius/pkg/cli/cmd/resource/history"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
+	resource_lock "github.com/radius-project/radius/pkg/cli/cmd/resource/lock"
+	resource_move "github.com/radius-project/radius/pkg/cli/cmd/resource/move"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
+	resource_unlock "github.com/radius-project/radius/pkg/cli/cmd/resource/unlock"
 	"github.com/radius-project/radius/pkg/cli/cmd/run"
 	"github.com/radius-project/radius/pkg/cli/cmd/uninstall"
 	uninstall_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/uninstall/kubernetes"
@@ -220,9 +226,27 @@ func initSubCommands() {
 	deleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(deleteCmd)
 
+	cancelCmd, _ := resource_cancel.NewCommand(framework)
+	resourceCmd.AddCommand(cancelCmd)
+
+	moveCmd, _ := resource_move.NewCommand(framework)
+	resourceCmd.AddCommand(moveCmd)
+
+	historyCmd, _ := resource_history.NewCommand(framework)
+	resourceCmd.AddCommand(historyCmd)
+
+	lockCmd, _ := resource_lock.NewCommand(framework)
+	resourceCmd.AddCommand(lockCmd)
+
+	unlockCmd, _ := resource_unlock.NewCommand(framework)
+	resourceCmd.AddCommand(unlockCmd)
+
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
+	planRecipeCmd, _ := recipe_plan.NewCommand(framework)
+	recipeCmd.AddCommand(planRecipeCmd)
+
 	registerRecipeCmd, _ := recipe_register.NewCommand(framework)
 	recipeCmd.AddCommand(registerRecipeCmd)
 
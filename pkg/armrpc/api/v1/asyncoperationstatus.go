@@ -37,6 +37,10 @@ type AsyncOperationStatus struct {
 	// EndTime represents the async operation end time.
 	EndTime *time.Time `json:"endTime,omitempty"`
 
+	// PercentComplete represents the progress of the async operation as a percentage between 0 and 100. It is only
+	// reported by operations that track their progress.
+	PercentComplete *float64 `json:"percentComplete,omitempty"`
+
 	// Error represents the error occurred during provisioning.
 	Error *ErrorDetails `json:"error,omitempty"`
 }
@@ -41,6 +41,15 @@ const (
 	// Used for CodeInvalidAuthenticationInfo.
 	CodeInvalidAuthenticationInfo = "InvalidAuthenticationInfo"
 
+	// Used when the caller is not authorized to perform the request.
+	CodeAuthorizationFailed = "AuthorizationFailed"
+
+	// Used when the request is throttled.
+	CodeTooManyRequests = "TooManyRequests"
+
+	// Used when the request is blocked by a management lock.
+	CodeScopeLocked = "ScopeLocked"
+
 	// Used for the cases when the precondition of a request fails.
 	CodePreconditionFailed = "PreconditionFailed"
 
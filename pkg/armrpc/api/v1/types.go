@@ -105,6 +105,9 @@ const (
 	// OperationProxy is used for controllers that proxy the underlying request without classifying the type of operation.
 	OperationProxy OperationMethod = "PROXY"
 
+	// OperationCancel is used to cancel a running asynchronous operation.
+	OperationCancel OperationMethod = "CANCEL"
+
 	Separator = "|"
 )
 
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
@@ -26,6 +27,10 @@ import (
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ErrOperationCanceled is the cause of the cancellation of the context passed to Controller.Run when the async operation
+// is canceled by the caller. Use context.Cause to distinguish it from timeouts and worker shutdown.
+var ErrOperationCanceled = errors.New("async operation was canceled by the caller")
+
 // Options represents controller options.
 type Options struct {
 	// StorageClient is the data storage client.
@@ -0,0 +1,42 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+)
+
+type progressReporterKey struct{}
+
+// ProgressReporter records the progress of the async operation being processed as a percentage between 0 and 100.
+type ProgressReporter func(ctx context.Context, percentComplete float64) error
+
+// WithProgressReporter adds the ProgressReporter for the async operation being processed to the context.
+func WithProgressReporter(ctx context.Context, reporter ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressReporterKey{}, reporter)
+}
+
+// ReportProgress records the progress of the async operation being processed using the ProgressReporter from the
+// context. It does nothing if the context has no ProgressReporter.
+func ReportProgress(ctx context.Context, percentComplete float64) error {
+	reporter, ok := ctx.Value(progressReporterKey{}).(ProgressReporter)
+	if !ok || reporter == nil {
+		return nil
+	}
+
+	return reporter(ctx, percentComplete)
+}
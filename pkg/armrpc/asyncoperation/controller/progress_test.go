@@ -0,0 +1,43 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportProgress(t *testing.T) {
+	t.Run("without reporter", func(t *testing.T) {
+		err := ReportProgress(context.Background(), 50)
+		require.NoError(t, err)
+	})
+
+	t.Run("with reporter", func(t *testing.T) {
+		reported := []float64{}
+		ctx := WithProgressReporter(context.Background(), func(ctx context.Context, percentComplete float64) error {
+			reported = append(reported, percentComplete)
+			return nil
+		})
+
+		require.NoError(t, ReportProgress(ctx, 25))
+		require.NoError(t, ReportProgress(ctx, 100))
+		require.Equal(t, []float64{25, 100}, reported)
+	})
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -56,6 +57,9 @@ type Request struct {
 
 	// OperationTimeout represents the timeout duration of async operation.
 	OperationTimeout *time.Duration `json:"asyncOperationTimeout"`
+
+	// Priority represents the priority of async operation. The operations with the higher priority are processed first.
+	Priority int `json:"priority,omitempty"`
 }
 
 // Timeout gets the operation timeout and returns the default timeout unless it specifies.
@@ -66,6 +70,17 @@ func (r *Request) Timeout() time.Duration {
 	return *r.OperationTimeout
 }
 
+// FairnessKey returns the key to schedule the async operations fairly across tenants. It is the root scope of the
+// resource, which is the resource group for the resource group scoped resources or the plane for the others.
+// It returns empty string if the resource id is invalid.
+func (r *Request) FairnessKey() string {
+	rID, err := resources.Parse(r.ResourceID)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(rID.RootScope())
+}
+
 // ARMRequestContext creates v1.ARMRequestContext object from async operation request. It returns error if the given resource id is invalid.
 func (r *Request) ARMRequestContext() (*v1.ARMRequestContext, error) {
 	rID, err := resources.Parse(r.ResourceID)
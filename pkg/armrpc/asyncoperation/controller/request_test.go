@@ -38,6 +38,37 @@ func TestTimeout(t *testing.T) {
 	require.Equal(t, testTimeout, r.Timeout())
 }
 
+func TestRequest_FairnessKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		resourceID string
+		key        string
+	}{
+		{
+			name:       "resource group scoped resource",
+			resourceID: "/planes/radius/local/resourceGroups/Test-RG/providers/Applications.Core/containers/test",
+			key:        "/planes/radius/local/resourcegroups/test-rg",
+		},
+		{
+			name:       "plane scoped resource",
+			resourceID: "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test",
+			key:        "/planes/radius/local",
+		},
+		{
+			name:       "invalid resource id",
+			resourceID: "invalid",
+			key:        "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Request{ResourceID: tt.resourceID}
+			require.Equal(t, tt.key, r.FairnessKey())
+		})
+	}
+}
+
 func TestRequest_ARMRequestContext(t *testing.T) {
 	opID := uuid.New()
 	subscriptionID := uuid.New()
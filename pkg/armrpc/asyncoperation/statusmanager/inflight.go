@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusmanager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// inFlightOperationGracePeriod is added to the timeout of an async operation to compute the time after which its
+// reservation expires. The reservation is normally released when the operation completes, the expiry only protects
+// the quota from the reservations of the operations which never complete, for example if the worker crashes.
+const inFlightOperationGracePeriod = 5 * time.Minute
+
+// InFlightOperations is the record of the async operations in progress in a root scope, such as a resource group. The
+// record is updated with its ETag, so that the concurrent requests can't reserve more than the quota.
+type InFlightOperations struct {
+	// RootScope is the root scope of the operations in lowercase.
+	RootScope string `json:"rootScope"`
+
+	// Operations maps the IDs of the operations in progress to the time at which their reservation expires.
+	Operations map[string]time.Time `json:"operations"`
+}
+
+// inFlightOperationsResourceID returns the ID of the in-flight operations record of the root scope of id.
+func (aom *statusManager) inFlightOperationsResourceID(id resources.ID) string {
+	key := sha256.Sum256([]byte(strings.ToLower(id.RootScope())))
+	return fmt.Sprintf("%s/providers/%s/inflightoperations/%s", id.PlaneScope(), strings.ToLower(id.ProviderNamespace()), hex.EncodeToString(key[:]))
+}
+
+// ReserveInFlight reserves one of the maxInFlight slots of the root scope of id for the async operation. It returns
+// false if the slots are taken, together with the number of the async operations in progress in the root scope.
+func (aom *statusManager) ReserveInFlight(ctx context.Context, id resources.ID, operationID uuid.UUID, maxInFlight int, timeout time.Duration) (bool, int, error) {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return false, 0, err
+	}
+
+	for {
+		obj, record, err := aom.getInFlightOperations(ctx, storeClient, id)
+		if err != nil {
+			return false, 0, err
+		}
+
+		now := time.Now().UTC()
+		for key, expiresAt := range record.Operations {
+			if !now.Before(expiresAt) {
+				delete(record.Operations, key)
+			}
+		}
+
+		if len(record.Operations) >= maxInFlight {
+			return false, len(record.Operations), nil
+		}
+
+		record.Operations[operationID.String()] = now.Add(timeout + inFlightOperationGracePeriod)
+		err = aom.saveInFlightOperations(ctx, storeClient, id, obj, record)
+		if errors.Is(err, &store.ErrConcurrency{}) {
+			// Another request updated the record since we read it, try again with the new record.
+			continue
+		} else if err != nil {
+			return false, 0, err
+		}
+
+		return true, len(record.Operations), nil
+	}
+}
+
+// ReleaseInFlight releases the slot reserved for the async operation. It does nothing if the operation has no slot.
+func (aom *statusManager) ReleaseInFlight(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for {
+		obj, record, err := aom.getInFlightOperations(ctx, storeClient, id)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := record.Operations[operationID.String()]; !ok {
+			return nil
+		}
+
+		delete(record.Operations, operationID.String())
+		err = aom.saveInFlightOperations(ctx, storeClient, id, obj, record)
+		if errors.Is(err, &store.ErrConcurrency{}) {
+			continue
+		}
+
+		return err
+	}
+}
+
+// getInFlightOperations reads the in-flight operations record of the root scope of id. The returned object is nil if
+// the record does not exist yet.
+func (aom *statusManager) getInFlightOperations(ctx context.Context, storeClient store.StorageClient, id resources.ID) (*store.Object, *InFlightOperations, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	record := &InFlightOperations{RootScope: strings.ToLower(id.RootScope()), Operations: map[string]time.Time{}}
+	obj, err := storeClient.Get(ctx, aom.inFlightOperationsResourceID(id))
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return nil, record, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	if err := obj.As(record); err != nil {
+		return nil, nil, err
+	}
+	if record.Operations == nil {
+		record.Operations = map[string]time.Time{}
+	}
+
+	return obj, record, nil
+}
+
+// saveInFlightOperations saves the record of the root scope of id with the ETag of obj, or creates it if obj is nil.
+// Both fail with ErrConcurrency if the record was written by another request since it was read.
+func (aom *statusManager) saveInFlightOperations(ctx context.Context, storeClient store.StorageClient, id resources.ID, obj *store.Object, record *InFlightOperations) error {
+	if obj == nil {
+		return storeClient.Save(ctx, &store.Object{Metadata: store.Metadata{ID: aom.inFlightOperationsResourceID(id)}, Data: record}, store.WithCreateOnly())
+	}
+
+	obj.Data = record
+	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+}
@@ -17,6 +17,7 @@ import (
 	uuid "github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	resources "github.com/radius-project/radius/pkg/ucp/resources"
+	store "github.com/radius-project/radius/pkg/ucp/store"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -43,6 +44,44 @@ func (m *MockStatusManager) EXPECT() *MockStatusManagerMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockStatusManager) Cancel(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockStatusManagerMockRecorder) Cancel(arg0, arg1, arg2 any) *MockStatusManagerCancelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockStatusManager)(nil).Cancel), arg0, arg1, arg2)
+	return &MockStatusManagerCancelCall{Call: call}
+}
+
+// MockStatusManagerCancelCall wrap *gomock.Call
+type MockStatusManagerCancelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerCancelCall) Return(arg0 error) *MockStatusManagerCancelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerCancelCall) Do(f func(context.Context, resources.ID, uuid.UUID) error) *MockStatusManagerCancelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerCancelCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID) error) *MockStatusManagerCancelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Delete mocks base method.
 func (m *MockStatusManager) Delete(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -158,6 +197,84 @@ func (c *MockStatusManagerQueueAsyncOperationCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// ReleaseInFlight mocks base method.
+func (m *MockStatusManager) ReleaseInFlight(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseInFlight", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseInFlight indicates an expected call of ReleaseInFlight.
+func (mr *MockStatusManagerMockRecorder) ReleaseInFlight(arg0, arg1, arg2 any) *MockStatusManagerReleaseInFlightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseInFlight", reflect.TypeOf((*MockStatusManager)(nil).ReleaseInFlight), arg0, arg1, arg2)
+	return &MockStatusManagerReleaseInFlightCall{Call: call}
+}
+
+// MockStatusManagerReleaseInFlightCall wrap *gomock.Call
+type MockStatusManagerReleaseInFlightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerReleaseInFlightCall) Return(arg0 error) *MockStatusManagerReleaseInFlightCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerReleaseInFlightCall) Do(f func(context.Context, resources.ID, uuid.UUID) error) *MockStatusManagerReleaseInFlightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerReleaseInFlightCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID) error) *MockStatusManagerReleaseInFlightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReserveInFlight mocks base method.
+func (m *MockStatusManager) ReserveInFlight(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 int, arg4 time.Duration) (bool, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveInFlight", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReserveInFlight indicates an expected call of ReserveInFlight.
+func (mr *MockStatusManagerMockRecorder) ReserveInFlight(arg0, arg1, arg2, arg3, arg4 any) *MockStatusManagerReserveInFlightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveInFlight", reflect.TypeOf((*MockStatusManager)(nil).ReserveInFlight), arg0, arg1, arg2, arg3, arg4)
+	return &MockStatusManagerReserveInFlightCall{Call: call}
+}
+
+// MockStatusManagerReserveInFlightCall wrap *gomock.Call
+type MockStatusManagerReserveInFlightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerReserveInFlightCall) Return(arg0 bool, arg1 int, arg2 error) *MockStatusManagerReserveInFlightCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerReserveInFlightCall) Do(f func(context.Context, resources.ID, uuid.UUID, int, time.Duration) (bool, int, error)) *MockStatusManagerReserveInFlightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerReserveInFlightCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID, int, time.Duration) (bool, int, error)) *MockStatusManagerReserveInFlightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m *MockStatusManager) Update(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 v1.ProvisioningState, arg4 *time.Time, arg5 *v1.ErrorDetails) error {
 	m.ctrl.T.Helper()
@@ -195,3 +312,79 @@ func (c *MockStatusManagerUpdateCall) DoAndReturn(f func(context.Context, resour
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UpdateProgress mocks base method.
+func (m *MockStatusManager) UpdateProgress(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProgress", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProgress indicates an expected call of UpdateProgress.
+func (mr *MockStatusManagerMockRecorder) UpdateProgress(arg0, arg1, arg2, arg3 any) *MockStatusManagerUpdateProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProgress", reflect.TypeOf((*MockStatusManager)(nil).UpdateProgress), arg0, arg1, arg2, arg3)
+	return &MockStatusManagerUpdateProgressCall{Call: call}
+}
+
+// MockStatusManagerUpdateProgressCall wrap *gomock.Call
+type MockStatusManagerUpdateProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerUpdateProgressCall) Return(arg0 error) *MockStatusManagerUpdateProgressCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerUpdateProgressCall) Do(f func(context.Context, resources.ID, uuid.UUID, float64) error) *MockStatusManagerUpdateProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerUpdateProgressCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID, float64) error) *MockStatusManagerUpdateProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateWithResource mocks base method.
+func (m *MockStatusManager) UpdateWithResource(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 v1.ProvisioningState, arg4 *time.Time, arg5 *v1.ErrorDetails, arg6 *store.Object) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithResource", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithResource indicates an expected call of UpdateWithResource.
+func (mr *MockStatusManagerMockRecorder) UpdateWithResource(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *MockStatusManagerUpdateWithResourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithResource", reflect.TypeOf((*MockStatusManager)(nil).UpdateWithResource), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return &MockStatusManagerUpdateWithResourceCall{Call: call}
+}
+
+// MockStatusManagerUpdateWithResourceCall wrap *gomock.Call
+type MockStatusManagerUpdateWithResourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerUpdateWithResourceCall) Return(arg0 error) *MockStatusManagerUpdateWithResourceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerUpdateWithResourceCall) Do(f func(context.Context, resources.ID, uuid.UUID, v1.ProvisioningState, *time.Time, *v1.ErrorDetails, *store.Object) error) *MockStatusManagerUpdateWithResourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerUpdateWithResourceCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID, v1.ProvisioningState, *time.Time, *v1.ErrorDetails, *store.Object) error) *MockStatusManagerUpdateWithResourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -43,4 +43,7 @@ type Status struct {
 
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime,omitempty"`
+
+	// CancelRequested is true when the caller has requested the async operation to be canceled.
+	CancelRequested bool `json:"cancelRequested,omitempty"`
 }
@@ -31,10 +31,21 @@ import (
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"github.com/google/uuid"
 )
 
+var (
+	// ErrOperationCompleted is returned when canceling an async operation that is already in a terminal state.
+	ErrOperationCompleted = errors.New("async operation has already completed")
+
+	// ErrUpdateNotAtomic is returned by UpdateWithResource when the store keeps the async operation status and the
+	// resource in different collections, which can't be written in a single transaction. Nothing is written when this
+	// error is returned.
+	ErrUpdateNotAtomic = errors.New("the store can't update the async operation status and the resource atomically")
+)
+
 // statusManager includes the necessary functions to manage asynchronous operations.
 type statusManager struct {
 	storeProvider dataprovider.DataStorageProvider
@@ -48,6 +59,8 @@ type QueueOperationOptions struct {
 	OperationTimeout time.Duration
 	// RetryAfter specifies the value of the Retry-After header that will be used for async operations.
 	RetryAfter time.Duration
+	// Priority specifies the priority of the async operation. The operations with the higher priority are processed first.
+	Priority int
 }
 
 //go:generate mockgen -typed -destination=./mock_statusmanager.go -package=statusmanager -self_package github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager StatusManager
@@ -60,8 +73,27 @@ type StatusManager interface {
 	QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error
 	// Update updates an async operation status.
 	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
+	// UpdateWithResource updates an async operation status and saves the resource in a single transaction. The resource
+	// is saved with its ETag. If resource is nil then only the async operation status is updated.
+	//
+	// The atomicity depends on the store: bolt and etcd commit both objects in a native transaction, CosmosDB commits
+	// them with a stored procedure since the resources and the operation statuses of a provider namespace share a
+	// collection and a partition key, and apiserver applies them on a best-effort basis with a rollback (see
+	// store.CommitSequential). ErrUpdateNotAtomic is returned if the store keeps them in different collections.
+	UpdateWithResource(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails, resource *store.Object) error
+	// UpdateProgress records the progress of a running async operation as a percentage between 0 and 100.
+	UpdateProgress(ctx context.Context, id resources.ID, operationID uuid.UUID, percentComplete float64) error
+	// Cancel requests the cancellation of an async operation. The operation is canceled by the worker processing it.
+	Cancel(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
+	// ReserveInFlight reserves one of the maxInFlight slots of the root scope of id for the async operation. It returns
+	// false if the slots are taken, together with the number of the async operations in progress in the root scope.
+	// The reservation is released when the operation reaches a terminal state, and expires after the timeout of the
+	// operation in case it never does.
+	ReserveInFlight(ctx context.Context, id resources.ID, operationID uuid.UUID, maxInFlight int, timeout time.Duration) (bool, int, error)
+	// ReleaseInFlight releases the slot reserved for the async operation.
+	ReleaseInFlight(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 }
 
 // New creates statusManager instance.
@@ -125,7 +157,7 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 		return err
 	}
 
-	if err = aom.queueRequestMessage(ctx, sCtx, aos, options.OperationTimeout); err != nil {
+	if err = aom.queueRequestMessage(ctx, sCtx, aos, options); err != nil {
 		delErr := storeClient.Delete(ctx, opID)
 		if delErr != nil {
 			return delErr
@@ -159,25 +191,114 @@ func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID
 }
 
 // Update retrieves an existing operation status resource from the store, updates its fields with the
-// given parameters, and saves it back to the store.
+// given parameters, and saves it back to the store. The in-flight slot of the operation is released when it reaches a
+// terminal state.
 func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
-	opID := aom.operationStatusResourceID(id, operationID)
 	storeClient, err := aom.getClient(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	obj, err := storeClient.Get(ctx, opID)
+	obj, err := aom.updatedStatus(ctx, storeClient, id, operationID, state, endTime, opError)
+	if err != nil {
+		return err
+	}
+
+	if err := storeClient.Save(ctx, obj, store.WithETag(obj.ETag)); err != nil {
+		return err
+	}
+
+	aom.releaseCompleted(ctx, id, operationID, state)
+	return nil
+}
+
+// UpdateWithResource retrieves an existing operation status resource from the store, updates its fields with the
+// given parameters, and commits it together with the resource in a single transaction. If the store keeps the
+// operation status and the resource in different collections then ErrUpdateNotAtomic is returned without writing
+// anything. The in-flight slot of the operation is released when it reaches a terminal state.
+func (aom *statusManager) UpdateWithResource(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails, resource *store.Object) error {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := aom.updatedStatus(ctx, storeClient, id, operationID, state, endTime, opError)
 	if err != nil {
 		return err
 	}
 
+	if resource == nil {
+		err = storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+	} else {
+		if err := aom.checkSameCollection(ctx, storeClient, id); err != nil {
+			return err
+		}
+
+		tx := &store.Transaction{}
+		tx.Save(resource, store.WithETag(resource.ETag))
+		tx.Save(obj, store.WithETag(obj.ETag))
+		err = store.Commit(ctx, storeClient, tx)
+	}
+	if err != nil {
+		return err
+	}
+
+	aom.releaseCompleted(ctx, id, operationID, state)
+	return nil
+}
+
+// checkSameCollection returns ErrUpdateNotAtomic if the store keeps the operation statuses and the resource with the
+// given ID in different collections, since a transaction can only write the objects of a single collection.
+func (aom *statusManager) checkSameCollection(ctx context.Context, storeClient store.StorageClient, id resources.ID) error {
+	statusCollection, ok := storeClient.(store.CollectionScoped)
+	if !ok {
+		return nil
+	}
+
+	resourceClient, err := aom.storeProvider.GetStorageClient(ctx, id.Type())
+	if err != nil {
+		return err
+	}
+
+	resourceCollection, ok := resourceClient.(store.CollectionScoped)
+	if !ok || !strings.EqualFold(resourceCollection.Collection(), statusCollection.Collection()) {
+		return ErrUpdateNotAtomic
+	}
+
+	return nil
+}
+
+// releaseCompleted releases the in-flight slot of the operation if it reached a terminal state. A failure is only
+// logged, since the status is already saved and the reservation expires anyway.
+func (aom *statusManager) releaseCompleted(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState) {
+	if !state.IsTerminal() {
+		return
+	}
+
+	if err := aom.ReleaseInFlight(ctx, id, operationID); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to release the in-flight slot of the async operation", "operationID", operationID.String())
+	}
+}
+
+// updatedStatus retrieves the operation status object and applies the given parameters to it.
+func (aom *statusManager) updatedStatus(ctx context.Context, storeClient store.StorageClient, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) (*store.Object, error) {
+	obj, err := storeClient.Get(ctx, aom.operationStatusResourceID(id, operationID))
+	if err != nil {
+		return nil, err
+	}
+
 	s := &Status{}
 	if err := obj.As(s); err != nil {
-		return err
+		return nil, err
 	}
 
 	s.Status = state
+	if !state.IsTerminal() {
+		// The operation is running again, for example after its message was requeued from the dead-letter queue.
+		s.EndTime = nil
+		s.Error = nil
+	}
+
 	if endTime != nil {
 		s.EndTime = endTime
 	}
@@ -190,6 +311,70 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 
 	obj.Data = s
 
+	return obj, nil
+}
+
+// Cancel marks the operation status resource as cancel requested. It returns ErrOperationCompleted if the operation is
+// already in a terminal state.
+func (aom *statusManager) Cancel(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := storeClient.Get(ctx, aom.operationStatusResourceID(id, operationID))
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	if s.Status.IsTerminal() {
+		return ErrOperationCompleted
+	}
+
+	s.CancelRequested = true
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
+
+	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+}
+
+// UpdateProgress retrieves an existing operation status resource from the store, sets its progress and saves it
+// back to the store. The progress of an operation that is already in a terminal state is not updated.
+func (aom *statusManager) UpdateProgress(ctx context.Context, id resources.ID, operationID uuid.UUID, percentComplete float64) error {
+	if percentComplete < 0 || percentComplete > 100 {
+		return fmt.Errorf("percentComplete must be between 0 and 100, got %v", percentComplete)
+	}
+
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := storeClient.Get(ctx, aom.operationStatusResourceID(id, operationID))
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	if s.Status.IsTerminal() {
+		return ErrOperationCompleted
+	}
+
+	s.PercentComplete = &percentComplete
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
+
 	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
 }
 
@@ -204,7 +389,8 @@ func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operation
 }
 
 // queueRequestMessage function is to put the async operation message to the queue to be worked on.
-func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, operationTimeout time.Duration) error {
+func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, options QueueOperationOptions) error {
+	operationTimeout := options.OperationTimeout
 	msg := &ctrl.Request{
 		APIVersion:       sCtx.APIVersion,
 		OperationID:      sCtx.OperationID,
@@ -216,7 +402,8 @@ func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMR
 		HomeTenantID:     sCtx.HomeTenantID,
 		ClientObjectID:   sCtx.ClientObjectID,
 		OperationTimeout: &operationTimeout,
+		Priority:         options.Priority,
 	}
 
-	return aom.queue.Enqueue(ctx, queue.NewMessage(msg))
+	return aom.queue.Enqueue(ctx, queue.NewMessage(msg), queue.WithPriority(msg.Priority), queue.WithFairnessKey(msg.FairnessKey()))
 }
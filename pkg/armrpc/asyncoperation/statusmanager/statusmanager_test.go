@@ -19,6 +19,8 @@ package statusmanager
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -29,6 +31,7 @@ import (
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -328,3 +331,344 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestCancelAsyncOperationStatus(t *testing.T) {
+	cancelCases := []struct {
+		Desc        string
+		State       v1.ProvisioningState
+		ExpectedErr error
+	}{
+		{
+			Desc:  "cancel_running_operation",
+			State: v1.ProvisioningStateUpdating,
+		},
+		{
+			Desc:        "cancel_completed_operation",
+			State:       v1.ProvisioningStateSucceeded,
+			ExpectedErr: ErrOperationCompleted,
+		},
+	}
+
+	for _, tt := range cancelCases {
+		t.Run(tt.Desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			aomTest.storeClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&store.Object{
+					Metadata: store.Metadata{ID: opID.String(), ETag: "etag"},
+					Data:     &Status{AsyncOperationStatus: v1.AsyncOperationStatus{Status: tt.State}},
+				}, nil)
+
+			if tt.ExpectedErr == nil {
+				aomTest.storeClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						require.True(t, obj.Data.(*Status).CancelRequested)
+						require.Equal(t, store.ETag("etag"), store.NewSaveConfig(options...).ETag)
+						return nil
+					})
+			}
+
+			rid, err := resources.ParseResource(azureEnvResourceID)
+			require.NoError(t, err)
+			err = aomTest.manager.Cancel(context.TODO(), rid, opID)
+			if tt.ExpectedErr != nil {
+				require.ErrorIs(t, err, tt.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateProgressAsyncOperationStatus(t *testing.T) {
+	progressCases := []struct {
+		Desc            string
+		State           v1.ProvisioningState
+		PercentComplete float64
+		ExpectedErr     error
+	}{
+		{
+			Desc:            "update_running_operation",
+			State:           v1.ProvisioningStateUpdating,
+			PercentComplete: 50,
+		},
+		{
+			Desc:            "update_completed_operation",
+			State:           v1.ProvisioningStateSucceeded,
+			PercentComplete: 50,
+			ExpectedErr:     ErrOperationCompleted,
+		},
+	}
+
+	for _, tt := range progressCases {
+		t.Run(tt.Desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			aomTest.storeClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&store.Object{
+					Metadata: store.Metadata{ID: opID.String(), ETag: "etag"},
+					Data:     &Status{AsyncOperationStatus: v1.AsyncOperationStatus{Status: tt.State}},
+				}, nil)
+
+			if tt.ExpectedErr == nil {
+				aomTest.storeClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						require.Equal(t, tt.PercentComplete, *obj.Data.(*Status).PercentComplete)
+						require.Equal(t, store.ETag("etag"), store.NewSaveConfig(options...).ETag)
+						return nil
+					})
+			}
+
+			rid, err := resources.ParseResource(azureEnvResourceID)
+			require.NoError(t, err)
+			err = aomTest.manager.UpdateProgress(context.TODO(), rid, opID, tt.PercentComplete)
+			if tt.ExpectedErr != nil {
+				require.ErrorIs(t, err, tt.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid_percent_complete", func(t *testing.T) {
+		aom := New(nil, nil, "test-location")
+
+		rid, err := resources.ParseResource(azureEnvResourceID)
+		require.NoError(t, err)
+		err = aom.UpdateProgress(context.TODO(), rid, opID, 101)
+		require.Error(t, err)
+	})
+}
+
+func TestUpdateAsyncOperationStatusWithResource(t *testing.T) {
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = boltstore.Close(db) })
+
+	sc, err := boltstore.NewBoltClient(db)
+	require.NoError(t, err)
+
+	mctrl := gomock.NewController(t)
+	dp := dataprovider.NewMockDataStorageProvider(mctrl)
+	dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(sc, nil).AnyTimes()
+	manager := New(dp, nil, "test-location")
+
+	ctx := context.Background()
+	rid, err := resources.ParseResource(ucpEnvResourceID)
+	require.NoError(t, err)
+
+	setupObjects := func(t *testing.T) *store.Object {
+		status := &store.Object{
+			Metadata: store.Metadata{ID: manager.(*statusManager).operationStatusResourceID(rid, opID)},
+			Data:     &Status{AsyncOperationStatus: v1.AsyncOperationStatus{Status: v1.ProvisioningStateUpdating}},
+		}
+		require.NoError(t, sc.Save(ctx, status))
+
+		resource := &store.Object{
+			Metadata: store.Metadata{ID: rid.String()},
+			Data:     map[string]any{"provisioningState": string(v1.ProvisioningStateUpdating)},
+		}
+		require.NoError(t, sc.Save(ctx, resource))
+		return resource
+	}
+
+	t.Run("commits resource and status", func(t *testing.T) {
+		resource := setupObjects(t)
+		resource.Data = map[string]any{"provisioningState": string(v1.ProvisioningStateSucceeded)}
+
+		err := manager.UpdateWithResource(ctx, rid, opID, v1.ProvisioningStateSucceeded, nil, nil, resource)
+		require.NoError(t, err)
+
+		status, err := manager.Get(ctx, rid, opID)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateSucceeded, status.Status)
+
+		obj, err := sc.Get(ctx, rid.String())
+		require.NoError(t, err)
+		require.Equal(t, string(v1.ProvisioningStateSucceeded), obj.Data.(map[string]any)["provisioningState"])
+	})
+
+	t.Run("stale resource applies nothing", func(t *testing.T) {
+		resource := setupObjects(t)
+		resource.ETag = "stale"
+		resource.Data = map[string]any{"provisioningState": string(v1.ProvisioningStateFailed)}
+
+		err := manager.UpdateWithResource(ctx, rid, opID, v1.ProvisioningStateFailed, nil, nil, resource)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		status, err := manager.Get(ctx, rid, opID)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateUpdating, status.Status)
+
+		obj, err := sc.Get(ctx, rid.String())
+		require.NoError(t, err)
+		require.Equal(t, string(v1.ProvisioningStateUpdating), obj.Data.(map[string]any)["provisioningState"])
+	})
+
+	t.Run("collection scoped store commits in the shared collection", func(t *testing.T) {
+		resource := setupObjects(t)
+		resource.Data = map[string]any{"provisioningState": string(v1.ProvisioningStateSucceeded)}
+
+		dp := dataprovider.NewMockDataStorageProvider(mctrl)
+		dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(&collectionScopedClient{sc, "applications.core"}, nil).AnyTimes()
+		dp.EXPECT().GetStorageClient(gomock.Any(), rid.Type()).Return(&collectionScopedClient{sc, "Applications.Core"}, nil)
+		manager := New(dp, nil, "test-location")
+
+		err := manager.UpdateWithResource(ctx, rid, opID, v1.ProvisioningStateSucceeded, nil, nil, resource)
+		require.NoError(t, err)
+
+		obj, err := sc.Get(ctx, rid.String())
+		require.NoError(t, err)
+		require.Equal(t, string(v1.ProvisioningStateSucceeded), obj.Data.(map[string]any)["provisioningState"])
+	})
+
+	t.Run("collection scoped store with separate collections applies nothing", func(t *testing.T) {
+		resource := setupObjects(t)
+		resource.Data = map[string]any{"provisioningState": string(v1.ProvisioningStateSucceeded)}
+
+		dp := dataprovider.NewMockDataStorageProvider(mctrl)
+		dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(&collectionScopedClient{sc, "applications.core/operationstatuses"}, nil)
+		dp.EXPECT().GetStorageClient(gomock.Any(), rid.Type()).Return(&collectionScopedClient{sc, "applications.core/environments"}, nil)
+		manager := New(dp, nil, "test-location")
+
+		err := manager.UpdateWithResource(ctx, rid, opID, v1.ProvisioningStateSucceeded, nil, nil, resource)
+		require.ErrorIs(t, err, ErrUpdateNotAtomic)
+
+		obj, err := sc.Get(ctx, rid.String())
+		require.NoError(t, err)
+		require.Equal(t, string(v1.ProvisioningStateUpdating), obj.Data.(map[string]any)["provisioningState"])
+	})
+}
+
+// collectionScopedClient is a storage client that can only write the objects of its collection, like CosmosDB.
+type collectionScopedClient struct {
+	store.StorageClient
+	collection string
+}
+
+func (c *collectionScopedClient) Collection() string {
+	return c.collection
+}
+
+func newInFlightTestManager(t *testing.T) StatusManager {
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = boltstore.Close(db) })
+
+	sc, err := boltstore.NewBoltClient(db)
+	require.NoError(t, err)
+
+	mctrl := gomock.NewController(t)
+	dp := dataprovider.NewMockDataStorageProvider(mctrl)
+	dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(sc, nil).AnyTimes()
+	q := queue.NewMockClient(mctrl)
+	q.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return New(dp, q, "test-location")
+}
+
+func TestReserveInFlight(t *testing.T) {
+	manager := newInFlightTestManager(t)
+	ctx := context.Background()
+	rid := resources.MustParse(ucpEnvResourceID)
+
+	queueOperation := func(t *testing.T, id string) *v1.ARMRequestContext {
+		sCtx := &v1.ARMRequestContext{
+			ResourceID:    resources.MustParse(id),
+			OperationID:   uuid.New(),
+			OperationType: rpctest.MustParseOperationType("APPLICATIONS.CORE/ENVIRONMENTS|PUT"),
+		}
+		reserved, _, err := manager.ReserveInFlight(ctx, sCtx.ResourceID, sCtx.OperationID, 2, operationTimeoutDuration)
+		require.NoError(t, err)
+		require.True(t, reserved)
+		require.NoError(t, manager.QueueAsyncOperation(ctx, sCtx, QueueOperationOptions{OperationTimeout: operationTimeoutDuration}))
+		return sCtx
+	}
+
+	first := queueOperation(t, ucpEnvResourceID)
+	_ = queueOperation(t, "/planes/radius/local/resourceGroups/RADIUS-TEST-RG/providers/Applications.Core/environments/env1")
+	_ = queueOperation(t, "/planes/radius/local/resourceGroups/other-rg/providers/Applications.Core/environments/env0")
+
+	// The slots of the resource group are taken.
+	reserved, count, err := manager.ReserveInFlight(ctx, rid, uuid.New(), 2, operationTimeoutDuration)
+	require.NoError(t, err)
+	require.False(t, reserved)
+	require.Equal(t, 2, count)
+
+	// Completing an operation releases its slot.
+	err = manager.Update(ctx, first.ResourceID, first.OperationID, v1.ProvisioningStateSucceeded, nil, nil)
+	require.NoError(t, err)
+
+	reserved, count, err = manager.ReserveInFlight(ctx, rid, uuid.New(), 2, operationTimeoutDuration)
+	require.NoError(t, err)
+	require.True(t, reserved)
+	require.Equal(t, 2, count)
+
+	// Releasing an operation without a slot does nothing.
+	require.NoError(t, manager.ReleaseInFlight(ctx, rid, uuid.New()))
+	require.NoError(t, manager.ReleaseInFlight(ctx, resources.MustParse("/planes/radius/local/resourceGroups/empty-rg/providers/Applications.Core/environments/env0"), uuid.New()))
+}
+
+func TestReserveInFlight_Expired(t *testing.T) {
+	manager := newInFlightTestManager(t)
+	ctx := context.Background()
+	rid := resources.MustParse(ucpEnvResourceID)
+
+	// The reservation expires immediately.
+	reserved, _, err := manager.ReserveInFlight(ctx, rid, uuid.New(), 1, -inFlightOperationGracePeriod)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	reserved, count, err := manager.ReserveInFlight(ctx, rid, uuid.New(), 1, operationTimeoutDuration)
+	require.NoError(t, err)
+	require.True(t, reserved)
+	require.Equal(t, 1, count)
+}
+
+func TestReserveInFlight_Concurrent(t *testing.T) {
+	manager := newInFlightTestManager(t)
+	ctx := context.Background()
+	rid := resources.MustParse(ucpEnvResourceID)
+
+	const (
+		requests    = 20
+		maxInFlight = 3
+	)
+
+	results := make(chan bool, requests)
+	errs := make(chan error, requests)
+	wg := sync.WaitGroup{}
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reserved, _, err := manager.ReserveInFlight(ctx, rid, uuid.New(), maxInFlight, operationTimeoutDuration)
+			results <- reserved
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(results)
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	reserved := 0
+	for r := range results {
+		if r {
+			reserved++
+		}
+	}
+	require.Equal(t, maxInFlight, reserved)
+}
@@ -41,15 +41,17 @@ type Service struct {
 	Controllers *ControllerRegistry
 	// RequestQueue is the queue client for async operation request message.
 	RequestQueue queue.Client
+
+	queueProvider *qprovider.QueueProvider
 }
 
 // Init initializes worker service - it initializes the StorageProvider, RequestQueue, OperationStatusManager, Controllers, KubeClient and
 // returns an error if any of these operations fail.
 func (s *Service) Init(ctx context.Context) error {
 	s.StorageProvider = dataprovider.NewStorageProvider(s.Options.Config.StorageProvider)
-	qp := qprovider.New(s.Options.Config.QueueProvider)
+	s.queueProvider = qprovider.New(s.Options.Config.QueueProvider)
 	var err error
-	s.RequestQueue, err = qp.GetClient(ctx)
+	s.RequestQueue, err = s.queueProvider.GetClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -72,5 +74,22 @@ func (s *Service) Start(ctx context.Context, opt Options) error {
 	}
 
 	logger.Info("Worker stopped...")
+	s.close(ctx)
 	return nil
 }
+
+// close closes the storage and queue providers once the worker has stopped, which releases the resources they hold,
+// such as the file of the bolt database.
+func (s *Service) close(ctx context.Context) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if s.StorageProvider != nil {
+		if err := s.StorageProvider.Close(); err != nil {
+			logger.Error(err, "failed to close the storage provider")
+		}
+	}
+	if s.queueProvider != nil {
+		if err := s.queueProvider.Close(); err != nil {
+			logger.Error(err, "failed to close the queue provider")
+		}
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/metrics"
+)
+
+// tenantTracker tracks the in-flight async operations and the queue depth of each tenant. The tenant of an
+// operation is the fairness key of its queue message, which is the resource group or the plane of the resource.
+type tenantTracker struct {
+	mu sync.Mutex
+
+	// maxConcurrency is the maximum number of in-flight operations of each tenant. 0 means unlimited.
+	maxConcurrency int
+	// inflight is the number of in-flight operations of each tenant.
+	inflight map[string]int
+	// queued is the set of tenants which had queued operations when the queue depth was observed last time.
+	queued map[string]struct{}
+}
+
+func newTenantTracker(maxConcurrency int) *tenantTracker {
+	return &tenantTracker{
+		maxConcurrency: maxConcurrency,
+		inflight:       map[string]int{},
+		queued:         map[string]struct{}{},
+	}
+}
+
+// allow reports whether the new operation of the tenant can be dequeued. This is used as the fairness key filter
+// of the dequeuer. The limit is best-effort because the dequeuer may prefetch a message before the in-flight
+// operations of the tenant are counted.
+func (t *tenantTracker) allow(tenant string) bool {
+	if t.maxConcurrency <= 0 {
+		return true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.inflight[tenant] < t.maxConcurrency
+}
+
+// start counts the new in-flight operation of the tenant.
+func (t *tenantTracker) start(ctx context.Context, tenant string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.inflight[tenant]++
+	metrics.DefaultAsyncOperationMetrics.RecordTenantInFlightOperation(ctx, tenant, t.inflight[tenant])
+}
+
+// done uncounts the finished in-flight operation of the tenant.
+func (t *tenantTracker) done(ctx context.Context, tenant string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.inflight[tenant]--
+	metrics.DefaultAsyncOperationMetrics.RecordTenantInFlightOperation(ctx, tenant, t.inflight[tenant])
+	if t.inflight[tenant] <= 0 {
+		delete(t.inflight, tenant)
+	}
+}
+
+// observeQueueDepth records the queue depth of each tenant. The tenants which no longer have queued operations
+// are recorded as 0.
+func (t *tenantTracker) observeQueueDepth(ctx context.Context, depths map[string]int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for tenant := range t.queued {
+		if _, ok := depths[tenant]; !ok {
+			metrics.DefaultAsyncOperationMetrics.RecordTenantQueueDepth(ctx, tenant, 0)
+			delete(t.queued, tenant)
+		}
+	}
+
+	for tenant, depth := range depths {
+		metrics.DefaultAsyncOperationMetrics.RecordTenantQueueDepth(ctx, tenant, depth)
+		t.queued[tenant] = struct{}{}
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantTracker_Allow(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	t.Run("unlimited", func(t *testing.T) {
+		tracker := newTenantTracker(0)
+		for i := 0; i < 10; i++ {
+			tracker.start(ctx, "rg1")
+		}
+		require.True(t, tracker.allow("rg1"))
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		tracker := newTenantTracker(2)
+		tracker.start(ctx, "rg1")
+		require.True(t, tracker.allow("rg1"))
+		tracker.start(ctx, "rg1")
+		require.False(t, tracker.allow("rg1"))
+		require.True(t, tracker.allow("rg2"))
+
+		tracker.done(ctx, "rg1")
+		require.True(t, tracker.allow("rg1"))
+		tracker.done(ctx, "rg1")
+		require.Empty(t, tracker.inflight)
+	})
+}
+
+func TestTenantTracker_ObserveQueueDepth(t *testing.T) {
+	ctx := testcontext.New(t)
+	tracker := newTenantTracker(0)
+
+	tracker.observeQueueDepth(ctx, map[string]int{"rg1": 3, "rg2": 1})
+	require.Equal(t, map[string]struct{}{"rg1": {}, "rg2": {}}, tracker.queued)
+
+	tracker.observeQueueDepth(ctx, map[string]int{"rg2": 1})
+	require.Equal(t, map[string]struct{}{"rg2": {}}, tracker.queued)
+
+	tracker.observeQueueDepth(ctx, map[string]int{})
+	require.Empty(t, tracker.queued)
+}
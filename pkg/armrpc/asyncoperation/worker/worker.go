@@ -58,6 +58,9 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultCancellationPollingInterval is the default interval for checking if a running operation has been canceled.
+	defaultCancellationPollingInterval = time.Duration(5) * time.Second
 )
 
 // Options configures AsyncRequestProcessorWorker
@@ -65,6 +68,11 @@ type Options struct {
 	// MaxOperationConcurrency is the maximum concurrency to process async request operation.
 	MaxOperationConcurrency int
 
+	// MaxOperationConcurrencyPerTenant is the maximum concurrency to process async request operations of each tenant,
+	// which is the resource group or the plane of the resource. The operations of the tenant which reached the limit
+	// are left in the queue so that they do not take all of MaxOperationConcurrency. 0 means unlimited.
+	MaxOperationConcurrencyPerTenant int
+
 	// MaxOperationRetryCount is the maximum retry count to process async request operation.
 	MaxOperationRetryCount int
 
@@ -79,6 +87,9 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// CancellationPollingInterval is the interval for checking if a running operation has been canceled.
+	CancellationPollingInterval time.Duration
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -88,7 +99,8 @@ type AsyncRequestProcessWorker struct {
 	registry     *ControllerRegistry
 	requestQueue queue.Client
 
-	sem *semaphore.Weighted
+	sem     *semaphore.Weighted
+	tenants *tenantTracker
 }
 
 // New creates AsyncRequestProcessWorker server instance.
@@ -115,6 +127,9 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.CancellationPollingInterval == 0 {
+		options.CancellationPollingInterval = defaultCancellationPollingInterval
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
@@ -122,14 +137,21 @@ func New(
 		registry:     ctrlRegistry,
 		requestQueue: qu,
 		sem:          semaphore.NewWeighted(int64(options.MaxOperationConcurrency)),
+		tenants:      newTenantTracker(options.MaxOperationConcurrencyPerTenant),
 	}
 }
 
 // Start starts worker's message loop - it starts a loop to process messages from a queue concurrently, and handles deduplication, updating
-// resource and operation status, and running the operation. It returns an error if it fails to start the dequeuer.
+// resource and operation status, and running the operation. Messages are dequeued by priority and fairly across tenants, skipping the
+// tenants which reached MaxOperationConcurrencyPerTenant. It returns an error if it fails to start the dequeuer.
 func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
-	msgCh, err := queue.StartDequeuer(ctx, w.requestQueue, queue.WithDequeueInterval(w.options.DequeueIntervalDuration))
+	msgCh, err := queue.StartDequeuer(ctx, w.requestQueue,
+		queue.WithDequeueInterval(w.options.DequeueIntervalDuration),
+		queue.WithFairnessKeyFilter(w.tenants.allow),
+		queue.WithQueueDepthObserver(func(depths map[string]int) {
+			w.tenants.observeQueueDepth(ctx, depths)
+		}))
 	if err != nil {
 		return err
 	}
@@ -140,9 +162,11 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 		if err := w.sem.Acquire(ctx, 1); err != nil {
 			break
 		}
+		w.tenants.start(ctx, msg.FairnessKey)
 
 		go func(msgreq *queue.Message) {
 			defer w.sem.Release(1)
+			defer w.tenants.done(ctx, msgreq.FairnessKey)
 
 			op := &ctrl.Request{}
 			if err := json.Unmarshal(msgreq.Data, op); err != nil {
@@ -184,7 +208,7 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 					Code:    v1.CodeInternal,
 					Message: errMsg,
 				})
-				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl.StorageClient())
+				w.deadLetterOperation(reqCtx, msgreq, op, failed, asyncCtrl.StorageClient())
 				return
 			}
 
@@ -192,15 +216,20 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 			// 1. The same message is delivered twice in multiple instances.
 			// 2. provisioningState is not matched between resource and operationStatuses
 
-			dup, err := w.isDuplicated(reqCtx, op.ResourceID, op.OperationID)
+			status, err := w.getOperationStatus(reqCtx, op.ResourceID, op.OperationID)
 			if err != nil {
 				opLogger.Error(err, "failed to check potential deduplication.")
 				return
 			}
-			if dup {
+			if w.isDuplicated(status) && !isReplayed(msgreq) {
 				opLogger.Info("duplicated message detected")
 				return
 			}
+			if status.CancelRequested {
+				opLogger.Info("operation was canceled before it started")
+				w.completeOperation(reqCtx, msgreq, canceledResult(op), asyncCtrl.StorageClient())
+				return
+			}
 
 			if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.StorageClient(), op, v1.ProvisioningStateUpdating, nil); err != nil {
 				return
@@ -224,11 +253,12 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		logger.Error(err, "failed to unmarshal queue message.")
 		return
 	}
-	asyncReqCtx, opCancel := context.WithCancel(ctx)
+	asyncReqCtx, opCancel := context.WithCancelCause(ctx)
+	asyncReqCtx = ctrl.WithProgressReporter(asyncReqCtx, w.progressReporter(asyncReq))
 	// Ensure that asyncReqCtx context is cancelled when runOperation returns.
 	// That is, cancelling asyncReqCtx signals to ctrl.Run() to cancel the execution,
 	// resulting in completing the go-routine calling ctrl.Run() when runOperation returns.
-	defer opCancel()
+	defer opCancel(nil)
 
 	opDone := make(chan struct{}, 1)
 	opStartAt := time.Now()
@@ -261,9 +291,10 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 
 		logger.Info("Operation returned", "success", result.Error == nil, "provisioningState", result.ProvisioningState(), "err", result.Error)
 
-		// There are two cases when asyncReqCtx is canceled.
-		// 1. When the operation is timed out, w.completeOperation will be called in L186
-		// 2. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
+		// There are three cases when asyncReqCtx is canceled.
+		// 1. When the operation is timed out, w.completeOperation will be called by the timeout handler below.
+		// 2. When the operation is canceled by the caller, w.completeOperation will be called by the cancellation handler below.
+		// 3. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
 		// Such cases should not call w.completeOperation.
 		if !errors.Is(asyncReqCtx.Err(), context.Canceled) {
 			w.completeOperation(ctx, message, result, asyncCtrl.StorageClient())
@@ -272,29 +303,42 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}()
 
 	operationTimeoutAfter := time.After(asyncReq.Timeout())
-	messageExtendAfter := w.getMessageExtendDuration(message.NextVisibleAt)
+	messageExtendAfter := time.After(w.getMessageExtendDuration(message.NextVisibleAt))
+
+	cancellationPoll := time.NewTicker(w.options.CancellationPollingInterval)
+	defer cancellationPoll.Stop()
 
 	for {
 		select {
-		case <-time.After(messageExtendAfter):
+		case <-messageExtendAfter:
 			if err := w.requestQueue.ExtendMessage(ctx, message); err != nil {
 				logger.Error(err, "fails to extend message lock")
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
 			}
-			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
+			messageExtendAfter = time.After(w.getMessageExtendDuration(message.NextVisibleAt))
 
 		case <-operationTimeoutAfter:
 			logger.Info("Cancelling async operation.")
 
-			opCancel()
+			opCancel(nil)
 			errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
 			result := ctrl.NewCanceledResult(errMessage)
 			result.Error.Target = asyncReq.ResourceID
 			w.completeOperation(ctx, message, result, asyncCtrl.StorageClient())
 			return
 
+		case <-cancellationPoll.C:
+			if !w.isCancelRequested(ctx, asyncReq) {
+				continue
+			}
+
+			logger.Info("Cancelling async operation as requested by the caller.")
+			opCancel(ctrl.ErrOperationCanceled)
+			w.completeOperation(ctx, message, canceledResult(asyncReq), asyncCtrl.StorageClient())
+			return
+
 		case <-ctx.Done():
 			logger.Info("Stopping processing async operation. This operation will be reprocessed.")
 			return
@@ -342,6 +386,26 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
 }
 
+// deadLetterOperation marks the operation as failed and moves the message to the dead-letter queue instead of finishing
+// it, so that the original request can be inspected and requeued after the underlying problem is fixed.
+func (w *AsyncRequestProcessWorker) deadLetterOperation(ctx context.Context, message *queue.Message, req *ctrl.Request, result ctrl.Result, sc store.StorageClient) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error)
+	if err != nil {
+		logger.Error(err, "failed to update resource and/or operation status")
+		return
+	}
+
+	if err := w.requestQueue.DeadLetterMessage(ctx, message, result.Error.Message); err != nil {
+		logger.Error(err, "failed to move the message to the dead-letter queue")
+		return
+	}
+
+	metrics.DefaultAsyncOperationMetrics.RecordDeadLetteredAsyncOperation(ctx, req)
+	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+}
+
 func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc store.StorageClient, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -351,45 +415,78 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 		return err
 	}
 
-	err = updateResourceState(ctx, sc, rID.String(), state)
+	resource, err := resourceWithState(ctx, sc, rID.String(), state)
 	if errors.Is(err, &store.ErrNotFound{}) {
 		logger.Info("failed to update the provisioningState in resource because it no longer exists.")
 	} else if err != nil {
-		logger.Error(err, "failed to update the provisioningState in resource.")
+		logger.Error(err, "failed to get the resource to update the provisioningState.")
 		return err
 	}
 
-	// Otherwise we update the operationStatus to the result.
+	// Update the provisioningState of the resource and the operationStatus to the result in a single transaction, so that
+	// they can't be left out of sync if the worker stops in between.
 	now := time.Now().UTC()
-	err = w.sm.Update(ctx, rID, req.OperationID, state, &now, opErr)
+	err = w.sm.UpdateWithResource(ctx, rID, req.OperationID, state, &now, opErr, resource)
 	if err != nil {
-		logger.Error(err, "failed to update operationstatus", "operationID", req.OperationID.String())
+		logger.Error(err, "failed to update the provisioningState in resource and operationstatus", "operationID", req.OperationID.String())
 		return err
 	}
 
 	return nil
 }
 
-func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, resourceID string, operationID uuid.UUID) (bool, error) {
-	rID, err := resources.ParseResource(resourceID)
-	if err != nil {
-		return false, err
+// progressReporter returns the ctrl.ProgressReporter that records the progress of the given async operation in its
+// operation status.
+func (w *AsyncRequestProcessWorker) progressReporter(req *ctrl.Request) ctrl.ProgressReporter {
+	return func(ctx context.Context, percentComplete float64) error {
+		rID, err := resources.ParseResource(req.ResourceID)
+		if err != nil {
+			return err
+		}
+
+		return w.sm.UpdateProgress(ctx, rID, req.OperationID, percentComplete)
 	}
+}
 
-	status, err := w.sm.Get(ctx, rID, operationID)
+func (w *AsyncRequestProcessWorker) getOperationStatus(ctx context.Context, resourceID string, operationID uuid.UUID) (*manager.Status, error) {
+	rID, err := resources.ParseResource(resourceID)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
+	return w.sm.Get(ctx, rID, operationID)
+}
+
+func (w *AsyncRequestProcessWorker) isDuplicated(status *manager.Status) bool {
 	// 1. If the operation is in updating state and the last updated time is within the deduplication duration, we consider it as a duplicated operation.
 	// 2. If the operation is in terminal state, we consider it as a duplicated operation.
-	if (status.Status == v1.ProvisioningStateUpdating && status.LastUpdatedTime.IsZero() &&
+	return (status.Status == v1.ProvisioningStateUpdating && status.LastUpdatedTime.IsZero() &&
 		status.LastUpdatedTime.Add(w.options.DeduplicationDuration).After(time.Now().UTC())) ||
-		status.Status.IsTerminal() {
-		return true, nil
+		status.Status.IsTerminal()
+}
+
+// isReplayed returns true if the message was requeued from the dead-letter queue and is being processed for the first
+// time since then. The operation of a replayed message is already in a terminal state, so it must not be treated as a
+// duplicated message.
+func isReplayed(msg *queue.Message) bool {
+	return msg.ReplayCount > 0 && msg.DequeueCount == 1
+}
+
+// isCancelRequested returns true if the caller has requested the cancellation of the operation.
+func (w *AsyncRequestProcessWorker) isCancelRequested(ctx context.Context, req *ctrl.Request) bool {
+	status, err := w.getOperationStatus(ctx, req.ResourceID, req.OperationID)
+	if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to check if the operation has been canceled")
+		return false
 	}
 
-	return false, nil
+	return status.CancelRequested
+}
+
+func canceledResult(req *ctrl.Request) ctrl.Result {
+	result := ctrl.NewCanceledResult(fmt.Sprintf("Operation (%s) has been canceled by the caller.", req.OperationType))
+	result.Error.Target = req.ResourceID
+	return result
 }
 
 func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time) time.Duration {
@@ -400,10 +497,12 @@ func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time
 	return d
 }
 
-func updateResourceState(ctx context.Context, sc store.StorageClient, id string, state v1.ProvisioningState) error {
+// resourceWithState gets the resource and sets its provisioningState to the given state. It returns nil if the resource is
+// already in the given state.
+func resourceWithState(ctx context.Context, sc store.StorageClient, id string, state v1.ProvisioningState) (*store.Object, error) {
 	obj, err := sc.Get(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	objmap := obj.Data.(map[string]any)
@@ -412,15 +511,10 @@ func updateResourceState(ctx context.Context, sc store.StorageClient, id string,
 		// Do not update it if provisioning state is already the target state.
 		// This happens when redeploying worker can stop completing message.
 		// So, provisioningState in Resource is updated but not in operationStatus record.
-		return nil
+		return nil, nil
 	}
 
 	objmap["provisioningState"] = string(state)
 
-	err = sc.Save(ctx, obj, store.WithETag(obj.ETag))
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return obj, nil
 }
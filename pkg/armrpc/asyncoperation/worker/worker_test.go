@@ -23,6 +23,7 @@ import (
 	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -37,13 +38,13 @@ func TestDefaultOptions(t *testing.T) {
 	require.Equal(t, defaultMaxOperationConcurrency, worker.options.MaxOperationConcurrency)
 }
 
-func TestUpdateResourceState(t *testing.T) {
+func TestResourceWithState(t *testing.T) {
 	updateStates := []struct {
 		tc          string
 		in          map[string]any
 		updateState v1.ProvisioningState
 		outErr      error
-		callSave    bool
+		updated     bool
 	}{
 		{
 			tc: "not found provisioningState",
@@ -53,7 +54,7 @@ func TestUpdateResourceState(t *testing.T) {
 			},
 			updateState: v1.ProvisioningStateAccepted,
 			outErr:      nil,
-			callSave:    true,
+			updated:     true,
 		},
 		{
 			tc: "not update state",
@@ -64,7 +65,7 @@ func TestUpdateResourceState(t *testing.T) {
 			},
 			updateState: v1.ProvisioningStateAccepted,
 			outErr:      nil,
-			callSave:    false,
+			updated:     false,
 		},
 		{
 			tc: "update state",
@@ -75,7 +76,7 @@ func TestUpdateResourceState(t *testing.T) {
 			},
 			updateState: v1.ProvisioningStateAccepted,
 			outErr:      nil,
-			callSave:    true,
+			updated:     true,
 		},
 	}
 
@@ -96,19 +97,15 @@ func TestUpdateResourceState(t *testing.T) {
 					}, nil
 				})
 
-			if tt.callSave {
-				mStorageClient.
-					EXPECT().
-					Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
-						k := obj.Data.(map[string]any)
-						require.Equal(t, k["provisioningState"].(string), string(tt.updateState))
-						return nil
-					})
-			}
-
-			err := updateResourceState(ctx, mStorageClient, "fakeid", tt.updateState)
+			obj, err := resourceWithState(ctx, mStorageClient, "fakeid", tt.updateState)
 			require.ErrorIs(t, err, tt.outErr)
+			if tt.updated {
+				require.NotNil(t, obj)
+				k := obj.Data.(map[string]any)
+				require.Equal(t, k["provisioningState"].(string), string(tt.updateState))
+			} else {
+				require.Nil(t, obj)
+			}
 		})
 	}
 
@@ -138,6 +135,23 @@ func TestGetMessageExtendDuration(t *testing.T) {
 	}
 }
 
+func TestIsReplayed(t *testing.T) {
+	tests := []struct {
+		replayCount  int
+		dequeueCount int
+		out          bool
+	}{
+		{replayCount: 0, dequeueCount: 1, out: false},
+		{replayCount: 1, dequeueCount: 1, out: true},
+		{replayCount: 1, dequeueCount: 2, out: false},
+	}
+
+	for _, tt := range tests {
+		msg := &queue.Message{Metadata: queue.Metadata{ReplayCount: tt.replayCount, DequeueCount: tt.dequeueCount}}
+		require.Equal(t, tt.out, isReplayed(msg))
+	}
+}
+
 func TestErrorHandling(t *testing.T) {
 	tests := []struct {
 		err            error
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// ResultSucceeded is the result of a request which completed successfully.
+	ResultSucceeded = "Succeeded"
+
+	// ResultAccepted is the result of a request which started an asynchronous operation. The outcome of the
+	// operation can be tracked with the operation ID of the record.
+	ResultAccepted = "Accepted"
+
+	// ResultFailed is the result of a request which was rejected or failed.
+	ResultFailed = "Failed"
+)
+
+// Record is an entry of the audit log. A record is written for every mutating request handled by UCP or a resource
+// provider.
+type Record struct {
+	// ID is the unique ID of the record.
+	ID string `json:"id"`
+
+	// Timestamp is the time at which the request was received.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Service is the name of the service which handled the request, such as 'ucp'.
+	Service string `json:"service"`
+
+	// Caller is the identity of the caller, when it is known.
+	Caller string `json:"caller,omitempty"`
+
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+
+	// ResourceID is the ID of the resource targeted by the request. For a custom action, it is the ID of the resource
+	// on which the action is performed.
+	ResourceID string `json:"resourceId"`
+
+	// OperationID is the ID of the operation. For an asynchronous operation, it is the ID of its operation status.
+	OperationID string `json:"operationId,omitempty"`
+
+	// CorrelationID is the correlation ID of the request.
+	CorrelationID string `json:"correlationId,omitempty"`
+
+	// APIVersion is the API version of the request.
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+
+	// Result is the result of the request, one of 'Succeeded', 'Accepted' or 'Failed'.
+	Result string `json:"result"`
+
+	// LatencyMilliseconds is the time it took to handle the request, in milliseconds.
+	LatencyMilliseconds int64 `json:"latencyMs"`
+}
+
+// Sink is the destination of the audit records.
+type Sink interface {
+	// Write writes a record to the sink.
+	Write(ctx context.Context, record Record) error
+}
+
+// IsAuditedMethod returns true if requests with the HTTP method are recorded in the audit log. Only the methods which
+// mutate resources are audited.
+func IsAuditedMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodPost:
+		return true
+	default:
+		return false
+	}
+}
+
+// ResultFromStatusCode returns the result of a request from the HTTP status code of its response.
+func ResultFromStatusCode(statusCode int) string {
+	switch {
+	case statusCode == http.StatusAccepted:
+		return ResultAccepted
+	case statusCode >= 200 && statusCode < 400:
+		return ResultSucceeded
+	default:
+		return ResultFailed
+	}
+}
+
+// MultiSink writes the records to each of its sinks.
+type MultiSink []Sink
+
+// Write writes the record to each of the sinks. A failure of one sink does not prevent writing to the others, the
+// errors are joined.
+func (s MultiSink) Write(ctx context.Context, record Record) error {
+	errs := []error{}
+	for _, sink := range s {
+		if err := sink.Write(ctx, record); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
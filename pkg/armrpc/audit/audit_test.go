@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsAuditedMethod(t *testing.T) {
+	require.True(t, IsAuditedMethod(http.MethodPut))
+	require.True(t, IsAuditedMethod(http.MethodPatch))
+	require.True(t, IsAuditedMethod(http.MethodDelete))
+	require.True(t, IsAuditedMethod(http.MethodPost))
+	require.True(t, IsAuditedMethod("put"))
+	require.False(t, IsAuditedMethod(http.MethodGet))
+	require.False(t, IsAuditedMethod(http.MethodHead))
+}
+
+func Test_ResultFromStatusCode(t *testing.T) {
+	require.Equal(t, ResultSucceeded, ResultFromStatusCode(http.StatusOK))
+	require.Equal(t, ResultSucceeded, ResultFromStatusCode(http.StatusNoContent))
+	require.Equal(t, ResultAccepted, ResultFromStatusCode(http.StatusAccepted))
+	require.Equal(t, ResultFailed, ResultFromStatusCode(http.StatusBadRequest))
+	require.Equal(t, ResultFailed, ResultFromStatusCode(http.StatusInternalServerError))
+}
+
+type testSink struct {
+	records []Record
+	err     error
+}
+
+func (s *testSink) Write(ctx context.Context, record Record) error {
+	s.records = append(s.records, record)
+	return s.err
+}
+
+func Test_MultiSink(t *testing.T) {
+	first := &testSink{err: errors.New("sink is broken")}
+	second := &testSink{}
+
+	record := Record{ID: "test-record"}
+	err := MultiSink{first, second}.Write(context.Background(), record)
+	require.ErrorContains(t, err, "sink is broken")
+
+	// The failure of the first sink does not prevent writing to the second.
+	require.Equal(t, []Record{record}, first.records)
+	require.Equal(t, []Record{record}, second.records)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// DefaultFileMaxSizeMB is the default size of the audit log file, in megabytes, above which it is rotated.
+	DefaultFileMaxSizeMB = 100
+
+	// DefaultFileMaxBackups is the default number of rotated audit log files which are kept.
+	DefaultFileMaxBackups = 5
+)
+
+var _ Sink = (*FileSink)(nil)
+
+// FileSink writes the records to a file as JSON lines. The file is rotated when it grows above its maximum size: the
+// current file is renamed with the '.1' suffix, the previous backups are shifted, and the oldest backups beyond the
+// maximum number of backups are removed.
+type FileSink struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+// NewFileSink creates a sink writing the records to the file at path. The file is rotated when its size exceeds
+// maxSizeBytes and at most maxBackups rotated files are kept.
+func NewFileSink(path string, maxSizeBytes int64, maxBackups int) (*FileSink, error) {
+	if path == "" {
+		return nil, errors.New("the path of the audit log file is required")
+	}
+
+	if maxSizeBytes <= 0 {
+		maxSizeBytes = DefaultFileMaxSizeMB * 1024 * 1024
+	}
+
+	if maxBackups < 0 {
+		maxBackups = 0
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create the directory of the audit log file: %w", err)
+	}
+
+	return &FileSink{path: path, maxSize: maxSizeBytes, maxBackups: maxBackups}, nil
+}
+
+// Write appends the record to the file as a single line of JSON, rotating the file first if the record does not fit.
+func (s *FileSink) Write(ctx context.Context, record Record) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+
+	if s.size > 0 && s.size+int64(len(b)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(b)
+	s.size += int64(n)
+	return err
+}
+
+// Close closes the current audit log file.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open the audit log file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	s.file = nil
+
+	if s.maxBackups == 0 {
+		if err := os.Remove(s.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return s.open()
+	}
+
+	// Remove the oldest backup and shift the others: path.(n-1) -> path.n, ..., path -> path.1
+	if err := os.Remove(s.backupPath(s.maxBackups)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(s.backupPath(i), s.backupPath(i+1)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	if err := os.Rename(s.path, s.backupPath(1)); err != nil {
+		return err
+	}
+
+	return s.open()
+}
+
+func (s *FileSink) backupPath(index int) string {
+	return fmt.Sprintf("%s.%d", s.path, index)
+}
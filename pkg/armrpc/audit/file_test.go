@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readLines(t *testing.T, path string) []string {
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSpace(string(b)), "\n")
+}
+
+func Test_FileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+
+	sink, err := NewFileSink(path, 1024, 2)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Write(context.Background(), Record{ID: "1"}))
+	require.NoError(t, sink.Write(context.Background(), Record{ID: "2"}))
+
+	lines := readLines(t, path)
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[0], `"id":"1"`)
+	require.Contains(t, lines[1], `"id":"2"`)
+}
+
+func Test_FileSink_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	// Each record is larger than half of the maximum size, so every write rotates the file.
+	record := Record{ResourceID: "/planes/radius/local/resourceGroups/" + strings.Repeat("a", 100)}
+	sink, err := NewFileSink(path, 200, 2)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	for _, id := range []string{"1", "2", "3", "4"} {
+		record.ID = id
+		require.NoError(t, sink.Write(context.Background(), record))
+	}
+
+	require.Contains(t, readLines(t, path)[0], `"id":"4"`)
+	require.Contains(t, readLines(t, path+".1")[0], `"id":"3"`)
+	require.Contains(t, readLines(t, path+".2")[0], `"id":"2"`)
+
+	// The oldest record is removed with the backups beyond the maximum.
+	require.NoFileExists(t, path+".3")
+}
+
+func Test_FileSink_AppendsToExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.NoError(t, os.WriteFile(path, []byte("{\"id\":\"0\"}\n"), 0o600))
+
+	sink, err := NewFileSink(path, 1024, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Write(context.Background(), Record{ID: "1"}))
+	require.NoError(t, sink.Close())
+
+	lines := readLines(t, path)
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[1], `"id":"1"`)
+}
+
+func Test_NewFileSink_NoPath(t *testing.T) {
+	_, err := NewFileSink("", 1024, 1)
+	require.Error(t, err)
+}
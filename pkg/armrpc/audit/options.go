@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+)
+
+const (
+	// SinkKindStdout writes the records to the standard output as JSON lines.
+	SinkKindStdout = "stdout"
+
+	// SinkKindFile writes the records to a rotating file as JSON lines.
+	SinkKindFile = "file"
+
+	// SinkKindStorage saves the records in the data store, where they can be queried by resource.
+	SinkKindStorage = "storage"
+)
+
+// Options represents the configuration of the audit log.
+type Options struct {
+	// Enabled turns on the audit log of the mutating requests.
+	Enabled bool `yaml:"enabled"`
+
+	// Sinks is the list of the destinations of the records: 'stdout', 'file' or 'storage'. Defaults to 'stdout'.
+	Sinks []string `yaml:"sinks,omitempty"`
+
+	// File configures the 'file' sink.
+	File FileOptions `yaml:"file,omitempty"`
+
+	// Storage configures the 'storage' sink.
+	Storage StorageOptions `yaml:"storage,omitempty"`
+}
+
+// FileOptions represents the configuration of the rotating audit log file.
+type FileOptions struct {
+	// Path is the path of the audit log file.
+	Path string `yaml:"path"`
+
+	// MaxSizeMB is the size of the file, in megabytes, above which it is rotated. Defaults to DefaultFileMaxSizeMB.
+	MaxSizeMB int `yaml:"maxSizeMB,omitempty"`
+
+	// MaxBackups is the number of rotated files which are kept. Defaults to DefaultFileMaxBackups.
+	MaxBackups *int `yaml:"maxBackups,omitempty"`
+}
+
+// StorageOptions represents the configuration of the records saved in the data store.
+type StorageOptions struct {
+	// MaxRecords is the maximum number of records kept in a root scope, such as a resource group. Defaults to
+	// DefaultStorageMaxRecords.
+	MaxRecords int `yaml:"maxRecords,omitempty"`
+
+	// RetentionDays is the number of days for which the records are kept. Defaults to DefaultStorageRetentionDays.
+	RetentionDays int `yaml:"retentionDays,omitempty"`
+}
+
+// NewSink creates the sink configured by the options. It returns nil when the audit log is disabled. The storage
+// provider is used by the 'storage' sink.
+func NewSink(ctx context.Context, options Options, storageProvider dataprovider.DataStorageProvider) (Sink, error) {
+	if !options.Enabled {
+		return nil, nil
+	}
+
+	kinds := options.Sinks
+	if len(kinds) == 0 {
+		kinds = []string{SinkKindStdout}
+	}
+
+	sinks := MultiSink{}
+	for _, kind := range kinds {
+		switch kind {
+		case SinkKindStdout:
+			sinks = append(sinks, NewWriterSink(os.Stdout))
+
+		case SinkKindFile:
+			maxSizeMB := options.File.MaxSizeMB
+			if maxSizeMB <= 0 {
+				maxSizeMB = DefaultFileMaxSizeMB
+			}
+
+			maxBackups := DefaultFileMaxBackups
+			if options.File.MaxBackups != nil {
+				maxBackups = *options.File.MaxBackups
+			}
+
+			sink, err := NewFileSink(options.File.Path, int64(maxSizeMB)*1024*1024, maxBackups)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+
+		case SinkKindStorage:
+			if storageProvider == nil {
+				return nil, fmt.Errorf("the audit sink %q requires a storage provider", SinkKindStorage)
+			}
+
+			client, err := storageProvider.GetStorageClient(ctx, RecordResourceType)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, NewStorageSink(client, options.Storage.MaxRecords, time.Duration(options.Storage.RetentionDays)*24*time.Hour))
+
+		default:
+			return nil, fmt.Errorf("unsupported audit sink %q, the supported sinks are %q, %q and %q", kind, SinkKindStdout, SinkKindFile, SinkKindStorage)
+		}
+	}
+
+	if len(sinks) == 1 {
+		return sinks[0], nil
+	}
+
+	return sinks, nil
+}
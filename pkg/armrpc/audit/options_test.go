@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func Test_NewSink(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		sink, err := NewSink(context.Background(), Options{}, nil)
+		require.NoError(t, err)
+		require.Nil(t, sink)
+	})
+
+	t.Run("default", func(t *testing.T) {
+		sink, err := NewSink(context.Background(), Options{Enabled: true}, nil)
+		require.NoError(t, err)
+		require.IsType(t, &WriterSink{}, sink)
+	})
+
+	t.Run("all sinks", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		storageProvider := dataprovider.NewMockDataStorageProvider(ctrl)
+		storageProvider.EXPECT().
+			GetStorageClient(gomock.Any(), RecordResourceType).
+			Return(store.NewMockStorageClient(ctrl), nil)
+
+		options := Options{
+			Enabled: true,
+			Sinks:   []string{SinkKindStdout, SinkKindFile, SinkKindStorage},
+			File:    FileOptions{Path: filepath.Join(t.TempDir(), "audit.log")},
+		}
+		sink, err := NewSink(context.Background(), options, storageProvider)
+		require.NoError(t, err)
+		require.IsType(t, MultiSink{}, sink)
+		require.Len(t, sink.(MultiSink), 3)
+	})
+
+	t.Run("storage without provider", func(t *testing.T) {
+		_, err := NewSink(context.Background(), Options{Enabled: true, Sinks: []string{SinkKindStorage}}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("unsupported sink", func(t *testing.T) {
+		_, err := NewSink(context.Background(), Options{Enabled: true, Sinks: []string{"syslog"}}, nil)
+		require.ErrorContains(t, err, "unsupported audit sink \"syslog\"")
+	})
+}
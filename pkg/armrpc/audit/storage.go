@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// RecordResourceType is the resource type used to store the audit records.
+	RecordResourceType = "System.Audit/auditRecords"
+
+	// DefaultStorageMaxRecords is the default maximum number of records kept in a root scope.
+	DefaultStorageMaxRecords = 1000
+
+	// DefaultStorageRetentionDays is the default number of days for which the records are kept.
+	DefaultStorageRetentionDays = 30
+
+	// storagePruneInterval is the number of records written in a root scope between two prunes of the scope. The
+	// records of a scope are pruned on the first write after the sink is created, and then every storagePruneInterval
+	// writes, so that the scope is not queried for every request.
+	storagePruneInterval = 100
+)
+
+var _ Sink = (*StorageSink)(nil)
+
+// StorageSink saves the records in the data store so they can be queried with ListRecords. A record is stored in the
+// root scope of the resource it refers to, for example in its resource group. The records of a root scope which are
+// older than the retention, and the oldest records above the maximum number of records, are deleted periodically.
+type StorageSink struct {
+	client     store.StorageClient
+	maxRecords int
+	retention  time.Duration
+
+	mu     sync.Mutex
+	writes map[string]int
+}
+
+// NewStorageSink creates a sink saving the records with the storage client, and keeping at most maxRecords records
+// for at most retention in each root scope. Non-positive values select DefaultStorageMaxRecords and
+// DefaultStorageRetentionDays.
+func NewStorageSink(client store.StorageClient, maxRecords int, retention time.Duration) *StorageSink {
+	if maxRecords <= 0 {
+		maxRecords = DefaultStorageMaxRecords
+	}
+
+	if retention <= 0 {
+		retention = DefaultStorageRetentionDays * 24 * time.Hour
+	}
+
+	return &StorageSink{client: client, maxRecords: maxRecords, retention: retention, writes: map[string]int{}}
+}
+
+// Write saves the record in the data store, and prunes the records of its root scope periodically.
+func (s *StorageSink) Write(ctx context.Context, record Record) error {
+	id, err := resources.Parse(record.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	rootScope := id.RootScope()
+	recordID := rootScope + resources.SegmentSeparator + resources.ProvidersSegment + resources.SegmentSeparator + RecordResourceType + resources.SegmentSeparator + record.ID
+	err = s.client.Save(ctx, &store.Object{
+		Metadata: store.Metadata{ID: recordID},
+		Data:     record,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !s.shouldPrune(rootScope) {
+		return nil
+	}
+
+	if err := s.prune(ctx, rootScope); err != nil {
+		return fmt.Errorf("failed to prune the audit records of %q: %w", rootScope, err)
+	}
+
+	return nil
+}
+
+func (s *StorageSink) shouldPrune(rootScope string) bool {
+	key := strings.ToLower(rootScope)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := s.writes[key]
+	s.writes[key] = (count + 1) % storagePruneInterval
+	return count == 0
+}
+
+// prune deletes the records of the root scope which are older than the retention, and the oldest records above the
+// maximum number of records.
+func (s *StorageSink) prune(ctx context.Context, rootScope string) error {
+	result, err := s.client.Query(ctx, store.Query{
+		RootScope:    rootScope,
+		ResourceType: RecordResourceType,
+	})
+	if err != nil {
+		return err
+	}
+
+	type storedRecord struct {
+		id        string
+		timestamp time.Time
+	}
+
+	stored := []storedRecord{}
+	for _, item := range result.Items {
+		record := Record{}
+		if err := item.As(&record); err != nil {
+			return err
+		}
+		stored = append(stored, storedRecord{id: item.ID, timestamp: record.Timestamp})
+	}
+
+	// Newest first, so that the records to delete are at the end.
+	sort.SliceStable(stored, func(i, j int) bool {
+		return stored[i].timestamp.After(stored[j].timestamp)
+	})
+
+	cutoff := time.Now().Add(-s.retention)
+	for i, record := range stored {
+		if i < s.maxRecords && !record.timestamp.Before(cutoff) {
+			continue
+		}
+
+		// Another replica may have deleted the record already.
+		if err := s.client.Delete(ctx, record.id); err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListRecords returns the audit records of the resource stored by StorageSink, oldest first.
+func ListRecords(ctx context.Context, client store.StorageClient, resourceID string) ([]Record, error) {
+	id, err := resources.Parse(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.Query(ctx, store.Query{
+		RootScope:    id.RootScope(),
+		ResourceType: RecordResourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	records := []Record{}
+	for _, item := range result.Items {
+		record := Record{}
+		if err := item.As(&record); err != nil {
+			return nil, err
+		}
+
+		if strings.EqualFold(record.ResourceID, resourceID) {
+			records = append(records, record)
+		}
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp.Before(records[j].Timestamp)
+	})
+
+	return records, nil
+}
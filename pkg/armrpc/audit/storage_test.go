@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	testResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env"
+)
+
+func Test_StorageSink_Write(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(ctrl)
+
+	record := Record{ID: "00000000-0000-0000-0000-000000000001", ResourceID: testResourceID}
+	storageClient.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			require.Equal(t, "/planes/radius/local/resourceGroups/test-rg/providers/System.Audit/auditRecords/00000000-0000-0000-0000-000000000001", obj.ID)
+			require.Equal(t, record, obj.Data)
+			return nil
+		}).
+		Times(2)
+
+	// The scope is pruned on the first write only.
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local/resourceGroups/test-rg", ResourceType: RecordResourceType}).
+		Return(&store.ObjectQueryResult{}, nil)
+
+	sink := NewStorageSink(storageClient, 0, 0)
+	require.NoError(t, sink.Write(context.Background(), record))
+	require.NoError(t, sink.Write(context.Background(), record))
+}
+
+func Test_StorageSink_Write_Prune(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(ctrl)
+
+	const collectionID = "/planes/radius/local/resourceGroups/test-rg/providers/System.Audit/auditRecords/"
+	now := time.Now().UTC()
+	stored := []store.Object{
+		{Metadata: store.Metadata{ID: collectionID + "5"}, Data: Record{ID: "5", Timestamp: now, ResourceID: testResourceID}},
+		{Metadata: store.Metadata{ID: collectionID + "1"}, Data: Record{ID: "1", Timestamp: now.Add(-1 * time.Hour), ResourceID: testResourceID}},
+		{Metadata: store.Metadata{ID: collectionID + "2"}, Data: Record{ID: "2", Timestamp: now.Add(-2 * time.Hour), ResourceID: testResourceID}},
+		{Metadata: store.Metadata{ID: collectionID + "3"}, Data: Record{ID: "3", Timestamp: now.Add(-3 * time.Hour), ResourceID: testResourceID}},
+		{Metadata: store.Metadata{ID: collectionID + "4"}, Data: Record{ID: "4", Timestamp: now.Add(-48 * time.Hour), ResourceID: testResourceID}},
+	}
+
+	storageClient.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+	storageClient.EXPECT().
+		Query(gomock.Any(), gomock.Any()).
+		Return(&store.ObjectQueryResult{Items: stored}, nil)
+
+	// Record 3 is above the maximum number of records with the new record 5, and record 4 is older than the retention.
+	storageClient.EXPECT().Delete(gomock.Any(), collectionID+"3").Return(nil)
+	storageClient.EXPECT().Delete(gomock.Any(), collectionID+"4").Return(&store.ErrNotFound{ID: collectionID + "4"})
+
+	sink := NewStorageSink(storageClient, 3, 24*time.Hour)
+	err := sink.Write(context.Background(), Record{ID: "5", Timestamp: now, ResourceID: testResourceID})
+	require.NoError(t, err)
+}
+
+func Test_StorageSink_Write_InvalidResourceID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(ctrl)
+
+	err := NewStorageSink(storageClient, 0, 0).Write(context.Background(), Record{ID: "1", ResourceID: "not-a-resource-id"})
+	require.Error(t, err)
+}
+
+func Test_ListRecords(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(ctrl)
+
+	first := Record{ID: "1", Timestamp: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), ResourceID: testResourceID}
+	second := Record{ID: "2", Timestamp: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC), ResourceID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/test-env"}
+	other := Record{ID: "3", Timestamp: time.Date(2023, 10, 3, 0, 0, 0, 0, time.UTC), ResourceID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/other-env"}
+
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local/resourceGroups/test-rg", ResourceType: RecordResourceType}).
+		Return(&store.ObjectQueryResult{Items: []store.Object{{Data: second}, {Data: other}, {Data: first}}}, nil)
+
+	records, err := ListRecords(context.Background(), storageClient, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, []Record{first, second}, records)
+}
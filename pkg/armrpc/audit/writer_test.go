@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WriterSink(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	sink := NewWriterSink(buffer)
+
+	records := []Record{
+		{ID: "1", Timestamp: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), Method: "PUT", ResourceID: "/planes/radius/local/resourceGroups/rg", StatusCode: 200, Result: ResultSucceeded},
+		{ID: "2", Timestamp: time.Date(2023, 10, 1, 0, 0, 1, 0, time.UTC), Method: "DELETE", ResourceID: "/planes/radius/local/resourceGroups/rg", StatusCode: 204, Result: ResultSucceeded},
+	}
+	for _, record := range records {
+		require.NoError(t, sink.Write(context.Background(), record))
+	}
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	require.Len(t, lines, 2)
+
+	for i, line := range lines {
+		actual := Record{}
+		require.NoError(t, json.Unmarshal([]byte(line), &actual))
+		require.Equal(t, records[i], actual)
+	}
+}
@@ -67,6 +67,14 @@ type Options struct {
 
 	// StatusManager is the async operation status manager.
 	StatusManager sm.StatusManager
+
+	// MaxInFlightOperationsPerResourceGroup is the maximum number of async operations which can be in progress at the
+	// same time in each resource group. Requests which would start another operation are throttled. 0 means unlimited.
+	MaxInFlightOperationsPerResourceGroup int
+
+	// AsyncOperationPriorities maps resource types or operation types ("<resource type>|<method>") to the priority of
+	// their async operations. It overrides ResourceOptions.AsyncOperationPriority for the matching operations.
+	AsyncOperationPriorities map[string]int
 }
 
 // ResourceOptions represents the options and filters for resource.
@@ -91,6 +99,10 @@ type ResourceOptions[T any] struct {
 	// value like 5 seconds if your operations will complete quickly.
 	AsyncOperationRetryAfter time.Duration
 
+	// AsyncOperationPriority is the priority of the async operations. The operations with the higher priority are
+	// processed first by the async worker. The default priority is 0. It is overridden by Options.AsyncOperationPriorities.
+	AsyncOperationPriority int
+
 	// ListRecursiveQuery specifies whether store query should be recursive or not. This should be set to true when the
 	// scope of the list operation does not match the scope of the underlying resource type.
 	//
@@ -21,14 +21,19 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 const (
@@ -163,27 +168,49 @@ func (c *Operation[P, T]) PrepareResource(ctx context.Context, req *http.Request
 	return nil, nil
 }
 
-// PrepareAsyncOperation saves the initial state and queue the async operation.
-func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource *T, initialState v1.ProvisioningState, asyncTimeout time.Duration, etag *string) (rest.Response, error) {
-	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+// asyncOperationPriority returns the priority configured for the operation type, or else for its resource type, or else
+// the default priority of the resource.
+func (c *Operation[P, T]) asyncOperationPriority(operationType v1.OperationType) int {
+	for _, key := range []string{operationType.String(), operationType.Type} {
+		for k, priority := range c.options.AsyncOperationPriorities {
+			if strings.EqualFold(k, key) {
+				return priority
+			}
+		}
+	}
 
-	P(newResource).SetProvisioningState(initialState)
+	return c.resourceOptions.AsyncOperationPriority
+}
 
-	var err error
-	*etag, err = c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, *etag)
-	if err != nil {
-		return nil, err
-	}
+// PrepareAsyncOperation saves the initial state and queue the async operation. If the quota of in-flight operations
+// of the resource group is reached then it returns a 429 response without saving the resource.
+func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource *T, initialState v1.ProvisioningState, asyncTimeout time.Duration, etag *string) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
 	options := sm.QueueOperationOptions{
 		OperationTimeout: asyncTimeout,
 		RetryAfter:       v1.DefaultRetryAfterDuration,
+		Priority:         c.asyncOperationPriority(serviceCtx.OperationType),
 	}
 	if c.resourceOptions.AsyncOperationRetryAfter != 0 {
 		options.RetryAfter = c.resourceOptions.AsyncOperationRetryAfter
 	}
 
+	if r, err := c.reserveInFlightOperation(ctx, serviceCtx, options); r != nil || err != nil {
+		return r, err
+	}
+
+	P(newResource).SetProvisioningState(initialState)
+
+	var err error
+	*etag, err = c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, *etag)
+	if err != nil {
+		c.releaseInFlightOperation(ctx, serviceCtx)
+		return nil, err
+	}
+
 	if err := c.StatusManager().QueueAsyncOperation(ctx, serviceCtx, options); err != nil {
+		c.releaseInFlightOperation(ctx, serviceCtx)
 		P(newResource).SetProvisioningState(v1.ProvisioningStateFailed)
 		_, rbErr := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, *etag)
 		if rbErr != nil {
@@ -195,6 +222,45 @@ func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource
 	return nil, nil
 }
 
+// reserveInFlightOperation reserves an in-flight slot of the resource group of the request for the async operation. It
+// returns a 429 response if MaxInFlightOperationsPerResourceGroup operations are already in progress in the resource
+// group.
+func (c *Operation[P, T]) reserveInFlightOperation(ctx context.Context, serviceCtx *v1.ARMRequestContext, options sm.QueueOperationOptions) (rest.Response, error) {
+	if !c.hasInFlightOperationQuota(serviceCtx.ResourceID) {
+		return nil, nil
+	}
+
+	maxInFlight := c.options.MaxInFlightOperationsPerResourceGroup
+	reserved, count, err := c.StatusManager().ReserveInFlight(ctx, serviceCtx.ResourceID, serviceCtx.OperationID, maxInFlight, options.OperationTimeout)
+	if err != nil {
+		return nil, err
+	} else if reserved {
+		return nil, nil
+	}
+
+	id := serviceCtx.ResourceID
+	metrics.DefaultRateLimitMetrics.RecordThrottledRequest(ctx, id.Type(), ratelimit.ScopeInFlightOperations)
+	return rest.NewTooManyRequestsResponse(fmt.Sprintf("The request was throttled because %d operations are already in progress in %q.", count, id.RootScope()), options.RetryAfter), nil
+}
+
+// releaseInFlightOperation releases the in-flight slot reserved for the async operation of the request when the
+// operation could not be queued. A failure is only logged, since the reservation expires anyway.
+func (c *Operation[P, T]) releaseInFlightOperation(ctx context.Context, serviceCtx *v1.ARMRequestContext) {
+	if !c.hasInFlightOperationQuota(serviceCtx.ResourceID) {
+		return
+	}
+
+	if err := c.StatusManager().ReleaseInFlight(ctx, serviceCtx.ResourceID, serviceCtx.OperationID); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to release the in-flight slot of the async operation", "operationID", serviceCtx.OperationID.String())
+	}
+}
+
+// hasInFlightOperationQuota returns true if the number of the async operations in progress in the resource group of
+// id is limited.
+func (c *Operation[P, T]) hasInFlightOperationQuota(id resources.ID) bool {
+	return c.options.MaxInFlightOperationsPerResourceGroup > 0 && id.FindScope(resources_radius.ScopeResourceGroups) != ""
+}
+
 // ConstructSyncResponse constructs synchronous API response.
 func (c *Operation[P, T]) ConstructSyncResponse(ctx context.Context, method, etag string, resource *T) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
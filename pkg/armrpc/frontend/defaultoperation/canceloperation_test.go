@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCancelOperationRun(t *testing.T) {
+	linkedResourceID := "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/containers/ctnr0"
+
+	newStatus := func(state v1.ProvisioningState) *manager.Status {
+		return &manager.Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{Status: state},
+			LinkedResourceID:     linkedResourceID,
+		}
+	}
+
+	cancelTests := []struct {
+		desc       string
+		getErr     error
+		status     *manager.Status
+		cancelErr  error
+		statusCode int
+	}{
+		{
+			desc:       "not found",
+			getErr:     &store.ErrNotFound{},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			desc:       "running operation",
+			status:     newStatus(v1.ProvisioningStateUpdating),
+			statusCode: http.StatusOK,
+		},
+		{
+			desc:       "completed operation",
+			status:     newStatus(v1.ProvisioningStateSucceeded),
+			cancelErr:  manager.ErrOperationCompleted,
+			statusCode: http.StatusConflict,
+		},
+		{
+			desc:       "concurrent update",
+			status:     newStatus(v1.ProvisioningStateUpdating),
+			cancelErr:  &store.ErrConcurrency{},
+			statusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range cancelTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+			mStatusManager := manager.NewMockStatusManager(mctrl)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(testcontext.New(t), http.MethodPost, operationStatusCancelTestHeaderFile, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			mStorageClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &store.Object{Metadata: store.Metadata{ID: id}, Data: tt.status}, nil
+				})
+
+			if tt.status != nil {
+				mStatusManager.
+					EXPECT().
+					Cancel(gomock.Any(), resources.MustParse(linkedResourceID), uuid.Nil).
+					Return(tt.cancelErr)
+			}
+
+			ctl, err := NewCancelOperation(ctrl.Options{
+				StorageClient: mStorageClient,
+				StatusManager: mStatusManager,
+			})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+		})
+	}
+
+	t.Run("store error", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mStorageClient := store.NewMockStorageClient(mctrl)
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(testcontext.New(t), http.MethodPost, operationStatusCancelTestHeaderFile, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mStorageClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("store error"))
+
+		ctl, err := NewCancelOperation(ctrl.Options{StorageClient: mStorageClient})
+		require.NoError(t, err)
+
+		_, err = ctl.Run(ctx, w, req)
+		require.Error(t, err)
+	})
+}
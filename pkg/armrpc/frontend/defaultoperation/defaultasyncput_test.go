@@ -332,3 +332,178 @@ func TestDefaultAsyncPut_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultAsyncPut_InFlightOperationQuota(t *testing.T) {
+	quotaCases := []struct {
+		desc       string
+		inProgress int
+		throttled  bool
+		queueErr   error
+	}{
+		{
+			desc:       "below-quota",
+			inProgress: 1,
+			throttled:  false,
+		},
+		{
+			desc:       "quota-reached",
+			inProgress: 2,
+			throttled:  true,
+		},
+		{
+			desc:       "queue-failed",
+			inProgress: 1,
+			throttled:  false,
+			queueErr:   errors.New("enqueue error"),
+		},
+	}
+
+	for _, tt := range quotaCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			reqModel, _, _ := loadTestResurce()
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, resourceTestHeaderFile, reqModel)
+			require.NoError(t, err)
+
+			ctx := rpctest.NewARMRequestContext(req)
+			sCtx := v1.ARMRequestContextFromContext(ctx)
+
+			mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+				Return(nil, &store.ErrNotFound{}).
+				Times(1)
+
+			msm.EXPECT().ReserveInFlight(gomock.Any(), sCtx.ResourceID, sCtx.OperationID, 2, gomock.Any()).
+				Return(!tt.throttled, tt.inProgress, nil).
+				Times(1)
+
+			if !tt.throttled {
+				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					MinTimes(1)
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(tt.queueErr).
+					Times(1)
+			}
+
+			if tt.queueErr != nil {
+				// The slot is released since the operation was not queued.
+				msm.EXPECT().ReleaseInFlight(gomock.Any(), sCtx.ResourceID, sCtx.OperationID).
+					Return(nil).
+					Times(1)
+			}
+
+			opts := ctrl.Options{
+				StorageClient:                         mds,
+				StatusManager:                         msm,
+				MaxInFlightOperationsPerResourceGroup: 2,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:         testResourceDataModelFromVersioned,
+				ResponseConverter:        testResourceDataModelToVersioned,
+				AsyncOperationRetryAfter: 5 * time.Second,
+			}
+
+			ctl, err := NewDefaultAsyncPut(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			if tt.queueErr != nil {
+				require.ErrorIs(t, err, tt.queueErr)
+				return
+			}
+			require.NoError(t, err)
+
+			_ = resp.Apply(ctx, w, req)
+			if tt.throttled {
+				require.Equal(t, http.StatusTooManyRequests, w.Result().StatusCode)
+				require.Equal(t, "5", w.Header().Get("Retry-After"))
+			} else {
+				require.Equal(t, http.StatusCreated, w.Result().StatusCode)
+			}
+		})
+	}
+}
+
+func TestDefaultAsyncPut_AsyncOperationPriority(t *testing.T) {
+	priorityCases := []struct {
+		desc       string
+		priorities map[string]int
+		expected   int
+	}{
+		{
+			desc:     "resource-default",
+			expected: 1,
+		},
+		{
+			desc:       "other-resource-type",
+			priorities: map[string]int{"Applications.Core/containers": 5},
+			expected:   1,
+		},
+		{
+			desc:       "resource-type",
+			priorities: map[string]int{"Applications.Core/environments": 5},
+			expected:   5,
+		},
+		{
+			desc:       "operation-type",
+			priorities: map[string]int{"Applications.Core/environments": 5, "applications.core/environments|PUT": 10},
+			expected:   10,
+		},
+	}
+
+	for _, tt := range priorityCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			reqModel, _, _ := loadTestResurce()
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, resourceTestHeaderFile, reqModel)
+			require.NoError(t, err)
+
+			ctx := rpctest.NewARMRequestContext(req)
+			// The operation type is injected by the router in the frontend server.
+			v1.ARMRequestContextFromContext(ctx).OperationType = rpctest.MustParseOperationType("Applications.Core/environments|PUT")
+
+			mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+				Return(nil, &store.ErrNotFound{}).
+				Times(1)
+			mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).
+				Times(1)
+			msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+					require.Equal(t, tt.expected, options.Priority)
+					return nil
+				}).
+				Times(1)
+
+			opts := ctrl.Options{
+				StorageClient:            mds,
+				StatusManager:            msm,
+				AsyncOperationPriorities: tt.priorities,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:       testResourceDataModelFromVersioned,
+				ResponseConverter:      testResourceDataModelToVersioned,
+				AsyncOperationPriority: 1,
+			}
+
+			ctl, err := NewDefaultAsyncPut(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusCreated, w.Result().StatusCode)
+		})
+	}
+}
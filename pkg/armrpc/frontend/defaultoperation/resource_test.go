@@ -36,9 +36,10 @@ import (
 )
 
 const (
-	resourceTestHeaderFile        = "resource_requestheaders.json"
-	operationStatusTestHeaderFile = "operationstatus_requestheaders.json"
-	testAPIVersion                = "2023-10-01-preview"
+	resourceTestHeaderFile              = "resource_requestheaders.json"
+	operationStatusTestHeaderFile       = "operationstatus_requestheaders.json"
+	operationStatusCancelTestHeaderFile = "operationstatus_cancel_requestheaders.json"
+	testAPIVersion                      = "2023-10-01-preview"
 )
 
 // TestResourceDataModel represents test resource.
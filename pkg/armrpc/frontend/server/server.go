@@ -21,9 +21,12 @@ import (
 	"net"
 	"net/http"
 
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
 	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/locks"
 	"github.com/radius-project/radius/pkg/validator"
 	"github.com/radius-project/radius/pkg/version"
 
@@ -45,6 +48,15 @@ type Options struct {
 	EnableArmAuth bool
 	Configure     func(chi.Router) error
 	ArmCertMgr    *authentication.ArmCertManager
+
+	// AuditSink is the optional sink of the audit records of the mutating requests.
+	AuditSink audit.Sink
+
+	// RateLimiter is the optional limiter used to throttle the requests.
+	RateLimiter *ratelimit.Limiter
+
+	// LockChecker is the optional checker of the management locks which block the PUT, PATCH and DELETE requests.
+	LockChecker *locks.Checker
 }
 
 // New creates a frontend server that can listen on the provided address and serve requests - it creates an HTTP server with a router,
@@ -64,6 +76,15 @@ func New(ctx context.Context, options Options) (*http.Server, error) {
 		r.Use(authentication.ClientCertValidator(options.ArmCertMgr))
 	}
 	r.Use(servicecontext.ARMRequestCtx(options.PathBase, options.Location))
+	if options.AuditSink != nil {
+		r.Use(servicecontext.AuditRequests(options.ServiceName, options.AuditSink))
+	}
+	if options.RateLimiter != nil {
+		r.Use(servicecontext.ThrottleRequests(options.RateLimiter, versionEndpoint, healthzEndpoint))
+	}
+	if options.LockChecker != nil {
+		r.Use(servicecontext.EnforceLocks(options.LockChecker))
+	}
 
 	r.Get(versionEndpoint, version.ReportVersionHandler)
 	r.Get(healthzEndpoint, version.ReportVersionHandler)
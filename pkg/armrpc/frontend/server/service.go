@@ -50,6 +50,8 @@ type Service struct {
 
 	// KubeClient is the Kubernetes controller runtime client.
 	KubeClient controller_runtime.Client
+
+	queueProvider *qprovider.QueueProvider
 }
 
 // Init initializes web service - it initializes the StorageProvider, QueueProvider, OperationStatusManager, KubeClient and ARMCertManager
@@ -58,8 +60,8 @@ func (s *Service) Init(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	s.StorageProvider = dataprovider.NewStorageProvider(s.Options.Config.StorageProvider)
-	qp := qprovider.New(s.Options.Config.QueueProvider)
-	reqQueueClient, err := qp.GetClient(ctx)
+	s.queueProvider = qprovider.New(s.Options.Config.QueueProvider)
+	reqQueueClient, err := s.queueProvider.GetClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -84,6 +86,7 @@ func (s *Service) Init(ctx context.Context) error {
 func (s *Service) Start(ctx context.Context, opt Options) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	ctx = hostoptions.WithContext(ctx, s.Options.Config)
+	defer s.close(ctx)
 
 	address := fmt.Sprintf("%s:%d", s.Options.Config.Server.Host, s.Options.Config.Server.Port)
 	server, err := New(ctx, opt)
@@ -111,3 +114,19 @@ func (s *Service) Start(ctx context.Context, opt Options) error {
 	logger.Info("Server stopped...")
 	return nil
 }
+
+// close closes the storage and queue providers once the server has stopped, which releases the resources they hold,
+// such as the file of the bolt database.
+func (s *Service) close(ctx context.Context) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if s.StorageProvider != nil {
+		if err := s.StorageProvider.Close(); err != nil {
+			logger.Error(err, "failed to close the storage provider")
+		}
+	}
+	if s.queueProvider != nil {
+		if err := s.queueProvider.Close(); err != nil {
+			logger.Error(err, "failed to close the queue provider")
+		}
+	}
+}
@@ -17,6 +17,10 @@ limitations under the License.
 package hostoptions
 
 import (
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
 	profilerprovider "github.com/radius-project/radius/pkg/profiler/provider"
 	"github.com/radius-project/radius/pkg/trace"
@@ -43,6 +47,10 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                    `yaml:"logging"`
 	Bicep            BicepOptions                             `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                         `yaml:"terraform,omitempty"`
+	Audit            audit.Options                            `yaml:"audit,omitempty"`
+	RateLimit        ratelimit.Options                        `yaml:"rateLimit,omitempty"`
+	DriftDetection   DriftDetectionOptions                    `yaml:"driftDetection,omitempty"`
+	AsyncOperation   AsyncOperationOptions                    `yaml:"asyncOperation,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -67,10 +75,20 @@ type WorkerServerOptions struct {
 	Port *int32 `yaml:"port,omitempty"`
 	// MaxOperationConcurrency is the maximum concurrency to process async request operation.
 	MaxOperationConcurrency *int `yaml:"maxOperationConcurrency,omitempty"`
+	// MaxOperationConcurrencyPerTenant is the maximum concurrency to process async request operations of each resource group or plane.
+	MaxOperationConcurrencyPerTenant *int `yaml:"maxOperationConcurrencyPerTenant,omitempty"`
 	// MaxOperationRetryCount is the maximum retry count to process async request operation.
 	MaxOperationRetryCount *int `yaml:"maxOperationRetryCount,omitempty"`
 }
 
+// AsyncOperationOptions includes the options of the async operations queued by the frontend server.
+type AsyncOperationOptions struct {
+	// Priorities maps resource types, such as "Applications.Core/containers", or operation types, such as
+	// "Applications.Core/containers|DELETE", to the priority of their async operations. The operations with the higher
+	// priority are processed first by the async worker. An operation type takes precedence over its resource type.
+	Priorities map[string]int `yaml:"priorities,omitempty"`
+}
+
 // BicepOptions includes options required for bicep execution.
 type BicepOptions struct {
 	// DeleteRetryCount is the number of times to retry the request.
@@ -83,4 +101,29 @@ type BicepOptions struct {
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string `yaml:"path,omitempty"`
+
+	// Cache configures the caches of the provider plugins, modules and provider mirrors shared by the Terraform executions.
+	Cache TerraformCacheOptions `yaml:"cache,omitempty"`
+}
+
+// TerraformCacheOptions includes the options of the caches shared by the Terraform executions.
+type TerraformCacheOptions struct {
+	// Enabled enables the caches.
+	Enabled bool `yaml:"enabled"`
+
+	// Path is the path to the directory of the caches. Defaults to the ".cache" directory under the Terraform path.
+	Path string `yaml:"path,omitempty"`
+
+	// MaxSizeMB is the maximum size of each cache in megabytes. The least recently used entries are evicted when a cache
+	// exceeds its size. Zero means unlimited.
+	MaxSizeMB int64 `yaml:"maxSizeMB,omitempty"`
+}
+
+// DriftDetectionOptions includes the options of the background drift detection of the recipe-backed portable resources.
+type DriftDetectionOptions struct {
+	// Enabled enables the periodic drift detection.
+	Enabled bool `yaml:"enabled"`
+
+	// Interval is the interval between drift detections. Defaults to 30 minutes.
+	Interval time.Duration `yaml:"interval,omitempty"`
 }
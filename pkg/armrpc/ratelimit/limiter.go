@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// ScopeCaller is the scope of the limit applied to the requests of each caller.
+	ScopeCaller = "caller"
+
+	// ScopeResourceGroup is the scope of the limit applied to the requests targeting each resource group.
+	ScopeResourceGroup = "resourceGroup"
+
+	// ScopeInFlightOperations is the scope of the quota on the in-flight async operations of each resource group.
+	ScopeInFlightOperations = "inFlightOperations"
+
+	// bucketIdleTimeout is the duration after which the bucket of an idle key is removed.
+	bucketIdleTimeout = 10 * time.Minute
+)
+
+// Decision is the result of the evaluation of a request by the Limiter.
+type Decision struct {
+	// Allowed is true if the request is allowed.
+	Allowed bool
+
+	// Scope is the scope of the limit which throttled the request. It is empty if the request is allowed.
+	Scope string
+
+	// RetryAfter is the duration after which the throttled request can be retried.
+	RetryAfter time.Duration
+}
+
+// Limiter throttles the requests using a token bucket per caller and per resource group.
+type Limiter struct {
+	perCaller        *keyedLimiter
+	perResourceGroup *keyedLimiter
+
+	// now returns the current time. It can be replaced in tests.
+	now func() time.Time
+}
+
+// New creates a Limiter from the options. It returns nil if rate limiting is disabled or if none of the limits is set.
+func New(options Options) *Limiter {
+	if !options.Enabled || (options.PerCaller.IsUnlimited() && options.PerResourceGroup.IsUnlimited()) {
+		return nil
+	}
+
+	return &Limiter{
+		perCaller:        newKeyedLimiter(options.PerCaller),
+		perResourceGroup: newKeyedLimiter(options.PerResourceGroup),
+		now:              time.Now,
+	}
+}
+
+// Allow evaluates a request of the caller targeting the resource group and consumes a token of each bucket if the
+// request is allowed. The resource group limit is skipped if resourceGroup is empty.
+func (l *Limiter) Allow(caller string, resourceGroup string) Decision {
+	now := l.now()
+
+	var callerReservation *rate.Reservation
+	if l.perCaller != nil {
+		callerReservation = l.perCaller.reserve(caller, now)
+		if delay := callerReservation.DelayFrom(now); delay > 0 {
+			callerReservation.CancelAt(now)
+			return Decision{Scope: ScopeCaller, RetryAfter: delay}
+		}
+	}
+
+	if l.perResourceGroup != nil && resourceGroup != "" {
+		reservation := l.perResourceGroup.reserve(resourceGroup, now)
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			reservation.CancelAt(now)
+
+			// Give back the token of the caller since the request is not processed.
+			if callerReservation != nil {
+				callerReservation.CancelAt(now)
+			}
+			return Decision{Scope: ScopeResourceGroup, RetryAfter: delay}
+		}
+	}
+
+	return Decision{Allowed: true}
+}
+
+// keyedLimiter holds a token bucket for each key.
+type keyedLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newKeyedLimiter(options LimitOptions) *keyedLimiter {
+	if options.IsUnlimited() {
+		return nil
+	}
+
+	burst := options.Burst
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(options.RequestsPerSecond)))
+	}
+
+	return &keyedLimiter{
+		limit:   rate.Limit(options.RequestsPerSecond),
+		burst:   burst,
+		buckets: map[string]*bucket{},
+	}
+}
+
+// reserve reserves a token of the bucket of the key. Keys are case-insensitive.
+func (k *keyedLimiter) reserve(key string, now time.Time) *rate.Reservation {
+	key = strings.ToLower(key)
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.sweep(now)
+
+	b, ok := k.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(k.limit, k.burst)}
+		k.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	return b.limiter.ReserveN(now, 1)
+}
+
+// sweep removes the buckets of the keys which have been idle for longer than bucketIdleTimeout. A bucket idle for
+// that long has usually been refilled, so removing it does not change the outcome of the next request.
+func (k *keyedLimiter) sweep(now time.Time) {
+	if now.Sub(k.lastSweep) < bucketIdleTimeout {
+		return
+	}
+
+	for key, b := range k.buckets {
+		if now.Sub(b.lastSeen) >= bucketIdleTimeout {
+			delete(k.buckets, key)
+		}
+	}
+	k.lastSweep = now
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimiter(t *testing.T, options Options) (*Limiter, *time.Time) {
+	limiter := New(options)
+	require.NotNil(t, limiter)
+
+	now := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func Test_New(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		require.Nil(t, New(Options{Enabled: false, PerCaller: LimitOptions{RequestsPerSecond: 1}}))
+	})
+
+	t.Run("no limits", func(t *testing.T) {
+		require.Nil(t, New(Options{Enabled: true}))
+	})
+
+	t.Run("default burst", func(t *testing.T) {
+		limiter := New(Options{Enabled: true, PerCaller: LimitOptions{RequestsPerSecond: 2.5}})
+		require.NotNil(t, limiter)
+		require.Equal(t, 3, limiter.perCaller.burst)
+		require.Nil(t, limiter.perResourceGroup)
+	})
+
+	t.Run("default burst for low rate", func(t *testing.T) {
+		limiter := New(Options{Enabled: true, PerResourceGroup: LimitOptions{RequestsPerSecond: 0.1}})
+		require.NotNil(t, limiter)
+		require.Equal(t, 1, limiter.perResourceGroup.burst)
+		require.Nil(t, limiter.perCaller)
+	})
+}
+
+func Test_Limiter_Allow_PerCaller(t *testing.T) {
+	limiter, now := newTestLimiter(t, Options{
+		Enabled:   true,
+		PerCaller: LimitOptions{RequestsPerSecond: 1, Burst: 2},
+	})
+
+	require.True(t, limiter.Allow("alice", "").Allowed)
+	require.True(t, limiter.Allow("ALICE", "").Allowed)
+
+	decision := limiter.Allow("alice", "")
+	require.False(t, decision.Allowed)
+	require.Equal(t, ScopeCaller, decision.Scope)
+	require.Equal(t, time.Second, decision.RetryAfter)
+
+	// Other callers have their own bucket.
+	require.True(t, limiter.Allow("bob", "").Allowed)
+
+	*now = now.Add(time.Second)
+	require.True(t, limiter.Allow("alice", "").Allowed)
+	require.False(t, limiter.Allow("alice", "").Allowed)
+}
+
+func Test_Limiter_Allow_PerResourceGroup(t *testing.T) {
+	limiter, now := newTestLimiter(t, Options{
+		Enabled:          true,
+		PerCaller:        LimitOptions{RequestsPerSecond: 1, Burst: 2},
+		PerResourceGroup: LimitOptions{RequestsPerSecond: 1, Burst: 1},
+	})
+
+	require.True(t, limiter.Allow("alice", "group1").Allowed)
+
+	decision := limiter.Allow("alice", "group1")
+	require.False(t, decision.Allowed)
+	require.Equal(t, ScopeResourceGroup, decision.Scope)
+	require.Equal(t, time.Second, decision.RetryAfter)
+
+	// The token of the caller is given back when the resource group limit throttles the request.
+	require.True(t, limiter.Allow("alice", "group2").Allowed)
+	require.False(t, limiter.Allow("alice", "group3").Allowed)
+
+	// Requests without a resource group are only limited per caller.
+	*now = now.Add(time.Second)
+	require.True(t, limiter.Allow("alice", "").Allowed)
+}
+
+func Test_Limiter_SweepsIdleBuckets(t *testing.T) {
+	limiter, now := newTestLimiter(t, Options{
+		Enabled:   true,
+		PerCaller: LimitOptions{RequestsPerSecond: 1},
+	})
+
+	require.True(t, limiter.Allow("alice", "").Allowed)
+	require.True(t, limiter.Allow("bob", "").Allowed)
+	require.Len(t, limiter.perCaller.buckets, 2)
+
+	*now = now.Add(bucketIdleTimeout)
+	require.True(t, limiter.Allow("bob", "").Allowed)
+	require.Len(t, limiter.perCaller.buckets, 1)
+	require.Contains(t, limiter.perCaller.buckets, "bob")
+}
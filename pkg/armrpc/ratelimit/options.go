@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+// Options represents the rate limiting options of the ARM RPC frontend server.
+type Options struct {
+	// Enabled enables the rate limiting of the requests.
+	Enabled bool `yaml:"enabled"`
+
+	// PerCaller is the token bucket limit applied to the requests of each caller.
+	PerCaller LimitOptions `yaml:"perCaller,omitempty"`
+
+	// PerResourceGroup is the token bucket limit applied to the requests targeting each resource group.
+	PerResourceGroup LimitOptions `yaml:"perResourceGroup,omitempty"`
+
+	// MaxInFlightOperationsPerResourceGroup is the maximum number of async operations which can be in progress at the
+	// same time in each resource group. Requests which would start another operation are throttled. 0 means unlimited.
+	MaxInFlightOperationsPerResourceGroup int `yaml:"maxInFlightOperationsPerResourceGroup,omitempty"`
+}
+
+// InFlightOperationQuota returns the maximum number of in-flight async operations per resource group, or 0 if rate
+// limiting is disabled.
+func (o Options) InFlightOperationQuota() int {
+	if !o.Enabled || o.MaxInFlightOperationsPerResourceGroup < 0 {
+		return 0
+	}
+
+	return o.MaxInFlightOperationsPerResourceGroup
+}
+
+// LimitOptions represents the options of a token bucket limit.
+type LimitOptions struct {
+	// RequestsPerSecond is the rate at which the bucket is refilled. 0 means unlimited.
+	RequestsPerSecond float64 `yaml:"requestsPerSecond,omitempty"`
+
+	// Burst is the size of the bucket, which is the maximum number of requests allowed at once. Defaults to the
+	// rate rounded up, and at least 1.
+	Burst int `yaml:"burst,omitempty"`
+}
+
+// IsUnlimited returns true if the limit does not throttle any request.
+func (o LimitOptions) IsUnlimited() bool {
+	return o.RequestsPerSecond <= 0
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Options_InFlightOperationQuota(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  Options
+		expected int
+	}{
+		{
+			name:     "disabled",
+			options:  Options{Enabled: false, MaxInFlightOperationsPerResourceGroup: 10},
+			expected: 0,
+		},
+		{
+			name:     "enabled",
+			options:  Options{Enabled: true, MaxInFlightOperationsPerResourceGroup: 10},
+			expected: 10,
+		},
+		{
+			name:     "negative",
+			options:  Options{Enabled: true, MaxInFlightOperationsPerResourceGroup: -1},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.options.InFlightOperationQuota())
+		})
+	}
+}
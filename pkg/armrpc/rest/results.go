@@ -20,9 +20,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 	"net/textproto"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -588,6 +590,18 @@ func NewConflictResponse(message string) Response {
 	}
 }
 
+// NewScopeLockedResponse creates a ConflictResponse for requests which are blocked by a management lock.
+func NewScopeLockedResponse(message string) Response {
+	return &ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeScopeLocked,
+				Message: message,
+			},
+		},
+	}
+}
+
 // Apply renders 409 Conflict HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
 func (r *ConflictResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -714,6 +728,90 @@ func (r *ClientAuthenticationFailed) Apply(ctx context.Context, w http.ResponseW
 	return nil
 }
 
+// ForbiddenResponse represents an HTTP 403 with an ARM error payload.
+type ForbiddenResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewForbiddenResponse creates a ForbiddenResponse with CodeAuthorizationFailed code and the given message.
+func NewForbiddenResponse(message string) Response {
+	return &ForbiddenResponse{
+		Body: v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeAuthorizationFailed,
+				Message: message,
+			},
+		},
+	}
+}
+
+// Apply renders 403 Forbidden HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *ForbiddenResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusForbidden), logging.LogHTTPStatusCode, http.StatusForbidden)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
+// TooManyRequestsResponse represents an HTTP 429 with an ARM error payload and a Retry-After header.
+type TooManyRequestsResponse struct {
+	Body       v1.ErrorResponse
+	RetryAfter time.Duration
+}
+
+// NewTooManyRequestsResponse creates a TooManyRequestsResponse with CodeTooManyRequests code, the given message and
+// the duration after which the request can be retried.
+func NewTooManyRequestsResponse(message string, retryAfter time.Duration) Response {
+	return &TooManyRequestsResponse{
+		Body: v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeTooManyRequests,
+				Message: message,
+			},
+		},
+		RetryAfter: retryAfter,
+	}
+}
+
+// Apply renders 429 Too Many Requests HTTP response into http.ResponseWriter by setting the Content-Type and Retry-After
+// headers and serializing the response. Retry-After is rounded up to a whole number of seconds and is at least 1.
+func (r *TooManyRequestsResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusTooManyRequests), logging.LogHTTPStatusCode, http.StatusTooManyRequests)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	retryAfter := int64(math.Ceil(r.RetryAfter.Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.Header().Add("Retry-After", strconv.FormatInt(retryAfter, 10))
+	w.WriteHeader(http.StatusTooManyRequests)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
 // AsyncOperationResultResponse
 type AsyncOperationResultResponse struct {
 	Headers map[string]string
@@ -147,6 +147,59 @@ func Test_OKResponse_WithBody(t *testing.T) {
 	require.Equal(t, payload, body)
 }
 
+func Test_ForbiddenResponse(t *testing.T) {
+	response := NewForbiddenResponse("access denied")
+
+	req := httptest.NewRequest("PUT", "http://example.com", nil)
+	w := httptest.NewRecorder()
+
+	err := response.Apply(context.TODO(), w, req)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.Equal(t, []string{"application/json"}, w.Header()["Content-Type"])
+
+	body := v1.ErrorResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+	require.Equal(t, v1.CodeAuthorizationFailed, body.Error.Code)
+	require.Equal(t, "access denied", body.Error.Message)
+}
+
+func Test_TooManyRequestsResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter time.Duration
+		expected   string
+	}{
+		{name: "whole seconds", retryAfter: 5 * time.Second, expected: "5"},
+		{name: "rounded up", retryAfter: 1500 * time.Millisecond, expected: "2"},
+		{name: "at least one second", retryAfter: 0, expected: "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := NewTooManyRequestsResponse("slow down", tt.retryAfter)
+
+			req := httptest.NewRequest("PUT", "http://example.com", nil)
+			w := httptest.NewRecorder()
+
+			err := response.Apply(context.TODO(), w, req)
+			require.NoError(t, err)
+
+			require.Equal(t, http.StatusTooManyRequests, w.Code)
+			require.Equal(t, []string{"application/json"}, w.Header()["Content-Type"])
+			require.Equal(t, tt.expected, w.Header().Get("Retry-After"))
+
+			body := v1.ErrorResponse{}
+			err = json.Unmarshal(w.Body.Bytes(), &body)
+			require.NoError(t, err)
+			require.Equal(t, v1.CodeTooManyRequests, body.Error.Code)
+			require.Equal(t, "slow down", body.Error.Message)
+		})
+	}
+}
+
 func TestGetAsyncLocationPath(t *testing.T) {
 	operationID := uuid.New()
 
@@ -290,3 +343,22 @@ func TestGetAsyncLocationPath(t *testing.T) {
 		})
 	}
 }
+
+func Test_ScopeLockedResponse(t *testing.T) {
+	response := NewScopeLockedResponse("the scope is locked")
+
+	req := httptest.NewRequest("DELETE", "http://example.com", nil)
+	w := httptest.NewRecorder()
+
+	err := response.Apply(context.TODO(), w, req)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusConflict, w.Code)
+	require.Equal(t, []string{"application/json"}, w.Header()["Content-Type"])
+
+	body := v1.ErrorResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+	require.Equal(t, v1.CodeScopeLocked, body.Error.Code)
+	require.Equal(t, "the scope is locked", body.Error.Message)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecontext
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// unauthenticatedCaller is the caller of the audit records of the requests whose caller was not authenticated.
+const unauthenticatedCaller = "unauthenticated"
+
+// AuditRequests is the middleware which writes an audit record to the sink for every mutating request. The record is
+// written after the request is handled so that it includes the result and the latency. This middleware must be used
+// after ARMRequestCtx.
+func AuditRequests(service string, sink audit.Sink) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if !audit.IsAuditedMethod(r.Method) {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := authorization.WithCallerHolder(r.Context())
+			r = r.WithContext(ctx)
+
+			start := time.Now()
+			recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			h.ServeHTTP(recorder, r)
+
+			rpcContext := v1.ARMRequestContextFromContext(ctx)
+
+			resourceID := r.URL.Path
+			if !rpcContext.ResourceID.IsEmpty() {
+				resourceID = rpcContext.ResourceID.String()
+			}
+
+			record := audit.Record{
+				ID:                  uuid.NewString(),
+				Timestamp:           start.UTC(),
+				Service:             service,
+				Caller:              callerFromContext(ctx),
+				Method:              r.Method,
+				ResourceID:          resourceID,
+				OperationID:         rpcContext.OperationID.String(),
+				CorrelationID:       rpcContext.CorrelationID,
+				APIVersion:          rpcContext.APIVersion,
+				StatusCode:          recorder.statusCode,
+				Result:              audit.ResultFromStatusCode(recorder.statusCode),
+				LatencyMilliseconds: time.Since(start).Milliseconds(),
+			}
+
+			if err := sink.Write(ctx, record); err != nil {
+				logger := ucplog.FromContextOrDiscard(ctx)
+				logger.Error(err, "failed to write the audit record", "resourceId", record.ResourceID, "operationId", record.OperationID)
+			}
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// callerFromContext returns the name of the authenticated caller of the request, or "unauthenticated".
+func callerFromContext(ctx context.Context) string {
+	if principal, ok := authorization.CallerFromContext(ctx); ok && principal.User != "" {
+		return principal.User
+	}
+
+	return unauthenticatedCaller
+}
+
+// statusRecorder records the status code of the response written by the next handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+}
+
+// WriteHeader records the status code and writes it to the underlying response writer.
+func (s *statusRecorder) WriteHeader(statusCode int) {
+	if !s.wroteHeader {
+		s.statusCode = statusCode
+		s.wroteHeader = true
+	}
+	s.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Unwrap returns the underlying response writer so that http.ResponseController can reach it.
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
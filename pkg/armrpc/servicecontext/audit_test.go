@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecontext
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+)
+
+type testAuditSink struct {
+	records []audit.Record
+}
+
+func (s *testAuditSink) Write(ctx context.Context, record audit.Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func TestAuditRequests(t *testing.T) {
+	const testResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env"
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		caller         string
+		headers        map[string]string
+		statusCode     int
+		expectRecord   bool
+		expectedID     string
+		expectedCaller string
+		expectedResult string
+	}{
+		{
+			name:         "read is not audited",
+			method:       http.MethodGet,
+			path:         testResourceID,
+			statusCode:   http.StatusOK,
+			expectRecord: false,
+		},
+		{
+			name:           "put",
+			method:         http.MethodPut,
+			path:           testResourceID,
+			caller:         "alice",
+			statusCode:     http.StatusOK,
+			expectRecord:   true,
+			expectedID:     testResourceID,
+			expectedCaller: "alice",
+			expectedResult: audit.ResultSucceeded,
+		},
+		{
+			name:           "async delete",
+			method:         http.MethodDelete,
+			path:           testResourceID,
+			caller:         "bob",
+			statusCode:     http.StatusAccepted,
+			expectRecord:   true,
+			expectedID:     testResourceID,
+			expectedCaller: "bob",
+			expectedResult: audit.ResultAccepted,
+		},
+		{
+			name:           "failed action",
+			method:         http.MethodPost,
+			path:           testResourceID + "/getMetadata",
+			caller:         "carol",
+			statusCode:     http.StatusForbidden,
+			expectRecord:   true,
+			expectedID:     testResourceID,
+			expectedCaller: "carol",
+			expectedResult: audit.ResultFailed,
+		},
+		{
+			name:           "unauthenticated",
+			method:         http.MethodPut,
+			path:           testResourceID,
+			statusCode:     http.StatusOK,
+			expectRecord:   true,
+			expectedID:     testResourceID,
+			expectedCaller: "unauthenticated",
+			expectedResult: audit.ResultSucceeded,
+		},
+		{
+			name:           "identity headers are not trusted",
+			method:         http.MethodPut,
+			path:           testResourceID,
+			headers:        map[string]string{"X-Remote-User": "mallory"},
+			statusCode:     http.StatusOK,
+			expectRecord:   true,
+			expectedID:     testResourceID,
+			expectedCaller: "unauthenticated",
+			expectedResult: audit.ResultSucceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &testAuditSink{}
+			// The handler records the caller the way the authorization middleware does.
+			handler := ARMRequestCtx("", "global")(AuditRequests("test-service", sink)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.caller != "" {
+					authorization.RecordCaller(r.Context(), authorization.Principal{User: tt.caller})
+				}
+				w.WriteHeader(tt.statusCode)
+			})))
+
+			req := httptest.NewRequest(tt.method, tt.path+"?api-version=2023-10-01-preview", nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tt.statusCode, w.Code)
+			if !tt.expectRecord {
+				require.Empty(t, sink.records)
+				return
+			}
+
+			require.Len(t, sink.records, 1)
+			record := sink.records[0]
+			require.NotEmpty(t, record.ID)
+			require.NotEmpty(t, record.OperationID)
+			require.Equal(t, "test-service", record.Service)
+			require.Equal(t, tt.method, record.Method)
+			require.Equal(t, tt.expectedID, record.ResourceID)
+			require.Equal(t, tt.expectedCaller, record.Caller)
+			require.Equal(t, "2023-10-01-preview", record.APIVersion)
+			require.Equal(t, tt.statusCode, record.StatusCode)
+			require.Equal(t, tt.expectedResult, record.Result)
+		})
+	}
+}
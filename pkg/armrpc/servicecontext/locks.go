@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecontext
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// EnforceLocks is the middleware which rejects the PUT, PATCH, POST and DELETE requests blocked by a management lock
+// with 409 Conflict, before the request reaches the controller. The POST requests of the read-only actions and of the
+// cancel action are never blocked. The checker is added to the context of the allowed requests for the controllers
+// which modify other resources than the resource of the request path. This middleware must be used after ARMRequestCtx.
+func EnforceLocks(checker *locks.Checker) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			ctx := locks.WithChecker(r.Context(), checker)
+			r = r.WithContext(ctx)
+			logger := ucplog.FromContextOrDiscard(ctx)
+			rpcContext := v1.ARMRequestContextFromContext(ctx)
+			if rpcContext.ResourceID.IsEmpty() || (r.Method == http.MethodPost && locks.IsReadOnlyAction(path.Base(r.URL.Path))) {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			lock, err := checker.Check(ctx, r.Method, rpcContext.ResourceID)
+			if err != nil {
+				logger.Error(err, "failed to check the locks of the request")
+				resp := rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+					Error: v1.ErrorDetails{
+						Code:    v1.CodeInternal,
+						Message: err.Error(),
+					},
+				})
+				if err := resp.Apply(ctx, w, r); err != nil {
+					logger.Error(err, "failed to write response")
+				}
+				return
+			}
+
+			if lock == nil {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			message := locks.BlockedMessage(fmt.Sprintf("The %s request for %q", r.Method, rpcContext.ResourceID.String()), lock)
+			logger.Info(message)
+
+			resp := rest.NewScopeLockedResponse(message)
+			if err := resp.Apply(ctx, w, r); err != nil {
+				logger.Error(err, "failed to write response")
+			}
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecontext
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func TestEnforceLocks(t *testing.T) {
+	const environmentID = "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod"
+
+	lock := store.Object{
+		Data: &datamodel.Lock{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   "/planes/radius/local/providers/System.Authorization/locks/prod",
+					Name: "prod",
+					Type: datamodel.LockResourceType,
+				},
+			},
+			Properties: datamodel.LockProperties{
+				Level: datamodel.LockLevelCanNotDelete,
+				Scope: "/planes/radius/local/resourceGroups/prod",
+				Notes: "Production must not be deleted.",
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		queryErr     error
+		expectQuery  bool
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "delete blocked",
+			method:       http.MethodDelete,
+			path:         environmentID,
+			expectQuery:  true,
+			expectedCode: http.StatusConflict,
+			expectedBody: "The DELETE request for \"" + environmentID + "\" is blocked by the CanNotDelete lock \"prod\" on the scope \"/planes/radius/local/resourceGroups/prod\". Notes: Production must not be deleted.",
+		},
+		{
+			name:         "update allowed",
+			method:       http.MethodPut,
+			path:         environmentID,
+			expectQuery:  true,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "action allowed",
+			method:       http.MethodPost,
+			path:         environmentID + "/deploy",
+			expectQuery:  true,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "read-only action not checked",
+			method:       http.MethodPost,
+			path:         environmentID + "/getMetadata",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "cancel not checked",
+			method:       http.MethodPost,
+			path:         "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/locations/global/operationStatuses/00000000-0000-0000-0000-000000000000/cancel",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "read allowed",
+			method:       http.MethodGet,
+			path:         environmentID,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "query error",
+			method:       http.MethodDelete,
+			path:         environmentID,
+			queryErr:     errors.New("store is down"),
+			expectQuery:  true,
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "store is down",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			storageClient := store.NewMockStorageClient(mctrl)
+			if tt.expectQuery {
+				result := &store.ObjectQueryResult{Items: []store.Object{lock}}
+				if tt.queryErr != nil {
+					result = nil
+				}
+				storageClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(result, tt.queryErr)
+			}
+
+			handler := ARMRequestCtx("", "global")(EnforceLocks(locks.NewChecker(storageClient))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})))
+
+			req := httptest.NewRequest(tt.method, tt.path+"?api-version=2023-10-01-preview", nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedBody == "" {
+				return
+			}
+
+			body := v1.ErrorResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			require.Equal(t, tt.expectedBody, body.Error.Message)
+			if tt.expectedCode == http.StatusConflict {
+				require.Equal(t, v1.CodeScopeLocked, body.Error.Code)
+			}
+		})
+	}
+}
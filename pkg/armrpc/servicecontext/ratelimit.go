@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecontext
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ThrottleRequests is the middleware which throttles the requests using the token buckets of the limiter. Throttled
+// requests are rejected with 429 Too Many Requests and a Retry-After header. Requests for the exempt paths, such as
+// health checks, are not throttled. The token bucket of the caller is selected by the principal authenticated by the
+// authorization middleware, or by the remote host of the request. This middleware must be used after ARMRequestCtx.
+func ThrottleRequests(limiter *ratelimit.Limiter, exemptPaths ...string) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			rpcContext := v1.ARMRequestContextFromContext(ctx)
+			if rpcContext.ResourceID.IsEmpty() || slices.ContainsFunc(exemptPaths, func(path string) bool { return strings.EqualFold(path, r.URL.Path) }) {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			resourceGroup := ""
+			if rpcContext.ResourceID.FindScope(resources_radius.ScopeResourceGroups) != "" {
+				resourceGroup = rpcContext.ResourceID.RootScope()
+			}
+
+			decision := limiter.Allow(callerFromRequest(r), resourceGroup)
+			if decision.Allowed {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			metrics.DefaultRateLimitMetrics.RecordThrottledRequest(ctx, rpcContext.ResourceID.Type(), decision.Scope)
+
+			resp := rest.NewTooManyRequestsResponse(fmt.Sprintf("The request was throttled because the %s rate limit was exceeded.", decision.Scope), decision.RetryAfter)
+			if err := resp.Apply(ctx, w, r); err != nil {
+				logger := ucplog.FromContextOrDiscard(ctx)
+				logger.Error(err, "failed to write the throttled response")
+			}
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// callerFromRequest returns the key of the token bucket of the caller: the principal authenticated by the
+// authorization middleware, or the remote host of the request. The identity headers of the request are not trusted.
+func callerFromRequest(r *http.Request) string {
+	if principal, ok := authorization.CallerFromContext(r.Context()); ok && principal.User != "" {
+		return "principal:" + principal.User
+	}
+
+	addr := middleware.RemoteAddrFromContext(r.Context())
+	if addr == "" {
+		addr = r.RemoteAddr
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+
+	return "address:" + addr
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecontext
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+)
+
+func TestThrottleRequests(t *testing.T) {
+	const (
+		resourceID      = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env"
+		otherResourceID = "/planes/radius/local/resourceGroups/other-rg/providers/Applications.Core/environments/test-env"
+	)
+
+	limiter := ratelimit.New(ratelimit.Options{
+		Enabled:          true,
+		PerCaller:        ratelimit.LimitOptions{RequestsPerSecond: 0.01, Burst: 2},
+		PerResourceGroup: ratelimit.LimitOptions{RequestsPerSecond: 0.01, Burst: 1},
+	})
+
+	handler := ARMRequestCtx("", "global")(ThrottleRequests(limiter, "/healthz")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	send := func(path string, caller string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, path+"?api-version=2023-10-01-preview", nil)
+		req.RemoteAddr = caller + ":1234"
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, send(resourceID, "10.0.0.1").Code)
+
+	// The resource group bucket is empty.
+	w := send(resourceID, "10.0.0.2")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "100", w.Header().Get("Retry-After"))
+
+	body := v1.ErrorResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, v1.CodeTooManyRequests, body.Error.Code)
+	require.Contains(t, body.Error.Message, ratelimit.ScopeResourceGroup)
+
+	// The caller bucket of 10.0.0.1 still has a token, but is empty afterwards.
+	require.Equal(t, http.StatusOK, send(otherResourceID, "10.0.0.1").Code)
+	w = send("/planes/radius/local/providers/Applications.Core/environments", "10.0.0.1")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+
+	body = v1.ErrorResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Contains(t, body.Error.Message, ratelimit.ScopeCaller)
+
+	// Requests for the exempt paths are not throttled.
+	for i := 0; i < 5; i++ {
+		require.Equal(t, http.StatusOK, send("/healthz", "10.0.0.1").Code)
+	}
+}
+
+func TestThrottleRequests_Caller(t *testing.T) {
+	const resourceID = "/planes/radius/local/providers/Applications.Core/environments/test-env"
+
+	limiter := ratelimit.New(ratelimit.Options{
+		Enabled:   true,
+		PerCaller: ratelimit.LimitOptions{RequestsPerSecond: 0.01, Burst: 1},
+	})
+
+	handler := ARMRequestCtx("", "global")(ThrottleRequests(limiter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+	handler = middleware.RemoveRemoteAddr(handler)
+
+	send := func(principal string, header string) int {
+		req := httptest.NewRequest(http.MethodPut, resourceID+"?api-version=2023-10-01-preview", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		if header != "" {
+			req.Header.Set("X-Remote-User", header)
+		}
+		if principal != "" {
+			ctx := authorization.WithCallerHolder(req.Context())
+			authorization.RecordCaller(ctx, authorization.Principal{User: principal})
+			req = req.WithContext(ctx)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// The identity headers don't select a bucket: both requests use the bucket of the remote address.
+	require.Equal(t, http.StatusOK, send("", "alice"))
+	require.Equal(t, http.StatusTooManyRequests, send("", "bob"))
+
+	// Authenticated callers have their own bucket.
+	require.Equal(t, http.StatusOK, send("alice", ""))
+	require.Equal(t, http.StatusTooManyRequests, send("alice", ""))
+	require.Equal(t, http.StatusOK, send("bob", ""))
+}
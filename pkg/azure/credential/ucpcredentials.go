@@ -49,7 +49,7 @@ type UCPCredentialOptions struct {
 	ClientOptions *azcore.ClientOptions
 }
 
-// UCPCredential authenticates service principal using UCP credential APIs.
+// UCPCredential authenticates service principal or workload identity using UCP credential APIs.
 type UCPCredential struct {
 	options    UCPCredentialOptions
 	credential *sdk_cred.AzureCredential
@@ -102,12 +102,16 @@ func (c *UCPCredential) refreshCredentials(ctx context.Context) error {
 		return err
 	}
 
-	if s.ClientID == "" || s.ClientSecret == "" || s.TenantID == "" {
+	if s.ClientID == "" || s.TenantID == "" {
+		return errors.New("invalid azure credential info")
+	}
+
+	if !s.IsWorkloadIdentity() && s.ClientSecret == "" {
 		return errors.New("invalid azure service principal credential info")
 	}
 
 	// Do not instantiate new client unless the secret is rotated.
-	if c.credential != nil && c.credential.ClientSecret == s.ClientSecret &&
+	if c.credential != nil && c.credential.Kind == s.Kind && c.credential.ClientSecret == s.ClientSecret &&
 		c.credential.ClientID == s.ClientID && c.credential.TenantID == s.TenantID {
 		c.refreshExpiry()
 		return nil
@@ -115,15 +119,7 @@ func (c *UCPCredential) refreshCredentials(ctx context.Context) error {
 
 	logger.Info("Retreived Azure Credential - ClientID: " + s.ClientID)
 
-	// Rotate credentials by creating new ClientSecretCredential.
-	var opt *azidentity.ClientSecretCredentialOptions
-	if c.options.ClientOptions != nil {
-		opt = &azidentity.ClientSecretCredentialOptions{
-			ClientOptions: *c.options.ClientOptions,
-		}
-	}
-
-	azCred, err := azidentity.NewClientSecretCredential(s.TenantID, s.ClientID, s.ClientSecret, opt)
+	azCred, err := c.newTokenCredential(s)
 	if err != nil {
 		return err
 	}
@@ -135,6 +131,31 @@ func (c *UCPCredential) refreshCredentials(ctx context.Context) error {
 	return nil
 }
 
+// newTokenCredential creates the token credential for the given UCP credential. Workload identity credentials read
+// the federated token from the file projected into the pod, which is specified by AZURE_FEDERATED_TOKEN_FILE.
+func (c *UCPCredential) newTokenCredential(s *sdk_cred.AzureCredential) (azcore.TokenCredential, error) {
+	if s.IsWorkloadIdentity() {
+		opt := &azidentity.WorkloadIdentityCredentialOptions{
+			ClientID: s.ClientID,
+			TenantID: s.TenantID,
+		}
+		if c.options.ClientOptions != nil {
+			opt.ClientOptions = *c.options.ClientOptions
+		}
+		return azidentity.NewWorkloadIdentityCredential(opt)
+	}
+
+	// Rotate credentials by creating new ClientSecretCredential.
+	var opt *azidentity.ClientSecretCredentialOptions
+	if c.options.ClientOptions != nil {
+		opt = &azidentity.ClientSecretCredentialOptions{
+			ClientOptions: *c.options.ClientOptions,
+		}
+	}
+
+	return azidentity.NewClientSecretCredential(s.TenantID, s.ClientID, s.ClientSecret, opt)
+}
+
 // GetToken attempts to refresh the Azure service principal credential if it is expired and then returns an
 // access token if the credential is ready. This method is called automatically by Azure SDK clients.
 func (c *UCPCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
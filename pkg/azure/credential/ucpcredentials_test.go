@@ -21,9 +21,11 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/stretchr/testify/require"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
 type mockProvider struct {
@@ -97,4 +99,27 @@ func TestRefreshCredentials(t *testing.T) {
 		require.False(t, c.isExpired())
 		require.Equal(t, old, c.tokenCred)
 	})
+
+	t.Run("workload identity credential", func(t *testing.T) {
+		t.Setenv("AZURE_FEDERATED_TOKEN_FILE", "/var/run/secrets/azure/tokens/azure-identity-token")
+		p := newMockProvider()
+		p.fakeCredential.Kind = datamodel.AzureWorkloadIdentityCredentialKind
+		p.fakeCredential.ClientSecret = ""
+		c, err := NewUCPCredential(UCPCredentialOptions{Provider: p})
+		require.NoError(t, err)
+
+		err = c.refreshCredentials(context.TODO())
+		require.NoError(t, err)
+		require.IsType(t, &azidentity.WorkloadIdentityCredential{}, c.tokenCred)
+	})
+
+	t.Run("service principal without secret", func(t *testing.T) {
+		p := newMockProvider()
+		p.fakeCredential.ClientSecret = ""
+		c, err := NewUCPCredential(UCPCredentialOptions{Provider: p})
+		require.NoError(t, err)
+
+		err = c.refreshCredentials(context.TODO())
+		require.Error(t, err)
+	})
 }
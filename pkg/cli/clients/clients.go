@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	ucp_v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -83,9 +84,25 @@ type DeploymentResult struct {
 	Outputs   map[string]DeploymentOutput
 }
 
+// WhatIfChange describes a change a deployment would make to a single resource.
+type WhatIfChange struct {
+	// ChangeType is the kind of change, for example Create, Modify or Delete.
+	ChangeType string
+	// ResourceID is the identifier of the resource that would change.
+	ResourceID string
+}
+
+// WhatIfResult is the set of changes a deployment would make.
+type WhatIfResult struct {
+	Changes []WhatIfChange
+}
+
 // DeploymentClient is used to deploy ARM-JSON templates (compiled Bicep output).
 type DeploymentClient interface {
 	Deploy(ctx context.Context, options DeploymentOptions) (DeploymentResult, error)
+
+	// WhatIf previews the changes that deploying the template would make without deploying it.
+	WhatIf(ctx context.Context, options DeploymentOptions) (WhatIfResult, error)
 }
 
 //go:generate mockgen -typed -destination=./mock_diagnosticsclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients DiagnosticsClient
@@ -158,6 +175,12 @@ type ApplicationsManagementClient interface {
 	// DeleteResource deletes a resource by its type and name (or id).
 	DeleteResource(ctx context.Context, resourceType string, resourceNameOrID string) (bool, error)
 
+	// CancelOperation requests the cancellation of a running asynchronous operation for the given resource type.
+	CancelOperation(ctx context.Context, resourceType string, operationID string) error
+
+	// ListAuditRecords lists the audit records of the mutating requests for the resource, oldest first.
+	ListAuditRecords(ctx context.Context, resourceID string) ([]audit.Record, error)
+
 	// ListApplications lists all applications in the configured scope.
 	ListApplications(ctx context.Context) ([]corerp.ApplicationResource, error)
 
@@ -188,6 +211,9 @@ type ApplicationsManagementClient interface {
 	// GetRecipeMetadata shows recipe details including list of all parameters for a given recipe registered to an environment.
 	GetRecipeMetadata(ctx context.Context, environmentNameOrID string, recipe corerp.RecipeGetMetadata) (corerp.RecipeGetMetadataResponse, error)
 
+	// PlanRecipe previews the changes that executing a recipe registered to an environment would make.
+	PlanRecipe(ctx context.Context, environmentNameOrID string, request corerp.RecipePlanRequest) (corerp.RecipePlanResponse, error)
+
 	// CreateOrUpdateEnvironment creates an environment by its name (or id).
 	CreateOrUpdateEnvironment(ctx context.Context, environmentNameOrID string, resource *corerp.EnvironmentResource) error
 
@@ -197,6 +223,10 @@ type ApplicationsManagementClient interface {
 	// ListResourceGroups lists all resource groups in the configured scope.
 	ListResourceGroups(ctx context.Context, planeName string) ([]ucp_v20231001preview.ResourceGroupResource, error)
 
+	// ListResourcesInResourceGroup lists the resources tracked in a resource group. When tags are given only the
+	// resources that have all of the tags are returned.
+	ListResourcesInResourceGroup(ctx context.Context, planeName string, resourceGroupName string, tags map[string]string) ([]ucp_v20231001preview.GenericResource, error)
+
 	// GetResourceGroup retrieves a resource group by its name.
 	GetResourceGroup(ctx context.Context, planeName string, resourceGroupName string) (ucp_v20231001preview.ResourceGroupResource, error)
 
@@ -205,6 +235,28 @@ type ApplicationsManagementClient interface {
 
 	// DeleteResourceGroup deletes a resource group by its name.
 	DeleteResourceGroup(ctx context.Context, planeName string, resourceGroupName string) (bool, error)
+
+	// DeleteResourceGroupCascade deletes a resource group by its name along with all of the resources it contains.
+	// Progress is reported through the optional progress callback.
+	DeleteResourceGroupCascade(ctx context.Context, planeName string, resourceGroupName string, progress func(percentComplete float64)) (bool, error)
+
+	// MoveResources moves resources from one resource group to another resource group in the same plane.
+	MoveResources(ctx context.Context, planeName string, resourceGroupName string, targetResourceGroupID string, resourceIDs []string) ([]string, error)
+
+	// ListRoleAssignments lists the role assignments of a plane.
+	ListRoleAssignments(ctx context.Context, planeName string) ([]ucp_v20231001preview.RoleAssignmentResource, error)
+
+	// CreateOrUpdateRoleAssignment creates or updates a role assignment by its name.
+	CreateOrUpdateRoleAssignment(ctx context.Context, planeName string, roleAssignmentName string, resource *ucp_v20231001preview.RoleAssignmentResource) error
+
+	// DeleteRoleAssignment deletes a role assignment by its name.
+	DeleteRoleAssignment(ctx context.Context, planeName string, roleAssignmentName string) (bool, error)
+
+	// CreateOrUpdateLock creates or updates a management lock by its name.
+	CreateOrUpdateLock(ctx context.Context, planeName string, lockName string, resource *ucp_v20231001preview.LockResource) error
+
+	// DeleteLock deletes a management lock by its name.
+	DeleteLock(ctx context.Context, planeName string, lockName string) (bool, error)
 }
 
 // ShallowCopy creates a shallow copy of the DeploymentParameters object by iterating through the original object and
@@ -19,6 +19,7 @@ package clients
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -59,3 +60,35 @@ func Is404Error(err error) bool {
 
 	return false
 }
+
+// ScopeLockedError is the error returned when a resource cannot be deleted because it is protected by a management lock.
+type ScopeLockedError struct {
+	// ResourceID is the ID of the protected resource.
+	ResourceID string
+
+	// LockID is the ID of the lock.
+	LockID string
+
+	// Level is the level of the lock.
+	Level string
+
+	// Scope is the scope of the lock.
+	Scope string
+}
+
+// Error returns the error message.
+func (e *ScopeLockedError) Error() string {
+	return fmt.Sprintf("the resource %q is protected by the %s lock %q on the scope %q", e.ResourceID, e.Level, e.LockID, e.Scope)
+}
+
+// IsScopeLockedError returns true if the error is a ScopeLockedError or a response error for a request which was
+// blocked by a management lock.
+func IsScopeLockedError(err error) bool {
+	scopeLockedError := &ScopeLockedError{}
+	if errors.As(err, &scopeLockedError) {
+		return true
+	}
+
+	responseError := &azcore.ResponseError{}
+	return errors.As(err, &responseError) && responseError.ErrorCode == v1.CodeScopeLocked
+}
@@ -18,11 +18,13 @@ package clients
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIs404Error(t *testing.T) {
@@ -63,3 +65,12 @@ func TestIs404Error(t *testing.T) {
 		t.Errorf("Expected Is404Error to return false for nil error, but it returned true")
 	}
 }
+
+func TestIsScopeLockedError(t *testing.T) {
+	require.True(t, IsScopeLockedError(&azcore.ResponseError{StatusCode: http.StatusConflict, ErrorCode: v1.CodeScopeLocked}))
+	require.True(t, IsScopeLockedError(fmt.Errorf("wrapped: %w", &azcore.ResponseError{ErrorCode: v1.CodeScopeLocked})))
+	require.True(t, IsScopeLockedError(&ScopeLockedError{ResourceID: "/planes/radius/local/resourceGroups/prod"}))
+	require.False(t, IsScopeLockedError(&azcore.ResponseError{StatusCode: http.StatusConflict, ErrorCode: v1.CodeConflict}))
+	require.False(t, IsScopeLockedError(errors.New("some other error")))
+	require.False(t, IsScopeLockedError(nil))
+}
@@ -18,13 +18,22 @@ package clients
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	armruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"golang.org/x/sync/errgroup"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/azure/clientv2"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
@@ -36,7 +45,9 @@ import (
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/to"
 	ucpv20231001 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucplocks "github.com/radius-project/radius/pkg/ucp/locks"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
@@ -48,11 +59,23 @@ type UCPApplicationsManagementClient struct {
 	applicationResourceClientFactory func(scope string) (applicationResourceClient, error)
 	environmentResourceClientFactory func(scope string) (environmentResourceClient, error)
 	resourceGroupClientFactory       func() (resourceGroupClient, error)
+	resourcesClientFactory           func() (resourcesClient, error)
+	roleAssignmentClientFactory      func() (roleAssignmentClient, error)
+	lockClientFactory                func() (lockClient, error)
 	capture                          func(ctx context.Context, capture **http.Response) context.Context
 }
 
 var _ ApplicationsManagementClient = (*UCPApplicationsManagementClient)(nil)
 
+// operationStatusAPIVersion is the api-version used for operation status requests.
+const operationStatusAPIVersion = "2023-10-01-preview"
+
+// auditRecordsAPIVersion is the api-version used for audit record requests.
+const auditRecordsAPIVersion = "2023-10-01-preview"
+
+// deleteResourceGroupPollFrequency is the interval between polls of a cascading resource group delete.
+const deleteResourceGroupPollFrequency = 2 * time.Second
+
 var (
 	ResourceTypesList = []string{
 		ds_ctrl.MongoDatabasesResourceType,
@@ -223,6 +246,80 @@ func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context,
 	return response.StatusCode != 204, nil
 }
 
+// CancelOperation requests the cancellation of a running asynchronous operation for the given resource type. The
+// operation is canceled asynchronously by the resource provider.
+func (amc *UCPApplicationsManagementClient) CancelOperation(ctx context.Context, resourceType string, operationID string) error {
+	scope, err := resources.ParseScope(amc.RootScope)
+	if err != nil {
+		return err
+	}
+
+	namespace, _, _ := strings.Cut(resourceType, resources.SegmentSeparator)
+	urlPath := scope.PlaneScope() + "/providers/" + namespace + "/locations/" + v1.LocationGlobal + "/operationstatuses/" + url.PathEscape(operationID) + "/cancel"
+
+	resp, err := amc.sendRequest(ctx, http.MethodPost, urlPath, operationStatusAPIVersion, url.Values{})
+	if err != nil {
+		return err
+	}
+
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return runtime.NewResponseError(resp)
+	}
+
+	return nil
+}
+
+// ListAuditRecords lists the audit records of the mutating requests for the resource, oldest first.
+func (amc *UCPApplicationsManagementClient) ListAuditRecords(ctx context.Context, resourceID string) ([]audit.Record, error) {
+	scope, err := resources.ParseScope(amc.RootScope)
+	if err != nil {
+		return nil, err
+	}
+
+	urlPath := scope.PlaneScope() + "/providers/" + audit.RecordResourceType
+	resp, err := amc.sendRequest(ctx, http.MethodGet, urlPath, auditRecordsAPIVersion, url.Values{"resourceId": []string{resourceID}})
+	if err != nil {
+		return nil, err
+	}
+
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	result := struct {
+		Value []audit.Record `json:"value"`
+	}{}
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
+
+// sendRequest sends a request for a UCP API which has no generated client.
+func (amc *UCPApplicationsManagementClient) sendRequest(ctx context.Context, method string, urlPath string, apiVersion string, query url.Values) (*http.Response, error) {
+	options := amc.ClientOptions
+	if options == nil {
+		options = &arm.ClientOptions{}
+	}
+
+	pipeline, err := armruntime.NewPipeline(clientv2.ModuleName, clientv2.ModuleVersion, &aztoken.AnonymousCredential{}, runtime.PipelineOptions{}, options)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(options.Cloud.Services[cloud.ResourceManager].Endpoint, urlPath))
+	if err != nil {
+		return nil, err
+	}
+
+	query.Set("api-version", apiVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	return pipeline.Do(req)
+}
+
 // ListApplications lists all applications in the configured scope.
 func (amc *UCPApplicationsManagementClient) ListApplications(ctx context.Context) ([]corerpv20231001.ApplicationResource, error) {
 	client, err := amc.createApplicationClient(amc.RootScope)
@@ -382,6 +479,24 @@ func (amc *UCPApplicationsManagementClient) DeleteApplication(ctx context.Contex
 		return false, err
 	}
 
+	applicationID, err := amc.fullyQualifyID(applicationNameOrID, "Applications.Core/applications")
+	if err != nil {
+		return false, err
+	}
+
+	// Nothing is deleted if the application or any of its resources is protected by a lock.
+	ids := []string{applicationID}
+	for _, resource := range resources {
+		ids = append(ids, to.String(resource.ID))
+	}
+	if err := amc.checkDeleteLocks(ctx, ids); err != nil {
+		return false, err
+	}
+
+	return amc.deleteApplication(ctx, scope, name, resources)
+}
+
+func (amc *UCPApplicationsManagementClient) deleteApplication(ctx context.Context, scope string, name string, resources []generated.GenericResource) (bool, error) {
 	// Delete resources in parallel
 	g, groupCtx := errgroup.WithContext(ctx)
 	for _, resource := range resources {
@@ -396,7 +511,7 @@ func (amc *UCPApplicationsManagementClient) DeleteApplication(ctx context.Contex
 	}
 
 	// Wait for dependent resources to be deleted.
-	err = g.Wait()
+	err := g.Wait()
 	if err != nil {
 		return false, err
 	}
@@ -515,6 +630,25 @@ func (amc *UCPApplicationsManagementClient) GetRecipeMetadata(ctx context.Contex
 	return resp.RecipeGetMetadataResponse, nil
 }
 
+// PlanRecipe previews the changes that executing a recipe registered to an environment would make.
+func (amc *UCPApplicationsManagementClient) PlanRecipe(ctx context.Context, environmentNameOrID string, request corerpv20231001.RecipePlanRequest) (corerpv20231001.RecipePlanResponse, error) {
+	scope, name, err := amc.extractScopeAndName(environmentNameOrID)
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+	client, err := amc.createEnvironmentClient(scope)
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+
+	resp, err := client.PlanRecipe(ctx, name, request, &corerpv20231001.EnvironmentsClientPlanRecipeOptions{})
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+
+	return resp.RecipePlanResponse, nil
+}
+
 // CreateOrUpdateEnvironment creates an environment by its name (or id).
 func (amc *UCPApplicationsManagementClient) CreateOrUpdateEnvironment(ctx context.Context, environmentNameOrID string, resource *corerpv20231001.EnvironmentResource) error {
 	scope, name, err := amc.extractScopeAndName(environmentNameOrID)
@@ -549,13 +683,43 @@ func (amc *UCPApplicationsManagementClient) DeleteEnvironment(ctx context.Contex
 		return false, err
 	}
 
+	environmentID, err := amc.fullyQualifyID(environmentNameOrID, "Applications.Core/environments")
+	if err != nil {
+		return false, err
+	}
+
 	applications, err := amc.ListApplicationsInEnvironment(ctx, name)
 	if err != nil {
 		return false, err
 	}
 
-	for _, application := range applications {
-		_, err := amc.DeleteApplication(ctx, *application.ID)
+	// Nothing is deleted if the environment, any of its applications or any of their resources is protected by a lock.
+	ids := []string{environmentID}
+	applicationResources := make([][]generated.GenericResource, len(applications))
+	for i, application := range applications {
+		resources, err := amc.ListResourcesInApplication(ctx, *application.ID)
+		if err != nil && !clientv2.Is404Error(err) {
+			return false, err
+		}
+
+		applicationResources[i] = resources
+		ids = append(ids, *application.ID)
+		for _, resource := range resources {
+			ids = append(ids, to.String(resource.ID))
+		}
+	}
+
+	if err := amc.checkDeleteLocks(ctx, ids); err != nil {
+		return false, err
+	}
+
+	for i, application := range applications {
+		applicationScope, applicationName, err := amc.extractScopeAndName(*application.ID)
+		if err != nil {
+			return false, err
+		}
+
+		_, err = amc.deleteApplication(ctx, applicationScope, applicationName, applicationResources[i])
 		if err != nil {
 			return false, err
 		}
@@ -601,6 +765,36 @@ func (amc *UCPApplicationsManagementClient) ListResourceGroups(ctx context.Conte
 	return results, nil
 }
 
+// ListResourcesInResourceGroup lists the resources tracked in a resource group. When tags are given only the resources
+// that have all of the tags are returned.
+func (amc *UCPApplicationsManagementClient) ListResourcesInResourceGroup(ctx context.Context, planeName string, resourceGroupName string, tags map[string]string) ([]ucpv20231001.GenericResource, error) {
+	client, err := amc.createResourcesClient()
+	if err != nil {
+		return nil, err
+	}
+
+	options := &ucpv20231001.ResourcesClientListOptions{}
+	for key, value := range tags {
+		options.Tag = append(options.Tag, key+"="+value)
+	}
+	sort.Strings(options.Tag)
+
+	results := []ucpv20231001.GenericResource{}
+	pager := client.NewListPager(planeName, resourceGroupName, options)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range page.Value {
+			results = append(results, *resource)
+		}
+	}
+
+	return results, nil
+}
+
 // GetResourceGroup retrieves a resource group by its name.
 func (amc *UCPApplicationsManagementClient) GetResourceGroup(ctx context.Context, planeName string, resourceGroupName string) (ucpv20231001.ResourceGroupResource, error) {
 	client, err := amc.createResourceGroupClient()
@@ -647,7 +841,12 @@ func (amc *UCPApplicationsManagementClient) DeleteResourceGroup(ctx context.Cont
 	var response *http.Response
 	ctx = amc.captureResponse(ctx, &response)
 
-	_, err = client.Delete(ctx, planeName, resourceGroupName, &ucpv20231001.ResourceGroupsClientDeleteOptions{})
+	poller, err := client.BeginDelete(ctx, planeName, resourceGroupName, &ucpv20231001.ResourceGroupsClientBeginDeleteOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
 	if err != nil {
 		return false, err
 	}
@@ -655,6 +854,235 @@ func (amc *UCPApplicationsManagementClient) DeleteResourceGroup(ctx context.Cont
 	return response.StatusCode != 204, nil
 }
 
+// DeleteResourceGroupCascade deletes a resource group by its name along with all of the resources it contains. The
+// resources are deleted asynchronously by UCP and progress is reported through the optional progress callback as a
+// percentage between 0 and 100.
+func (amc *UCPApplicationsManagementClient) DeleteResourceGroupCascade(ctx context.Context, planeName string, resourceGroupName string, progress func(percentComplete float64)) (bool, error) {
+	client, err := amc.createResourceGroupClient()
+	if err != nil {
+		return false, err
+	}
+
+	var response *http.Response
+	ctx = amc.captureResponse(ctx, &response)
+
+	poller, err := client.BeginDelete(ctx, planeName, resourceGroupName, &ucpv20231001.ResourceGroupsClientBeginDeleteOptions{Cascade: to.Ptr(true)})
+	if err != nil {
+		return false, err
+	}
+
+	for !poller.Done() {
+		pollResponse, err := poller.Poll(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		if progress != nil {
+			status := v1.AsyncOperationStatus{}
+			if err := runtime.UnmarshalAsJSON(pollResponse, &status); err == nil && status.PercentComplete != nil {
+				progress(*status.PercentComplete)
+			}
+		}
+
+		if !poller.Done() {
+			select {
+			case <-ctx.Done():
+				return false, ctx.Err()
+			case <-time.After(deleteResourceGroupPollFrequency):
+			}
+		}
+	}
+
+	_, err = poller.Result(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return response.StatusCode != 204, nil
+}
+
+// MoveResources moves the given resources from one resource group to another resource group in the same plane. The
+// IDs of the moved resources are returned.
+func (amc *UCPApplicationsManagementClient) MoveResources(ctx context.Context, planeName string, resourceGroupName string, targetResourceGroupID string, resourceIDs []string) ([]string, error) {
+	client, err := amc.createResourceGroupClient()
+	if err != nil {
+		return nil, err
+	}
+
+	request := ucpv20231001.MoveResourcesRequest{
+		TargetResourceGroup: to.Ptr(targetResourceGroupID),
+		Resources:           to.SliceOfPtrs(resourceIDs...),
+	}
+
+	response, err := client.MoveResources(ctx, planeName, resourceGroupName, request, &ucpv20231001.ResourceGroupsClientMoveResourcesOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	moved := []string{}
+	for _, id := range response.Resources {
+		if id != nil {
+			moved = append(moved, *id)
+		}
+	}
+
+	return moved, nil
+}
+
+// ListRoleAssignments lists the role assignments of a plane.
+func (amc *UCPApplicationsManagementClient) ListRoleAssignments(ctx context.Context, planeName string) ([]ucpv20231001.RoleAssignmentResource, error) {
+	client, err := amc.createRoleAssignmentClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []ucpv20231001.RoleAssignmentResource{}
+	pager := client.NewListPager(planeName, &ucpv20231001.RoleAssignmentsClientListOptions{})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, roleAssignment := range page.Value {
+			results = append(results, *roleAssignment)
+		}
+	}
+
+	return results, nil
+}
+
+// CreateOrUpdateRoleAssignment creates or updates a role assignment by its name.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdateRoleAssignment(ctx context.Context, planeName string, roleAssignmentName string, resource *ucpv20231001.RoleAssignmentResource) error {
+	client, err := amc.createRoleAssignmentClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CreateOrUpdate(ctx, planeName, roleAssignmentName, *resource, &ucpv20231001.RoleAssignmentsClientCreateOrUpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteRoleAssignment deletes a role assignment by its name.
+func (amc *UCPApplicationsManagementClient) DeleteRoleAssignment(ctx context.Context, planeName string, roleAssignmentName string) (bool, error) {
+	client, err := amc.createRoleAssignmentClient()
+	if err != nil {
+		return false, err
+	}
+
+	var response *http.Response
+	ctx = amc.captureResponse(ctx, &response)
+
+	_, err = client.Delete(ctx, planeName, roleAssignmentName, &ucpv20231001.RoleAssignmentsClientDeleteOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return response.StatusCode != 204, nil
+}
+
+// CreateOrUpdateLock creates or updates a management lock by its name.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdateLock(ctx context.Context, planeName string, lockName string, resource *ucpv20231001.LockResource) error {
+	client, err := amc.createLockClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CreateOrUpdate(ctx, planeName, lockName, *resource, &ucpv20231001.LocksClientCreateOrUpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteLock deletes a management lock by its name.
+func (amc *UCPApplicationsManagementClient) DeleteLock(ctx context.Context, planeName string, lockName string) (bool, error) {
+	client, err := amc.createLockClient()
+	if err != nil {
+		return false, err
+	}
+
+	var response *http.Response
+	ctx = amc.captureResponse(ctx, &response)
+
+	_, err = client.Delete(ctx, planeName, lockName, &ucpv20231001.LocksClientDeleteOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return response.StatusCode != 204, nil
+}
+
+// checkDeleteLocks returns a ScopeLockedError if deleting any of the given resources would be blocked by a management
+// lock. The CLI deletes the resources of applications and environments one by one, so this is checked before anything
+// is deleted. Locks which cannot be listed, for example because the caller is not allowed to read them, are not checked
+// here since they are still enforced by the server.
+func (amc *UCPApplicationsManagementClient) checkDeleteLocks(ctx context.Context, ids []string) error {
+	planeLocks := map[string][]ucpv20231001.LockResource{}
+	for _, id := range ids {
+		parsed, err := resources.Parse(id)
+		if err != nil {
+			return err
+		}
+
+		planeName := parsed.FindScope(resources_radius.PlaneTypeRadius)
+		if planeName == "" {
+			continue
+		}
+
+		locks, ok := planeLocks[planeName]
+		if !ok {
+			locks, err = amc.listLocks(ctx, planeName)
+			if err != nil {
+				return err
+			}
+			planeLocks[planeName] = locks
+		}
+
+		for _, lock := range locks {
+			if lock.Properties == nil || lock.Properties.Level == nil {
+				continue
+			}
+
+			if ucplocks.Blocks(string(*lock.Properties.Level), to.String(lock.Properties.Scope), http.MethodDelete, id) {
+				return &ScopeLockedError{ResourceID: id, LockID: to.String(lock.ID), Level: string(*lock.Properties.Level), Scope: to.String(lock.Properties.Scope)}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (amc *UCPApplicationsManagementClient) listLocks(ctx context.Context, planeName string) ([]ucpv20231001.LockResource, error) {
+	client, err := amc.createLockClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []ucpv20231001.LockResource{}
+	pager := client.NewListPager(planeName, &ucpv20231001.LocksClientListOptions{})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		responseError := &azcore.ResponseError{}
+		if errors.As(err, &responseError) && (responseError.StatusCode == http.StatusNotFound || responseError.StatusCode == http.StatusForbidden) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, lock := range page.Value {
+			results = append(results, *lock)
+		}
+	}
+
+	return results, nil
+}
+
 func (amc *UCPApplicationsManagementClient) createApplicationClient(scope string) (applicationResourceClient, error) {
 	if amc.applicationResourceClientFactory == nil {
 		// Generated client doesn't like the leading '/' in the scope.
@@ -690,6 +1118,30 @@ func (amc *UCPApplicationsManagementClient) createResourceGroupClient() (resourc
 	return amc.resourceGroupClientFactory()
 }
 
+func (amc *UCPApplicationsManagementClient) createResourcesClient() (resourcesClient, error) {
+	if amc.resourcesClientFactory == nil {
+		return ucpv20231001.NewResourcesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.resourcesClientFactory()
+}
+
+func (amc *UCPApplicationsManagementClient) createRoleAssignmentClient() (roleAssignmentClient, error) {
+	if amc.roleAssignmentClientFactory == nil {
+		return ucpv20231001.NewRoleAssignmentsClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.roleAssignmentClientFactory()
+}
+
+func (amc *UCPApplicationsManagementClient) createLockClient() (lockClient, error) {
+	if amc.lockClientFactory == nil {
+		return ucpv20231001.NewLocksClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.lockClientFactory()
+}
+
 func (amc *UCPApplicationsManagementClient) extractScopeAndName(nameOrID string) (string, string, error) {
 	if strings.HasPrefix(nameOrID, resources.SegmentSeparator) {
 		// Treat this as a resource id.
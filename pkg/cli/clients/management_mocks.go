@@ -34,7 +34,7 @@ import (
 // Because these interfaces are non-exported, they MUST be defined in their own file
 // and we MUST use -source on mockgen to generate mocks for them.
 
-//go:generate mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient
+//go:generate mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourcesClient,roleAssignmentClient,lockClient
 
 // genericResourceClient is an interface for mocking the generated SDK client for any resource.
 type genericResourceClient interface {
@@ -62,12 +62,33 @@ type environmentResourceClient interface {
 	NewListByScopePager(options *corerpv20231001.EnvironmentsClientListByScopeOptions) *runtime.Pager[corerpv20231001.EnvironmentsClientListByScopeResponse]
 
 	GetMetadata(ctx context.Context, environmentName string, body corerpv20231001.RecipeGetMetadata, options *corerpv20231001.EnvironmentsClientGetMetadataOptions) (corerpv20231001.EnvironmentsClientGetMetadataResponse, error)
+	PlanRecipe(ctx context.Context, environmentName string, body corerpv20231001.RecipePlanRequest, options *corerpv20231001.EnvironmentsClientPlanRecipeOptions) (corerpv20231001.EnvironmentsClientPlanRecipeResponse, error)
 }
 
 // resourceGroupClient is an interface for mocking the generated SDK client for resource groups.
 type resourceGroupClient interface {
 	CreateOrUpdate(ctx context.Context, planeName string, resourceGroupName string, resource ucpv20231001.ResourceGroupResource, options *ucpv20231001.ResourceGroupsClientCreateOrUpdateOptions) (ucpv20231001.ResourceGroupsClientCreateOrUpdateResponse, error)
-	Delete(ctx context.Context, planeName string, resourceGroupName string, options *ucpv20231001.ResourceGroupsClientDeleteOptions) (ucpv20231001.ResourceGroupsClientDeleteResponse, error)
+	BeginDelete(ctx context.Context, planeName string, resourceGroupName string, options *ucpv20231001.ResourceGroupsClientBeginDeleteOptions) (*runtime.Poller[ucpv20231001.ResourceGroupsClientDeleteResponse], error)
 	Get(ctx context.Context, planeName string, resourceGroupName string, options *ucpv20231001.ResourceGroupsClientGetOptions) (ucpv20231001.ResourceGroupsClientGetResponse, error)
+	MoveResources(ctx context.Context, planeName string, resourceGroupName string, body ucpv20231001.MoveResourcesRequest, options *ucpv20231001.ResourceGroupsClientMoveResourcesOptions) (ucpv20231001.ResourceGroupsClientMoveResourcesResponse, error)
 	NewListPager(planeName string, options *ucpv20231001.ResourceGroupsClientListOptions) *runtime.Pager[ucpv20231001.ResourceGroupsClientListResponse]
 }
+
+// resourcesClient is an interface for mocking the generated SDK client for the resources tracked in a resource group.
+type resourcesClient interface {
+	NewListPager(planeName string, resourceGroupName string, options *ucpv20231001.ResourcesClientListOptions) *runtime.Pager[ucpv20231001.ResourcesClientListResponse]
+}
+
+// roleAssignmentClient is an interface for mocking the generated SDK client for role assignments.
+type roleAssignmentClient interface {
+	CreateOrUpdate(ctx context.Context, planeName string, roleAssignmentName string, resource ucpv20231001.RoleAssignmentResource, options *ucpv20231001.RoleAssignmentsClientCreateOrUpdateOptions) (ucpv20231001.RoleAssignmentsClientCreateOrUpdateResponse, error)
+	Delete(ctx context.Context, planeName string, roleAssignmentName string, options *ucpv20231001.RoleAssignmentsClientDeleteOptions) (ucpv20231001.RoleAssignmentsClientDeleteResponse, error)
+	NewListPager(planeName string, options *ucpv20231001.RoleAssignmentsClientListOptions) *runtime.Pager[ucpv20231001.RoleAssignmentsClientListResponse]
+}
+
+// lockClient is an interface for mocking the generated SDK client for management locks.
+type lockClient interface {
+	CreateOrUpdate(ctx context.Context, planeName string, lockName string, resource ucpv20231001.LockResource, options *ucpv20231001.LocksClientCreateOrUpdateOptions) (ucpv20231001.LocksClientCreateOrUpdateResponse, error)
+	Delete(ctx context.Context, planeName string, lockName string, options *ucpv20231001.LocksClientDeleteOptions) (ucpv20231001.LocksClientDeleteResponse, error)
+	NewListPager(planeName string, options *ucpv20231001.LocksClientListOptions) *runtime.Pager[ucpv20231001.LocksClientListResponse]
+}
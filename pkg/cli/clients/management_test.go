@@ -18,16 +18,21 @@ package clients
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
 	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/stretchr/testify/require"
@@ -409,6 +414,9 @@ func Test_Application(t *testing.T) {
 		client.genericResourceClientFactory = func(scope string, resourceType string) (genericResourceClient, error) {
 			return genericResourceMock, nil
 		}
+		client.lockClientFactory = func() (lockClient, error) {
+			return lockMock(ctrl), nil
+		}
 
 		resourceListPages := []generated.GenericResourcesClientListByRootScopeResponse{
 			{
@@ -467,6 +475,63 @@ func Test_Application(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, deleted)
 	})
+	t.Run("DeleteApplication blocked by lock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := NewMockapplicationResourceClient(ctrl)
+		genericResourceMock := NewMockgenericResourceClient(ctrl)
+		client := createClient(mock)
+		client.genericResourceClientFactory = func(scope string, resourceType string) (genericResourceClient, error) {
+			return genericResourceMock, nil
+		}
+		client.lockClientFactory = func() (lockClient, error) {
+			return lockMock(ctrl, ucp.LockResource{
+				ID: to.Ptr("/planes/radius/local/providers/System.Authorization/locks/database"),
+				Properties: &ucp.LockProperties{
+					Level: to.Ptr(ucp.LockLevelCanNotDelete),
+					Scope: to.Ptr(testScope + "/providers/Applications.Test/testResources/test1"),
+				},
+			}), nil
+		}
+
+		resourceListPages := []generated.GenericResourcesClientListByRootScopeResponse{
+			{
+				GenericResourcesList: generated.GenericResourcesList{
+					Value: []*generated.GenericResource{
+						{
+							ID:   to.Ptr(testScope + "/providers/Applications.Test/testResources/test1"),
+							Name: to.Ptr("test1"),
+							Type: to.Ptr("Applications.Test/testResources"),
+							Properties: map[string]any{
+								"application": testScope + "/providers/Applications.Core/applications/test-application",
+							},
+						},
+					},
+					NextLink: to.Ptr("0"),
+				},
+			},
+		}
+
+		for i := range ResourceTypesList {
+			if i == 0 {
+				genericResourceMock.EXPECT().
+					NewListByRootScopePager(gomock.Any()).
+					Return(pager(resourceListPages))
+			} else {
+				genericResourceMock.EXPECT().
+					NewListByRootScopePager(gomock.Any()).
+					Return(pager([]generated.GenericResourcesClientListByRootScopeResponse{{GenericResourcesList: generated.GenericResourcesList{NextLink: to.Ptr("0")}}}))
+			}
+		}
+
+		// Nothing is deleted.
+		_, err := client.DeleteApplication(context.Background(), testResourceID)
+		require.True(t, IsScopeLockedError(err))
+
+		scopeLockedError := &ScopeLockedError{}
+		require.ErrorAs(t, err, &scopeLockedError)
+		require.Equal(t, testScope+"/providers/Applications.Test/testResources/test1", scopeLockedError.ResourceID)
+		require.Equal(t, "/planes/radius/local/providers/System.Authorization/locks/database", scopeLockedError.LockID)
+	})
 }
 
 func Test_Environment(t *testing.T) {
@@ -605,6 +670,35 @@ func Test_Environment(t *testing.T) {
 		require.Equal(t, expectedResult, result)
 	})
 
+	t.Run("PlanRecipe", func(t *testing.T) {
+		mock := NewMockenvironmentResourceClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		request := corerp.RecipePlanRequest{
+			Name:         to.Ptr("test-recipe"),
+			ResourceType: to.Ptr("Applications.Core/gateways"),
+			ResourceName: to.Ptr("test-gateway"),
+		}
+
+		expectedResult := corerp.RecipePlanResponse{
+			Changes: []*corerp.RecipeResourceChange{
+				{
+					Action:       to.Ptr("create"),
+					ResourceType: to.Ptr("Microsoft.Network/applicationGateways"),
+					Name:         to.Ptr("gateway0"),
+				},
+			},
+		}
+
+		mock.EXPECT().
+			PlanRecipe(gomock.Any(), testResourceName, request, gomock.Any()).
+			Return(corerp.EnvironmentsClientPlanRecipeResponse{RecipePlanResponse: expectedResult}, nil)
+
+		result, err := client.PlanRecipe(context.Background(), testResourceID, request)
+		require.NoError(t, err)
+		require.Equal(t, expectedResult, result)
+	})
+
 	t.Run("CreateOrUpdateEnviroment", func(t *testing.T) {
 		mock := NewMockenvironmentResourceClient(gomock.NewController(t))
 		client := createClient(mock)
@@ -629,6 +723,9 @@ func Test_Environment(t *testing.T) {
 		client.genericResourceClientFactory = func(scope string, resourceType string) (genericResourceClient, error) {
 			return genericResourceMock, nil
 		}
+		client.lockClientFactory = func() (lockClient, error) {
+			return lockMock(ctrl), nil
+		}
 
 		resourceListPages := []generated.GenericResourcesClientListByRootScopeResponse{
 			{
@@ -816,16 +913,281 @@ func Test_ResourceGroup(t *testing.T) {
 		client := createClient(mock)
 
 		mock.EXPECT().
-			Delete(gomock.Any(), "local", testResourceName, gomock.Any()).
-			DoAndReturn(func(ctx context.Context, s1, s2 string, rgcdo *ucp.ResourceGroupsClientDeleteOptions) (ucp.ResourceGroupsClientDeleteResponse, error) {
-				setCapture(ctx, &http.Response{StatusCode: 200})
-				return ucp.ResourceGroupsClientDeleteResponse{}, nil
+			BeginDelete(gomock.Any(), "local", testResourceName, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, s1, s2 string, options *ucp.ResourceGroupsClientBeginDeleteOptions) (*runtime.Poller[ucp.ResourceGroupsClientDeleteResponse], error) {
+				require.Nil(t, options.Cascade)
+				return poller(&ucp.ResourceGroupsClientDeleteResponse{}), nil
 			})
 
 		deleted, err := client.DeleteResourceGroup(context.Background(), "local", testResourceName)
 		require.NoError(t, err)
 		require.True(t, deleted)
 	})
+
+	t.Run("DeleteResourceGroupCascade", func(t *testing.T) {
+		mock := NewMockresourceGroupClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			BeginDelete(gomock.Any(), "local", testResourceName, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, s1, s2 string, options *ucp.ResourceGroupsClientBeginDeleteOptions) (*runtime.Poller[ucp.ResourceGroupsClientDeleteResponse], error) {
+				require.Equal(t, to.Ptr(true), options.Cascade)
+				return poller(&ucp.ResourceGroupsClientDeleteResponse{}), nil
+			})
+
+		deleted, err := client.DeleteResourceGroupCascade(context.Background(), "local", testResourceName, nil)
+		require.NoError(t, err)
+		require.True(t, deleted)
+	})
+
+	t.Run("MoveResources", func(t *testing.T) {
+		mock := NewMockresourceGroupClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		sourceID := "/planes/radius/local/resourcegroups/" + testResourceName + "/providers/Applications.Core/applications/my-app"
+		targetGroupID := "/planes/radius/local/resourcegroups/other-group"
+		expectedRequest := ucp.MoveResourcesRequest{
+			TargetResourceGroup: to.Ptr(targetGroupID),
+			Resources:           []*string{to.Ptr(sourceID)},
+		}
+
+		movedID := targetGroupID + "/providers/Applications.Core/applications/my-app"
+		mock.EXPECT().
+			MoveResources(gomock.Any(), "local", testResourceName, expectedRequest, gomock.Any()).
+			Return(ucp.ResourceGroupsClientMoveResourcesResponse{
+				MoveResourcesResult: ucp.MoveResourcesResult{Resources: []*string{to.Ptr(movedID)}},
+			}, nil)
+
+		moved, err := client.MoveResources(context.Background(), "local", testResourceName, targetGroupID, []string{sourceID})
+		require.NoError(t, err)
+		require.Equal(t, []string{movedID}, moved)
+	})
+}
+
+func Test_ListResourcesInResourceGroup(t *testing.T) {
+	createClient := func(wrapped resourcesClient) *UCPApplicationsManagementClient {
+		return &UCPApplicationsManagementClient{
+			RootScope: testScope,
+			resourcesClientFactory: func() (resourcesClient, error) {
+				return wrapped, nil
+			},
+			capture: testCapture,
+		}
+	}
+
+	resourcePages := []ucp.ResourcesClientListResponse{
+		{
+			GenericResourceListResult: ucp.GenericResourceListResult{
+				Value: []*ucp.GenericResource{
+					{
+						ID:   to.Ptr("/planes/radius/local/resourcegroups/test-rg/providers/Applications.Core/containers/payments-api"),
+						Name: to.Ptr("payments-api"),
+						Type: to.Ptr("Applications.Core/containers"),
+						Tags: map[string]*string{"team": to.Ptr("payments"), "env": to.Ptr("dev")},
+					},
+				},
+				NextLink: to.Ptr("0"),
+			},
+		},
+		{
+			GenericResourceListResult: ucp.GenericResourceListResult{
+				Value: []*ucp.GenericResource{
+					{
+						ID:   to.Ptr("/planes/radius/local/resourcegroups/test-rg/providers/Applications.Core/containers/payments-worker"),
+						Name: to.Ptr("payments-worker"),
+						Type: to.Ptr("Applications.Core/containers"),
+						Tags: map[string]*string{"team": to.Ptr("payments"), "env": to.Ptr("dev")},
+					},
+				},
+				NextLink: to.Ptr("1"),
+			},
+		},
+	}
+
+	mock := NewMockresourcesClient(gomock.NewController(t))
+	client := createClient(mock)
+
+	mock.EXPECT().
+		NewListPager("local", "test-rg", &ucp.ResourcesClientListOptions{Tag: []string{"env=dev", "team=payments"}}).
+		Return(pager(resourcePages))
+
+	expected := []ucp.GenericResource{*resourcePages[0].Value[0], *resourcePages[1].Value[0]}
+
+	resources, err := client.ListResourcesInResourceGroup(context.Background(), "local", "test-rg", map[string]string{"team": "payments", "env": "dev"})
+	require.NoError(t, err)
+	require.Equal(t, expected, resources)
+}
+
+func Test_RoleAssignment(t *testing.T) {
+	createClient := func(wrapped roleAssignmentClient) *UCPApplicationsManagementClient {
+		return &UCPApplicationsManagementClient{
+			RootScope: testScope,
+			roleAssignmentClientFactory: func() (roleAssignmentClient, error) {
+				return wrapped, nil
+			},
+			capture: testCapture,
+		}
+	}
+
+	testRoleAssignmentName := "test-role-assignment"
+
+	expectedResource := ucp.RoleAssignmentResource{
+		ID:       to.Ptr("/planes/radius/local/providers/System.Authorization/roleAssignments/" + testRoleAssignmentName),
+		Name:     &testRoleAssignmentName,
+		Type:     to.Ptr("System.Authorization/roleAssignments"),
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &ucp.RoleAssignmentProperties{
+			PrincipalID:        to.Ptr("alice"),
+			PrincipalType:      to.Ptr(ucp.PrincipalTypeUser),
+			RoleDefinitionName: to.Ptr(ucp.RoleDefinitionNameReader),
+			Scope:              to.Ptr("/planes/radius/local/resourceGroups/test-rg"),
+		},
+	}
+
+	t.Run("ListRoleAssignments", func(t *testing.T) {
+		mock := NewMockroleAssignmentClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		roleAssignmentPages := []ucp.RoleAssignmentsClientListResponse{
+			{
+				RoleAssignmentResourceListResult: ucp.RoleAssignmentResourceListResult{
+					Value:    []*ucp.RoleAssignmentResource{&expectedResource},
+					NextLink: to.Ptr("0"),
+				},
+			},
+		}
+
+		mock.EXPECT().
+			NewListPager("local", gomock.Any()).
+			Return(pager(roleAssignmentPages))
+
+		roleAssignments, err := client.ListRoleAssignments(context.Background(), "local")
+		require.NoError(t, err)
+		require.Equal(t, []ucp.RoleAssignmentResource{expectedResource}, roleAssignments)
+	})
+
+	t.Run("CreateOrUpdateRoleAssignment", func(t *testing.T) {
+		mock := NewMockroleAssignmentClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			CreateOrUpdate(gomock.Any(), "local", testRoleAssignmentName, expectedResource, gomock.Any()).
+			Return(ucp.RoleAssignmentsClientCreateOrUpdateResponse{}, nil)
+
+		err := client.CreateOrUpdateRoleAssignment(context.Background(), "local", testRoleAssignmentName, &expectedResource)
+		require.NoError(t, err)
+	})
+
+	t.Run("DeleteRoleAssignment", func(t *testing.T) {
+		mock := NewMockroleAssignmentClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			Delete(gomock.Any(), "local", testRoleAssignmentName, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, s1, s2 string, options *ucp.RoleAssignmentsClientDeleteOptions) (ucp.RoleAssignmentsClientDeleteResponse, error) {
+				setCapture(ctx, &http.Response{StatusCode: 200})
+				return ucp.RoleAssignmentsClientDeleteResponse{}, nil
+			})
+
+		deleted, err := client.DeleteRoleAssignment(context.Background(), "local", testRoleAssignmentName)
+		require.NoError(t, err)
+		require.True(t, deleted)
+	})
+}
+
+func Test_Lock(t *testing.T) {
+	createClient := func(wrapped lockClient) *UCPApplicationsManagementClient {
+		return &UCPApplicationsManagementClient{
+			RootScope: testScope,
+			lockClientFactory: func() (lockClient, error) {
+				return wrapped, nil
+			},
+			capture: testCapture,
+		}
+	}
+
+	testLockName := "test-lock"
+
+	expectedResource := ucp.LockResource{
+		ID:       to.Ptr("/planes/radius/local/providers/System.Authorization/locks/" + testLockName),
+		Name:     &testLockName,
+		Type:     to.Ptr("System.Authorization/locks"),
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &ucp.LockProperties{
+			Level: to.Ptr(ucp.LockLevelCanNotDelete),
+			Scope: to.Ptr("/planes/radius/local/resourceGroups/test-rg"),
+		},
+	}
+
+	t.Run("CreateOrUpdateLock", func(t *testing.T) {
+		mock := NewMocklockClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			CreateOrUpdate(gomock.Any(), "local", testLockName, expectedResource, gomock.Any()).
+			Return(ucp.LocksClientCreateOrUpdateResponse{}, nil)
+
+		err := client.CreateOrUpdateLock(context.Background(), "local", testLockName, &expectedResource)
+		require.NoError(t, err)
+	})
+
+	t.Run("DeleteLock", func(t *testing.T) {
+		mock := NewMocklockClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			Delete(gomock.Any(), "local", testLockName, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, s1, s2 string, options *ucp.LocksClientDeleteOptions) (ucp.LocksClientDeleteResponse, error) {
+				setCapture(ctx, &http.Response{StatusCode: 204})
+				return ucp.LocksClientDeleteResponse{}, nil
+			})
+
+		deleted, err := client.DeleteLock(context.Background(), "local", testLockName)
+		require.NoError(t, err)
+		require.False(t, deleted)
+	})
+}
+
+func Test_CancelOperation(t *testing.T) {
+	operationID := "00000000-0000-0000-0000-000000000001"
+
+	createClient := func(t *testing.T, statusCode int) (*UCPApplicationsManagementClient, *http.Request) {
+		var received http.Request
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = *r
+			w.WriteHeader(statusCode)
+		}))
+		t.Cleanup(server.Close)
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		return &UCPApplicationsManagementClient{
+			RootScope:     testScope,
+			ClientOptions: sdk.NewClientOptions(connection),
+		}, &received
+	}
+
+	t.Run("CancelOperation", func(t *testing.T) {
+		client, received := createClient(t, http.StatusOK)
+
+		err := client.CancelOperation(context.Background(), "Applications.Core/containers", operationID)
+		require.NoError(t, err)
+		require.Equal(t, http.MethodPost, received.Method)
+		require.Equal(t, "/planes/radius/local/providers/Applications.Core/locations/global/operationstatuses/"+operationID+"/cancel", received.URL.Path)
+		require.Equal(t, operationStatusAPIVersion, received.URL.Query().Get("api-version"))
+	})
+
+	t.Run("CancelOperation - completed", func(t *testing.T) {
+		client, _ := createClient(t, http.StatusConflict)
+
+		err := client.CancelOperation(context.Background(), "Applications.Core/containers", operationID)
+		require.Error(t, err)
+
+		var responseError *azcore.ResponseError
+		require.ErrorAs(t, err, &responseError)
+		require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	})
 }
 
 func Test_extractScopeAndName(t *testing.T) {
@@ -885,6 +1247,21 @@ func Test_fullyQualifyID(t *testing.T) {
 	})
 }
 
+// lockMock returns a lock client which lists the given locks.
+func lockMock(ctrl *gomock.Controller, locks ...ucp.LockResource) lockClient {
+	value := []*ucp.LockResource{}
+	for i := range locks {
+		value = append(value, &locks[i])
+	}
+
+	mock := NewMocklockClient(ctrl)
+	mock.EXPECT().
+		NewListPager("local", gomock.Any()).
+		Return(pager([]ucp.LocksClientListResponse{{LockResourceListResult: ucp.LockResourceListResult{Value: value, NextLink: to.Ptr("0")}}})).
+		AnyTimes()
+	return mock
+}
+
 func pager[S ~[]E, E any](pages S) *runtime.Pager[E] {
 	// Generated autorest types don't implement comparable, so we use
 	// the next link to encode the index of each page.
@@ -968,3 +1345,43 @@ func setCapture(ctx context.Context, response *http.Response) {
 func testCapture(ctx context.Context, capture **http.Response) context.Context {
 	return context.WithValue(ctx, holder{}, &holder{capture})
 }
+
+func Test_ListAuditRecords(t *testing.T) {
+	resourceID := testScope + "/providers/Applications.Core/containers/test-container"
+	records := []audit.Record{
+		{
+			ID:         "00000000-0000-0000-0000-000000000001",
+			Timestamp:  time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+			Service:    "radius",
+			Caller:     "alice",
+			Method:     http.MethodPut,
+			ResourceID: resourceID,
+			StatusCode: http.StatusAccepted,
+			Result:     audit.ResultAccepted,
+		},
+	}
+
+	var received http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = *r
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"value": records})
+	}))
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+
+	client := &UCPApplicationsManagementClient{
+		RootScope:     testScope,
+		ClientOptions: sdk.NewClientOptions(connection),
+	}
+
+	actual, err := client.ListAuditRecords(context.Background(), resourceID)
+	require.NoError(t, err)
+	require.Equal(t, records, actual)
+	require.Equal(t, http.MethodGet, received.Method)
+	require.Equal(t, "/planes/radius/local/providers/System.Audit/auditRecords", received.URL.Path)
+	require.Equal(t, resourceID, received.URL.Query().Get("resourceId"))
+	require.Equal(t, auditRecordsAPIVersion, received.URL.Query().Get("api-version"))
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	audit "github.com/radius-project/radius/pkg/armrpc/audit"
 	generated "github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	v20231001preview0 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -42,6 +43,44 @@ func (m *MockApplicationsManagementClient) EXPECT() *MockApplicationsManagementC
 	return m.recorder
 }
 
+// CancelOperation mocks base method.
+func (m *MockApplicationsManagementClient) CancelOperation(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOperation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOperation indicates an expected call of CancelOperation.
+func (mr *MockApplicationsManagementClientMockRecorder) CancelOperation(arg0, arg1, arg2 any) *MockApplicationsManagementClientCancelOperationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOperation", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CancelOperation), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientCancelOperationCall{Call: call}
+}
+
+// MockApplicationsManagementClientCancelOperationCall wrap *gomock.Call
+type MockApplicationsManagementClientCancelOperationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientCancelOperationCall) Return(arg0 error) *MockApplicationsManagementClientCancelOperationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientCancelOperationCall) Do(f func(context.Context, string, string) error) *MockApplicationsManagementClientCancelOperationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientCancelOperationCall) DoAndReturn(f func(context.Context, string, string) error) *MockApplicationsManagementClientCancelOperationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateApplicationIfNotFound mocks base method.
 func (m *MockApplicationsManagementClient) CreateApplicationIfNotFound(arg0 context.Context, arg1 string, arg2 *v20231001preview.ApplicationResource) error {
 	m.ctrl.T.Helper()
@@ -156,6 +195,44 @@ func (c *MockApplicationsManagementClientCreateOrUpdateEnvironmentCall) DoAndRet
 	return c
 }
 
+// CreateOrUpdateLock mocks base method.
+func (m *MockApplicationsManagementClient) CreateOrUpdateLock(arg0 context.Context, arg1, arg2 string, arg3 *v20231001preview0.LockResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateLock", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateLock indicates an expected call of CreateOrUpdateLock.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateLock(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientCreateOrUpdateLockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateLock", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateLock), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientCreateOrUpdateLockCall{Call: call}
+}
+
+// MockApplicationsManagementClientCreateOrUpdateLockCall wrap *gomock.Call
+type MockApplicationsManagementClientCreateOrUpdateLockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientCreateOrUpdateLockCall) Return(arg0 error) *MockApplicationsManagementClientCreateOrUpdateLockCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientCreateOrUpdateLockCall) Do(f func(context.Context, string, string, *v20231001preview0.LockResource) error) *MockApplicationsManagementClientCreateOrUpdateLockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientCreateOrUpdateLockCall) DoAndReturn(f func(context.Context, string, string, *v20231001preview0.LockResource) error) *MockApplicationsManagementClientCreateOrUpdateLockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateOrUpdateResourceGroup mocks base method.
 func (m *MockApplicationsManagementClient) CreateOrUpdateResourceGroup(arg0 context.Context, arg1, arg2 string, arg3 *v20231001preview0.ResourceGroupResource) error {
 	m.ctrl.T.Helper()
@@ -194,6 +271,44 @@ func (c *MockApplicationsManagementClientCreateOrUpdateResourceGroupCall) DoAndR
 	return c
 }
 
+// CreateOrUpdateRoleAssignment mocks base method.
+func (m *MockApplicationsManagementClient) CreateOrUpdateRoleAssignment(arg0 context.Context, arg1, arg2 string, arg3 *v20231001preview0.RoleAssignmentResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateRoleAssignment", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateRoleAssignment indicates an expected call of CreateOrUpdateRoleAssignment.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateRoleAssignment(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientCreateOrUpdateRoleAssignmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateRoleAssignment", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateRoleAssignment), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientCreateOrUpdateRoleAssignmentCall{Call: call}
+}
+
+// MockApplicationsManagementClientCreateOrUpdateRoleAssignmentCall wrap *gomock.Call
+type MockApplicationsManagementClientCreateOrUpdateRoleAssignmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientCreateOrUpdateRoleAssignmentCall) Return(arg0 error) *MockApplicationsManagementClientCreateOrUpdateRoleAssignmentCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientCreateOrUpdateRoleAssignmentCall) Do(f func(context.Context, string, string, *v20231001preview0.RoleAssignmentResource) error) *MockApplicationsManagementClientCreateOrUpdateRoleAssignmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientCreateOrUpdateRoleAssignmentCall) DoAndReturn(f func(context.Context, string, string, *v20231001preview0.RoleAssignmentResource) error) *MockApplicationsManagementClientCreateOrUpdateRoleAssignmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteApplication mocks base method.
 func (m *MockApplicationsManagementClient) DeleteApplication(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -272,6 +387,45 @@ func (c *MockApplicationsManagementClientDeleteEnvironmentCall) DoAndReturn(f fu
 	return c
 }
 
+// DeleteLock mocks base method.
+func (m *MockApplicationsManagementClient) DeleteLock(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLock indicates an expected call of DeleteLock.
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteLock(arg0, arg1, arg2 any) *MockApplicationsManagementClientDeleteLockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLock", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteLock), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientDeleteLockCall{Call: call}
+}
+
+// MockApplicationsManagementClientDeleteLockCall wrap *gomock.Call
+type MockApplicationsManagementClientDeleteLockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientDeleteLockCall) Return(arg0 bool, arg1 error) *MockApplicationsManagementClientDeleteLockCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientDeleteLockCall) Do(f func(context.Context, string, string) (bool, error)) *MockApplicationsManagementClientDeleteLockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientDeleteLockCall) DoAndReturn(f func(context.Context, string, string) (bool, error)) *MockApplicationsManagementClientDeleteLockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteResource mocks base method.
 func (m *MockApplicationsManagementClient) DeleteResource(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -350,6 +504,84 @@ func (c *MockApplicationsManagementClientDeleteResourceGroupCall) DoAndReturn(f
 	return c
 }
 
+// DeleteResourceGroupCascade mocks base method.
+func (m *MockApplicationsManagementClient) DeleteResourceGroupCascade(arg0 context.Context, arg1, arg2 string, arg3 func(float64)) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourceGroupCascade", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourceGroupCascade indicates an expected call of DeleteResourceGroupCascade.
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteResourceGroupCascade(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientDeleteResourceGroupCascadeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceGroupCascade", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteResourceGroupCascade), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientDeleteResourceGroupCascadeCall{Call: call}
+}
+
+// MockApplicationsManagementClientDeleteResourceGroupCascadeCall wrap *gomock.Call
+type MockApplicationsManagementClientDeleteResourceGroupCascadeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientDeleteResourceGroupCascadeCall) Return(arg0 bool, arg1 error) *MockApplicationsManagementClientDeleteResourceGroupCascadeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientDeleteResourceGroupCascadeCall) Do(f func(context.Context, string, string, func(float64)) (bool, error)) *MockApplicationsManagementClientDeleteResourceGroupCascadeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientDeleteResourceGroupCascadeCall) DoAndReturn(f func(context.Context, string, string, func(float64)) (bool, error)) *MockApplicationsManagementClientDeleteResourceGroupCascadeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteRoleAssignment mocks base method.
+func (m *MockApplicationsManagementClient) DeleteRoleAssignment(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoleAssignment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoleAssignment indicates an expected call of DeleteRoleAssignment.
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteRoleAssignment(arg0, arg1, arg2 any) *MockApplicationsManagementClientDeleteRoleAssignmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoleAssignment", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteRoleAssignment), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientDeleteRoleAssignmentCall{Call: call}
+}
+
+// MockApplicationsManagementClientDeleteRoleAssignmentCall wrap *gomock.Call
+type MockApplicationsManagementClientDeleteRoleAssignmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientDeleteRoleAssignmentCall) Return(arg0 bool, arg1 error) *MockApplicationsManagementClientDeleteRoleAssignmentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientDeleteRoleAssignmentCall) Do(f func(context.Context, string, string) (bool, error)) *MockApplicationsManagementClientDeleteRoleAssignmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientDeleteRoleAssignmentCall) DoAndReturn(f func(context.Context, string, string) (bool, error)) *MockApplicationsManagementClientDeleteRoleAssignmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplication mocks base method.
 func (m *MockApplicationsManagementClient) GetApplication(arg0 context.Context, arg1 string) (v20231001preview.ApplicationResource, error) {
 	m.ctrl.T.Helper()
@@ -623,6 +855,45 @@ func (c *MockApplicationsManagementClientListApplicationsCall) DoAndReturn(f fun
 	return c
 }
 
+// ListAuditRecords mocks base method.
+func (m *MockApplicationsManagementClient) ListAuditRecords(arg0 context.Context, arg1 string) ([]audit.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditRecords", arg0, arg1)
+	ret0, _ := ret[0].([]audit.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditRecords indicates an expected call of ListAuditRecords.
+func (mr *MockApplicationsManagementClientMockRecorder) ListAuditRecords(arg0, arg1 any) *MockApplicationsManagementClientListAuditRecordsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditRecords", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAuditRecords), arg0, arg1)
+	return &MockApplicationsManagementClientListAuditRecordsCall{Call: call}
+}
+
+// MockApplicationsManagementClientListAuditRecordsCall wrap *gomock.Call
+type MockApplicationsManagementClientListAuditRecordsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListAuditRecordsCall) Return(arg0 []audit.Record, arg1 error) *MockApplicationsManagementClientListAuditRecordsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListAuditRecordsCall) Do(f func(context.Context, string) ([]audit.Record, error)) *MockApplicationsManagementClientListAuditRecordsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListAuditRecordsCall) DoAndReturn(f func(context.Context, string) ([]audit.Record, error)) *MockApplicationsManagementClientListAuditRecordsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListEnvironments mocks base method.
 func (m *MockApplicationsManagementClient) ListEnvironments(arg0 context.Context) ([]v20231001preview.EnvironmentResource, error) {
 	m.ctrl.T.Helper()
@@ -818,6 +1089,45 @@ func (c *MockApplicationsManagementClientListResourcesInEnvironmentCall) DoAndRe
 	return c
 }
 
+// ListResourcesInResourceGroup mocks base method.
+func (m *MockApplicationsManagementClient) ListResourcesInResourceGroup(arg0 context.Context, arg1, arg2 string, arg3 map[string]string) ([]v20231001preview0.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourcesInResourceGroup", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]v20231001preview0.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourcesInResourceGroup indicates an expected call of ListResourcesInResourceGroup.
+func (mr *MockApplicationsManagementClientMockRecorder) ListResourcesInResourceGroup(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientListResourcesInResourceGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourcesInResourceGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListResourcesInResourceGroup), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientListResourcesInResourceGroupCall{Call: call}
+}
+
+// MockApplicationsManagementClientListResourcesInResourceGroupCall wrap *gomock.Call
+type MockApplicationsManagementClientListResourcesInResourceGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListResourcesInResourceGroupCall) Return(arg0 []v20231001preview0.GenericResource, arg1 error) *MockApplicationsManagementClientListResourcesInResourceGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListResourcesInResourceGroupCall) Do(f func(context.Context, string, string, map[string]string) ([]v20231001preview0.GenericResource, error)) *MockApplicationsManagementClientListResourcesInResourceGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListResourcesInResourceGroupCall) DoAndReturn(f func(context.Context, string, string, map[string]string) ([]v20231001preview0.GenericResource, error)) *MockApplicationsManagementClientListResourcesInResourceGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListResourcesOfType mocks base method.
 func (m *MockApplicationsManagementClient) ListResourcesOfType(arg0 context.Context, arg1 string) ([]generated.GenericResource, error) {
 	m.ctrl.T.Helper()
@@ -934,3 +1244,120 @@ func (c *MockApplicationsManagementClientListResourcesOfTypeInEnvironmentCall) D
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ListRoleAssignments mocks base method.
+func (m *MockApplicationsManagementClient) ListRoleAssignments(arg0 context.Context, arg1 string) ([]v20231001preview0.RoleAssignmentResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleAssignments", arg0, arg1)
+	ret0, _ := ret[0].([]v20231001preview0.RoleAssignmentResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoleAssignments indicates an expected call of ListRoleAssignments.
+func (mr *MockApplicationsManagementClientMockRecorder) ListRoleAssignments(arg0, arg1 any) *MockApplicationsManagementClientListRoleAssignmentsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleAssignments", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListRoleAssignments), arg0, arg1)
+	return &MockApplicationsManagementClientListRoleAssignmentsCall{Call: call}
+}
+
+// MockApplicationsManagementClientListRoleAssignmentsCall wrap *gomock.Call
+type MockApplicationsManagementClientListRoleAssignmentsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListRoleAssignmentsCall) Return(arg0 []v20231001preview0.RoleAssignmentResource, arg1 error) *MockApplicationsManagementClientListRoleAssignmentsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListRoleAssignmentsCall) Do(f func(context.Context, string) ([]v20231001preview0.RoleAssignmentResource, error)) *MockApplicationsManagementClientListRoleAssignmentsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListRoleAssignmentsCall) DoAndReturn(f func(context.Context, string) ([]v20231001preview0.RoleAssignmentResource, error)) *MockApplicationsManagementClientListRoleAssignmentsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MoveResources mocks base method.
+func (m *MockApplicationsManagementClient) MoveResources(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveResources", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveResources indicates an expected call of MoveResources.
+func (mr *MockApplicationsManagementClientMockRecorder) MoveResources(arg0, arg1, arg2, arg3, arg4 any) *MockApplicationsManagementClientMoveResourcesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveResources", reflect.TypeOf((*MockApplicationsManagementClient)(nil).MoveResources), arg0, arg1, arg2, arg3, arg4)
+	return &MockApplicationsManagementClientMoveResourcesCall{Call: call}
+}
+
+// MockApplicationsManagementClientMoveResourcesCall wrap *gomock.Call
+type MockApplicationsManagementClientMoveResourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientMoveResourcesCall) Return(arg0 []string, arg1 error) *MockApplicationsManagementClientMoveResourcesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientMoveResourcesCall) Do(f func(context.Context, string, string, string, []string) ([]string, error)) *MockApplicationsManagementClientMoveResourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientMoveResourcesCall) DoAndReturn(f func(context.Context, string, string, string, []string) ([]string, error)) *MockApplicationsManagementClientMoveResourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PlanRecipe mocks base method.
+func (m *MockApplicationsManagementClient) PlanRecipe(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipePlanRequest) (v20231001preview.RecipePlanResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanRecipe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview.RecipePlanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanRecipe indicates an expected call of PlanRecipe.
+func (mr *MockApplicationsManagementClientMockRecorder) PlanRecipe(arg0, arg1, arg2 any) *MockApplicationsManagementClientPlanRecipeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanRecipe", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PlanRecipe), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientPlanRecipeCall{Call: call}
+}
+
+// MockApplicationsManagementClientPlanRecipeCall wrap *gomock.Call
+type MockApplicationsManagementClientPlanRecipeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientPlanRecipeCall) Return(arg0 v20231001preview.RecipePlanResponse, arg1 error) *MockApplicationsManagementClientPlanRecipeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientPlanRecipeCall) Do(f func(context.Context, string, v20231001preview.RecipePlanRequest) (v20231001preview.RecipePlanResponse, error)) *MockApplicationsManagementClientPlanRecipeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientPlanRecipeCall) DoAndReturn(f func(context.Context, string, v20231001preview.RecipePlanRequest) (v20231001preview.RecipePlanResponse, error)) *MockApplicationsManagementClientPlanRecipeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient
+//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourcesClient,roleAssignmentClient,lockClient
 //
 
 // Package clients is a generated GoMock package.
@@ -632,6 +632,45 @@ func (c *MockenvironmentResourceClientNewListByScopePagerCall) DoAndReturn(f fun
 	return c
 }
 
+// PlanRecipe mocks base method.
+func (m *MockenvironmentResourceClient) PlanRecipe(ctx context.Context, environmentName string, body v20231001preview.RecipePlanRequest, options *v20231001preview.EnvironmentsClientPlanRecipeOptions) (v20231001preview.EnvironmentsClientPlanRecipeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanRecipe", ctx, environmentName, body, options)
+	ret0, _ := ret[0].(v20231001preview.EnvironmentsClientPlanRecipeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanRecipe indicates an expected call of PlanRecipe.
+func (mr *MockenvironmentResourceClientMockRecorder) PlanRecipe(ctx, environmentName, body, options any) *MockenvironmentResourceClientPlanRecipeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanRecipe", reflect.TypeOf((*MockenvironmentResourceClient)(nil).PlanRecipe), ctx, environmentName, body, options)
+	return &MockenvironmentResourceClientPlanRecipeCall{Call: call}
+}
+
+// MockenvironmentResourceClientPlanRecipeCall wrap *gomock.Call
+type MockenvironmentResourceClientPlanRecipeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockenvironmentResourceClientPlanRecipeCall) Return(arg0 v20231001preview.EnvironmentsClientPlanRecipeResponse, arg1 error) *MockenvironmentResourceClientPlanRecipeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockenvironmentResourceClientPlanRecipeCall) Do(f func(context.Context, string, v20231001preview.RecipePlanRequest, *v20231001preview.EnvironmentsClientPlanRecipeOptions) (v20231001preview.EnvironmentsClientPlanRecipeResponse, error)) *MockenvironmentResourceClientPlanRecipeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockenvironmentResourceClientPlanRecipeCall) DoAndReturn(f func(context.Context, string, v20231001preview.RecipePlanRequest, *v20231001preview.EnvironmentsClientPlanRecipeOptions) (v20231001preview.EnvironmentsClientPlanRecipeResponse, error)) *MockenvironmentResourceClientPlanRecipeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockresourceGroupClient is a mock of resourceGroupClient interface.
 type MockresourceGroupClient struct {
 	ctrl     *gomock.Controller
@@ -655,80 +694,80 @@ func (m *MockresourceGroupClient) EXPECT() *MockresourceGroupClientMockRecorder
 	return m.recorder
 }
 
-// CreateOrUpdate mocks base method.
-func (m *MockresourceGroupClient) CreateOrUpdate(ctx context.Context, planeName, resourceGroupName string, resource v20231001preview0.ResourceGroupResource, options *v20231001preview0.ResourceGroupsClientCreateOrUpdateOptions) (v20231001preview0.ResourceGroupsClientCreateOrUpdateResponse, error) {
+// BeginDelete mocks base method.
+func (m *MockresourceGroupClient) BeginDelete(ctx context.Context, planeName, resourceGroupName string, options *v20231001preview0.ResourceGroupsClientBeginDeleteOptions) (*runtime.Poller[v20231001preview0.ResourceGroupsClientDeleteResponse], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, planeName, resourceGroupName, resource, options)
-	ret0, _ := ret[0].(v20231001preview0.ResourceGroupsClientCreateOrUpdateResponse)
+	ret := m.ctrl.Call(m, "BeginDelete", ctx, planeName, resourceGroupName, options)
+	ret0, _ := ret[0].(*runtime.Poller[v20231001preview0.ResourceGroupsClientDeleteResponse])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOrUpdate indicates an expected call of CreateOrUpdate.
-func (mr *MockresourceGroupClientMockRecorder) CreateOrUpdate(ctx, planeName, resourceGroupName, resource, options any) *MockresourceGroupClientCreateOrUpdateCall {
+// BeginDelete indicates an expected call of BeginDelete.
+func (mr *MockresourceGroupClientMockRecorder) BeginDelete(ctx, planeName, resourceGroupName, options any) *MockresourceGroupClientBeginDeleteCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockresourceGroupClient)(nil).CreateOrUpdate), ctx, planeName, resourceGroupName, resource, options)
-	return &MockresourceGroupClientCreateOrUpdateCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginDelete", reflect.TypeOf((*MockresourceGroupClient)(nil).BeginDelete), ctx, planeName, resourceGroupName, options)
+	return &MockresourceGroupClientBeginDeleteCall{Call: call}
 }
 
-// MockresourceGroupClientCreateOrUpdateCall wrap *gomock.Call
-type MockresourceGroupClientCreateOrUpdateCall struct {
+// MockresourceGroupClientBeginDeleteCall wrap *gomock.Call
+type MockresourceGroupClientBeginDeleteCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockresourceGroupClientCreateOrUpdateCall) Return(arg0 v20231001preview0.ResourceGroupsClientCreateOrUpdateResponse, arg1 error) *MockresourceGroupClientCreateOrUpdateCall {
+func (c *MockresourceGroupClientBeginDeleteCall) Return(arg0 *runtime.Poller[v20231001preview0.ResourceGroupsClientDeleteResponse], arg1 error) *MockresourceGroupClientBeginDeleteCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockresourceGroupClientCreateOrUpdateCall) Do(f func(context.Context, string, string, v20231001preview0.ResourceGroupResource, *v20231001preview0.ResourceGroupsClientCreateOrUpdateOptions) (v20231001preview0.ResourceGroupsClientCreateOrUpdateResponse, error)) *MockresourceGroupClientCreateOrUpdateCall {
+func (c *MockresourceGroupClientBeginDeleteCall) Do(f func(context.Context, string, string, *v20231001preview0.ResourceGroupsClientBeginDeleteOptions) (*runtime.Poller[v20231001preview0.ResourceGroupsClientDeleteResponse], error)) *MockresourceGroupClientBeginDeleteCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockresourceGroupClientCreateOrUpdateCall) DoAndReturn(f func(context.Context, string, string, v20231001preview0.ResourceGroupResource, *v20231001preview0.ResourceGroupsClientCreateOrUpdateOptions) (v20231001preview0.ResourceGroupsClientCreateOrUpdateResponse, error)) *MockresourceGroupClientCreateOrUpdateCall {
+func (c *MockresourceGroupClientBeginDeleteCall) DoAndReturn(f func(context.Context, string, string, *v20231001preview0.ResourceGroupsClientBeginDeleteOptions) (*runtime.Poller[v20231001preview0.ResourceGroupsClientDeleteResponse], error)) *MockresourceGroupClientBeginDeleteCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Delete mocks base method.
-func (m *MockresourceGroupClient) Delete(ctx context.Context, planeName, resourceGroupName string, options *v20231001preview0.ResourceGroupsClientDeleteOptions) (v20231001preview0.ResourceGroupsClientDeleteResponse, error) {
+// CreateOrUpdate mocks base method.
+func (m *MockresourceGroupClient) CreateOrUpdate(ctx context.Context, planeName, resourceGroupName string, resource v20231001preview0.ResourceGroupResource, options *v20231001preview0.ResourceGroupsClientCreateOrUpdateOptions) (v20231001preview0.ResourceGroupsClientCreateOrUpdateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, planeName, resourceGroupName, options)
-	ret0, _ := ret[0].(v20231001preview0.ResourceGroupsClientDeleteResponse)
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, planeName, resourceGroupName, resource, options)
+	ret0, _ := ret[0].(v20231001preview0.ResourceGroupsClientCreateOrUpdateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockresourceGroupClientMockRecorder) Delete(ctx, planeName, resourceGroupName, options any) *MockresourceGroupClientDeleteCall {
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockresourceGroupClientMockRecorder) CreateOrUpdate(ctx, planeName, resourceGroupName, resource, options any) *MockresourceGroupClientCreateOrUpdateCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockresourceGroupClient)(nil).Delete), ctx, planeName, resourceGroupName, options)
-	return &MockresourceGroupClientDeleteCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockresourceGroupClient)(nil).CreateOrUpdate), ctx, planeName, resourceGroupName, resource, options)
+	return &MockresourceGroupClientCreateOrUpdateCall{Call: call}
 }
 
-// MockresourceGroupClientDeleteCall wrap *gomock.Call
-type MockresourceGroupClientDeleteCall struct {
+// MockresourceGroupClientCreateOrUpdateCall wrap *gomock.Call
+type MockresourceGroupClientCreateOrUpdateCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockresourceGroupClientDeleteCall) Return(arg0 v20231001preview0.ResourceGroupsClientDeleteResponse, arg1 error) *MockresourceGroupClientDeleteCall {
+func (c *MockresourceGroupClientCreateOrUpdateCall) Return(arg0 v20231001preview0.ResourceGroupsClientCreateOrUpdateResponse, arg1 error) *MockresourceGroupClientCreateOrUpdateCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockresourceGroupClientDeleteCall) Do(f func(context.Context, string, string, *v20231001preview0.ResourceGroupsClientDeleteOptions) (v20231001preview0.ResourceGroupsClientDeleteResponse, error)) *MockresourceGroupClientDeleteCall {
+func (c *MockresourceGroupClientCreateOrUpdateCall) Do(f func(context.Context, string, string, v20231001preview0.ResourceGroupResource, *v20231001preview0.ResourceGroupsClientCreateOrUpdateOptions) (v20231001preview0.ResourceGroupsClientCreateOrUpdateResponse, error)) *MockresourceGroupClientCreateOrUpdateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockresourceGroupClientDeleteCall) DoAndReturn(f func(context.Context, string, string, *v20231001preview0.ResourceGroupsClientDeleteOptions) (v20231001preview0.ResourceGroupsClientDeleteResponse, error)) *MockresourceGroupClientDeleteCall {
+func (c *MockresourceGroupClientCreateOrUpdateCall) DoAndReturn(f func(context.Context, string, string, v20231001preview0.ResourceGroupResource, *v20231001preview0.ResourceGroupsClientCreateOrUpdateOptions) (v20231001preview0.ResourceGroupsClientCreateOrUpdateResponse, error)) *MockresourceGroupClientCreateOrUpdateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -772,6 +811,45 @@ func (c *MockresourceGroupClientGetCall) DoAndReturn(f func(context.Context, str
 	return c
 }
 
+// MoveResources mocks base method.
+func (m *MockresourceGroupClient) MoveResources(ctx context.Context, planeName, resourceGroupName string, body v20231001preview0.MoveResourcesRequest, options *v20231001preview0.ResourceGroupsClientMoveResourcesOptions) (v20231001preview0.ResourceGroupsClientMoveResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveResources", ctx, planeName, resourceGroupName, body, options)
+	ret0, _ := ret[0].(v20231001preview0.ResourceGroupsClientMoveResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveResources indicates an expected call of MoveResources.
+func (mr *MockresourceGroupClientMockRecorder) MoveResources(ctx, planeName, resourceGroupName, body, options any) *MockresourceGroupClientMoveResourcesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveResources", reflect.TypeOf((*MockresourceGroupClient)(nil).MoveResources), ctx, planeName, resourceGroupName, body, options)
+	return &MockresourceGroupClientMoveResourcesCall{Call: call}
+}
+
+// MockresourceGroupClientMoveResourcesCall wrap *gomock.Call
+type MockresourceGroupClientMoveResourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockresourceGroupClientMoveResourcesCall) Return(arg0 v20231001preview0.ResourceGroupsClientMoveResourcesResponse, arg1 error) *MockresourceGroupClientMoveResourcesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockresourceGroupClientMoveResourcesCall) Do(f func(context.Context, string, string, v20231001preview0.MoveResourcesRequest, *v20231001preview0.ResourceGroupsClientMoveResourcesOptions) (v20231001preview0.ResourceGroupsClientMoveResourcesResponse, error)) *MockresourceGroupClientMoveResourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockresourceGroupClientMoveResourcesCall) DoAndReturn(f func(context.Context, string, string, v20231001preview0.MoveResourcesRequest, *v20231001preview0.ResourceGroupsClientMoveResourcesOptions) (v20231001preview0.ResourceGroupsClientMoveResourcesResponse, error)) *MockresourceGroupClientMoveResourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NewListPager mocks base method.
 func (m *MockresourceGroupClient) NewListPager(planeName string, options *v20231001preview0.ResourceGroupsClientListOptions) *runtime.Pager[v20231001preview0.ResourceGroupsClientListResponse] {
 	m.ctrl.T.Helper()
@@ -809,3 +887,342 @@ func (c *MockresourceGroupClientNewListPagerCall) DoAndReturn(f func(string, *v2
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockresourcesClient is a mock of resourcesClient interface.
+type MockresourcesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourcesClientMockRecorder
+}
+
+// MockresourcesClientMockRecorder is the mock recorder for MockresourcesClient.
+type MockresourcesClientMockRecorder struct {
+	mock *MockresourcesClient
+}
+
+// NewMockresourcesClient creates a new mock instance.
+func NewMockresourcesClient(ctrl *gomock.Controller) *MockresourcesClient {
+	mock := &MockresourcesClient{ctrl: ctrl}
+	mock.recorder = &MockresourcesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockresourcesClient) EXPECT() *MockresourcesClientMockRecorder {
+	return m.recorder
+}
+
+// NewListPager mocks base method.
+func (m *MockresourcesClient) NewListPager(planeName, resourceGroupName string, options *v20231001preview0.ResourcesClientListOptions) *runtime.Pager[v20231001preview0.ResourcesClientListResponse] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPager", planeName, resourceGroupName, options)
+	ret0, _ := ret[0].(*runtime.Pager[v20231001preview0.ResourcesClientListResponse])
+	return ret0
+}
+
+// NewListPager indicates an expected call of NewListPager.
+func (mr *MockresourcesClientMockRecorder) NewListPager(planeName, resourceGroupName, options any) *MockresourcesClientNewListPagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPager", reflect.TypeOf((*MockresourcesClient)(nil).NewListPager), planeName, resourceGroupName, options)
+	return &MockresourcesClientNewListPagerCall{Call: call}
+}
+
+// MockresourcesClientNewListPagerCall wrap *gomock.Call
+type MockresourcesClientNewListPagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockresourcesClientNewListPagerCall) Return(arg0 *runtime.Pager[v20231001preview0.ResourcesClientListResponse]) *MockresourcesClientNewListPagerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockresourcesClientNewListPagerCall) Do(f func(string, string, *v20231001preview0.ResourcesClientListOptions) *runtime.Pager[v20231001preview0.ResourcesClientListResponse]) *MockresourcesClientNewListPagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockresourcesClientNewListPagerCall) DoAndReturn(f func(string, string, *v20231001preview0.ResourcesClientListOptions) *runtime.Pager[v20231001preview0.ResourcesClientListResponse]) *MockresourcesClientNewListPagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockroleAssignmentClient is a mock of roleAssignmentClient interface.
+type MockroleAssignmentClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockroleAssignmentClientMockRecorder
+}
+
+// MockroleAssignmentClientMockRecorder is the mock recorder for MockroleAssignmentClient.
+type MockroleAssignmentClientMockRecorder struct {
+	mock *MockroleAssignmentClient
+}
+
+// NewMockroleAssignmentClient creates a new mock instance.
+func NewMockroleAssignmentClient(ctrl *gomock.Controller) *MockroleAssignmentClient {
+	mock := &MockroleAssignmentClient{ctrl: ctrl}
+	mock.recorder = &MockroleAssignmentClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockroleAssignmentClient) EXPECT() *MockroleAssignmentClientMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockroleAssignmentClient) CreateOrUpdate(ctx context.Context, planeName, roleAssignmentName string, resource v20231001preview0.RoleAssignmentResource, options *v20231001preview0.RoleAssignmentsClientCreateOrUpdateOptions) (v20231001preview0.RoleAssignmentsClientCreateOrUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, planeName, roleAssignmentName, resource, options)
+	ret0, _ := ret[0].(v20231001preview0.RoleAssignmentsClientCreateOrUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockroleAssignmentClientMockRecorder) CreateOrUpdate(ctx, planeName, roleAssignmentName, resource, options any) *MockroleAssignmentClientCreateOrUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockroleAssignmentClient)(nil).CreateOrUpdate), ctx, planeName, roleAssignmentName, resource, options)
+	return &MockroleAssignmentClientCreateOrUpdateCall{Call: call}
+}
+
+// MockroleAssignmentClientCreateOrUpdateCall wrap *gomock.Call
+type MockroleAssignmentClientCreateOrUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockroleAssignmentClientCreateOrUpdateCall) Return(arg0 v20231001preview0.RoleAssignmentsClientCreateOrUpdateResponse, arg1 error) *MockroleAssignmentClientCreateOrUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockroleAssignmentClientCreateOrUpdateCall) Do(f func(context.Context, string, string, v20231001preview0.RoleAssignmentResource, *v20231001preview0.RoleAssignmentsClientCreateOrUpdateOptions) (v20231001preview0.RoleAssignmentsClientCreateOrUpdateResponse, error)) *MockroleAssignmentClientCreateOrUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockroleAssignmentClientCreateOrUpdateCall) DoAndReturn(f func(context.Context, string, string, v20231001preview0.RoleAssignmentResource, *v20231001preview0.RoleAssignmentsClientCreateOrUpdateOptions) (v20231001preview0.RoleAssignmentsClientCreateOrUpdateResponse, error)) *MockroleAssignmentClientCreateOrUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockroleAssignmentClient) Delete(ctx context.Context, planeName, roleAssignmentName string, options *v20231001preview0.RoleAssignmentsClientDeleteOptions) (v20231001preview0.RoleAssignmentsClientDeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, planeName, roleAssignmentName, options)
+	ret0, _ := ret[0].(v20231001preview0.RoleAssignmentsClientDeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockroleAssignmentClientMockRecorder) Delete(ctx, planeName, roleAssignmentName, options any) *MockroleAssignmentClientDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockroleAssignmentClient)(nil).Delete), ctx, planeName, roleAssignmentName, options)
+	return &MockroleAssignmentClientDeleteCall{Call: call}
+}
+
+// MockroleAssignmentClientDeleteCall wrap *gomock.Call
+type MockroleAssignmentClientDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockroleAssignmentClientDeleteCall) Return(arg0 v20231001preview0.RoleAssignmentsClientDeleteResponse, arg1 error) *MockroleAssignmentClientDeleteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockroleAssignmentClientDeleteCall) Do(f func(context.Context, string, string, *v20231001preview0.RoleAssignmentsClientDeleteOptions) (v20231001preview0.RoleAssignmentsClientDeleteResponse, error)) *MockroleAssignmentClientDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockroleAssignmentClientDeleteCall) DoAndReturn(f func(context.Context, string, string, *v20231001preview0.RoleAssignmentsClientDeleteOptions) (v20231001preview0.RoleAssignmentsClientDeleteResponse, error)) *MockroleAssignmentClientDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NewListPager mocks base method.
+func (m *MockroleAssignmentClient) NewListPager(planeName string, options *v20231001preview0.RoleAssignmentsClientListOptions) *runtime.Pager[v20231001preview0.RoleAssignmentsClientListResponse] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPager", planeName, options)
+	ret0, _ := ret[0].(*runtime.Pager[v20231001preview0.RoleAssignmentsClientListResponse])
+	return ret0
+}
+
+// NewListPager indicates an expected call of NewListPager.
+func (mr *MockroleAssignmentClientMockRecorder) NewListPager(planeName, options any) *MockroleAssignmentClientNewListPagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPager", reflect.TypeOf((*MockroleAssignmentClient)(nil).NewListPager), planeName, options)
+	return &MockroleAssignmentClientNewListPagerCall{Call: call}
+}
+
+// MockroleAssignmentClientNewListPagerCall wrap *gomock.Call
+type MockroleAssignmentClientNewListPagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockroleAssignmentClientNewListPagerCall) Return(arg0 *runtime.Pager[v20231001preview0.RoleAssignmentsClientListResponse]) *MockroleAssignmentClientNewListPagerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockroleAssignmentClientNewListPagerCall) Do(f func(string, *v20231001preview0.RoleAssignmentsClientListOptions) *runtime.Pager[v20231001preview0.RoleAssignmentsClientListResponse]) *MockroleAssignmentClientNewListPagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockroleAssignmentClientNewListPagerCall) DoAndReturn(f func(string, *v20231001preview0.RoleAssignmentsClientListOptions) *runtime.Pager[v20231001preview0.RoleAssignmentsClientListResponse]) *MockroleAssignmentClientNewListPagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocklockClient is a mock of lockClient interface.
+type MocklockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MocklockClientMockRecorder
+}
+
+// MocklockClientMockRecorder is the mock recorder for MocklockClient.
+type MocklockClientMockRecorder struct {
+	mock *MocklockClient
+}
+
+// NewMocklockClient creates a new mock instance.
+func NewMocklockClient(ctrl *gomock.Controller) *MocklockClient {
+	mock := &MocklockClient{ctrl: ctrl}
+	mock.recorder = &MocklockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklockClient) EXPECT() *MocklockClientMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MocklockClient) CreateOrUpdate(ctx context.Context, planeName, lockName string, resource v20231001preview0.LockResource, options *v20231001preview0.LocksClientCreateOrUpdateOptions) (v20231001preview0.LocksClientCreateOrUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, planeName, lockName, resource, options)
+	ret0, _ := ret[0].(v20231001preview0.LocksClientCreateOrUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MocklockClientMockRecorder) CreateOrUpdate(ctx, planeName, lockName, resource, options any) *MocklockClientCreateOrUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MocklockClient)(nil).CreateOrUpdate), ctx, planeName, lockName, resource, options)
+	return &MocklockClientCreateOrUpdateCall{Call: call}
+}
+
+// MocklockClientCreateOrUpdateCall wrap *gomock.Call
+type MocklockClientCreateOrUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklockClientCreateOrUpdateCall) Return(arg0 v20231001preview0.LocksClientCreateOrUpdateResponse, arg1 error) *MocklockClientCreateOrUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklockClientCreateOrUpdateCall) Do(f func(context.Context, string, string, v20231001preview0.LockResource, *v20231001preview0.LocksClientCreateOrUpdateOptions) (v20231001preview0.LocksClientCreateOrUpdateResponse, error)) *MocklockClientCreateOrUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklockClientCreateOrUpdateCall) DoAndReturn(f func(context.Context, string, string, v20231001preview0.LockResource, *v20231001preview0.LocksClientCreateOrUpdateOptions) (v20231001preview0.LocksClientCreateOrUpdateResponse, error)) *MocklockClientCreateOrUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MocklockClient) Delete(ctx context.Context, planeName, lockName string, options *v20231001preview0.LocksClientDeleteOptions) (v20231001preview0.LocksClientDeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, planeName, lockName, options)
+	ret0, _ := ret[0].(v20231001preview0.LocksClientDeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MocklockClientMockRecorder) Delete(ctx, planeName, lockName, options any) *MocklockClientDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocklockClient)(nil).Delete), ctx, planeName, lockName, options)
+	return &MocklockClientDeleteCall{Call: call}
+}
+
+// MocklockClientDeleteCall wrap *gomock.Call
+type MocklockClientDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklockClientDeleteCall) Return(arg0 v20231001preview0.LocksClientDeleteResponse, arg1 error) *MocklockClientDeleteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklockClientDeleteCall) Do(f func(context.Context, string, string, *v20231001preview0.LocksClientDeleteOptions) (v20231001preview0.LocksClientDeleteResponse, error)) *MocklockClientDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklockClientDeleteCall) DoAndReturn(f func(context.Context, string, string, *v20231001preview0.LocksClientDeleteOptions) (v20231001preview0.LocksClientDeleteResponse, error)) *MocklockClientDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NewListPager mocks base method.
+func (m *MocklockClient) NewListPager(planeName string, options *v20231001preview0.LocksClientListOptions) *runtime.Pager[v20231001preview0.LocksClientListResponse] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPager", planeName, options)
+	ret0, _ := ret[0].(*runtime.Pager[v20231001preview0.LocksClientListResponse])
+	return ret0
+}
+
+// NewListPager indicates an expected call of NewListPager.
+func (mr *MocklockClientMockRecorder) NewListPager(planeName, options any) *MocklockClientNewListPagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPager", reflect.TypeOf((*MocklockClient)(nil).NewListPager), planeName, options)
+	return &MocklockClientNewListPagerCall{Call: call}
+}
+
+// MocklockClientNewListPagerCall wrap *gomock.Call
+type MocklockClientNewListPagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklockClientNewListPagerCall) Return(arg0 *runtime.Pager[v20231001preview0.LocksClientListResponse]) *MocklockClientNewListPagerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklockClientNewListPagerCall) Do(f func(string, *v20231001preview0.LocksClientListOptions) *runtime.Pager[v20231001preview0.LocksClientListResponse]) *MocklockClientNewListPagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklockClientNewListPagerCall) DoAndReturn(f func(string, *v20231001preview0.LocksClientListOptions) *runtime.Pager[v20231001preview0.LocksClientListResponse]) *MocklockClientNewListPagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
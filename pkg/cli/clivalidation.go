@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/config"
+	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/spf13/cobra"
@@ -320,8 +321,24 @@ func RequireAzureSubscriptionId(cmd *cobra.Command) (string, error) {
 	return subscriptionId, err
 }
 
+// RequireOutput reads the output format from the command flags and validates that it is a supported format,
+// so that an invalid format is reported before the command does any work.
 func RequireOutput(cmd *cobra.Command) (string, error) {
-	return cmd.Flags().GetString("output")
+	format, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return "", err
+	}
+
+	// An empty format means the command's default format.
+	if format == "" {
+		return format, nil
+	}
+
+	if _, err := output.NewFormatter(format); err != nil {
+		return "", clierrors.Message("Invalid output format %q: %s. Supported formats are %s.", format, err.Error(), strings.Join(output.SupportedFormats(), ", "))
+	}
+
+	return format, nil
 }
 
 // RequireWorkspace is used by commands that require an existing workspace either set as the default,
@@ -23,6 +23,9 @@ import (
 	"testing"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
 
@@ -80,3 +83,56 @@ func Test_RequireResourceType(t *testing.T) {
 		})
 	}
 }
+
+func Test_RequireOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Default format",
+			args: []string{},
+			want: "table",
+		},
+		{
+			name: "YAML format",
+			args: []string{"-o", "yaml"},
+			want: "yaml",
+		},
+		{
+			name: "Custom columns format",
+			args: []string{"--output=custom-columns=NAME,STATE"},
+			want: "custom-columns=NAME,STATE",
+		},
+		{
+			name:    "Unsupported format",
+			args:    []string{"-o", "xml"},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid template",
+			args:    []string{"-o", "go-template={{ .name"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			commonflags.AddOutputFlag(cmd)
+			require.NoError(t, cmd.ParseFlags(tt.args))
+
+			got, err := RequireOutput(cmd)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.True(t, clierrors.IsFriendlyError(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -184,7 +184,9 @@ func (r *Runner) Run(ctx context.Context) error {
 	}
 
 	deleted, err := client.DeleteApplication(ctx, r.ApplicationName)
-	if err != nil {
+	if clients.IsScopeLockedError(err) {
+		return clierrors.MessageWithCause(err, "The application %q cannot be deleted because it is protected by a management lock. Remove the lock with `rad resource unlock` and try again.", r.ApplicationName)
+	} else if err != nil {
 		return err
 	}
 
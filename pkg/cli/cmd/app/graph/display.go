@@ -83,6 +83,14 @@ func display(applicationResources []*v20231001preview.ApplicationGraphResource,
 			}
 		}
 
+		// Only container resources have init containers or sidecars, so the section is omitted when there are none.
+		if len(resource.Containers) > 0 {
+			output.WriteString("Containers:\n")
+			for _, container := range resource.Containers {
+				output.WriteString(fmt.Sprintf("  %s (%s, %s)\n", *container.Name, *container.Kind, *container.Image))
+			}
+		}
+
 		if len(resource.OutputResources) == 0 {
 			output.WriteString("Resources: (none)\n")
 		} else {
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/stretchr/testify/require"
 )
 
@@ -161,4 +162,42 @@ Resources: (none)
 		require.Equal(t, expected, actual)
 	})
 
+	t.Run("init containers and sidecars", func(t *testing.T) {
+		graph := []*corerpv20231001preview.ApplicationGraphResource{
+			{
+				ID:                to.Ptr("/planes/radius/local/resourcegroups/default/providers/Applications.Core/containers/frontend"),
+				Name:              to.Ptr("frontend"),
+				Type:              to.Ptr("Applications.Core/containers"),
+				ProvisioningState: to.Ptr("Succeeded"),
+				OutputResources:   []*corerpv20231001preview.ApplicationGraphOutputResource{},
+				Connections:       []*corerpv20231001preview.ApplicationGraphConnection{},
+				Containers: []*corerpv20231001preview.ApplicationGraphContainer{
+					{
+						Name:  to.Ptr("migrate"),
+						Image: to.Ptr("migrate:latest"),
+						Kind:  to.Ptr(corerpv20231001preview.ApplicationGraphContainerKindInit),
+					},
+					{
+						Name:  to.Ptr("logshipper"),
+						Image: to.Ptr("fluent-bit:latest"),
+						Kind:  to.Ptr(corerpv20231001preview.ApplicationGraphContainerKindSidecar),
+					},
+				},
+			},
+		}
+
+		expected := `Displaying application: test-app
+
+Name: frontend (Applications.Core/containers)
+Connections: (none)
+Containers:
+  migrate (init, migrate:latest)
+  logshipper (sidecar, fluent-bit:latest)
+Resources: (none)
+
+`
+
+		actual := display(graph, "test-app")
+		require.Equal(t, expected, actual)
+	})
 }
@@ -18,7 +18,8 @@ package list
 
 import "github.com/radius-project/radius/pkg/cli/output"
 
-// credentialFormat configures the output format of a table to display the Name and Status of a cloud provider.
+// credentialFormat configures the output format of a table to display the Name and Status of a cloud provider, and the
+// health, rotation and expiry of its credential.
 func credentialFormat() output.FormatterOptions {
 	return output.FormatterOptions{
 		Columns: []output.Column{
@@ -30,6 +31,18 @@ func credentialFormat() output.FormatterOptions {
 				Heading:  "REGISTERED",
 				JSONPath: "{ .Enabled }",
 			},
+			{
+				Heading:  "HEALTH",
+				JSONPath: "{ .Health }",
+			},
+			{
+				Heading:  "ROTATED",
+				JSONPath: "{ .RotatedAt }",
+			},
+			{
+				Heading:  "EXPIRES",
+				JSONPath: "{ .ExpiresAt }",
+			},
 		},
 	}
 }
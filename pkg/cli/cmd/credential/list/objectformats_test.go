@@ -37,6 +37,23 @@ func Test_credentialFormat(t *testing.T) {
 	err := output.Write(output.FormatTable, obj, buffer, credentialFormat())
 	require.NoError(t, err)
 
-	expected := "PROVIDER  REGISTERED\ntest      true\n"
+	expected := "PROVIDER  REGISTERED  HEALTH    ROTATED   EXPIRES\ntest      true                            \n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_credentialFormat_Status(t *testing.T) {
+	obj := credential.CloudProviderStatus{
+		Name:      "aws",
+		Enabled:   true,
+		Health:    "Unhealthy",
+		RotatedAt: "2024-06-01T00:00:00Z",
+		ExpiresAt: "2025-01-01T00:00:00Z",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, credentialFormat())
+	require.NoError(t, err)
+
+	expected := "PROVIDER  REGISTERED  HEALTH     ROTATED               EXPIRES\naws       true        Unhealthy  2024-06-01T00:00:00Z  2025-01-01T00:00:00Z\n"
 	require.Equal(t, expected, buffer.String())
 }
@@ -51,10 +51,17 @@ This command is intended for scripting or advanced use-cases. See 'rad init' for
 to configure these settings.
 
 Radius will use the provided IAM credential for all interactions with AWS. 
+
+Alternatively, pass --iam-role-arn to have Radius assume the provided IAM role using IAM roles for service
+accounts (IRSA) instead of an access key. The role must trust the OIDC provider of the cluster and the Radius
+service accounts.
 ` + common.LongDescriptionBlurb,
 		Example: `
 # Register (Add or update) cloud provider credential for AWS with IAM authentication
 rad credential register aws --access-key-id <access-key-id> --secret-access-key <secret-access-key>
+
+# Register (Add or update) cloud provider credential for AWS with IAM roles for service accounts (IRSA)
+rad credential register aws --iam-role-arn <iam-role-arn>
 `,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -63,11 +70,11 @@ rad credential register aws --access-key-id <access-key-id> --secret-access-key
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
 
-	cmd.Flags().String("access-key-id", "", "The AWS IAM access key id.")
-	_ = cmd.MarkFlagRequired("access-key-id")
+	cmd.Flags().String("access-key-id", "", "The AWS IAM access key id. Required unless --iam-role-arn is specified.")
 
-	cmd.Flags().String("secret-access-key", "", "The AWS IAM secret access key.")
-	_ = cmd.MarkFlagRequired("secret-access-key")
+	cmd.Flags().String("secret-access-key", "", "The AWS IAM secret access key. Required unless --iam-role-arn is specified.")
+
+	cmd.Flags().String("iam-role-arn", "", "The ARN of the AWS IAM role to assume using IAM roles for service accounts (IRSA).")
 
 	return cmd, runner
 }
@@ -82,6 +89,7 @@ type Runner struct {
 
 	AccessKeyID     string
 	SecretAccessKey string
+	IAMRoleARN      string
 	KubeContext     string
 }
 
@@ -97,7 +105,7 @@ func NewRunner(factory framework.Factory) *Runner {
 // Validate runs validation for the `rad credential register aws` command.
 //
 
-// Validate() checks if the required workspace, output format, access key ID and secret access key are present, and if not, returns an error.
+// Validate() checks if the required workspace, output format, access key ID and secret access key (or IAM role ARN) are present, and if not, returns an error.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
 	if err != nil {
@@ -119,14 +127,25 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	iamRoleARN, err := cmd.Flags().GetString("iam-role-arn")
+	if err != nil {
+		return err
+	}
 	r.AccessKeyID = accessKeyID
 	r.SecretAccessKey = secretAccessKey
-
-	if r.AccessKeyID == "" {
-		return clierrors.Message("Access Key id %q cannot be empty.", r.AccessKeyID)
-	}
-	if r.SecretAccessKey == "" {
-		return clierrors.Message("Secret Access Key %q cannot be empty.", r.SecretAccessKey)
+	r.IAMRoleARN = iamRoleARN
+
+	if r.IAMRoleARN != "" {
+		if r.AccessKeyID != "" || r.SecretAccessKey != "" {
+			return clierrors.Message("The --access-key-id and --secret-access-key flags cannot be used with --iam-role-arn.")
+		}
+	} else {
+		if r.AccessKeyID == "" {
+			return clierrors.Message("Access Key id %q cannot be empty.", r.AccessKeyID)
+		}
+		if r.SecretAccessKey == "" {
+			return clierrors.Message("Secret Access Key %q cannot be empty.", r.SecretAccessKey)
+		}
 	}
 
 	kubeContext, ok := r.Workspace.KubernetesContext()
@@ -148,16 +167,27 @@ func (r *Runner) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	credential := ucp.AwsCredentialResource{
-		Location: to.Ptr(v1.LocationGlobal),
-		Type:     to.Ptr(cli_credential.AWSCredential),
-		Properties: &ucp.AwsAccessKeyCredentialProperties{
+	var properties ucp.AwsCredentialPropertiesClassification = &ucp.AwsAccessKeyCredentialProperties{
+		Storage: &ucp.CredentialStorageProperties{
+			Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+		},
+		AccessKeyID:     &r.AccessKeyID,
+		SecretAccessKey: &r.SecretAccessKey,
+	}
+	if r.IAMRoleARN != "" {
+		properties = &ucp.AwsIRSACredentialProperties{
 			Storage: &ucp.CredentialStorageProperties{
 				Kind: to.Ptr(ucp.CredentialStorageKindInternal),
 			},
-			AccessKeyID:     &r.AccessKeyID,
-			SecretAccessKey: &r.SecretAccessKey,
-		},
+			RoleARN: &r.IAMRoleARN,
+			Kind:    to.Ptr(ucp.AWSCredentialKindIRSA),
+		}
+	}
+
+	credential := ucp.AwsCredentialResource{
+		Location:   to.Ptr(v1.LocationGlobal),
+		Type:       to.Ptr(cli_credential.AWSCredential),
+		Properties: properties,
 	}
 
 	err = client.PutAWS(ctx, credential)
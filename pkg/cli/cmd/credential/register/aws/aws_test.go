@@ -37,6 +37,7 @@ import (
 const (
 	testAccessKeyId     = "TEST-ACCESS-KEY-ID"
 	testSecretAccessKey = "TEST-SECRET-ACCESS-KEY"
+	testIAMRoleARN      = "arn:aws:iam::000000000000:role/radius"
 )
 
 func Test_CommandValidation(t *testing.T) {
@@ -92,6 +93,24 @@ func Test_Validate(t *testing.T) {
 			ExpectedValid: false,
 			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
 		},
+		{
+			Name: "Valid AWS IRSA command",
+			Input: []string{
+				"--iam-role-arn", testIAMRoleARN,
+			},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name: "AWS IRSA command with access key",
+			Input: []string{
+				"--iam-role-arn", testIAMRoleARN,
+				"--access-key-id", testAccessKeyId,
+				"--secret-access-key", testSecretAccessKey,
+			},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
 	}
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
 }
@@ -150,5 +169,43 @@ func Test_Run(t *testing.T) {
 			}
 			require.Equal(t, expected, outputSink.Writes)
 		})
+
+		t.Run("Success with IRSA", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			expectedPut := ucp.AwsCredentialResource{
+				Location: to.Ptr(v1.LocationGlobal),
+				Type:     to.Ptr(cli_credential.AWSCredential),
+				Properties: &ucp.AwsIRSACredentialProperties{
+					Storage: &ucp.CredentialStorageProperties{
+						Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+					},
+					RoleARN: to.Ptr(testIAMRoleARN),
+					Kind:    to.Ptr(ucp.AWSCredentialKindIRSA),
+				},
+			}
+
+			client := cli_credential.NewMockCredentialManagementClient(ctrl)
+			client.EXPECT().
+				PutAWS(gomock.Any(), expectedPut).
+				Return(nil).
+				Times(1)
+
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+				Output:            &output.MockOutput{},
+				Workspace: &workspaces.Workspace{
+					Connection: map[string]any{
+						"kind":    workspaces.KindKubernetes,
+						"context": "my-context",
+					},
+					Source: workspaces.SourceUserConfig,
+				},
+				Format:      "table",
+				IAMRoleARN:  testIAMRoleARN,
+				KubeContext: "my-context",
+			}
+			err := runner.Run(context.Background())
+			require.NoError(t, err)
+		})
 	})
 }
@@ -55,6 +55,10 @@ to configure these settings.
 Radius will use the provided service principal for all interactions with Azure, including Bicep deployment, 
 Radius Environments, and Radius portable resources. 
 
+Alternatively, pass --workload-identity to have Radius authenticate with Azure workload identity instead of a client
+secret. The provided client id must be the Azure AD application or user-assigned managed identity federated with the
+Radius service accounts.
+
 Radius will use the provided subscription and resource group as the default target scope for Bicep deployment.
 The provided service principal must have the Contributor or Owner role assigned for the provided resource group
 in order to create or manage resources contained in the group. The resource group should be created before
@@ -63,6 +67,9 @@ calling 'rad credential register azure'.
 		Example: `
 # Register (Add or update) cloud provider credential for Azure with service principal authentication
 rad credential register azure --client-id <client id/app id> --client-secret <client secret/password> --tenant-id <tenant id>
+
+# Register (Add or update) cloud provider credential for Azure with workload identity authentication
+rad credential register azure --workload-identity --client-id <client id/app id> --tenant-id <tenant id>
 `,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -74,8 +81,9 @@ rad credential register azure --client-id <client id/app id> --client-secret <cl
 	cmd.Flags().String("client-id", "", "The client id or app id of an Azure service principal.")
 	_ = cmd.MarkFlagRequired("client-id")
 
-	cmd.Flags().String("client-secret", "", "The client secret or password of an Azure service principal.")
-	_ = cmd.MarkFlagRequired("client-secret")
+	cmd.Flags().String("client-secret", "", "The client secret or password of an Azure service principal. Required unless --workload-identity is specified.")
+
+	cmd.Flags().Bool("workload-identity", false, "Use Azure workload identity instead of a service principal client secret.")
 
 	cmd.Flags().String("tenant-id", "", "The tenant id of an Azure service principal.")
 	_ = cmd.MarkFlagRequired("tenant-id")
@@ -91,10 +99,11 @@ type Runner struct {
 	Format            string
 	Workspace         *workspaces.Workspace
 
-	ClientID     string
-	ClientSecret string
-	TenantID     string
-	KubeContext  string
+	ClientID         string
+	ClientSecret     string
+	TenantID         string
+	WorkloadIdentity bool
+	KubeContext      string
 }
 
 // NewRunner creates a new instance of the `rad credential register azure` runner.
@@ -110,7 +119,8 @@ func NewRunner(factory framework.Factory) *Runner {
 //
 
 // Validate checks for the presence of a workspace, output format, client ID, client secret and tenant ID, and
-// sets them in the Runner struct if they are present. If any of these are not present, an error is returned.
+// sets them in the Runner struct if they are present. If any of these are not present, an error is returned. The
+// client secret must be omitted when workload identity is used.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
 	if err != nil {
@@ -136,10 +146,22 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	workloadIdentity, err := cmd.Flags().GetBool("workload-identity")
+	if err != nil {
+		return err
+	}
 
 	r.ClientID = clientID
 	r.ClientSecret = clientSecret
 	r.TenantID = tenantID
+	r.WorkloadIdentity = workloadIdentity
+
+	if r.WorkloadIdentity && r.ClientSecret != "" {
+		return clierrors.Message("The --client-secret flag cannot be used with --workload-identity.")
+	}
+	if !r.WorkloadIdentity && r.ClientSecret == "" {
+		return clierrors.Message("The --client-secret flag is required unless --workload-identity is specified.")
+	}
 
 	kubeContext, ok := r.Workspace.KubernetesContext()
 	if !ok {
@@ -162,19 +184,31 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	credential := ucp.AzureCredentialResource{
-		Location: to.Ptr(v1.LocationGlobal),
-		Type:     to.Ptr(cli_credential.AzureCredential),
-		ID:       to.Ptr(fmt.Sprintf(common.AzureCredentialID, "default")),
-		Properties: &ucp.AzureServicePrincipalProperties{
+	var properties ucp.AzureCredentialPropertiesClassification = &ucp.AzureServicePrincipalProperties{
+		Storage: &ucp.CredentialStorageProperties{
+			Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+		},
+		TenantID:     &r.TenantID,
+		ClientID:     &r.ClientID,
+		ClientSecret: &r.ClientSecret,
+		Kind:         to.Ptr(ucp.AzureCredentialKindServicePrincipal),
+	}
+	if r.WorkloadIdentity {
+		properties = &ucp.AzureWorkloadIdentityProperties{
 			Storage: &ucp.CredentialStorageProperties{
 				Kind: to.Ptr(ucp.CredentialStorageKindInternal),
 			},
-			TenantID:     &r.TenantID,
-			ClientID:     &r.ClientID,
-			ClientSecret: &r.ClientSecret,
-			Kind:         to.Ptr(ucp.AzureCredentialKindServicePrincipal),
-		},
+			TenantID: &r.TenantID,
+			ClientID: &r.ClientID,
+			Kind:     to.Ptr(ucp.AzureCredentialKindWorkloadIdentity),
+		}
+	}
+
+	credential := ucp.AzureCredentialResource{
+		Location:   to.Ptr(v1.LocationGlobal),
+		Type:       to.Ptr(cli_credential.AzureCredential),
+		ID:         to.Ptr(fmt.Sprintf(common.AzureCredentialID, "default")),
+		Properties: properties,
 	}
 
 	// Update server-side to add/change credentials
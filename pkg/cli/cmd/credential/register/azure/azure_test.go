@@ -104,6 +104,27 @@ func Test_Validate(t *testing.T) {
 			ExpectedValid: false,
 			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
 		},
+		{
+			Name: "Valid Azure workload identity command",
+			Input: []string{
+				"--workload-identity",
+				"--client-id", "abcd",
+				"--tenant-id", "ijkl",
+			},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name: "Azure workload identity command with client-secret",
+			Input: []string{
+				"--workload-identity",
+				"--client-id", "abcd",
+				"--client-secret", "efgh",
+				"--tenant-id", "ijkl",
+			},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
 		{
 			Name: "Azure command without subscription",
 			Input: []string{
@@ -254,5 +275,50 @@ func Test_Run(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, expectedConfig, actualConfig)
 		})
+
+		t.Run("Success with workload identity", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			expectedPut := ucp.AzureCredentialResource{
+				Location: to.Ptr(v1.LocationGlobal),
+				Type:     to.Ptr(cli_credential.AzureCredential),
+				ID:       to.Ptr(fmt.Sprintf(common.AzureCredentialID, "default")),
+				Properties: &ucp.AzureWorkloadIdentityProperties{
+					Storage: &ucp.CredentialStorageProperties{
+						Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+					},
+					ClientID: to.Ptr("cool-client-id"),
+					TenantID: to.Ptr("cool-tenant-id"),
+					Kind:     to.Ptr(ucp.AzureCredentialKindWorkloadIdentity),
+				},
+			}
+
+			client := cli_credential.NewMockCredentialManagementClient(ctrl)
+			client.EXPECT().
+				PutAzure(gomock.Any(), expectedPut).
+				Return(nil).
+				Times(1)
+
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+				Output:            &output.MockOutput{},
+				Workspace: &workspaces.Workspace{
+					Connection: map[string]any{
+						"kind":    workspaces.KindKubernetes,
+						"context": "my-context",
+					},
+					Source: workspaces.SourceUserConfig,
+				},
+				Format: "table",
+
+				ClientID:         "cool-client-id",
+				TenantID:         "cool-tenant-id",
+				WorkloadIdentity: true,
+				KubeContext:      "my-context",
+			}
+
+			err := runner.Run(context.Background())
+			require.NoError(t, err)
+		})
 	})
 }
@@ -44,6 +44,10 @@ func credentialFormat(credentialType string) output.FormatterOptions {
 					Heading:  "TENANTID",
 					JSONPath: "{ .AzureCredentials.TenantID }",
 				},
+				{
+					Heading:  "KIND",
+					JSONPath: "{ .AzureCredentials.Kind }",
+				},
 			},
 		}
 	} else if strings.EqualFold(credentialType, "aws") {
@@ -61,6 +65,14 @@ func credentialFormat(credentialType string) output.FormatterOptions {
 					Heading:  "ACCESSKEYID",
 					JSONPath: "{ .AWSCredentials.AccessKeyID }",
 				},
+				{
+					Heading:  "ROLEARN",
+					JSONPath: "{ .AWSCredentials.RoleARN }",
+				},
+				{
+					Heading:  "KIND",
+					JSONPath: "{ .AWSCredentials.Kind }",
+				},
 			},
 		}
 	}
@@ -34,6 +34,7 @@ func Test_credentialFormat_Azure(t *testing.T) {
 		},
 		AzureCredentials: &credential.AzureCredentialProperties{
 			ClientID: to.Ptr("test-client-id"),
+			Kind:     to.Ptr("ServicePrincipal"),
 			TenantID: to.Ptr("test-tenant-id"),
 		},
 	}
@@ -42,7 +43,7 @@ func Test_credentialFormat_Azure(t *testing.T) {
 	err := output.Write(output.FormatTable, obj, buffer, credentialFormat("azure"))
 	require.NoError(t, err)
 
-	expected := "NAME      REGISTERED  CLIENTID        TENANTID\ntest      true        test-client-id  test-tenant-id\n"
+	expected := "NAME      REGISTERED  CLIENTID        TENANTID        KIND\ntest      true        test-client-id  test-tenant-id  ServicePrincipal\n"
 	require.Equal(t, expected, buffer.String())
 }
 
@@ -54,6 +55,8 @@ func Test_credentialFormat_AWS(t *testing.T) {
 		},
 		AWSCredentials: &credential.AWSCredentialProperties{
 			AccessKeyID: to.Ptr("test-access-key-id"),
+			Kind:        to.Ptr("AccessKey"),
+			RoleARN:     to.Ptr(""),
 		},
 	}
 
@@ -61,6 +64,27 @@ func Test_credentialFormat_AWS(t *testing.T) {
 	err := output.Write(output.FormatTable, obj, buffer, credentialFormat("aws"))
 	require.NoError(t, err)
 
-	expected := "NAME      REGISTERED  ACCESSKEYID\ntest      true        test-access-key-id\n"
+	expected := "NAME      REGISTERED  ACCESSKEYID         ROLEARN   KIND\ntest      true        test-access-key-id            AccessKey\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_credentialFormat_AWS_IRSA(t *testing.T) {
+	obj := credential.ProviderCredentialConfiguration{
+		CloudProviderStatus: credential.CloudProviderStatus{
+			Name:    "test",
+			Enabled: true,
+		},
+		AWSCredentials: &credential.AWSCredentialProperties{
+			AccessKeyID: to.Ptr(""),
+			Kind:        to.Ptr("IRSA"),
+			RoleARN:     to.Ptr("test-role-arn"),
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, credentialFormat("aws"))
+	require.NoError(t, err)
+
+	expected := "NAME      REGISTERED  ACCESSKEYID  ROLEARN        KIND\ntest      true                     test-role-arn  IRSA\n"
 	require.Equal(t, expected, buffer.String())
 }
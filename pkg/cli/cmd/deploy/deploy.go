@@ -31,6 +31,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -101,6 +102,10 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+
+# preview the changes a deployment would make without deploying
+rad deploy myapp.bicep --what-if
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -111,6 +116,7 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().Bool("what-if", false, "Preview the changes the deployment would make without deploying")
 
 	return cmd, runner
 }
@@ -129,6 +135,7 @@ type Runner struct {
 	Parameters      map[string]map[string]any
 	Workspace       *workspaces.Workspace
 	Providers       *clients.Providers
+	WhatIf          bool
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -235,6 +242,14 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// The flag is not registered by commands that reuse this runner, such as `rad run`.
+	if cmd.Flags().Lookup("what-if") != nil {
+		r.WhatIf, err = cmd.Flags().GetBool("what-if")
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -263,6 +278,10 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.WhatIf {
+		return r.runWhatIf(ctx, template)
+	}
+
 	// Create application if specified. This supports the case where the application resource
 	// is not specified in Bicep. Creating the application automatically helps us "bootstrap" in a new environment.
 	if r.ApplicationName != "" {
@@ -321,6 +340,31 @@ func (r *Runner) Run(ctx context.Context) error {
 	return nil
 }
 
+// runWhatIf previews the changes the deployment would make and displays them. Nothing is deployed, including
+// the application that a regular deployment creates automatically.
+func (r *Runner) runWhatIf(ctx context.Context, template map[string]any) error {
+	r.Output.LogInfo("Previewing template '%v' in environment '%v' from workspace '%v'...", r.FilePath, r.EnvironmentName, r.Workspace.Name)
+
+	result, err := r.Deploy.WhatIf(ctx, deploy.Options{
+		ConnectionFactory: r.ConnectionFactory,
+		Workspace:         *r.Workspace,
+		Template:          template,
+		Parameters:        r.Parameters,
+		Providers:         r.Providers,
+	})
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("")
+	if len(result.Changes) == 0 {
+		r.Output.LogInfo("No changes. The deployment would not modify any resources.")
+		return nil
+	}
+
+	return r.Output.WriteFormatted(output.FormatTable, result.Changes, objectformats.GetWhatIfChangeTableFormat())
+}
+
 func (r *Runner) injectAutomaticParameters(template map[string]any) error {
 	if r.Providers.Radius.EnvironmentID != "" {
 		err := bicep.InjectEnvironmentParam(template, r.Parameters, r.Providers.Radius.EnvironmentID)
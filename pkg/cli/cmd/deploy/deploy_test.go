@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -74,6 +75,24 @@ func Test_Validate(t *testing.T) {
 
 			},
 		},
+		{
+			Name:          "rad deploy - valid with what-if",
+			Input:         []string{"app.bicep", "--what-if"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).WhatIf)
+			},
+		},
 		{
 			Name:          "rad deploy - valid with environment",
 			Input:         []string{"app.bicep", "-e", "prod"},
@@ -499,6 +518,87 @@ func Test_Run(t *testing.T) {
 		require.Empty(t, outputSink.Writes)
 	})
 
+	t.Run("What-if deployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bicep := bicep.NewMockInterface(ctrl)
+		bicep.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(map[string]any{}, nil).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name: "kind-kind",
+		}
+		providers := clients.Providers{
+			Radius: &clients.RadiusProvider{
+				EnvironmentID: fmt.Sprintf("/planes/radius/local/resourceGroups/%s/providers/applications.core/environments/%s", radcli.TestEnvironmentName, radcli.TestEnvironmentName),
+				ApplicationID: fmt.Sprintf("/planes/radius/local/resourceGroups/%s/providers/applications.core/applications/test-application", radcli.TestEnvironmentName),
+			},
+		}
+
+		changes := []clients.WhatIfChange{
+			{
+				ChangeType: "Create",
+				ResourceID: fmt.Sprintf("/planes/radius/local/resourceGroups/%s/providers/Applications.Core/containers/frontend", radcli.TestEnvironmentName),
+			},
+		}
+
+		// The application is not created and nothing is deployed in what-if mode.
+		appManagmentMock := clients.NewMockApplicationsManagementClient(ctrl)
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			WhatIf(gomock.Any(), deploy.Options{
+				ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagmentMock},
+				Workspace:         *workspace,
+				Template:          map[string]any{},
+				Parameters:        map[string]map[string]any{},
+				Providers:         &providers,
+			}).
+			Return(clients.WhatIfResult{Changes: changes}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			Bicep:             bicep,
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagmentMock},
+			Deploy:            deployMock,
+			Output:            outputSink,
+			Providers:         &providers,
+			FilePath:          "app.bicep",
+			ApplicationName:   "test-application",
+			EnvironmentName:   radcli.TestEnvironmentName,
+			Parameters:        map[string]map[string]any{},
+			Workspace:         workspace,
+			WhatIf:            true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Previewing template '%v' in environment '%v' from workspace '%v'...",
+				Params: []any{"app.bicep", radcli.TestEnvironmentName, "kind-kind"},
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  output.FormatTable,
+				Obj:     changes,
+				Options: objectformats.GetWhatIfChangeTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
 	t.Run("Deployment with missing parameters", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
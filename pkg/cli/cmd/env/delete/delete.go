@@ -21,6 +21,8 @@ import (
 	"fmt"
 
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
@@ -157,7 +159,9 @@ func (r *Runner) Run(ctx context.Context) error {
 	}
 
 	deleted, err := client.DeleteEnvironment(ctx, r.EnvironmentName)
-	if err != nil {
+	if clients.IsScopeLockedError(err) {
+		return clierrors.MessageWithCause(err, "The environment %q cannot be deleted because it is protected by a management lock. Remove the lock with `rad resource unlock` and try again.", r.EnvironmentName)
+	} else if err != nil {
 		return err
 	}
 
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
@@ -139,6 +140,36 @@ func Test_Show(t *testing.T) {
 		require.Equal(t, expected, outputSink.Writes)
 	})
 
+	t.Run("Error: Environment Locked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		lockErr := &clients.ScopeLockedError{
+			ResourceID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+			LockID:     "/planes/radius/local/providers/System.Authorization/locks/lock-1",
+			Level:      "CanNotDelete",
+			Scope:      "/planes/radius/local/resourceGroups/test-group",
+		}
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			DeleteEnvironment(gomock.Any(), "test-env").
+			Return(false, lockErr).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			Output:            &output.MockOutput{},
+			EnvironmentName:   "test-env",
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		expected := clierrors.MessageWithCause(lockErr, "The environment %q cannot be deleted because it is protected by a management lock. Remove the lock with `rad resource unlock` and try again.", "test-env")
+		require.Equal(t, expected, err)
+	})
+
 	t.Run("Success: Prompt Confirmed", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
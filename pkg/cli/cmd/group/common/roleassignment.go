@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// RoleAssignment describes the role assignment given by the flags of the `rad group grant` and `rad group revoke` commands.
+type RoleAssignment struct {
+	// PrincipalType is the kind of principal the role is assigned to.
+	PrincipalType v20231001preview.PrincipalType
+
+	// PrincipalID is the name of the user or group the role is assigned to.
+	PrincipalID string
+
+	// Role is the name of the built-in role.
+	Role v20231001preview.RoleDefinitionName
+}
+
+// AddRoleAssignmentFlags adds the flags describing a role assignment to the command.
+func AddRoleAssignmentFlags(cmd *cobra.Command) {
+	cmd.Flags().String("user", "", "The name of the user the role is assigned to")
+	cmd.Flags().String("user-group", "", "The name of the group of users the role is assigned to")
+	cmd.Flags().String("role", "", "The built-in role to assign. One of 'Reader', 'Contributor' or 'Owner'")
+	cmd.MarkFlagsMutuallyExclusive("user", "user-group")
+	_ = cmd.MarkFlagRequired("role")
+}
+
+// ParseRoleAssignmentFlags reads the role assignment described by the flags added with AddRoleAssignmentFlags. Exactly
+// one of the user or group flags must be set and the role must be one of the built-in roles, matched case-insensitively.
+func ParseRoleAssignmentFlags(cmd *cobra.Command) (RoleAssignment, error) {
+	user, err := cmd.Flags().GetString("user")
+	if err != nil {
+		return RoleAssignment{}, err
+	}
+
+	group, err := cmd.Flags().GetString("user-group")
+	if err != nil {
+		return RoleAssignment{}, err
+	}
+
+	role, err := cmd.Flags().GetString("role")
+	if err != nil {
+		return RoleAssignment{}, err
+	}
+
+	result := RoleAssignment{}
+	switch {
+	case user != "" && group != "":
+		return RoleAssignment{}, clierrors.Message("Only one of --user or --user-group can be specified.")
+	case user != "":
+		result.PrincipalType = v20231001preview.PrincipalTypeUser
+		result.PrincipalID = user
+	case group != "":
+		result.PrincipalType = v20231001preview.PrincipalTypeGroup
+		result.PrincipalID = group
+	default:
+		return RoleAssignment{}, clierrors.Message("One of --user or --user-group must be specified.")
+	}
+
+	for _, value := range v20231001preview.PossibleRoleDefinitionNameValues() {
+		if strings.EqualFold(string(value), role) {
+			result.Role = value
+			return result, nil
+		}
+	}
+
+	return RoleAssignment{}, clierrors.Message("The role %q is not a built-in role. Valid roles are 'Reader', 'Contributor' and 'Owner'.", role)
+}
+
+// RoleAssignmentName returns the name of the role assignment granting the role to the principal at the scope. The name
+// is derived from its inputs so that granting the same role twice updates a single role assignment and revoking it does
+// not require a lookup.
+func RoleAssignmentName(scope string, assignment RoleAssignment) string {
+	key := strings.ToLower(strings.Join([]string{scope, string(assignment.PrincipalType), assignment.PrincipalID, string(assignment.Role)}, "|"))
+	hash := sha256.Sum256([]byte(key))
+	return "ra-" + hex.EncodeToString(hash[:])[:24]
+}
+
+// ResourceGroupID returns the resource ID of the resource group in the local Radius plane.
+func ResourceGroupID(resourceGroupName string) string {
+	return "/planes/radius/local/resourceGroups/" + resourceGroupName
+}
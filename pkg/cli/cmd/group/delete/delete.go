@@ -18,9 +18,14 @@ package delete
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
@@ -30,6 +35,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	deleteConfirmation            = "Are you sure you want to delete the resource group '%v'? A resource group can be deleted only when empty"
+	deleteConfirmationWithCascade = "Are you sure you want to delete the resource group '%v' and all of the resources it contains?"
+)
+
 // NewCommand creates an instance of the command and runner for the `rad group delete` command.
 //
 
@@ -44,15 +54,22 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		Short: "Delete a resource group",
 		Long: `Delete a resource group. 
 		
-		Delete a resource group if it is empty. If not empty, delete the contents and try again`,
-		Example: `rad group delete rgprod`,
-		Args:    cobra.MaximumNArgs(1),
-		RunE:    framework.RunCommand(runner),
+		Delete a resource group if it is empty. If not empty, delete the contents and try again, or use --cascade to
+		delete the resource group along with all of the resources it contains`,
+		Example: `
+# Delete an empty resource group
+rad group delete rgprod
+
+# Delete a resource group and all of the resources it contains
+rad group delete rgprod --cascade`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
 	}
 
 	commonflags.AddWorkspaceFlag(cmd)
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddConfirmationFlag(cmd)
+	cmd.Flags().Bool("cascade", false, "Delete all of the resources contained in the resource group before deleting the resource group")
 
 	return cmd, runner
 }
@@ -66,6 +83,7 @@ type Runner struct {
 	Workspace            *workspaces.Workspace
 	UCPResourceGroupName string
 	Confirmation         bool
+	Cascade              bool
 }
 
 // NewRunner creates a new instance of the `rad group delete` runner.
@@ -99,9 +117,15 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	cascade, err := cmd.Flags().GetBool("cascade")
+	if err != nil {
+		return err
+	}
+
 	r.UCPResourceGroupName = resourceGroup
 	r.Workspace = workspace
 	r.Confirmation = yes
+	r.Cascade = cascade
 
 	return nil
 }
@@ -110,13 +134,18 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 //
 
 // Run checks if the user has confirmed the deletion of the resource group, and if so, deletes the resource group and
-// returns an error if unsuccessful.
+// returns an error if unsuccessful. When cascade is set the resources contained in the resource group are deleted too.
 func (r *Runner) Run(ctx context.Context) error {
 
 	// Prompt user to confirm deletion
 	if !r.Confirmation {
+		promptMessage := fmt.Sprintf(deleteConfirmation, r.UCPResourceGroupName)
+		if r.Cascade {
+			promptMessage = fmt.Sprintf(deleteConfirmationWithCascade, r.UCPResourceGroupName)
+		}
+
 		confirmed, err := prompt.YesOrNoPrompt(
-			fmt.Sprintf("Are you sure you want to delete the resource group '%v'? A resource group can be deleted only when empty", r.UCPResourceGroupName),
+			promptMessage,
 			prompt.ConfirmNo,
 			r.InputPrompter)
 		if err != nil {
@@ -135,8 +164,20 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	deleted, err := client.DeleteResourceGroup(ctx, "local", r.UCPResourceGroupName)
-	if err != nil {
+	var deleted bool
+	if r.Cascade {
+		deleted, err = client.DeleteResourceGroupCascade(ctx, "local", r.UCPResourceGroupName, func(percentComplete float64) {
+			r.Output.LogInfo("deleting resource group %q: %.0f%% complete", r.UCPResourceGroupName, percentComplete)
+		})
+	} else {
+		deleted, err = client.DeleteResourceGroup(ctx, "local", r.UCPResourceGroupName)
+	}
+	var responseError *azcore.ResponseError
+	if clients.IsScopeLockedError(err) {
+		return clierrors.MessageWithCause(err, "The resource group %q cannot be deleted because it is protected by a management lock. Remove the lock with `rad resource unlock` and try again.", r.UCPResourceGroupName)
+	} else if errors.As(err, &responseError) && responseError.StatusCode == http.StatusConflict {
+		return clierrors.MessageWithCause(err, "The resource group %q is not empty. Delete the resources it contains or use --cascade to delete them along with the resource group.", r.UCPResourceGroupName)
+	} else if err != nil {
 		return err
 	}
 
@@ -18,9 +18,13 @@ package delete
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
@@ -56,6 +60,15 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "Delete Command with cascade",
+			Input:         []string{"groupname", "--cascade"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Delete Command with fallback workspace",
 			Input:         []string{"groupname"},
@@ -137,6 +150,123 @@ func Test_Run(t *testing.T) {
 
 		})
 
+		t.Run("Success (cascade)", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				DeleteResourceGroupCascade(gomock.Any(), "local", "testrg", gomock.Any()).
+				DoAndReturn(func(ctx context.Context, planeName string, resourceGroupName string, progress func(float64)) (bool, error) {
+					progress(50)
+					return true, nil
+				}).
+				Times(1)
+
+			outputSink := &output.MockOutput{}
+
+			runner := &Runner{
+				ConnectionFactory:    &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				Workspace:            &workspaces.Workspace{},
+				UCPResourceGroupName: "testrg",
+				Confirmation:         true,
+				Cascade:              true,
+				Output:               outputSink,
+			}
+
+			err := runner.Run(context.Background())
+			require.NoError(t, err)
+
+			expected := []any{
+				output.LogOutput{
+					Format: "deleting resource group %q ...\n",
+					Params: []any{"testrg"},
+				},
+				output.LogOutput{
+					Format: "deleting resource group %q: %.0f%% complete",
+					Params: []any{"testrg", float64(50)},
+				},
+				output.LogOutput{
+					Format: "resource group %q deleted",
+					Params: []any{"testrg"},
+				},
+			}
+			require.Equal(t, expected, outputSink.Writes)
+		})
+
+		t.Run("Not empty", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			responseErr := &azcore.ResponseError{StatusCode: http.StatusConflict}
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				DeleteResourceGroup(gomock.Any(), "local", "testrg").
+				Return(false, responseErr).
+				Times(1)
+
+			runner := &Runner{
+				ConnectionFactory:    &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				Workspace:            &workspaces.Workspace{},
+				UCPResourceGroupName: "testrg",
+				Confirmation:         true,
+				Output:               &output.MockOutput{},
+			}
+
+			err := runner.Run(context.Background())
+			expected := clierrors.MessageWithCause(responseErr, "The resource group %q is not empty. Delete the resources it contains or use --cascade to delete them along with the resource group.", "testrg")
+			require.Equal(t, expected, err)
+		})
+
+		t.Run("Locked", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			responseErr := &azcore.ResponseError{StatusCode: http.StatusConflict, ErrorCode: v1.CodeScopeLocked}
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				DeleteResourceGroup(gomock.Any(), "local", "testrg").
+				Return(false, responseErr).
+				Times(1)
+
+			runner := &Runner{
+				ConnectionFactory:    &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				Workspace:            &workspaces.Workspace{},
+				UCPResourceGroupName: "testrg",
+				Confirmation:         true,
+				Output:               &output.MockOutput{},
+			}
+
+			err := runner.Run(context.Background())
+			expected := clierrors.MessageWithCause(responseErr, "The resource group %q cannot be deleted because it is protected by a management lock. Remove the lock with `rad resource unlock` and try again.", "testrg")
+			require.Equal(t, expected, err)
+		})
+
+		t.Run("Answer yes on cascade confirmation", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				DeleteResourceGroupCascade(gomock.Any(), "local", "testrg", gomock.Any()).
+				Return(true, nil).
+				Times(1)
+
+			prompter := prompt.NewMockInterface(ctrl)
+			prompter.EXPECT().
+				GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, "Are you sure you want to delete the resource group 'testrg' and all of the resources it contains?").
+				Return(prompt.ConfirmYes, nil).
+				Times(1)
+
+			runner := &Runner{
+				ConnectionFactory:    &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				Workspace:            &workspaces.Workspace{},
+				UCPResourceGroupName: "testrg",
+				Confirmation:         false,
+				Cascade:              true,
+				InputPrompter:        prompter,
+				Output:               &output.MockOutput{},
+			}
+
+			err := runner.Run(context.Background())
+			require.NoError(t, err)
+		})
+
 		t.Run("Answer no on confirmation", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grant
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/group/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad group grant` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "grant resourcegroupname",
+		Short: "Grant a role on a resource group",
+		Long: `Grant a role on a resource group
+
+Assigns one of the built-in roles to a user or a group on a resource group. The role applies to the resource group and to all of the resources it contains.
+
+The built-in roles are:
+  - Reader: allows reading resources.
+  - Contributor: allows reading, creating, updating and deleting resources.
+  - Owner: allows all operations, including granting and revoking roles.
+
+Roles are only enforced when the authorization of requests is enabled in UCP.
+`,
+		Example: `
+# Allow the user 'alice' to read the resources of the resource group 'prod'
+rad group grant prod --user alice --role Reader
+
+# Allow the members of the group 'developers' to deploy to the resource group 'dev'
+rad group grant dev --user-group developers --role Contributor`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	common.AddRoleAssignmentFlags(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad group grant` command.
+type Runner struct {
+	ConfigHolder         *framework.ConfigHolder
+	ConnectionFactory    connections.Factory
+	Output               output.Interface
+	Workspace            *workspaces.Workspace
+	UCPResourceGroupName string
+	RoleAssignment       common.RoleAssignment
+}
+
+// NewRunner creates a new instance of the `rad group grant` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad group grant` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := cli.RequireUCPResourceGroup(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	err = common.ValidateResourceGroupName(resourceGroup)
+	if err != nil {
+		return err
+	}
+
+	roleAssignment, err := common.ParseRoleAssignmentFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.UCPResourceGroupName = resourceGroup
+	r.RoleAssignment = roleAssignment
+	r.Workspace = workspace
+
+	return nil
+}
+
+// Run runs the `rad group grant` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	scope := common.ResourceGroupID(r.UCPResourceGroupName)
+	name := common.RoleAssignmentName(scope, r.RoleAssignment)
+
+	r.Output.LogInfo("granting role %q to %s %q on resource group %q...", r.RoleAssignment.Role, r.RoleAssignment.PrincipalType, r.RoleAssignment.PrincipalID, r.UCPResourceGroupName)
+
+	err = client.CreateOrUpdateRoleAssignment(ctx, "local", name, &v20231001preview.RoleAssignmentResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &v20231001preview.RoleAssignmentProperties{
+			PrincipalID:        to.Ptr(r.RoleAssignment.PrincipalID),
+			PrincipalType:      to.Ptr(r.RoleAssignment.PrincipalType),
+			RoleDefinitionName: to.Ptr(r.RoleAssignment.Role),
+			Scope:              to.Ptr(scope),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("role %q granted", r.RoleAssignment.Role)
+	return nil
+}
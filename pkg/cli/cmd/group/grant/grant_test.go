@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/group/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Grant Command with user",
+			Input:         []string{"rg", "--user", "alice", "--role", "reader"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "rg", r.UCPResourceGroupName)
+				require.Equal(t, common.RoleAssignment{
+					PrincipalType: v20231001preview.PrincipalTypeUser,
+					PrincipalID:   "alice",
+					Role:          v20231001preview.RoleDefinitionNameReader,
+				}, r.RoleAssignment)
+			},
+		},
+		{
+			Name:          "Grant Command with group",
+			Input:         []string{"rg", "--user-group", "developers", "--role", "Contributor"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Grant Command without principal",
+			Input:         []string{"rg", "--role", "Owner"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Grant Command with user and group",
+			Input:         []string{"rg", "--user", "alice", "--user-group", "developers", "--role", "Owner"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Grant Command without role",
+			Input:         []string{"rg", "--user", "alice"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Grant Command with unknown role",
+			Input:         []string{"rg", "--user", "alice", "--role", "admin"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Grant Command with invalid resource group name",
+			Input:         []string{"rg#1", "--user", "alice", "--role", "Reader"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Run rad group grant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		roleAssignment := common.RoleAssignment{
+			PrincipalType: v20231001preview.PrincipalTypeUser,
+			PrincipalID:   "alice",
+			Role:          v20231001preview.RoleDefinitionNameReader,
+		}
+		scope := "/planes/radius/local/resourceGroups/testrg"
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			CreateOrUpdateRoleAssignment(gomock.Any(), "local", common.RoleAssignmentName(scope, roleAssignment), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, planeName string, name string, resource *v20231001preview.RoleAssignmentResource) error {
+				require.Equal(t, "alice", *resource.Properties.PrincipalID)
+				require.Equal(t, v20231001preview.PrincipalTypeUser, *resource.Properties.PrincipalType)
+				require.Equal(t, v20231001preview.RoleDefinitionNameReader, *resource.Properties.RoleDefinitionName)
+				require.Equal(t, scope, *resource.Properties.Scope)
+				return nil
+			}).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+
+			Name: "kind-kind",
+		}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:    &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:            workspace,
+			UCPResourceGroupName: "testrg",
+			RoleAssignment:       roleAssignment,
+			Output:               outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "granting role %q to %s %q on resource group %q...",
+				Params: []any{v20231001preview.RoleDefinitionNameReader, v20231001preview.PrincipalTypeUser, "alice", "testrg"},
+			},
+			output.LogOutput{
+				Format: "role %q granted",
+				Params: []any{v20231001preview.RoleDefinitionNameReader},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
@@ -19,8 +19,10 @@ package group
 import (
 	group_create "github.com/radius-project/radius/pkg/cli/cmd/group/create"
 	group_delete "github.com/radius-project/radius/pkg/cli/cmd/group/delete"
+	group_grant "github.com/radius-project/radius/pkg/cli/cmd/group/grant"
 	group_switch "github.com/radius-project/radius/pkg/cli/cmd/group/groupswitch"
 	group_list "github.com/radius-project/radius/pkg/cli/cmd/group/list"
+	group_revoke "github.com/radius-project/radius/pkg/cli/cmd/group/revoke"
 	group_show "github.com/radius-project/radius/pkg/cli/cmd/group/show"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/spf13/cobra"
@@ -56,6 +58,9 @@ rad group delete prod
 
 # Show details of resource group in default workspace
 rad group show dev
+
+# Grant a role on a resource group to a user
+rad group grant dev --user alice --role Contributor
 `,
 	}
 
@@ -74,6 +79,12 @@ rad group show dev
 	groupswitch, _ := group_switch.NewCommand(factory)
 	cmd.AddCommand(groupswitch)
 
+	grant, _ := group_grant.NewCommand(factory)
+	cmd.AddCommand(grant)
+
+	revoke, _ := group_revoke.NewCommand(factory)
+	cmd.AddCommand(revoke)
+
 	return cmd
 
 }
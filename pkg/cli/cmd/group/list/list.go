@@ -90,7 +90,7 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	format, err := cmd.Flags().GetString("output")
+	format, err := cli.RequireOutput(cmd)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revoke
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/group/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad group revoke` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "revoke resourcegroupname",
+		Short: "Revoke a role on a resource group",
+		Long: `Revoke a role on a resource group
+
+Removes a role previously assigned to a user or a group on a resource group with 'rad group grant'.
+`,
+		Example: `
+# Revoke the 'Reader' role of the user 'alice' on the resource group 'prod'
+rad group revoke prod --user alice --role Reader`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	common.AddRoleAssignmentFlags(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad group revoke` command.
+type Runner struct {
+	ConfigHolder         *framework.ConfigHolder
+	ConnectionFactory    connections.Factory
+	Output               output.Interface
+	Workspace            *workspaces.Workspace
+	UCPResourceGroupName string
+	RoleAssignment       common.RoleAssignment
+}
+
+// NewRunner creates a new instance of the `rad group revoke` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad group revoke` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := cli.RequireUCPResourceGroup(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	err = common.ValidateResourceGroupName(resourceGroup)
+	if err != nil {
+		return err
+	}
+
+	roleAssignment, err := common.ParseRoleAssignmentFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.UCPResourceGroupName = resourceGroup
+	r.RoleAssignment = roleAssignment
+	r.Workspace = workspace
+
+	return nil
+}
+
+// Run runs the `rad group revoke` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	name := common.RoleAssignmentName(common.ResourceGroupID(r.UCPResourceGroupName), r.RoleAssignment)
+
+	r.Output.LogInfo("revoking role %q of %s %q on resource group %q...", r.RoleAssignment.Role, r.RoleAssignment.PrincipalType, r.RoleAssignment.PrincipalID, r.UCPResourceGroupName)
+
+	deleted, err := client.DeleteRoleAssignment(ctx, "local", name)
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		r.Output.LogInfo("role %q revoked", r.RoleAssignment.Role)
+	} else {
+		r.Output.LogInfo("role %q was not granted", r.RoleAssignment.Role)
+	}
+	return nil
+}
@@ -77,3 +77,27 @@ func RecipeParametersFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// RecipePlanFormat returns the column headings and JSONPaths for the table of resource changes produced by a recipe plan.
+func RecipePlanFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .Action }",
+			},
+			{
+				Heading:  "RESOURCE TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "RESOURCE ID",
+				JSONPath: "{ .ResourceID }",
+			},
+		},
+	}
+}
@@ -58,3 +58,19 @@ func Test_RecipeParametersFormat(t *testing.T) {
 	expected := "PARAMETER  TYPE       DEFAULT VALUE  MIN       MAX\ntest       test-type  1              4         3\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_RecipePlanFormat(t *testing.T) {
+	obj := types.RecipeResourceChange{
+		Action:       "create",
+		ResourceType: "test-type",
+		Name:         "test",
+		ResourceID:   "test-id",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, RecipePlanFormat())
+	require.NoError(t, err)
+
+	expected := "ACTION    RESOURCE TYPE  NAME      RESOURCE ID\ncreate    test-type      test      test-id\n"
+	require.Equal(t, expected, buffer.String())
+}
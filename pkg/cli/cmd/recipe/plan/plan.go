@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipe"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+const (
+	resourceNameFlag = "resource-name"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe plan` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "plan [recipe-name]",
+		Short: "Preview the changes a recipe would make",
+		Long: `Preview the changes a recipe would make
+
+The recipe plan command computes the infrastructure changes that executing a recipe would make, without deploying anything. Each change lists the action (create, update or delete), the resource type, the resource name and, when known, the resource ID.
+
+Changes are computed against the resources the recipe previously deployed for the resource named by the resource-name flag. When the flag is omitted the recipe name is used as the resource name.
+
+By default, the command is scoped to the resource group and environment defined in your rad.yaml workspace file. You can optionally override these values through the environment and group flags.
+
+By default, the command outputs a human-readable table. You can customize the output format with the output flag.`,
+		Example: `
+# preview the changes of a recipe
+rad recipe plan redis-prod --resource-type Applications.Datastores/redisCaches
+
+# preview the changes of a recipe for an existing resource, overriding a parameter
+rad recipe plan redis-prod --resource-type Applications.Datastores/redisCaches --resource-name cache --parameters sku=Premium
+
+# preview the changes of a recipe, with a JSON output
+rad recipe plan redis-prod --resource-type Applications.Datastores/redisCaches --output json`,
+		RunE: framework.RunCommand(runner),
+		Args: cobra.ExactArgs(1),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddResourceTypeFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().String(resourceNameFlag, "", "The name of the resource the recipe is planned for. Defaults to the recipe name")
+	_ = cmd.MarkFlagRequired(cli.ResourceTypeFlag)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe plan` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	RecipeName        string
+	ResourceType      string
+	ResourceName      string
+	Parameters        map[string]map[string]any
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad recipe plan` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe plan` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	if !r.Workspace.IsNamedWorkspace() {
+		return workspaces.ErrNamedWorkspaceRequired
+	}
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	recipeName, err := cli.RequireRecipeNameArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	r.RecipeName = recipeName
+
+	resourceType, err := cli.GetResourceType(cmd)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+
+	resourceName, err := cmd.Flags().GetString(resourceNameFlag)
+	if err != nil {
+		return err
+	}
+	if resourceName == "" {
+		resourceName = recipeName
+	}
+	r.ResourceName = resourceName
+
+	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: bicep.OSFileSystem{}}
+	r.Parameters, err = parser.Parse(parameterArgs...)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = "table"
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad recipe plan` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	request := v20231001preview.RecipePlanRequest{
+		Name:         &r.RecipeName,
+		ResourceType: &r.ResourceType,
+		ResourceName: &r.ResourceName,
+	}
+	if len(r.Parameters) > 0 {
+		request.Parameters = bicep.ConvertToMapStringInterface(r.Parameters)
+	}
+
+	plan, err := client.PlanRecipe(ctx, r.Workspace.Environment, request)
+	if err != nil {
+		return err
+	}
+
+	changes := []types.RecipeResourceChange{}
+	for _, change := range plan.Changes {
+		if change == nil {
+			continue
+		}
+
+		changes = append(changes, types.RecipeResourceChange{
+			Action:       to.String(change.Action),
+			ResourceType: to.String(change.ResourceType),
+			Name:         to.String(change.Name),
+			ResourceID:   to.String(change.ResourceID),
+		})
+	}
+
+	if len(changes) == 0 {
+		r.Output.LogInfo("No changes. The recipe would not modify any resources.")
+		return nil
+	}
+
+	return r.Output.WriteFormatted(r.Format, changes, common.RecipePlanFormat())
+}
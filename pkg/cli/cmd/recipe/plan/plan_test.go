@@ -0,0 +1,229 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipe"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	datastoresrp "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Plan Command",
+			Input:         []string{"recipeName", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "recipeName", r.RecipeName)
+				require.Equal(t, "recipeName", r.ResourceName)
+				require.Empty(t, r.Parameters)
+			},
+		},
+		{
+			Name:          "Valid Plan Command with resource name and parameters",
+			Input:         []string{"recipeName", "--resource-type", datastoresrp.RedisCachesResourceType, "--resource-name", "cache", "--parameters", "sku=Premium"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "cache", r.ResourceName)
+				require.Equal(t, map[string]map[string]any{"sku": {"value": "Premium"}}, r.Parameters)
+			},
+		},
+		{
+			Name:          "Plan Command with incorrect fallback workspace",
+			Input:         []string{"-e", "my-env", "-g", "my-env", "recipeName", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "Plan Command with too many positional args",
+			Input:         []string{"recipeName", "arg2", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command without ResourceType",
+			Input:         []string{"recipeName"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Plan recipe - Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		expectedRequest := v20231001preview.RecipePlanRequest{
+			Name:         to.Ptr("cosmosDB"),
+			ResourceType: to.Ptr(datastoresrp.MongoDatabasesResourceType),
+			ResourceName: to.Ptr("mongo"),
+			Parameters:   map[string]any{"throughput": float64(400)},
+		}
+		planResponse := v20231001preview.RecipePlanResponse{
+			Changes: []*v20231001preview.RecipeResourceChange{
+				{
+					Action:       to.Ptr("create"),
+					ResourceType: to.Ptr("Microsoft.DocumentDB/databaseAccounts"),
+					Name:         to.Ptr("account"),
+				},
+				{
+					Action:       to.Ptr("delete"),
+					ResourceType: to.Ptr("Microsoft.DocumentDB/databaseAccounts"),
+					Name:         to.Ptr("old-account"),
+					ResourceID:   to.Ptr("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.DocumentDB/databaseAccounts/old-account"),
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), "test-env", expectedRequest).
+			Return(planResponse, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "test-env"},
+			Format:            "table",
+			RecipeName:        "cosmosDB",
+			ResourceType:      datastoresrp.MongoDatabasesResourceType,
+			ResourceName:      "mongo",
+			Parameters:        map[string]map[string]any{"throughput": {"value": float64(400)}},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []types.RecipeResourceChange{
+					{
+						Action:       "create",
+						ResourceType: "Microsoft.DocumentDB/databaseAccounts",
+						Name:         "account",
+					},
+					{
+						Action:       "delete",
+						ResourceType: "Microsoft.DocumentDB/databaseAccounts",
+						Name:         "old-account",
+						ResourceID:   "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.DocumentDB/databaseAccounts/old-account",
+					},
+				},
+				Options: common.RecipePlanFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Plan recipe - No changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(v20231001preview.RecipePlanResponse{}, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			RecipeName:        "cosmosDB",
+			ResourceType:      datastoresrp.MongoDatabasesResourceType,
+			ResourceName:      "cosmosDB",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No changes. The recipe would not modify any resources.",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Plan recipe - Failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		expectedErr := errors.New("recipe plan failed")
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(v20231001preview.RecipePlanResponse{}, expectedErr).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			RecipeName:        "cosmosDB",
+			ResourceType:      datastoresrp.MongoDatabasesResourceType,
+			ResourceName:      "cosmosDB",
+		}
+
+		err := runner.Run(context.Background())
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
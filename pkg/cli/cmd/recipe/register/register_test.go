@@ -63,6 +63,15 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "Valid Register Command for helm recipe",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindHelm, "--template-path", "oci://ghcr.io/testpublicrecipe/charts/redis", "--resource-type", ds_ctrl.RedisCachesResourceType, "--template-version", "18.6.1", "--plain-http"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Valid Register Command with parameters passed as file",
 			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindBicep, "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType, "--parameters", "@testdata/recipeparam.json", "--plain-http"},
@@ -195,6 +204,54 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expectedOutput, outputSink.Writes)
 	})
 
+	t.Run("Register helm recipe Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		envResource := v20231001preview.EnvironmentResource{
+			ID:       to.Ptr("/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind"),
+			Name:     to.Ptr("kind-kind"),
+			Type:     to.Ptr("applications.core/environments"),
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.EnvironmentProperties{
+				Compute: &v20231001preview.KubernetesCompute{
+					Namespace: to.Ptr("default"),
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), gomock.Any()).
+			Return(envResource, nil).Times(1)
+
+		appManagementClient.EXPECT().
+			CreateOrUpdateEnvironment(context.Background(), "kind-kind", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, envName string, resource *v20231001preview.EnvironmentResource) error {
+				require.Equal(t, &v20231001preview.HelmRecipeProperties{
+					TemplateKind:    to.Ptr(recipes.TemplateKindHelm),
+					TemplatePath:    to.Ptr("oci://ghcr.io/testpublicrecipe/charts/redis"),
+					TemplateVersion: to.Ptr("18.6.1"),
+					PlainHTTP:       to.Ptr(false),
+					Parameters:      map[string]any{},
+				}, resource.Properties.Recipes[ds_ctrl.RedisCachesResourceType]["redis"])
+				return nil
+			}).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			TemplateKind:      recipes.TemplateKindHelm,
+			TemplatePath:      "oci://ghcr.io/testpublicrecipe/charts/redis",
+			TemplateVersion:   "18.6.1",
+			ResourceType:      ds_ctrl.RedisCachesResourceType,
+			RecipeName:        "redis",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
 	t.Run("Register recipe Failure", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 
@@ -32,3 +32,10 @@ type RecipeParameter struct {
 	MaxValue     string      `json:"maxValue,omitempty"`
 	MinValue     string      `json:"minValue,omitempty"`
 }
+
+type RecipeResourceChange struct {
+	Action       string `json:"action"`
+	ResourceType string `json:"resourceType"`
+	Name         string `json:"name"`
+	ResourceID   string `json:"resourceId,omitempty"`
+}
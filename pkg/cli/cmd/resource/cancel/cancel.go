@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cancel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for canceling a running operation on a Radius resource, with flags for
+// workspace and resource group. It returns the command and a Runner to execute the command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "cancel [resourceType] [operationId]",
+		Short: "Cancel a running operation on a Radius resource",
+		Long: `Cancels a running asynchronous operation on a Radius resource.
+
+The operation id is the last segment of the Azure-AsyncOperation URL returned when the operation was started.
+Cancellation is asynchronous: the resource provider stops the operation and marks it as Canceled.`,
+		Example: `
+		sample list of resourceType: containers, gateways, daprPubSubBrokers, extenders, mongoDatabases, rabbitMQMessageQueues, redisCaches, sqlDatabases, daprStateStores, daprSecretStores
+
+		# Cancel a running operation on a container
+		rad resource cancel containers 2a8a5e09-6a2f-4a4b-9f35-0d4b7a3a9f1c`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource cancel` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	OperationID       string
+}
+
+// NewRunner creates a new instance of the `rad resource cancel` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate checks the workspace, scope, resource type and operation id from the command line arguments and sets them
+// in the Runner struct. It returns an error if any of these values are invalid.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceType, err := cli.RequireResourceType(args)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+
+	if _, err := uuid.Parse(args[1]); err != nil {
+		return clierrors.Message("The operation id %q is invalid. The operation id must be a UUID.", args[1])
+	}
+	r.OperationID = args[1]
+
+	return nil
+}
+
+// Run requests the cancellation of the operation and logs the result. If an error occurs, it is returned.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	err = client.CancelOperation(ctx, r.ResourceType, r.OperationID)
+	var responseError *azcore.ResponseError
+	if clients.Is404Error(err) {
+		return clierrors.Message("The operation %q does not exist.", r.OperationID)
+	} else if errors.As(err, &responseError) && responseError.StatusCode == http.StatusConflict {
+		return clierrors.MessageWithCause(err, "The operation %q could not be canceled because it has already completed or is being updated.", r.OperationID)
+	} else if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Cancellation of operation %q requested", r.OperationID)
+	return nil
+}
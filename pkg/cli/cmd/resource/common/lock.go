@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+// LockTarget describes the scope protected by the lock given by the arguments of the `rad resource lock` and
+// `rad resource unlock` commands.
+type LockTarget struct {
+	// Scope is the resource ID of the resource or scope protected by the lock.
+	Scope string
+
+	// PlaneName is the name of the Radius plane the lock is created in.
+	PlaneName string
+}
+
+// RequireLockTarget reads the scope of a lock from the arguments of the command. The scope can be specified either
+// as a resource type and name, which are resolved against the current scope, or as a fully-qualified resource ID.
+// Resource IDs of scopes, such as resource groups, are accepted.
+func RequireLockTarget(cmd *cobra.Command, args []string, workspace *workspaces.Workspace) (LockTarget, error) {
+	var id resources.ID
+	if len(args) == 1 {
+		if !strings.HasPrefix(args[0], "/") {
+			return LockTarget{}, clierrors.Message("Specify a resource type and name, or a fully-qualified resource ID.")
+		}
+
+		parsed, err := resources.Parse(args[0])
+		if err != nil {
+			return LockTarget{}, clierrors.Message("%q is not a valid resource ID.", args[0])
+		}
+		id = parsed
+	} else {
+		scope, err := cli.RequireScope(cmd, *workspace)
+		if err != nil {
+			return LockTarget{}, err
+		}
+
+		resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+		if err != nil {
+			return LockTarget{}, err
+		}
+
+		parsed, err := resources.Parse(scope + "/providers/" + resourceType + "/" + resourceName)
+		if err != nil {
+			return LockTarget{}, err
+		}
+		id = parsed
+	}
+
+	planeName := id.FindScope(resources_radius.PlaneTypeRadius)
+	if planeName == "" {
+		return LockTarget{}, clierrors.Message("%q is not a resource in a Radius plane. Locks can only be applied to resources and scopes in a Radius plane.", id.String())
+	}
+
+	return LockTarget{Scope: id.String(), PlaneName: planeName}, nil
+}
+
+// LockName returns the name of the lock protecting the given scope. The name is derived from the scope so that
+// locking a scope twice updates the same lock, and so that the lock can be found again by `rad resource unlock`.
+func LockName(scope string) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(scope)))
+	return "lock-" + hex.EncodeToString(hash[:])[:24]
+}
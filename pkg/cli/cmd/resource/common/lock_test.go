@@ -0,0 +1,30 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LockName(t *testing.T) {
+	name := LockName("/planes/radius/local/resourceGroups/prod")
+	require.Len(t, name, len("lock-")+24)
+	require.Equal(t, name, LockName("/planes/radius/local/resourcegroups/PROD"))
+	require.NotEqual(t, name, LockName("/planes/radius/local/resourceGroups/dev"))
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
@@ -170,7 +171,9 @@ func (r *Runner) Run(ctx context.Context) error {
 	}
 
 	deleted, err := client.DeleteResource(ctx, r.ResourceType, r.ResourceName)
-	if err != nil {
+	if clients.IsScopeLockedError(err) {
+		return clierrors.MessageWithCause(err, "The resource %q of type %q cannot be deleted because it is protected by a management lock. Remove the lock with `rad resource unlock` and try again.", r.ResourceName, r.ResourceType)
+	} else if err != nil {
 		return err
 	}
 
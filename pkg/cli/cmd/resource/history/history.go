@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource history` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "history [resourceType] [resourceName] | history [resourceId]",
+		Short: "Show the audit history of a Radius resource",
+		Long: `Show the audit history of a Radius resource.
+
+The history lists every mutating request (PUT, PATCH, DELETE and POST) recorded for the resource, including the caller,
+the result and the latency of the request. Audit records are only available when the audit log is enabled with the
+storage sink.`,
+		Example: `
+# show the history of a resource in the current resource group
+rad resource history containers orders
+
+# show the history of a resource by its resource ID
+rad resource history /planes/radius/local/resourceGroups/prod/providers/Applications.Core/containers/orders
+
+# show the history of a resource in a specified resource group as JSON
+rad resource history containers orders --group prod --output json
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource history` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceID        string
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad resource history` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource history` command.
+//
+// The resource can be specified either as a resource type and name, which are resolved against the
+// current scope, or as a fully-qualified resource ID.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	if len(args) == 1 {
+		if !strings.HasPrefix(args[0], "/") {
+			return clierrors.Message("Specify a resource type and name, or a fully-qualified resource ID.")
+		}
+
+		id, err := resources.ParseResource(args[0])
+		if err != nil {
+			return clierrors.Message("%q is not a valid resource ID.", args[0])
+		}
+		r.ResourceID = id.String()
+	} else {
+		scope, err := cli.RequireScope(cmd, *r.Workspace)
+		if err != nil {
+			return err
+		}
+		r.Workspace.Scope = scope
+
+		resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+		if err != nil {
+			return err
+		}
+		r.ResourceID = scope + "/providers/" + resourceType + "/" + resourceName
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource history` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	records, err := client.ListAuditRecords(ctx, r.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		r.Output.LogInfo("No audit records found for %s", r.ResourceID)
+		return nil
+	}
+
+	return r.Output.WriteFormatted(r.Format, records, objectformats.GetAuditRecordTableFormat())
+}
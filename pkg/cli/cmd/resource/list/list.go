@@ -18,6 +18,7 @@ package list
 
 import (
 	"context"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -29,6 +30,8 @@ import (
 	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 	"github.com/spf13/cobra"
 )
 
@@ -43,7 +46,10 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "list [resourceType]",
 		Short: "Lists resources",
-		Long:  "List all resources of specified type",
+		Long: `List all resources of specified type
+
+Use --tag to list the resources that have the given tags. When --tag is used the resource type is optional and the
+resources of all resource groups are listed, unless a resource group is given with --group.`,
 		Example: `
 	sample list of resourceType: containers, gateways, pubSubBrokers, extenders, mongoDatabases, rabbitMQMessageQueues, redisCaches, sqlDatabases, stateStores, secretStores
 
@@ -57,8 +63,14 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	
 	# list all resources of a specified type in an application (shorthand flag)
 	rad resource list containers -a icecream-store
+
+	# list all resources tagged with team=payments in all resource groups
+	rad resource list --tag team=payments
+
+	# list all containers tagged with team=payments and env=dev in a resource group
+	rad resource list containers --tag team=payments --tag env=dev --group dev-a
 	`,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.RangeArgs(0, 1),
 		RunE: framework.RunCommand(runner),
 	}
 
@@ -66,6 +78,7 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().StringArray("tag", []string{}, "Only list the resources that have the tag, in the form 'key=value'. Can be specified multiple times")
 
 	return cmd, runner
 }
@@ -79,6 +92,16 @@ type Runner struct {
 	ApplicationName   string
 	Format            string
 	ResourceType      string
+
+	// Tags are the tags used to filter the listed resources. When set, resources are listed through UCP.
+	Tags map[string]string
+
+	// ResourceGroupName is the resource group to list tagged resources from. When empty, all resource groups of
+	// the plane are listed.
+	ResourceGroupName string
+
+	// PlaneName is the name of the Radius plane of the workspace.
+	PlaneName string
 }
 
 // NewRunner creates a new instance of the `rad resource list` runner.
@@ -109,17 +132,31 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.Workspace.Scope = scope
 
-	applicationName, err := cli.ReadApplicationName(cmd, *workspace)
+	tags, err := cmd.Flags().GetStringArray("tag")
 	if err != nil {
 		return err
 	}
-	r.ApplicationName = applicationName
 
-	resourceType, err := cli.RequireResourceType(args)
-	if err != nil {
-		return err
+	if len(tags) > 0 {
+		err = r.validateTags(cmd, tags)
+		if err != nil {
+			return err
+		}
+	} else {
+		applicationName, err := cli.ReadApplicationName(cmd, *workspace)
+		if err != nil {
+			return err
+		}
+		r.ApplicationName = applicationName
+	}
+
+	if len(args) > 0 || len(tags) == 0 {
+		resourceType, err := cli.RequireResourceType(args)
+		if err != nil {
+			return err
+		}
+		r.ResourceType = resourceType
 	}
-	r.ResourceType = resourceType
 
 	format, err := cli.RequireOutput(cmd)
 	if err != nil {
@@ -130,6 +167,42 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func (r *Runner) validateTags(cmd *cobra.Command, tags []string) error {
+	applicationName, err := cmd.Flags().GetString("application")
+	if err != nil {
+		return err
+	}
+	if applicationName != "" {
+		return clierrors.Message("The flags '--tag' and '--application' cannot be used together.")
+	}
+
+	r.Tags = map[string]string{}
+	for _, tag := range tags {
+		key, value, found := strings.Cut(tag, "=")
+		if !found || key == "" {
+			return clierrors.Message("The tag %q is invalid. Tags must have the form 'key=value'.", tag)
+		}
+		r.Tags[key] = value
+	}
+
+	scope, err := resources.ParseScope(r.Workspace.Scope)
+	if err != nil {
+		return err
+	}
+	r.PlaneName = scope.FindScope(resources_radius.PlaneTypeRadius)
+	if r.PlaneName == "" {
+		return clierrors.Message("The scope %q is not a Radius scope.", r.Workspace.Scope)
+	}
+
+	// Only restrict the query to a resource group if one was explicitly requested.
+	r.ResourceGroupName, err = cmd.Flags().GetString("group")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Run runs the `rad resource list` command.
 //
 
@@ -145,7 +218,12 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	var resourceList []generated.GenericResource
 
-	if r.ApplicationName == "" {
+	if len(r.Tags) > 0 {
+		resourceList, err = r.listTaggedResources(ctx, client)
+		if err != nil {
+			return err
+		}
+	} else if r.ApplicationName == "" {
 		resourceList, err = client.ListResourcesOfType(ctx, r.ResourceType)
 		if err != nil {
 			return err
@@ -166,3 +244,48 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return r.Output.WriteFormatted(r.Format, resourceList, objectformats.GetGenericResourceTableFormat())
 }
+
+// listTaggedResources lists the resources with the requested tags using the resources tracked by UCP.
+func (r *Runner) listTaggedResources(ctx context.Context, client clients.ApplicationsManagementClient) ([]generated.GenericResource, error) {
+	resourceGroupNames := []string{}
+	if r.ResourceGroupName != "" {
+		resourceGroupNames = append(resourceGroupNames, r.ResourceGroupName)
+	} else {
+		groups, err := client.ListResourceGroups(ctx, r.PlaneName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range groups {
+			resourceGroupNames = append(resourceGroupNames, *group.Name)
+		}
+	}
+
+	results := []generated.GenericResource{}
+	for _, resourceGroupName := range resourceGroupNames {
+		tracked, err := client.ListResourcesInResourceGroup(ctx, r.PlaneName, resourceGroupName, r.Tags)
+		if clients.Is404Error(err) && r.ResourceGroupName != "" {
+			return nil, clierrors.Message("The resource group %q could not be found.", r.ResourceGroupName)
+		} else if clients.Is404Error(err) {
+			// The resource group was deleted while we were listing.
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range tracked {
+			if r.ResourceType != "" && !strings.EqualFold(r.ResourceType, *resource.Type) {
+				continue
+			}
+
+			results = append(results, generated.GenericResource{
+				ID:   resource.ID,
+				Name: resource.Name,
+				Type: resource.Type,
+				Tags: resource.Tags,
+			})
+		}
+	}
+
+	return results, nil
+}
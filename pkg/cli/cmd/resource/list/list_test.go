@@ -29,6 +29,8 @@ import (
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -95,6 +97,55 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "List Command with tag",
+			Input:         []string{"--tag", "team=payments"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, map[string]string{"team": "payments"}, r.Tags)
+				require.Equal(t, "local", r.PlaneName)
+				require.Equal(t, "", r.ResourceGroupName)
+				require.Equal(t, "", r.ResourceType)
+			},
+		},
+		{
+			Name:          "List Command with resource type, tags and group",
+			Input:         []string{"containers", "--tag", "team=payments", "--tag", "env=", "-g", "dev-a"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, map[string]string{"team": "payments", "env": ""}, r.Tags)
+				require.Equal(t, "dev-a", r.ResourceGroupName)
+				require.Equal(t, "Applications.Core/containers", r.ResourceType)
+			},
+		},
+		{
+			Name:          "List Command with invalid tag",
+			Input:         []string{"--tag", "team"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "List Command with tag and application",
+			Input:         []string{"containers", "--tag", "team=payments", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "List Command with ambiguous args",
 			Input:         []string{"secretStores"},
@@ -212,4 +263,130 @@ func Test_Run(t *testing.T) {
 			require.Equal(t, expected, outputSink.Writes)
 		})
 	})
+
+	t.Run("List resources by tag", func(t *testing.T) {
+		trackedResources := func(group string) []ucp.GenericResource {
+			return []ucp.GenericResource{
+				{
+					ID:   to.Ptr("/planes/radius/local/resourceGroups/" + group + "/providers/Applications.Core/containers/api"),
+					Name: to.Ptr("api"),
+					Type: to.Ptr("Applications.Core/containers"),
+					Tags: map[string]*string{"team": to.Ptr("payments")},
+				},
+				{
+					ID:   to.Ptr("/planes/radius/local/resourceGroups/" + group + "/providers/Applications.Datastores/redisCaches/cache"),
+					Name: to.Ptr("cache"),
+					Type: to.Ptr("Applications.Datastores/redisCaches"),
+					Tags: map[string]*string{"team": to.Ptr("payments")},
+				},
+			}
+		}
+
+		t.Run("Success (all groups)", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				ListResourceGroups(gomock.Any(), "local").
+				Return([]ucp.ResourceGroupResource{{Name: to.Ptr("dev-a")}, {Name: to.Ptr("dev-b")}}, nil).
+				Times(1)
+			appManagementClient.EXPECT().
+				ListResourcesInResourceGroup(gomock.Any(), "local", "dev-a", map[string]string{"team": "payments"}).
+				Return(trackedResources("dev-a"), nil).
+				Times(1)
+			appManagementClient.EXPECT().
+				ListResourcesInResourceGroup(gomock.Any(), "local", "dev-b", map[string]string{"team": "payments"}).
+				Return(trackedResources("dev-b"), nil).
+				Times(1)
+
+			outputSink := &output.MockOutput{}
+
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				Output:            outputSink,
+				Workspace:         &workspaces.Workspace{},
+				Tags:              map[string]string{"team": "payments"},
+				PlaneName:         "local",
+				Format:            "table",
+			}
+
+			err := runner.Run(context.Background())
+			require.NoError(t, err)
+
+			expectedResources := []generated.GenericResource{}
+			for _, group := range []string{"dev-a", "dev-b"} {
+				for _, resource := range trackedResources(group) {
+					expectedResources = append(expectedResources, generated.GenericResource{ID: resource.ID, Name: resource.Name, Type: resource.Type, Tags: resource.Tags})
+				}
+			}
+
+			expected := []any{
+				output.FormattedOutput{
+					Format:  "table",
+					Obj:     expectedResources,
+					Options: objectformats.GetGenericResourceTableFormat(),
+				},
+			}
+			require.Equal(t, expected, outputSink.Writes)
+		})
+
+		t.Run("Success (resource group and type)", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				ListResourcesInResourceGroup(gomock.Any(), "local", "dev-a", map[string]string{"team": "payments"}).
+				Return(trackedResources("dev-a"), nil).
+				Times(1)
+
+			outputSink := &output.MockOutput{}
+
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				Output:            outputSink,
+				Workspace:         &workspaces.Workspace{},
+				Tags:              map[string]string{"team": "payments"},
+				PlaneName:         "local",
+				ResourceGroupName: "dev-a",
+				ResourceType:      "Applications.Core/containers",
+				Format:            "table",
+			}
+
+			err := runner.Run(context.Background())
+			require.NoError(t, err)
+
+			container := trackedResources("dev-a")[0]
+			expected := []any{
+				output.FormattedOutput{
+					Format:  "table",
+					Obj:     []generated.GenericResource{{ID: container.ID, Name: container.Name, Type: container.Type, Tags: container.Tags}},
+					Options: objectformats.GetGenericResourceTableFormat(),
+				},
+			}
+			require.Equal(t, expected, outputSink.Writes)
+		})
+
+		t.Run("Resource group does not exist", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				ListResourcesInResourceGroup(gomock.Any(), "local", "dev-a", map[string]string{"team": "payments"}).
+				Return(nil, radcli.Create404Error()).
+				Times(1)
+
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				Output:            &output.MockOutput{},
+				Workspace:         &workspaces.Workspace{},
+				Tags:              map[string]string{"team": "payments"},
+				PlaneName:         "local",
+				ResourceGroupName: "dev-a",
+				Format:            "table",
+			}
+
+			err := runner.Run(context.Background())
+			require.Equal(t, clierrors.Message("The resource group %q could not be found.", "dev-a"), err)
+		})
+	})
 }
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/resource/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource lock` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "lock [resourceType] [resourceName] | lock [resourceId]",
+		Short: "Protect a Radius resource or scope with a management lock",
+		Long: `Protect a Radius resource or scope with a management lock.
+
+A lock applies to the resource or scope and to everything it contains. The lock levels are:
+  - CanNotDelete: the resource can be read and updated, but not deleted or moved.
+  - ReadOnly: the resource can be read, but not updated, deleted or moved, and its actions are blocked.
+
+Locking a resource that is already locked updates the level and the notes of the existing lock. Use 'rad resource unlock'
+to remove the lock.`,
+		Example: `
+# prevent the deletion of a resource in the current resource group
+rad resource lock containers orders
+
+# prevent any change to the resource group 'prod' and to the resources it contains
+rad resource lock /planes/radius/local/resourceGroups/prod --level ReadOnly --notes "Production freeze"
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().String("level", string(v20231001preview.LockLevelCanNotDelete), "The level of the lock. One of 'CanNotDelete' or 'ReadOnly'")
+	cmd.Flags().String("notes", "", "Notes describing the reason for the lock")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource lock` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Target            common.LockTarget
+	Level             v20231001preview.LockLevel
+	Notes             string
+}
+
+// NewRunner creates a new instance of the `rad resource lock` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource lock` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	target, err := common.RequireLockTarget(cmd, args, r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Target = target
+
+	level, err := cmd.Flags().GetString("level")
+	if err != nil {
+		return err
+	}
+
+	r.Level = ""
+	for _, possible := range v20231001preview.PossibleLockLevelValues() {
+		if strings.EqualFold(level, string(possible)) {
+			r.Level = possible
+			break
+		}
+	}
+	if r.Level == "" {
+		return clierrors.Message("The lock level %q is not valid. Specify one of 'CanNotDelete' or 'ReadOnly'.", level)
+	}
+
+	notes, err := cmd.Flags().GetString("notes")
+	if err != nil {
+		return err
+	}
+	r.Notes = notes
+
+	return nil
+}
+
+// Run runs the `rad resource lock` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Locking %s with level %q...", r.Target.Scope, r.Level)
+
+	properties := &v20231001preview.LockProperties{
+		Level: to.Ptr(r.Level),
+		Scope: to.Ptr(r.Target.Scope),
+	}
+	if r.Notes != "" {
+		properties.Notes = to.Ptr(r.Notes)
+	}
+
+	err = client.CreateOrUpdateLock(ctx, r.Target.PlaneName, common.LockName(r.Target.Scope), &v20231001preview.LockResource{
+		Location:   to.Ptr(v1.LocationGlobal),
+		Properties: properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Lock %q applied to %s", r.Level, r.Target.Scope)
+	return nil
+}
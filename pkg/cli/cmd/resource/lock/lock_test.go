@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/resource/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testResourceID = "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Core/containers/foo"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Lock Command with type and name",
+			Input:         []string{"containers", "foo"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, common.LockTarget{Scope: testResourceID, PlaneName: "local"}, r.Target)
+				require.Equal(t, v20231001preview.LockLevelCanNotDelete, r.Level)
+				require.Empty(t, r.Notes)
+			},
+		},
+		{
+			Name:          "Valid Lock Command with resource group ID, level and notes",
+			Input:         []string{"/planes/radius/local/resourceGroups/prod", "--level", "readonly", "--notes", "Production freeze"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, common.LockTarget{Scope: "/planes/radius/local/resourceGroups/prod", PlaneName: "local"}, r.Target)
+				require.Equal(t, v20231001preview.LockLevelReadOnly, r.Level)
+				require.Equal(t, "Production freeze", r.Notes)
+			},
+		},
+		{
+			Name:          "Lock Command with invalid level",
+			Input:         []string{"containers", "foo", "--level", "NoAccess"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Lock Command with resource outside of a Radius plane",
+			Input:         []string{"/subscriptions/sub/resourceGroups/rg"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Lock Command with single non-ID argument",
+			Input:         []string{"containers"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Lock Command with too many args",
+			Input:         []string{"containers", "a", "b"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		CreateOrUpdateLock(gomock.Any(), "local", common.LockName(testResourceID), &v20231001preview.LockResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.LockProperties{
+				Level: to.Ptr(v20231001preview.LockLevelReadOnly),
+				Scope: to.Ptr(testResourceID),
+				Notes: to.Ptr("Production freeze"),
+			},
+		}).
+		Return(nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Output:            outputSink,
+		Workspace:         &workspaces.Workspace{},
+		Target:            common.LockTarget{Scope: testResourceID, PlaneName: "local"},
+		Level:             v20231001preview.LockLevelReadOnly,
+		Notes:             "Production freeze",
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.LogOutput{
+			Format: "Locking %s with level %q...",
+			Params: []any{testResourceID, v20231001preview.LockLevelReadOnly},
+		},
+		output.LogOutput{
+			Format: "Lock %q applied to %s",
+			Params: []any{v20231001preview.LockLevelReadOnly, testResourceID},
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// applicationsResourceType is the resource type of Radius applications. Moving an application also moves all of the
+	// resources that belong to it.
+	applicationsResourceType = "Applications.Core/applications"
+
+	targetGroupFlag = "target-group"
+)
+
+// NewCommand creates a new cobra command for moving a Radius resource to another resource group, with flags for
+// workspace, source resource group and target resource group. It returns the command and a Runner to execute the command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "move [resourceType] [resourceName]",
+		Short: "Move a Radius resource to another resource group",
+		Long: `Moves a Radius resource to another resource group in the same plane.
+
+The resource ID is rewritten to use the target resource group, and references to the resource from other resources are
+updated. Moving an application also moves all of the resources that belong to the application.`,
+		Example: `
+		sample list of resourceType: applications, containers, gateways, daprPubSubBrokers, extenders, mongoDatabases, rabbitMQMessageQueues, redisCaches, sqlDatabases, daprStateStores, daprSecretStores
+
+		# Move a container named orders from the current resource group to the resource group dev-b
+		rad resource move containers orders --target-group dev-b
+
+		# Move an application named myapp and all of its resources from the resource group dev-a to the resource group dev-b
+		rad resource move applications myapp --group dev-a --target-group dev-b`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().String(targetGroupFlag, "", "The name of the resource group to move the resource to")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource move` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	ResourceName      string
+	PlaneName         string
+	ResourceGroupName string
+	TargetGroupName   string
+}
+
+// NewRunner creates a new instance of the `rad resource move` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate checks the workspace, scope, resource type and name, and target resource group from the command line
+// arguments and sets them in the Runner struct. It returns an error if any of these values are invalid.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	scopeID, err := resources.ParseScope(scope)
+	if err != nil {
+		return err
+	}
+	r.PlaneName = scopeID.FindScope(resources_radius.PlaneTypeRadius)
+	r.ResourceGroupName = scopeID.FindScope(resources_radius.ScopeResourceGroups)
+	if r.PlaneName == "" || r.ResourceGroupName == "" {
+		return clierrors.Message("The scope %q is not a Radius resource group.", scope)
+	}
+
+	if len(args) > 0 && (strings.EqualFold(args[0], "applications") || strings.EqualFold(args[0], applicationsResourceType)) {
+		r.ResourceType = applicationsResourceType
+		r.ResourceName = args[1]
+	} else {
+		r.ResourceType, r.ResourceName, err = cli.RequireResourceTypeAndName(args)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.TargetGroupName, err = cmd.Flags().GetString(targetGroupFlag)
+	if err != nil {
+		return err
+	}
+	if r.TargetGroupName == "" {
+		return clierrors.Message("The target resource group is required. Use `--%s` to pass in a resource group name.", targetGroupFlag)
+	}
+	if strings.EqualFold(r.TargetGroupName, r.ResourceGroupName) {
+		return clierrors.Message("The resource is already in the resource group %q.", r.TargetGroupName)
+	}
+
+	return nil
+}
+
+// Run moves the resource, and the resources of an application, to the target resource group and logs the new
+// resource IDs. If an error occurs, it is returned.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.GetResourceGroup(ctx, r.PlaneName, r.TargetGroupName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource group %q does not exist.", r.TargetGroupName)
+	} else if err != nil {
+		return err
+	}
+
+	resourceIDs, err := r.resourceIDs(ctx, client)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource %q of type %q does not exist.", r.ResourceName, r.ResourceType)
+	} else if err != nil {
+		return err
+	}
+
+	targetGroupID := fmt.Sprintf("/planes/%s/%s/resourceGroups/%s", resources_radius.PlaneTypeRadius, r.PlaneName, r.TargetGroupName)
+	moved, err := client.MoveResources(ctx, r.PlaneName, r.ResourceGroupName, targetGroupID, resourceIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range moved {
+		r.Output.LogInfo("Moved %s", id)
+	}
+
+	return nil
+}
+
+// resourceIDs returns the IDs of the resources to move. For an application this includes all of the resources in the
+// application that live in the source resource group.
+func (r *Runner) resourceIDs(ctx context.Context, client clients.ApplicationsManagementClient) ([]string, error) {
+	if r.ResourceType != applicationsResourceType {
+		resource, err := client.GetResource(ctx, r.ResourceType, r.ResourceName)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{*resource.ID}, nil
+	}
+
+	application, err := client.GetApplication(ctx, r.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{*application.ID}
+
+	applicationResources, err := client.ListResourcesInApplication(ctx, r.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range applicationResources {
+		id, err := resources.ParseResource(*resource.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Resources shared from other resource groups stay where they are.
+		if !strings.EqualFold(id.FindScope(resources_radius.ScopeResourceGroups), r.ResourceGroupName) {
+			continue
+		}
+
+		ids = append(ids, *resource.ID)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	sourceGroupScope = "/planes/radius/local/resourceGroups/dev-a"
+	targetGroupID    = "/planes/radius/local/resourceGroups/dev-b"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Move Command",
+			Input:         []string{"containers", "orders", "--target-group", "dev-b"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Core/containers", r.ResourceType)
+				require.Equal(t, "orders", r.ResourceName)
+				require.Equal(t, "local", r.PlaneName)
+				require.Equal(t, "test-resource-group", r.ResourceGroupName)
+				require.Equal(t, "dev-b", r.TargetGroupName)
+			},
+		},
+		{
+			Name:          "Valid Move Command for application",
+			Input:         []string{"applications", "myapp", "-g", "dev-a", "--target-group", "dev-b"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Core/applications", r.ResourceType)
+				require.Equal(t, "dev-a", r.ResourceGroupName)
+			},
+		},
+		{
+			Name:          "Move Command without target group",
+			Input:         []string{"containers", "orders"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Move Command with same target group",
+			Input:         []string{"containers", "orders", "-g", "dev-a", "--target-group", "dev-a"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Move Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "orders", "--target-group", "dev-b"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Move Command with insufficient args",
+			Input:         []string{"containers", "--target-group", "dev-b"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	createRunner := func(client clients.ApplicationsManagementClient, outputSink *output.MockOutput, resourceType string, resourceName string) *Runner {
+		return &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: sourceGroupScope},
+			ResourceType:      resourceType,
+			ResourceName:      resourceName,
+			PlaneName:         "local",
+			ResourceGroupName: "dev-a",
+			TargetGroupName:   "dev-b",
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		containerID := sourceGroupScope + "/providers/Applications.Core/containers/orders"
+		movedID := targetGroupID + "/providers/Applications.Core/containers/orders"
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceGroup(gomock.Any(), "local", "dev-b").
+			Return(ucp.ResourceGroupResource{}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), "Applications.Core/containers", "orders").
+			Return(generated.GenericResource{ID: to.Ptr(containerID)}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			MoveResources(gomock.Any(), "local", "dev-a", targetGroupID, []string{containerID}).
+			Return([]string{movedID}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := createRunner(appManagementClient, outputSink, "Applications.Core/containers", "orders").Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Moved %s",
+				Params: []any{movedID},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success (application)", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		applicationID := sourceGroupScope + "/providers/Applications.Core/applications/myapp"
+		containerID := sourceGroupScope + "/providers/Applications.Core/containers/orders"
+		sharedID := "/planes/radius/local/resourceGroups/shared/providers/Applications.Datastores/redisCaches/cache"
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceGroup(gomock.Any(), "local", "dev-b").
+			Return(ucp.ResourceGroupResource{}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "myapp").
+			Return(corerp.ApplicationResource{ID: to.Ptr(applicationID)}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesInApplication(gomock.Any(), "myapp").
+			Return([]generated.GenericResource{{ID: to.Ptr(containerID)}, {ID: to.Ptr(sharedID)}}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			MoveResources(gomock.Any(), "local", "dev-a", targetGroupID, []string{applicationID, containerID}).
+			Return([]string{
+				targetGroupID + "/providers/Applications.Core/applications/myapp",
+				targetGroupID + "/providers/Applications.Core/containers/orders",
+			}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := createRunner(appManagementClient, outputSink, "Applications.Core/applications", "myapp").Run(context.Background())
+		require.NoError(t, err)
+		require.Len(t, outputSink.Writes, 2)
+	})
+
+	t.Run("Target group not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceGroup(gomock.Any(), "local", "dev-b").
+			Return(ucp.ResourceGroupResource{}, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		err := createRunner(appManagementClient, &output.MockOutput{}, "Applications.Core/containers", "orders").Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource group %q does not exist.", "dev-b"), err)
+	})
+
+	t.Run("Resource not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceGroup(gomock.Any(), "local", "dev-b").
+			Return(ucp.ResourceGroupResource{}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), "Applications.Core/containers", "orders").
+			Return(generated.GenericResource{}, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		err := createRunner(appManagementClient, &output.MockOutput{}, "Applications.Core/containers", "orders").Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource %q of type %q does not exist.", "orders", "Applications.Core/containers"), err)
+	})
+}
@@ -18,14 +18,19 @@ package show
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/spf13/cobra"
 )
 
@@ -125,7 +130,8 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 //
 
 // Run creates a connection to an applications management client, retrieves resource details, and writes the details in a
-// specified format to an output. It returns an error if any of these steps fail.
+// specified format to an output. In the table format the recipe drift of the resource, if detected, is written after the
+// details. It returns an error if any of these steps fail.
 func (r *Runner) Run(ctx context.Context) error {
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
 	if err != nil {
@@ -137,5 +143,57 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	return r.Output.WriteFormatted(r.Format, resourceDetails, objectformats.GetGenericResourceTableFormat())
+	err = r.Output.WriteFormatted(r.Format, resourceDetails, objectformats.GetGenericResourceTableFormat())
+	if err != nil {
+		return err
+	}
+
+	// The drift is part of the resource properties in the other formats.
+	if r.Format != output.FormatTable {
+		return nil
+	}
+
+	drift, err := getRecipeDrift(resourceDetails)
+	if err != nil || drift == nil {
+		return err
+	}
+
+	r.Output.LogInfo("")
+	if drift.LastCheckedAt != nil {
+		r.Output.LogInfo("Recipe drift: %s (last checked %s)", to.String(drift.State), drift.LastCheckedAt.UTC().Format(time.RFC3339))
+	} else {
+		r.Output.LogInfo("Recipe drift: %s", to.String(drift.State))
+	}
+	if drift.Message != nil {
+		r.Output.LogInfo(*drift.Message)
+	}
+	if len(drift.Changes) == 0 {
+		return nil
+	}
+
+	r.Output.LogInfo("")
+	return r.Output.WriteFormatted(output.FormatTable, drift.Changes, objectformats.GetRecipeDriftChangeTableFormat())
+}
+
+// getRecipeDrift reads the recipe drift from the status of the resource. It returns nil if the drift of the resource has
+// not been detected.
+func getRecipeDrift(resource generated.GenericResource) (*corerp.RecipeDriftStatus, error) {
+	status, _ := resource.Properties["status"].(map[string]any)
+	recipe, _ := status["recipe"].(map[string]any)
+	value, ok := recipe["drift"]
+	if !ok {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := &corerp.RecipeDriftStatus{}
+	if err := json.Unmarshal(b, drift); err != nil {
+		return nil, err
+	}
+
+	return drift, nil
 }
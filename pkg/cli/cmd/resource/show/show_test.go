@@ -26,6 +26,8 @@ import (
 	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -136,4 +138,105 @@ func Test_Run(t *testing.T) {
 		}
 		require.Equal(t, expected, outputSink.Writes)
 	})
+
+	t.Run("Validate rad resource show with recipe drift", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		resource := radcli.CreateResource("redisCaches", "redis")
+		resource.Properties = map[string]any{
+			"status": map[string]any{
+				"recipe": map[string]any{
+					"templateKind": "terraform",
+					"templatePath": "ghcr.io/radius-project/recipes/redis:1.0",
+					"drift": map[string]any{
+						"state":         "Drifted",
+						"lastCheckedAt": "2024-06-01T00:00:00Z",
+						"changes": []any{
+							map[string]any{"action": "update", "resourceType": "aws_s3_bucket", "name": "aws_s3_bucket.backup"},
+						},
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), "redisCaches", "redis").
+			Return(resource, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "redisCaches",
+			ResourceName:      "redis",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resource,
+				Options: objectformats.GetGenericResourceTableFormat(),
+			},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "Recipe drift: %s (last checked %s)", Params: []any{"Drifted", "2024-06-01T00:00:00Z"}},
+			output.LogOutput{Format: ""},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []*corerp.RecipeDriftChange{
+					{Action: to.Ptr("update"), ResourceType: to.Ptr("aws_s3_bucket"), Name: to.Ptr("aws_s3_bucket.backup")},
+				},
+				Options: objectformats.GetRecipeDriftChangeTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Validate rad resource show with recipe drift in json format", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		resource := radcli.CreateResource("redisCaches", "redis")
+		resource.Properties = map[string]any{
+			"status": map[string]any{
+				"recipe": map[string]any{
+					"drift": map[string]any{"state": "InSync"},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), "redisCaches", "redis").
+			Return(resource, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "redisCaches",
+			ResourceName:      "redis",
+			Format:            "json",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		// The drift is already part of the resource properties.
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     resource,
+				Options: objectformats.GetGenericResourceTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -20,9 +20,11 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/to"
 	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 )
 
@@ -44,6 +46,12 @@ const (
 type AWSCredentialProperties struct {
 	// AccessKeyID is the access key ID for the AWS credential.
 	AccessKeyID *string
+
+	// Kind is the kind of the AWS credential.
+	Kind *string
+
+	// RoleARN is the ARN of the IAM role assumed for an IRSA credential.
+	RoleARN *string
 }
 
 // AWSCredentialManagementClient is used to interface with cloud provider configuration and credentials.
@@ -84,19 +92,26 @@ func (cpm *AWSCredentialManagementClient) Get(ctx context.Context, credentialNam
 	if err != nil {
 		return ProviderCredentialConfiguration{}, err
 	}
-	awsAccessKeyCredentials, ok := resp.AwsCredentialResource.Properties.(*ucp.AwsAccessKeyCredentialProperties)
-	if !ok {
+	var awsCredentials *AWSCredentialProperties
+	switch p := resp.AwsCredentialResource.Properties.(type) {
+	case *ucp.AwsAccessKeyCredentialProperties:
+		awsCredentials = &AWSCredentialProperties{
+			AccessKeyID: p.AccessKeyID,
+			Kind:        (*string)(p.Kind),
+		}
+	case *ucp.AwsIRSACredentialProperties:
+		awsCredentials = &AWSCredentialProperties{
+			Kind:    (*string)(p.Kind),
+			RoleARN: p.RoleARN,
+		}
+	default:
 		return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", AWSCredential)
 	}
 
+	base := resp.AwsCredentialResource.Properties.GetAwsCredentialProperties()
 	providerCredentialConfiguration := ProviderCredentialConfiguration{
-		CloudProviderStatus: CloudProviderStatus{
-			Name:    AWSCredential,
-			Enabled: true,
-		},
-		AWSCredentials: &AWSCredentialProperties{
-			AccessKeyID: awsAccessKeyCredentials.AccessKeyID,
-		},
+		CloudProviderStatus: newCloudProviderStatus(AWSCredential, base.ExpiresAt, base.Status),
+		AWSCredentials:      awsCredentials,
 	}
 	return providerCredentialConfiguration, nil
 }
@@ -122,10 +137,22 @@ func (cpm *AWSCredentialManagementClient) List(ctx context.Context) ([]CloudProv
 
 	res := []CloudProviderStatus{}
 	if len(providerList) > 0 {
-		res = append(res, CloudProviderStatus{
-			Name:    AWSCredential,
-			Enabled: true,
-		})
+		// Report the status of the default credential, or the first one if there is no default credential.
+		cred := providerList[0]
+		for _, c := range providerList {
+			if strings.EqualFold(to.String(c.Name), defaultSecretName) {
+				cred = c
+				break
+			}
+		}
+
+		var expiresAt *time.Time
+		var status *ucp.CredentialStatus
+		if cred.Properties != nil {
+			base := cred.Properties.GetAwsCredentialProperties()
+			expiresAt, status = base.ExpiresAt, base.Status
+		}
+		res = append(res, newCloudProviderStatus(AWSCredential, expiresAt, status))
 	}
 	return res, nil
 }
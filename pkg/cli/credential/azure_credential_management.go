@@ -20,9 +20,11 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/to"
 	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 )
 
@@ -58,6 +60,16 @@ type CloudProviderStatus struct {
 
 	// Enabled is the enabled/disabled status of the provider.
 	Enabled bool
+
+	// Health is the health of the credential reported by the last credential validation.
+	Health string
+
+	// RotatedAt is the time at which the credential was last registered or rotated, in RFC3339 format.
+	RotatedAt string
+
+	// ExpiresAt is the time at which the credential expires, in RFC3339 format. It is empty if the credential does
+	// not expire.
+	ExpiresAt string
 }
 
 type ProviderCredentialConfiguration struct {
@@ -71,13 +83,13 @@ type ProviderCredentialConfiguration struct {
 }
 
 type AzureCredentialProperties struct {
-	// clientId for ServicePrincipal
+	// clientId for ServicePrincipal or WorkloadIdentity
 	ClientID *string
 
 	// The credential kind
 	Kind *string
 
-	// tenantId for ServicePrincipal
+	// tenantId for ServicePrincipal or WorkloadIdentity
 	TenantID *string
 }
 
@@ -124,21 +136,28 @@ func (cpm *AzureCredentialManagementClient) Get(ctx context.Context, credentialN
 		return ProviderCredentialConfiguration{}, err
 	}
 
-	azureServicePrincipal, ok := resp.AzureCredentialResource.Properties.(*ucp.AzureServicePrincipalProperties)
-	if !ok {
+	var azureCredentials *AzureCredentialProperties
+	switch p := resp.AzureCredentialResource.Properties.(type) {
+	case *ucp.AzureServicePrincipalProperties:
+		azureCredentials = &AzureCredentialProperties{
+			ClientID: p.ClientID,
+			Kind:     (*string)(p.Kind),
+			TenantID: p.TenantID,
+		}
+	case *ucp.AzureWorkloadIdentityProperties:
+		azureCredentials = &AzureCredentialProperties{
+			ClientID: p.ClientID,
+			Kind:     (*string)(p.Kind),
+			TenantID: p.TenantID,
+		}
+	default:
 		return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", AzureCredential)
 	}
 
+	base := resp.AzureCredentialResource.Properties.GetAzureCredentialProperties()
 	providerCredentialConfiguration := ProviderCredentialConfiguration{
-		CloudProviderStatus: CloudProviderStatus{
-			Name:    AzureCredential,
-			Enabled: true,
-		},
-		AzureCredentials: &AzureCredentialProperties{
-			ClientID: azureServicePrincipal.ClientID,
-			Kind:     (*string)(azureServicePrincipal.Kind),
-			TenantID: azureServicePrincipal.TenantID,
-		},
+		CloudProviderStatus: newCloudProviderStatus(AzureCredential, base.ExpiresAt, base.Status),
+		AzureCredentials:    azureCredentials,
 	}
 
 	return providerCredentialConfiguration, nil
@@ -165,10 +184,22 @@ func (cpm *AzureCredentialManagementClient) List(ctx context.Context) ([]CloudPr
 
 	res := []CloudProviderStatus{}
 	if len(providerList) > 0 {
-		res = append(res, CloudProviderStatus{
-			Name:    AzureCredential,
-			Enabled: true,
-		})
+		// Report the status of the default credential, or the first one if there is no default credential.
+		cred := providerList[0]
+		for _, c := range providerList {
+			if strings.EqualFold(to.String(c.Name), defaultSecretName) {
+				cred = c
+				break
+			}
+		}
+
+		var expiresAt *time.Time
+		var status *ucp.CredentialStatus
+		if cred.Properties != nil {
+			base := cred.Properties.GetAzureCredentialProperties()
+			expiresAt, status = base.ExpiresAt, base.Status
+		}
+		res = append(res, newCloudProviderStatus(AzureCredential, expiresAt, status))
 	}
 
 	return res, nil
@@ -19,6 +19,7 @@ package credential
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
 	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -151,3 +152,26 @@ func (cpm *UCPCredentialManagementClient) Delete(ctx context.Context, providerNa
 
 	return true, nil
 }
+
+// newCloudProviderStatus returns the status of the credential registered with the cloud provider.
+func newCloudProviderStatus(providerName string, expiresAt *time.Time, status *ucp.CredentialStatus) CloudProviderStatus {
+	result := CloudProviderStatus{
+		Name:    providerName,
+		Enabled: true,
+	}
+
+	if expiresAt != nil {
+		result.ExpiresAt = expiresAt.UTC().Format(time.RFC3339)
+	}
+
+	if status != nil {
+		if status.Health != nil {
+			result.Health = string(*status.Health)
+		}
+		if status.RotatedAt != nil {
+			result.RotatedAt = status.RotatedAt.UTC().Format(time.RFC3339)
+		}
+	}
+
+	return result
+}
@@ -19,6 +19,7 @@ package credential
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/stretchr/testify/require"
@@ -548,3 +549,27 @@ func setupErrorAWSDeleteMocks(mockAzure MockAzureCredentialManagementClientInter
 		Delete(gomock.Any(), gomock.Any()).
 		Return(false, errInternalServer).Times(1)
 }
+
+func Test_newCloudProviderStatus(t *testing.T) {
+	t.Run("without status", func(t *testing.T) {
+		status := newCloudProviderStatus(azureProviderName, nil, nil)
+		require.Equal(t, CloudProviderStatus{Name: azureProviderName, Enabled: true}, status)
+	})
+
+	t.Run("with status", func(t *testing.T) {
+		status := newCloudProviderStatus(awsProviderName,
+			to.Ptr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			&ucp.CredentialStatus{
+				CreatedAt: to.Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				RotatedAt: to.Ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+				Health:    to.Ptr(ucp.CredentialHealthStateHealthy),
+			})
+		require.Equal(t, CloudProviderStatus{
+			Name:      awsProviderName,
+			Enabled:   true,
+			Health:    "Healthy",
+			RotatedAt: "2024-06-01T00:00:00Z",
+			ExpiresAt: "2025-01-01T00:00:00Z",
+		}, status)
+	})
+}
@@ -99,3 +99,18 @@ func DeployWithProgress(ctx context.Context, options Options) (clients.Deploymen
 
 	return result, nil
 }
+
+// WhatIf creates a deployment client and previews the changes that deploying the template would make.
+// Nothing is deployed and no progress is displayed.
+func WhatIf(ctx context.Context, options Options) (clients.WhatIfResult, error) {
+	deploymentClient, err := options.ConnectionFactory.CreateDeploymentClient(ctx, options.Workspace)
+	if err != nil {
+		return clients.WhatIfResult{}, err
+	}
+
+	return deploymentClient.WhatIf(ctx, clients.DeploymentOptions{
+		Template:   options.Template,
+		Parameters: options.Parameters,
+		Providers:  options.Providers,
+	})
+}
@@ -78,3 +78,42 @@ func (c *MockInterfaceDeployWithProgressCall) DoAndReturn(f func(context.Context
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// WhatIf mocks base method.
+func (m *MockInterface) WhatIf(arg0 context.Context, arg1 Options) (clients.WhatIfResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WhatIf", arg0, arg1)
+	ret0, _ := ret[0].(clients.WhatIfResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WhatIf indicates an expected call of WhatIf.
+func (mr *MockInterfaceMockRecorder) WhatIf(arg0, arg1 any) *MockInterfaceWhatIfCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WhatIf", reflect.TypeOf((*MockInterface)(nil).WhatIf), arg0, arg1)
+	return &MockInterfaceWhatIfCall{Call: call}
+}
+
+// MockInterfaceWhatIfCall wrap *gomock.Call
+type MockInterfaceWhatIfCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceWhatIfCall) Return(arg0 clients.WhatIfResult, arg1 error) *MockInterfaceWhatIfCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceWhatIfCall) Do(f func(context.Context, Options) (clients.WhatIfResult, error)) *MockInterfaceWhatIfCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceWhatIfCall) DoAndReturn(f func(context.Context, Options) (clients.WhatIfResult, error)) *MockInterfaceWhatIfCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
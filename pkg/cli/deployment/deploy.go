@@ -94,26 +94,54 @@ func (dc *ResourceDeploymentClient) Deploy(ctx context.Context, options clients.
 	return summary, nil
 }
 
-func (dc *ResourceDeploymentClient) startDeployment(ctx context.Context, name string, options clients.DeploymentOptions) (*runtime.Poller[sdkclients.ClientCreateOrUpdateResponse], error) {
-	var resourceId string
-	scopes := []ucpresources.ScopeSegment{
-		{
-			Type: "radius",
-			Name: "local",
-		},
-		{
-			Type: "resourcegroups",
-			Name: dc.RadiusResourceGroup,
+// WhatIf previews the changes that deploying the template would make and returns them without deploying anything.
+func (dc *ResourceDeploymentClient) WhatIf(ctx context.Context, options clients.DeploymentOptions) (clients.WhatIfResult, error) {
+	name := fmt.Sprintf("rad-whatif-%v", uuid.New().String())
+	poller, err := dc.Client.WhatIf(ctx,
+		sdkclients.Deployment{
+			Properties: &sdkclients.DeploymentProperties{
+				Template:       options.Template,
+				Parameters:     options.Parameters,
+				ProviderConfig: dc.GetProviderConfigs(options),
+				Mode:           armresources.DeploymentModeIncremental,
+			},
 		},
+		dc.deploymentResourceID(name),
+		sdkclients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return clients.WhatIfResult{}, err
 	}
-	types := []ucpresources.TypeSegment{
-		{
-			Type: "Microsoft.Resources/deployments",
-			Name: name,
-		},
+
+	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: deploymentPollInterval})
+	if err != nil {
+		return clients.WhatIfResult{}, err
 	}
 
-	resourceId = ucpresources.MakeUCPID(scopes, types, nil)
+	return createWhatIfResult(&resp.WhatIfOperationResult), nil
+}
+
+func createWhatIfResult(result *armresources.WhatIfOperationResult) clients.WhatIfResult {
+	changes := []clients.WhatIfChange{}
+	if result.Properties == nil {
+		return clients.WhatIfResult{Changes: changes}
+	}
+
+	for _, change := range result.Properties.Changes {
+		if change == nil || change.ResourceID == nil || change.ChangeType == nil {
+			continue
+		}
+
+		changes = append(changes, clients.WhatIfChange{
+			ChangeType: string(*change.ChangeType),
+			ResourceID: *change.ResourceID,
+		})
+	}
+
+	return clients.WhatIfResult{Changes: changes}
+}
+
+func (dc *ResourceDeploymentClient) startDeployment(ctx context.Context, name string, options clients.DeploymentOptions) (*runtime.Poller[sdkclients.ClientCreateOrUpdateResponse], error) {
+	resourceId := dc.deploymentResourceID(name)
 	providerConfig := dc.GetProviderConfigs(options)
 
 	poller, err := dc.Client.CreateOrUpdate(ctx,
@@ -134,6 +162,28 @@ func (dc *ResourceDeploymentClient) startDeployment(ctx context.Context, name st
 	return poller, nil
 }
 
+// deploymentResourceID returns the UCP resource ID of the deployment with the given name.
+func (dc *ResourceDeploymentClient) deploymentResourceID(name string) string {
+	scopes := []ucpresources.ScopeSegment{
+		{
+			Type: "radius",
+			Name: "local",
+		},
+		{
+			Type: "resourcegroups",
+			Name: dc.RadiusResourceGroup,
+		},
+	}
+	types := []ucpresources.TypeSegment{
+		{
+			Type: "Microsoft.Resources/deployments",
+			Name: name,
+		},
+	}
+
+	return ucpresources.MakeUCPID(scopes, types, nil)
+}
+
 // GetProviderConfigs() creates a default provider config and then updates it with any provider scopes passed in the DeploymentOptions.
 func (dc *ResourceDeploymentClient) GetProviderConfigs(options clients.DeploymentOptions) sdkclients.ProviderConfig {
 	providerConfig := sdkclients.NewDefaultProviderConfig(dc.RadiusResourceGroup)
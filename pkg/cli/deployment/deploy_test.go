@@ -19,8 +19,10 @@ package deployment
 import (
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/stretchr/testify/require"
 )
 
@@ -91,3 +93,40 @@ func Test_GetProviderConfigsWithAzProvider(t *testing.T) {
 	providerConfig := resourceDeploymentClient.GetProviderConfigs(options)
 	require.Equal(t, providerConfig, expectedConfig)
 }
+
+func Test_CreateWhatIfResult(t *testing.T) {
+	result := &armresources.WhatIfOperationResult{
+		Properties: &armresources.WhatIfOperationProperties{
+			Changes: []*armresources.WhatIfChange{
+				{
+					ChangeType: to.Ptr(armresources.ChangeTypeCreate),
+					ResourceID: to.Ptr("/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/containers/frontend"),
+				},
+				{
+					ChangeType: to.Ptr(armresources.ChangeTypeModify),
+					ResourceID: to.Ptr("/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/applications/app"),
+				},
+				nil,
+				{
+					ChangeType: to.Ptr(armresources.ChangeTypeDelete),
+				},
+			},
+		},
+	}
+
+	expected := clients.WhatIfResult{
+		Changes: []clients.WhatIfChange{
+			{
+				ChangeType: "Create",
+				ResourceID: "/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/containers/frontend",
+			},
+			{
+				ChangeType: "Modify",
+				ResourceID: "/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/applications/app",
+			},
+		},
+	}
+
+	require.Equal(t, expected, createWhatIfResult(result))
+	require.Equal(t, clients.WhatIfResult{Changes: []clients.WhatIfChange{}}, createWhatIfResult(&armresources.WhatIfOperationResult{}))
+}
@@ -73,3 +73,89 @@ func GetGenericResourceTableFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// GetAuditRecordTableFormat returns the fields to output from an audit record.
+func GetAuditRecordTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:     "TIMESTAMP",
+				JSONPath:    "{ .Timestamp }",
+				Transformer: &TimestampTransformer{},
+			},
+			{
+				Heading:  "SERVICE",
+				JSONPath: "{ .Service }",
+			},
+			{
+				Heading:  "CALLER",
+				JSONPath: "{ .Caller }",
+			},
+			{
+				Heading:  "METHOD",
+				JSONPath: "{ .Method }",
+			},
+			{
+				Heading:  "RESULT",
+				JSONPath: "{ .Result }",
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .StatusCode }",
+			},
+			{
+				Heading:  "LATENCY (MS)",
+				JSONPath: "{ .LatencyMilliseconds }",
+			},
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .OperationID }",
+			},
+		},
+	}
+}
+
+// GetWhatIfChangeTableFormat returns the fields to output from a deployment what-if change.
+func GetWhatIfChangeTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "CHANGE",
+				JSONPath: "{ .ChangeType }",
+			},
+			{
+				Heading:     "RESOURCE",
+				JSONPath:    "{ .ResourceID }",
+				Transformer: &ResourceIDToResourceNameTransformer{},
+			},
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ResourceID }",
+			},
+		},
+	}
+}
+
+// GetRecipeDriftChangeTableFormat returns the fields to output from a change reported by the recipe drift detection.
+func GetRecipeDriftChangeTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .Action }",
+			},
+			{
+				Heading:  "RESOURCE TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "RESOURCE ID",
+				JSONPath: "{ .ResourceID }",
+			},
+		},
+	}
+}
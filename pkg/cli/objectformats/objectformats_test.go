@@ -19,7 +19,10 @@ package objectformats
 import (
 	"bytes"
 	"testing"
+	"time"
 
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/output"
 	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -62,3 +65,56 @@ func Test_GetGenericResourceTableFormat(t *testing.T) {
 	expected := "RESOURCE  TYPE       GROUP       STATE\ntest      test-type  test-group  Updating\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_GetAuditRecordTableFormat(t *testing.T) {
+	obj := audit.Record{
+		Timestamp:           time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+		Service:             "ucp",
+		Caller:              "alice",
+		Method:              "PUT",
+		OperationID:         "op-1",
+		StatusCode:          200,
+		Result:              audit.ResultSucceeded,
+		LatencyMilliseconds: 12,
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, GetAuditRecordTableFormat())
+	require.NoError(t, err)
+
+	require.Contains(t, buffer.String(), "TIMESTAMP")
+	require.Contains(t, buffer.String(), "2023-10-01T12:00:00Z")
+	require.Contains(t, buffer.String(), "alice")
+	require.Contains(t, buffer.String(), "Succeeded")
+	require.Contains(t, buffer.String(), "op-1")
+}
+
+func Test_GetWhatIfChangeTableFormat(t *testing.T) {
+	obj := clients.WhatIfChange{
+		ChangeType: "Create",
+		ResourceID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, GetWhatIfChangeTableFormat())
+	require.NoError(t, err)
+
+	expected := "CHANGE    RESOURCE  ID\nCreate    test      /planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_GetRecipeDriftChangeTableFormat(t *testing.T) {
+	obj := corerpv20231001preview.RecipeDriftChange{
+		Action:       to.Ptr("create"),
+		ResourceType: to.Ptr("Service"),
+		Name:         to.Ptr("redis"),
+		ResourceID:   to.Ptr("/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"),
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, GetRecipeDriftChangeTableFormat())
+	require.NoError(t, err)
+
+	expected := "ACTION    RESOURCE TYPE  NAME      RESOURCE ID\ncreate    Service        redis     /planes/kubernetes/local/namespaces/default/providers/core/Service/redis\n"
+	require.Equal(t, expected, buffer.String())
+}
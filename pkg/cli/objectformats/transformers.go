@@ -17,6 +17,9 @@ limitations under the License.
 package objectformats
 
 import (
+	"strings"
+	"time"
+
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
@@ -60,3 +63,23 @@ func (t *ResourceIDToResourceNameTransformer) Transform(input string) string {
 
 	return id.Name()
 }
+
+// TimestampTransformer is a transformer that takes a serialized timestamp and returns it in RFC3339 format.
+type TimestampTransformer struct {
+}
+
+// Transform takes a serialized timestamp and returns it in RFC3339 format. Input that cannot be parsed
+// is returned with any surrounding quotes removed.
+func (t *TimestampTransformer) Transform(input string) string {
+	input = strings.Trim(input, "\"")
+	if input == "" {
+		return ""
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, input)
+	if err != nil {
+		return input
+	}
+
+	return timestamp.UTC().Format(time.RFC3339)
+}
@@ -85,3 +85,35 @@ func Test_ResourceIDToResourceGroupNameTransformer(t *testing.T) {
 		})
 	}
 }
+
+func Test_TimestampTransformer(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "empty input",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "invalid input",
+			input:    "\"not-a-time\"",
+			expected: "not-a-time",
+		},
+		{
+			name:     "valid input",
+			input:    "\"2023-10-01T12:00:00.123456789Z\"",
+			expected: "2023-10-01T12:00:00Z",
+		},
+	}
+
+	for _, testcase := range cases {
+		t.Run(testcase.name, func(t *testing.T) {
+			transformer := &TimestampTransformer{}
+			actual := transformer.Transform(testcase.input)
+			require.Equal(t, testcase.expected, actual)
+		})
+	}
+}
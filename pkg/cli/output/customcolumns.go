@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// CustomColumnsFormatter formats the output as a table with the columns chosen by the user.
+type CustomColumnsFormatter struct {
+	// Columns is the list of column specifications. Each specification is either the heading of a column
+	// defined by the command, or a heading and a JSONPath expression separated by a colon.
+	Columns []string
+}
+
+// NewCustomColumnsFormatter parses a comma-separated list of column specifications, such as
+// "NAME,STATE:{ .Properties.ProvisioningState }", and returns a CustomColumnsFormatter. It returns an error if the
+// list is empty or a specification is invalid.
+func NewCustomColumnsFormatter(spec string) (*CustomColumnsFormatter, error) {
+	columns := []string{}
+	for _, column := range splitColumns(spec) {
+		column = strings.TrimSpace(column)
+		if column == "" {
+			continue
+		}
+
+		heading, path, hasPath := strings.Cut(column, ":")
+		if strings.TrimSpace(heading) == "" || (hasPath && strings.TrimSpace(path) == "") {
+			return nil, fmt.Errorf("invalid custom column %q, expected HEADING or HEADING:JSONPATH", column)
+		}
+
+		columns = append(columns, column)
+	}
+
+	if len(columns) == 0 {
+		return nil, errors.New("custom-columns format requires at least one column, for example: custom-columns=NAME,TYPE")
+	}
+
+	return &CustomColumnsFormatter{Columns: columns}, nil
+}
+
+// Format takes in an object, a writer and formatting options and writes a table with the custom columns to the writer.
+// Columns without a JSONPath expression reuse the column with the same heading from the formatting options.
+func (f *CustomColumnsFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	columns := []Column{}
+	for _, spec := range f.Columns {
+		heading, path, hasPath := strings.Cut(spec, ":")
+		heading = strings.TrimSpace(heading)
+
+		if hasPath {
+			path = strings.TrimSpace(path)
+			if !strings.HasPrefix(path, "{") {
+				path = "{" + path + "}"
+			}
+
+			columns = append(columns, Column{Heading: heading, JSONPath: path})
+			continue
+		}
+
+		column, ok := findColumn(options.Columns, heading)
+		if !ok {
+			return fmt.Errorf("unknown column %q, available columns are: %s", heading, strings.Join(columnHeadings(options.Columns), ", "))
+		}
+		columns = append(columns, column)
+	}
+
+	return (&TableFormatter{}).Format(obj, writer, FormatterOptions{Columns: columns})
+}
+
+// splitColumns splits the column specifications on commas that are not part of a JSONPath expression.
+func splitColumns(spec string) []string {
+	columns := []string{}
+	depth := 0
+	start := 0
+	for i, c := range spec {
+		switch c {
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				columns = append(columns, spec[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(columns, spec[start:])
+}
+
+func findColumn(columns []Column, heading string) (Column, bool) {
+	for _, c := range columns {
+		if strings.EqualFold(c.Heading, heading) {
+			return c, true
+		}
+	}
+
+	return Column{}, false
+}
+
+func columnHeadings(columns []Column) []string {
+	headings := []string{}
+	for _, c := range columns {
+		headings = append(headings, c.Heading)
+	}
+
+	return headings
+}
+
+var _ Formatter = (*CustomColumnsFormatter)(nil)
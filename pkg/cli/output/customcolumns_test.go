@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCustomColumnsFormatter(t *testing.T) {
+	formatter, err := NewCustomColumnsFormatter("Size, COOL:{ .IsCool },Items:.Items[0,1]")
+	require.NoError(t, err)
+	require.Equal(t, []string{"Size", "COOL:{ .IsCool }", "Items:.Items[0,1]"}, formatter.Columns)
+
+	_, err = NewCustomColumnsFormatter("")
+	require.EqualError(t, err, "custom-columns format requires at least one column, for example: custom-columns=NAME,TYPE")
+
+	_, err = NewCustomColumnsFormatter("SIZE:")
+	require.EqualError(t, err, "invalid custom column \"SIZE:\", expected HEADING or HEADING:JSONPATH")
+}
+
+func Test_CustomColumns_Slice(t *testing.T) {
+	obj := []any{
+		tableInput{
+			Size:   "mega",
+			IsCool: true,
+		},
+		tableInput{
+			Size:   "medium",
+			IsCool: false,
+		},
+	}
+
+	formatter, err := NewCustomColumnsFormatter("lowered,COOL:.IsCool,size")
+	require.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	err = formatter.Format(obj, buffer, tableInputOptions)
+	require.NoError(t, err)
+
+	expected := `Lowered     COOL      Size
+some-value  true      mega
+some-value  false     medium
+`
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_CustomColumns_UnknownColumn(t *testing.T) {
+	formatter, err := NewCustomColumnsFormatter("Size,Color")
+	require.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	err = formatter.Format(tableInput{}, buffer, tableInputOptions)
+	require.EqualError(t, err, "unknown column \"Color\", available columns are: Size, Coolness, Unknown, Static, Lowered")
+}
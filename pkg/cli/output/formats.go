@@ -17,15 +17,29 @@ limitations under the License.
 package output
 
 const (
-	FormatJson    = "json"
-	FormatTable   = "table"
-	DefaultFormat = FormatTable
+	FormatJson      = "json"
+	FormatJSONLines = "jsonl"
+	FormatTable     = "table"
+	FormatYAML      = "yaml"
+	DefaultFormat   = FormatTable
+
+	// FormatCustomColumns is the format for tables with user-defined columns. It takes a comma-separated list of
+	// columns as an argument, for example: custom-columns=NAME,STATE:{ .Properties.ProvisioningState }
+	FormatCustomColumns = "custom-columns"
+
+	// FormatGoTemplate is the format for Go templates. It takes the template as an argument, for example:
+	// go-template={{ .name }}
+	FormatGoTemplate = "go-template"
 )
 
 // SupportedFormats returns a slice of strings containing the supported formats for a request.
 func SupportedFormats() []string {
 	return []string{
 		FormatJson,
+		FormatJSONLines,
 		FormatTable,
+		FormatYAML,
+		FormatCustomColumns + "=<columns>",
+		FormatGoTemplate + "=<template>",
 	}
 }
@@ -46,13 +46,24 @@ type Formatter interface {
 }
 
 // NewFormatter takes in a string and returns a Formatter interface and an error if the format is not supported.
+// The custom-columns and go-template formats take an argument following an equals sign, such as
+// "custom-columns=NAME,TYPE".
 func NewFormatter(format string) (Formatter, error) {
-	normalized := strings.ToLower(strings.TrimSpace(format))
-	switch normalized {
-	case FormatJson:
+	name, argument, hasArgument := strings.Cut(strings.TrimSpace(format), "=")
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	switch {
+	case normalized == FormatJson && !hasArgument:
 		return &JSONFormatter{}, nil
-	case FormatTable:
+	case normalized == FormatJSONLines && !hasArgument:
+		return &JSONLinesFormatter{}, nil
+	case normalized == FormatTable && !hasArgument:
 		return &TableFormatter{}, nil
+	case normalized == FormatYAML && !hasArgument:
+		return &YAMLFormatter{}, nil
+	case normalized == FormatCustomColumns:
+		return NewCustomColumnsFormatter(argument)
+	case normalized == FormatGoTemplate:
+		return NewGoTemplateFormatter(argument)
 	default:
 		return nil, fmt.Errorf("unsupported format %s", format)
 	}
@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewFormatter(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected Formatter
+	}{
+		{format: "json", expected: &JSONFormatter{}},
+		{format: " JSON ", expected: &JSONFormatter{}},
+		{format: "jsonl", expected: &JSONLinesFormatter{}},
+		{format: "table", expected: &TableFormatter{}},
+		{format: "yaml", expected: &YAMLFormatter{}},
+		{format: "custom-columns=NAME,TYPE", expected: &CustomColumnsFormatter{Columns: []string{"NAME", "TYPE"}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			formatter, err := NewFormatter(tc.format)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, formatter)
+		})
+	}
+
+	t.Run("go-template", func(t *testing.T) {
+		formatter, err := NewFormatter("Go-Template={{ .Name }}")
+		require.NoError(t, err)
+		require.IsType(t, &GoTemplateFormatter{}, formatter)
+	})
+
+	for _, format := range []string{"xml", "json=x", "table=NAME", "custom-columns", "go-template="} {
+		t.Run(format, func(t *testing.T) {
+			_, err := NewFormatter(format)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"text/template"
+)
+
+// GoTemplateFormatter formats the output using a Go template.
+type GoTemplateFormatter struct {
+	Template *template.Template
+}
+
+// NewGoTemplateFormatter parses the given Go template and returns a GoTemplateFormatter, or an error if the template
+// is empty or invalid.
+func NewGoTemplateFormatter(text string) (*GoTemplateFormatter, error) {
+	if text == "" {
+		return nil, errors.New("go-template format requires a template, for example: go-template={{ .name }}")
+	}
+
+	t, err := template.New(FormatGoTemplate).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid go-template: %w", err)
+	}
+
+	return &GoTemplateFormatter{Template: t}, nil
+}
+
+// Format takes in an object, a writer and formatting options and executes the template for the object, writing a
+// newline after the result. The template is executed against the JSON representation of the object, so fields are
+// named as in the JSON output. Slices and arrays execute the template once for each element.
+func (f *GoTemplateFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	var data any
+	err = json.Unmarshal(b, &data)
+	if err != nil {
+		return err
+	}
+
+	items, ok := data.([]any)
+	if !ok {
+		items = []any{data}
+	}
+
+	for _, item := range items {
+		err = f.Template.Execute(writer, item)
+		if err != nil {
+			return err
+		}
+
+		_, err = writer.Write([]byte("\n"))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ Formatter = (*GoTemplateFormatter)(nil)
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewGoTemplateFormatter_Invalid(t *testing.T) {
+	_, err := NewGoTemplateFormatter("")
+	require.EqualError(t, err, "go-template format requires a template, for example: go-template={{ .name }}")
+
+	_, err = NewGoTemplateFormatter("{{ .size ")
+	require.ErrorContains(t, err, "invalid go-template")
+}
+
+func Test_GoTemplate_Scalar(t *testing.T) {
+	obj := &yamlInput{
+		Size:   "mega",
+		IsCool: true,
+	}
+
+	formatter, err := NewGoTemplateFormatter("{{ .size }} {{ if .isCool }}cool{{ end }}")
+	require.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	err = formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "mega cool\n", buffer.String())
+}
+
+func Test_GoTemplate_Slice(t *testing.T) {
+	obj := []yamlInput{
+		{
+			Size:   "mega",
+			IsCool: true,
+		},
+		{
+			Size: "medium",
+		},
+	}
+
+	formatter, err := NewGoTemplateFormatter("{{ .size }}={{ .isCool }}{{ .missing }}")
+	require.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	err = formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "mega=true<no value>\nmedium=false<no value>\n", buffer.String())
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+)
+
+type JSONLinesFormatter struct {
+}
+
+// Format takes in an object, a writer and an options object and writes the object as compact JSON on a single line.
+// Slices and arrays are written as one line per element so that the output can be processed as a stream.
+func (f *JSONLinesFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	items := []any{obj}
+
+	v := reflect.ValueOf(obj)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Array || v.Kind() == reflect.Slice {
+		items = make([]any, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			items = append(items, v.Index(i).Interface())
+		}
+	}
+
+	for _, item := range items {
+		b, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+
+		_, err = writer.Write(append(b, '\n'))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ Formatter = (*JSONLinesFormatter)(nil)
@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_JSONLines_Scalar(t *testing.T) {
+	obj := &jsonInput{
+		Size:   "mega",
+		IsCool: true,
+	}
+
+	formatter := &JSONLinesFormatter{}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+
+	expected := `{"Size":"mega","IsCool":true}
+`
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_JSONLines_Slice(t *testing.T) {
+	obj := []jsonInput{
+		{
+			Size:   "mega",
+			IsCool: true,
+		},
+		{
+			Size:   "medium",
+			IsCool: false,
+		},
+	}
+
+	formatter := &JSONLinesFormatter{}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+
+	expected := `{"Size":"mega","IsCool":true}
+{"Size":"medium","IsCool":false}
+`
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_JSONLines_EmptySlice(t *testing.T) {
+	formatter := &JSONLinesFormatter{}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format([]jsonInput{}, buffer, FormatterOptions{})
+	require.NoError(t, err)
+	require.Empty(t, buffer.String())
+}
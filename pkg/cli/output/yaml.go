@@ -0,0 +1,45 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"io"
+
+	"sigs.k8s.io/yaml"
+)
+
+type YAMLFormatter struct {
+}
+
+// Format takes in an object, a writer and an options object and marshals the object into YAML, writing it to the writer,
+// and returns an error if any of the operations fail. The object is converted to JSON first, so the YAML output
+// has the same fields as the JSON output.
+func (f *YAMLFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	b, err := yaml.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(b)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ Formatter = (*YAMLFormatter)(nil)
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type yamlInput struct {
+	Size   string `json:"size"`
+	IsCool bool   `json:"isCool"`
+}
+
+func Test_YAML_Scalar(t *testing.T) {
+	obj := yamlInput{
+		Size:   "mega",
+		IsCool: true,
+	}
+
+	formatter := &YAMLFormatter{}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+
+	expected := `isCool: true
+size: mega
+`
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_YAML_Slice(t *testing.T) {
+	obj := []any{
+		yamlInput{
+			Size:   "mega",
+			IsCool: true,
+		},
+		yamlInput{
+			Size:   "medium",
+			IsCool: false,
+		},
+	}
+
+	formatter := &YAMLFormatter{}
+
+	buffer := &bytes.Buffer{}
+	err := formatter.Format(obj, buffer, FormatterOptions{})
+	require.NoError(t, err)
+
+	expected := `- isCool: true
+  size: mega
+- isCool: false
+  size: medium
+`
+	require.Equal(t, expected, buffer.String())
+}
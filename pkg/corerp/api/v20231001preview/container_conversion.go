@@ -58,42 +58,6 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 		}
 	}
 
-	var livenessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.LivenessProbe != nil {
-		livenessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.LivenessProbe)
-	}
-
-	var readinessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.ReadinessProbe != nil {
-		readinessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.ReadinessProbe)
-	}
-
-	ports := make(map[string]datamodel.ContainerPort)
-	for key, val := range src.Properties.Container.Ports {
-		port := datamodel.ContainerPort{
-			ContainerPort: to.Int32(val.ContainerPort),
-			Protocol:      toPortProtocolDataModel(val.Protocol),
-		}
-
-		if val.Port != nil {
-			port.Port = to.Int32(val.Port)
-		}
-
-		if val.Scheme != nil {
-			port.Scheme = to.String(val.Scheme)
-		}
-
-		ports[key] = port
-	}
-
-	var volumes map[string]datamodel.VolumeProperties
-	if src.Properties.Container.Volumes != nil {
-		volumes = make(map[string]datamodel.VolumeProperties)
-		for key, val := range src.Properties.Container.Volumes {
-			volumes[key] = toVolumePropertiesDataModel(val)
-		}
-	}
-
 	var extensions []datamodel.Extension
 	if src.Properties.Extensions != nil {
 		for _, e := range src.Properties.Extensions {
@@ -119,19 +83,10 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 			BasicResourceProperties: rpv1.BasicResourceProperties{
 				Application: to.String(src.Properties.Application),
 			},
-			Connections: connections,
-			Container: datamodel.Container{
-				Image:           to.String(src.Properties.Container.Image),
-				ImagePullPolicy: toImagePullPolicyDataModel(src.Properties.Container.ImagePullPolicy),
-				Env:             to.StringMap(src.Properties.Container.Env),
-				LivenessProbe:   livenessProbe,
-				Ports:           ports,
-				ReadinessProbe:  readinessProbe,
-				Volumes:         volumes,
-				Command:         stringSlice(src.Properties.Container.Command),
-				Args:            stringSlice(src.Properties.Container.Args),
-				WorkingDir:      to.String(src.Properties.Container.WorkingDir),
-			},
+			Connections:          connections,
+			Container:            toContainerDataModel(src.Properties.Container),
+			InitContainers:       toContainersDataModel(src.Properties.InitContainers),
+			Sidecars:             toContainersDataModel(src.Properties.Sidecars),
 			Extensions:           extensions,
 			Runtimes:             toRuntimePropertiesDataModel(src.Properties.Runtimes),
 			ResourceProvisioning: toContainerResourceProvisioningDataModel(src.Properties.ResourceProvisioning),
@@ -184,40 +139,6 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 		}
 	}
 
-	var livenessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.LivenessProbe.IsEmpty() {
-		livenessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.LivenessProbe)
-	}
-
-	var readinessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.ReadinessProbe.IsEmpty() {
-		readinessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.ReadinessProbe)
-	}
-
-	ports := make(map[string]*ContainerPortProperties)
-	for key, val := range c.Properties.Container.Ports {
-		ports[key] = &ContainerPortProperties{
-			ContainerPort: to.Ptr(val.ContainerPort),
-			Protocol:      fromPortProtocolDataModel(val.Protocol),
-		}
-
-		if val.Port != 0 {
-			ports[key].Port = to.Ptr(val.Port)
-		}
-
-		if val.Scheme != "" {
-			ports[key].Scheme = to.Ptr(val.Scheme)
-		}
-	}
-
-	var volumes map[string]VolumeClassification
-	if c.Properties.Container.Volumes != nil {
-		volumes = make(map[string]VolumeClassification)
-		for key, val := range c.Properties.Container.Volumes {
-			volumes[key] = fromVolumePropertiesDataModel(val)
-		}
-	}
-
 	var extensions []ExtensionClassification
 	if c.Properties.Extensions != nil {
 		for _, e := range c.Properties.Extensions {
@@ -244,21 +165,12 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResourcesDataModel(c.Properties.Status.OutputResources),
 		},
-		ProvisioningState: fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
-		Application:       to.Ptr(c.Properties.Application),
-		Connections:       connections,
-		Container: &Container{
-			Image:           to.Ptr(c.Properties.Container.Image),
-			ImagePullPolicy: fromImagePullPolicyDataModel(c.Properties.Container.ImagePullPolicy),
-			Env:             *to.StringMapPtr(c.Properties.Container.Env),
-			LivenessProbe:   livenessProbe,
-			Ports:           ports,
-			ReadinessProbe:  readinessProbe,
-			Volumes:         volumes,
-			Command:         to.SliceOfPtrs(c.Properties.Container.Command...),
-			Args:            to.SliceOfPtrs(c.Properties.Container.Args...),
-			WorkingDir:      to.Ptr(c.Properties.Container.WorkingDir),
-		},
+		ProvisioningState:    fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
+		Application:          to.Ptr(c.Properties.Application),
+		Connections:          connections,
+		Container:            fromContainerDataModel(c.Properties.Container),
+		InitContainers:       fromContainersDataModel(c.Properties.InitContainers),
+		Sidecars:             fromContainersDataModel(c.Properties.Sidecars),
 		Extensions:           extensions,
 		Identity:             identity,
 		Runtimes:             fromRuntimePropertiesDataModel(c.Properties.Runtimes),
@@ -270,6 +182,138 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 	return nil
 }
 
+func toContainerDataModel(c *Container) datamodel.Container {
+	var livenessProbe datamodel.HealthProbeProperties
+	if c.LivenessProbe != nil {
+		livenessProbe = toHealthProbePropertiesDataModel(c.LivenessProbe)
+	}
+
+	var readinessProbe datamodel.HealthProbeProperties
+	if c.ReadinessProbe != nil {
+		readinessProbe = toHealthProbePropertiesDataModel(c.ReadinessProbe)
+	}
+
+	ports := make(map[string]datamodel.ContainerPort)
+	for key, val := range c.Ports {
+		port := datamodel.ContainerPort{
+			ContainerPort: to.Int32(val.ContainerPort),
+			Protocol:      toPortProtocolDataModel(val.Protocol),
+		}
+
+		if val.Port != nil {
+			port.Port = to.Int32(val.Port)
+		}
+
+		if val.Scheme != nil {
+			port.Scheme = to.String(val.Scheme)
+		}
+
+		ports[key] = port
+	}
+
+	var volumes map[string]datamodel.VolumeProperties
+	if c.Volumes != nil {
+		volumes = make(map[string]datamodel.VolumeProperties)
+		for key, val := range c.Volumes {
+			volumes[key] = toVolumePropertiesDataModel(val)
+		}
+	}
+
+	return datamodel.Container{
+		Image:           to.String(c.Image),
+		ImagePullPolicy: toImagePullPolicyDataModel(c.ImagePullPolicy),
+		Env:             to.StringMap(c.Env),
+		EnvValueFrom:    toEnvValueFromDataModel(c.EnvValueFrom),
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         stringSlice(c.Command),
+		Args:            stringSlice(c.Args),
+		WorkingDir:      to.String(c.WorkingDir),
+		Resources:       toContainerResourcesDataModel(c.Resources),
+		Order:           c.Order,
+	}
+}
+
+func toContainersDataModel(containers map[string]*Container) map[string]datamodel.Container {
+	if containers == nil {
+		return nil
+	}
+
+	result := map[string]datamodel.Container{}
+	for name, c := range containers {
+		if c != nil {
+			result[name] = toContainerDataModel(c)
+		}
+	}
+	return result
+}
+
+func fromContainerDataModel(c datamodel.Container) *Container {
+	var livenessProbe HealthProbePropertiesClassification
+	if !c.LivenessProbe.IsEmpty() {
+		livenessProbe = fromHealthProbePropertiesDataModel(c.LivenessProbe)
+	}
+
+	var readinessProbe HealthProbePropertiesClassification
+	if !c.ReadinessProbe.IsEmpty() {
+		readinessProbe = fromHealthProbePropertiesDataModel(c.ReadinessProbe)
+	}
+
+	ports := make(map[string]*ContainerPortProperties)
+	for key, val := range c.Ports {
+		ports[key] = &ContainerPortProperties{
+			ContainerPort: to.Ptr(val.ContainerPort),
+			Protocol:      fromPortProtocolDataModel(val.Protocol),
+		}
+
+		if val.Port != 0 {
+			ports[key].Port = to.Ptr(val.Port)
+		}
+
+		if val.Scheme != "" {
+			ports[key].Scheme = to.Ptr(val.Scheme)
+		}
+	}
+
+	var volumes map[string]VolumeClassification
+	if c.Volumes != nil {
+		volumes = make(map[string]VolumeClassification)
+		for key, val := range c.Volumes {
+			volumes[key] = fromVolumePropertiesDataModel(val)
+		}
+	}
+
+	return &Container{
+		Image:           to.Ptr(c.Image),
+		ImagePullPolicy: fromImagePullPolicyDataModel(c.ImagePullPolicy),
+		Env:             *to.StringMapPtr(c.Env),
+		EnvValueFrom:    fromEnvValueFromDataModel(c.EnvValueFrom),
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         to.SliceOfPtrs(c.Command...),
+		Args:            to.SliceOfPtrs(c.Args...),
+		WorkingDir:      to.Ptr(c.WorkingDir),
+		Resources:       fromContainerResourcesDataModel(c.Resources),
+		Order:           c.Order,
+	}
+}
+
+func fromContainersDataModel(containers map[string]datamodel.Container) map[string]*Container {
+	if containers == nil {
+		return nil
+	}
+
+	result := map[string]*Container{}
+	for name, c := range containers {
+		result[name] = fromContainerDataModel(c)
+	}
+	return result
+}
+
 func toImagePullPolicyDataModel(pullPolicy *ImagePullPolicy) string {
 	if pullPolicy == nil {
 		return ""
@@ -654,6 +698,104 @@ func fromPermissionDataModel(rbac datamodel.VolumePermission) *VolumePermission
 	return &r
 }
 
+func toContainerResourcesDataModel(r *ContainerResources) *datamodel.ContainerResources {
+	if r == nil {
+		return nil
+	}
+	return &datamodel.ContainerResources{
+		Requests: toContainerResourceQuantitiesDataModel(r.Requests),
+		Limits:   toContainerResourceQuantitiesDataModel(r.Limits),
+	}
+}
+
+func toContainerResourceQuantitiesDataModel(q *ContainerResourceQuantities) *datamodel.ContainerResourceQuantities {
+	if q == nil {
+		return nil
+	}
+	return &datamodel.ContainerResourceQuantities{
+		CPU:    to.String(q.CPU),
+		Memory: to.String(q.Memory),
+	}
+}
+
+func fromContainerResourcesDataModel(r *datamodel.ContainerResources) *ContainerResources {
+	if r == nil {
+		return nil
+	}
+	return &ContainerResources{
+		Requests: fromContainerResourceQuantitiesDataModel(r.Requests),
+		Limits:   fromContainerResourceQuantitiesDataModel(r.Limits),
+	}
+}
+
+func fromContainerResourceQuantitiesDataModel(q *datamodel.ContainerResourceQuantities) *ContainerResourceQuantities {
+	if q == nil {
+		return nil
+	}
+	result := &ContainerResourceQuantities{}
+	if q.CPU != "" {
+		result.CPU = to.Ptr(q.CPU)
+	}
+	if q.Memory != "" {
+		result.Memory = to.Ptr(q.Memory)
+	}
+	return result
+}
+
+func toEnvValueFromDataModel(env map[string]*EnvironmentVariableReference) map[string]datamodel.EnvironmentVariableReference {
+	if env == nil {
+		return nil
+	}
+
+	result := map[string]datamodel.EnvironmentVariableReference{}
+	for name, ref := range env {
+		if ref == nil {
+			continue
+		}
+
+		converted := datamodel.EnvironmentVariableReference{}
+		if ref.SecretRef != nil {
+			converted.SecretRef = &datamodel.EnvironmentVariableSecretReference{
+				Source: to.String(ref.SecretRef.Source),
+				Key:    to.String(ref.SecretRef.Key),
+			}
+		}
+		if ref.ConnectionRef != nil {
+			converted.ConnectionRef = &datamodel.EnvironmentVariableConnectionReference{
+				Name:     to.String(ref.ConnectionRef.Name),
+				Property: to.String(ref.ConnectionRef.Property),
+			}
+		}
+		result[name] = converted
+	}
+	return result
+}
+
+func fromEnvValueFromDataModel(env map[string]datamodel.EnvironmentVariableReference) map[string]*EnvironmentVariableReference {
+	if env == nil {
+		return nil
+	}
+
+	result := map[string]*EnvironmentVariableReference{}
+	for name, ref := range env {
+		converted := &EnvironmentVariableReference{}
+		if ref.SecretRef != nil {
+			converted.SecretRef = &EnvironmentVariableSecretReference{
+				Source: to.Ptr(ref.SecretRef.Source),
+				Key:    to.Ptr(ref.SecretRef.Key),
+			}
+		}
+		if ref.ConnectionRef != nil {
+			converted.ConnectionRef = &EnvironmentVariableConnectionReference{
+				Name:     to.Ptr(ref.ConnectionRef.Name),
+				Property: to.Ptr(ref.ConnectionRef.Property),
+			}
+		}
+		result[name] = converted
+	}
+	return result
+}
+
 // toExtensionDataModel: Converts from versioned datamodel to base datamodel
 func toExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 	switch c := e.(type) {
@@ -664,6 +806,16 @@ func toExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 				Replicas: c.Replicas,
 			},
 		}
+	case *HorizontalAutoscalingExtension:
+		return datamodel.Extension{
+			Kind: datamodel.HorizontalAutoscaling,
+			HorizontalAutoscaling: &datamodel.HorizontalAutoscalingExtension{
+				MinReplicas:             c.MinReplicas,
+				MaxReplicas:             to.Int32(c.MaxReplicas),
+				TargetCPUUtilization:    c.TargetCPUUtilization,
+				TargetMemoryUtilization: c.TargetMemoryUtilization,
+			},
+		}
 	case *DaprSidecarExtension:
 		return datamodel.Extension{
 			Kind: datamodel.DaprSidecar,
@@ -695,6 +847,14 @@ func fromExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassi
 			Kind:     to.Ptr(string(e.Kind)),
 			Replicas: e.ManualScaling.Replicas,
 		}
+	case datamodel.HorizontalAutoscaling:
+		return &HorizontalAutoscalingExtension{
+			Kind:                    to.Ptr(string(e.Kind)),
+			MinReplicas:             e.HorizontalAutoscaling.MinReplicas,
+			MaxReplicas:             to.Ptr(e.HorizontalAutoscaling.MaxReplicas),
+			TargetCPUUtilization:    e.HorizontalAutoscaling.TargetCPUUtilization,
+			TargetMemoryUtilization: e.HorizontalAutoscaling.TargetMemoryUtilization,
+		}
 	case datamodel.DaprSidecar:
 		return &DaprSidecarExtension{
 			Kind:     to.Ptr(string(e.Kind)),
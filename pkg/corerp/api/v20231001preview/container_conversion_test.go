@@ -61,6 +61,18 @@ func TestContainerConvertVersionedToDataModel(t *testing.T) {
 			err:      nil,
 			emptyExt: true,
 		},
+		{
+			filename: "containerresource-autoscaling.json",
+			err:      nil,
+		},
+		{
+			filename: "containerresource-envvaluefrom.json",
+			err:      nil,
+		},
+		{
+			filename: "containerresource-sidecars.json",
+			err:      nil,
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -91,6 +103,68 @@ func TestContainerConvertVersionedToDataModel(t *testing.T) {
 					return
 				}
 
+				if tt.filename == "containerresource-autoscaling.json" {
+					require.Equal(t, &datamodel.ContainerResources{
+						Requests: &datamodel.ContainerResourceQuantities{CPU: "250m", Memory: "128Mi"},
+						Limits:   &datamodel.ContainerResourceQuantities{CPU: "1", Memory: "512Mi"},
+					}, ct.Properties.Container.Resources)
+					require.Equal(t, []datamodel.Extension{
+						{
+							Kind: datamodel.HorizontalAutoscaling,
+							HorizontalAutoscaling: &datamodel.HorizontalAutoscalingExtension{
+								MinReplicas:          to.Ptr[int32](2),
+								MaxReplicas:          10,
+								TargetCPUUtilization: to.Ptr[int32](70),
+							},
+						},
+					}, ct.Properties.Extensions)
+					return
+				}
+
+				if tt.filename == "containerresource-envvaluefrom.json" {
+					require.Equal(t, map[string]string{"LOG_LEVEL": "debug"}, ct.Properties.Container.Env)
+					require.Equal(t, map[string]datamodel.EnvironmentVariableReference{
+						"DB_PASSWORD": {
+							SecretRef: &datamodel.EnvironmentVariableSecretReference{
+								Source: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/secret0",
+								Key:    "password",
+							},
+						},
+						"REDIS_HOST": {
+							ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{
+								Name:     "redis",
+								Property: "host",
+							},
+						},
+					}, ct.Properties.Container.EnvValueFrom)
+					return
+				}
+
+				if tt.filename == "containerresource-sidecars.json" {
+					require.Len(t, ct.Properties.InitContainers, 2)
+					require.Equal(t, to.Ptr[int32](1), ct.Properties.InitContainers["fetch-config"].Order)
+					migrate := ct.Properties.InitContainers["migrate"]
+					require.Equal(t, "ghcr.io/radius-project/migrate", migrate.Image)
+					require.Equal(t, to.Ptr[int32](2), migrate.Order)
+					require.Equal(t, []string{"/bin/migrate"}, migrate.Command)
+					require.Equal(t, datamodel.VolumeProperties{
+						Kind: datamodel.Ephemeral,
+						Ephemeral: &datamodel.EphemeralVolume{
+							VolumeBase:   datamodel.VolumeBase{MountPath: "/data"},
+							ManagedStore: datamodel.ManagedStoreDisk,
+						},
+					}, migrate.Volumes["data"])
+
+					require.Len(t, ct.Properties.Sidecars, 1)
+					proxy := ct.Properties.Sidecars["proxy"]
+					require.Equal(t, "ghcr.io/radius-project/proxy", proxy.Image)
+					require.Equal(t, datamodel.ContainerPort{ContainerPort: 8080, Protocol: datamodel.ProtocolTCP}, proxy.Ports["proxy"])
+					require.Equal(t, datamodel.HTTPGetHealthProbe, proxy.ReadinessProbe.Kind)
+					require.Equal(t, int32(8080), proxy.ReadinessProbe.HTTPGet.ContainerPort)
+					require.Equal(t, "/healthz", proxy.ReadinessProbe.HTTPGet.Path)
+					return
+				}
+
 				val, ok := ct.Properties.Connections["inventory"]
 				require.True(t, ok)
 				require.Equal(t, "inventory_route_id", val.Source)
@@ -148,6 +222,15 @@ func TestContainerConvertDataModelToVersioned(t *testing.T) {
 		{
 			filename: "containerresourcedatamodel-manual.json",
 		},
+		{
+			filename: "containerresourcedatamodel-autoscaling.json",
+		},
+		{
+			filename: "containerresourcedatamodel-envvaluefrom.json",
+		},
+		{
+			filename: "containerresourcedatamodel-sidecars.json",
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -176,6 +259,64 @@ func TestContainerConvertDataModelToVersioned(t *testing.T) {
 					return
 				}
 
+				if tt.filename == "containerresourcedatamodel-autoscaling.json" {
+					require.Equal(t, &ContainerResources{
+						Requests: &ContainerResourceQuantities{CPU: to.Ptr("250m"), Memory: to.Ptr("128Mi")},
+						Limits:   &ContainerResourceQuantities{CPU: to.Ptr("1")},
+					}, versioned.Properties.Container.Resources)
+					require.Equal(t, []ExtensionClassification{
+						&HorizontalAutoscalingExtension{
+							Kind:                    to.Ptr("horizontalAutoscaling"),
+							MinReplicas:             to.Ptr[int32](2),
+							MaxReplicas:             to.Ptr[int32](10),
+							TargetMemoryUtilization: to.Ptr[int32](80),
+						},
+					}, versioned.Properties.Extensions)
+					return
+				}
+
+				if tt.filename == "containerresourcedatamodel-envvaluefrom.json" {
+					require.Equal(t, map[string]*EnvironmentVariableReference{
+						"DB_PASSWORD": {
+							SecretRef: &EnvironmentVariableSecretReference{
+								Source: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/secret0"),
+								Key:    to.Ptr("password"),
+							},
+						},
+						"REDIS_HOST": {
+							ConnectionRef: &EnvironmentVariableConnectionReference{
+								Name:     to.Ptr("redis"),
+								Property: to.Ptr("host"),
+							},
+						},
+					}, versioned.Properties.Container.EnvValueFrom)
+					return
+				}
+
+				if tt.filename == "containerresourcedatamodel-sidecars.json" {
+					require.Len(t, versioned.Properties.InitContainers, 2)
+					require.Equal(t, to.Ptr[int32](1), versioned.Properties.InitContainers["fetch-config"].Order)
+					migrate := versioned.Properties.InitContainers["migrate"]
+					require.Equal(t, "ghcr.io/radius-project/migrate", *migrate.Image)
+					require.Equal(t, to.Ptr[int32](2), migrate.Order)
+					require.Equal(t, []*string{to.Ptr("/bin/migrate")}, migrate.Command)
+					require.Equal(t, &EphemeralVolume{
+						Kind:         to.Ptr("ephemeral"),
+						MountPath:    to.Ptr("/data"),
+						ManagedStore: to.Ptr(ManagedStoreDisk),
+					}, migrate.Volumes["data"])
+
+					require.Len(t, versioned.Properties.Sidecars, 1)
+					proxy := versioned.Properties.Sidecars["proxy"]
+					require.Equal(t, "ghcr.io/radius-project/proxy", *proxy.Image)
+					require.Equal(t, int32(8080), *proxy.Ports["proxy"].ContainerPort)
+					probe, ok := proxy.ReadinessProbe.(*HTTPGetHealthProbeProperties)
+					require.True(t, ok)
+					require.Equal(t, int32(8080), *probe.ContainerPort)
+					require.Equal(t, "/healthz", *probe.Path)
+					return
+				}
+
 				val, ok := r.Properties.Connections["inventory"]
 				require.True(t, ok)
 				require.Equal(t, "inventory_route_id", val.Source)
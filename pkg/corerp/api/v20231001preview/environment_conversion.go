@@ -102,6 +102,11 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 				Scope: to.String(src.Properties.Providers.Aws.Scope),
 			}
 		}
+		if src.Properties.Providers.Gcp != nil {
+			converted.Properties.Providers.GCP = datamodel.ProvidersGCP{
+				Scope: to.String(src.Properties.Providers.Gcp.Scope),
+			}
+		}
 	}
 
 	if src.Properties.Simulated != nil && *src.Properties.Simulated {
@@ -165,6 +170,11 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 				Scope: to.Ptr(env.Properties.Providers.AWS.Scope),
 			}
 		}
+		if env.Properties.Providers.GCP != (datamodel.ProvidersGCP{}) {
+			dst.Properties.Providers.Gcp = &ProvidersGcp{
+				Scope: to.Ptr(env.Properties.Providers.GCP.Scope),
+			}
+		}
 	}
 
 	if env.Properties.Simulated {
@@ -205,10 +215,39 @@ func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeCon
 			}
 
 			recipeConfig.Terraform.Providers = toRecipeConfigTerraformProvidersDatamodel(config)
+
+			if backend := config.Terraform.Backend; backend != nil {
+				recipeConfig.Terraform.Backend = datamodel.TerraformBackendConfig{
+					Secret: to.String(backend.Secret),
+				}
+				if backend.Kind != nil {
+					recipeConfig.Terraform.Backend.Kind = string(*backend.Kind)
+				}
+				if backend.Config != nil {
+					recipeConfig.Terraform.Backend.Config = to.StringMap(backend.Config)
+				}
+			}
+
+			if mirror := config.Terraform.ProviderMirror; mirror != nil {
+				recipeConfig.Terraform.ProviderMirror = datamodel.TerraformProviderMirrorConfig{
+					Path:      to.String(mirror.Path),
+					PlainHTTP: to.Bool(mirror.PlainHTTP),
+					Secret:    to.String(mirror.Secret),
+				}
+				if mirror.Kind != nil {
+					recipeConfig.Terraform.ProviderMirror.Kind = string(*mirror.Kind)
+				}
+			}
 		}
 
 		recipeConfig.Env = toRecipeConfigEnvDatamodel(config)
 
+		if config.Drift != nil {
+			recipeConfig.Drift = datamodel.RecipeDriftConfig{
+				AutoRemediate: to.Bool(config.Drift.AutoRemediate),
+			}
+		}
+
 		return recipeConfig
 	}
 
@@ -236,10 +275,41 @@ func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeC
 			}
 
 			recipeConfig.Terraform.Providers = fromRecipeConfigTerraformProvidersDatamodel(config)
+
+			if !reflect.DeepEqual(config.Terraform.Backend, datamodel.TerraformBackendConfig{}) {
+				recipeConfig.Terraform.Backend = &TerraformBackendConfig{
+					Kind: to.Ptr(TerraformBackendKind(config.Terraform.Backend.Kind)),
+				}
+				if config.Terraform.Backend.Config != nil {
+					recipeConfig.Terraform.Backend.Config = *to.StringMapPtr(config.Terraform.Backend.Config)
+				}
+				if config.Terraform.Backend.Secret != "" {
+					recipeConfig.Terraform.Backend.Secret = to.Ptr(config.Terraform.Backend.Secret)
+				}
+			}
+
+			if !reflect.DeepEqual(config.Terraform.ProviderMirror, datamodel.TerraformProviderMirrorConfig{}) {
+				recipeConfig.Terraform.ProviderMirror = &TerraformProviderMirrorConfig{
+					Kind: to.Ptr(TerraformProviderMirrorKind(config.Terraform.ProviderMirror.Kind)),
+					Path: to.Ptr(config.Terraform.ProviderMirror.Path),
+				}
+				if config.Terraform.ProviderMirror.PlainHTTP {
+					recipeConfig.Terraform.ProviderMirror.PlainHTTP = to.Ptr(true)
+				}
+				if config.Terraform.ProviderMirror.Secret != "" {
+					recipeConfig.Terraform.ProviderMirror.Secret = to.Ptr(config.Terraform.ProviderMirror.Secret)
+				}
+			}
 		}
 
 		recipeConfig.Env = fromRecipeConfigEnvDatamodel(config)
 
+		if !reflect.DeepEqual(config.Drift, datamodel.RecipeDriftConfig{}) {
+			recipeConfig.Drift = &RecipeDriftConfig{
+				AutoRemediate: to.Ptr(config.Drift.AutoRemediate),
+			}
+		}
+
 		return recipeConfig
 	}
 
@@ -383,6 +453,14 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 			PlainHTTP:    to.Bool(c.PlainHTTP),
 			Parameters:   c.Parameters,
 		}, nil
+	case *HelmRecipeProperties:
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:    types.TemplateKindHelm,
+			TemplateVersion: to.String(c.TemplateVersion),
+			TemplatePath:    to.String(c.TemplatePath),
+			PlainHTTP:       to.Bool(c.PlainHTTP),
+			Parameters:      c.Parameters,
+		}, nil
 	}
 	return datamodel.EnvironmentRecipeProperties{}, nil
 }
@@ -403,6 +481,14 @@ func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipePr
 			Parameters:   e.Parameters,
 			PlainHTTP:    to.Ptr(e.PlainHTTP),
 		}
+	case types.TemplateKindHelm:
+		return &HelmRecipeProperties{
+			TemplateKind:    to.Ptr(e.TemplateKind),
+			TemplateVersion: to.Ptr(e.TemplateVersion),
+			TemplatePath:    to.Ptr(e.TemplatePath),
+			Parameters:      e.Parameters,
+			PlainHTTP:       to.Ptr(e.PlainHTTP),
+		}
 	}
 
 	return nil
@@ -127,6 +127,9 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 						AWS: datamodel.ProvidersAWS{
 							Scope: "/planes/aws/aws/accounts/140313373712/regions/us-west-2",
 						},
+						GCP: datamodel.ProvidersGCP{
+							Scope: "/planes/gcp/gcp/projects/my-project/regions/us-central1",
+						},
 					},
 					RecipeConfig: datamodel.RecipeConfigProperties{
 						Terraform: datamodel.TerraformConfigProperties{
@@ -148,12 +151,28 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 									},
 								},
 							},
+							Backend: datamodel.TerraformBackendConfig{
+								Kind: "s3",
+								Config: map[string]string{
+									"bucket": "tfstate",
+									"region": "us-west-2",
+								},
+								Secret: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tfstate",
+							},
+							ProviderMirror: datamodel.TerraformProviderMirrorConfig{
+								Kind:   "oci",
+								Path:   "myregistry.azurecr.io/terraform/providers:1.0",
+								Secret: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/registry",
+							},
 						},
 						Env: datamodel.EnvironmentVariables{
 							AdditionalProperties: map[string]string{
 								"myEnvVar": "myEnvValue",
 							},
 						},
+						Drift: datamodel.RecipeDriftConfig{
+							AutoRemediate: true,
+						},
 					},
 					Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
 						ds_ctrl.MongoDatabasesResourceType: {
@@ -180,6 +199,14 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 								TemplatePath: "br:ghcr.io/sampleregistry/radius/recipes/rediscaches",
 								PlainHTTP:    true,
 							},
+							"helm-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind:    recipes.TemplateKindHelm,
+								TemplatePath:    "oci://ghcr.io/sampleregistry/charts/redis",
+								TemplateVersion: "18.6.1",
+								Parameters: map[string]any{
+									"replicaCount": float64(2),
+								},
+							},
 						},
 						dapr_ctrl.DaprStateStoresResourceType: {
 							"statestore-recipe": datamodel.EnvironmentRecipeProperties{
@@ -321,11 +348,11 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 		},
 		{
 			filename: "environmentresource-invalid-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\""},
 		},
 		{
 			filename: "environmentresource-missing-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\""},
 		},
 		{
 			filename: "environmentresource-terraformrecipe-localpath.json",
@@ -405,6 +432,7 @@ func TestConvertDataModelToVersioned(t *testing.T) {
 				recipeDetails := versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"]
 
 				if tt.filename == "environmentresourcedatamodel.json" {
+					require.Equal(t, "/planes/gcp/gcp/projects/my-project/regions/us-central1", string(*versioned.Properties.Providers.Gcp.Scope))
 					require.Equal(t, "Azure/cosmosdb/azurerm", string(*versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"].GetRecipeProperties().TemplatePath))
 					require.Equal(t, recipes.TemplateKindTerraform, string(*versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"].GetRecipeProperties().TemplateKind))
 					require.Equal(t, "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/github", string(*versioned.Properties.RecipeConfig.Terraform.Authentication.Git.Pat["dev.azure.com"].Secret))
@@ -420,6 +448,23 @@ func TestConvertDataModelToVersioned(t *testing.T) {
 					require.Equal(t, "00000000-0000-0000-0000-000000000000", subscriptionId)
 					require.Equal(t, 1, len(versioned.Properties.RecipeConfig.Env))
 					require.Equal(t, to.Ptr("myEnvValue"), versioned.Properties.RecipeConfig.Env["myEnvVar"])
+					require.Equal(t, &TerraformBackendConfig{
+						Kind:   to.Ptr(TerraformBackendKindS3),
+						Config: map[string]*string{"bucket": to.Ptr("tfstate"), "region": to.Ptr("us-west-2")},
+						Secret: to.Ptr("/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tfstate"),
+					}, versioned.Properties.RecipeConfig.Terraform.Backend)
+					require.Equal(t, &TerraformProviderMirrorConfig{
+						Kind:   to.Ptr(TerraformProviderMirrorKindOci),
+						Path:   to.Ptr("myregistry.azurecr.io/terraform/providers:1.0"),
+						Secret: to.Ptr("/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/registry"),
+					}, versioned.Properties.RecipeConfig.Terraform.ProviderMirror)
+					require.Equal(t, &RecipeDriftConfig{AutoRemediate: to.Ptr(true)}, versioned.Properties.RecipeConfig.Drift)
+					require.Equal(t, &HelmRecipeProperties{
+						TemplateKind:    to.Ptr(recipes.TemplateKindHelm),
+						TemplatePath:    to.Ptr("oci://ghcr.io/sampleregistry/charts/mongodb"),
+						TemplateVersion: to.Ptr("14.4.0"),
+						PlainHTTP:       to.Ptr(true),
+					}, versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["helm-recipe"])
 				}
 
 				if tt.filename == "environmentresourcedatamodelemptyext.json" {
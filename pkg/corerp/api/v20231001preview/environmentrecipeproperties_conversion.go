@@ -43,6 +43,9 @@ func (dst *RecipeGetMetadataResponse) ConvertFrom(src v1.DataModelInterface) err
 		dst.TemplateVersion = to.Ptr(recipe.TemplateVersion)
 	case types.TemplateKindBicep:
 		dst.PlainHTTP = to.Ptr(recipe.PlainHTTP)
+	case types.TemplateKindHelm:
+		dst.TemplateVersion = to.Ptr(recipe.TemplateVersion)
+		dst.PlainHTTP = to.Ptr(recipe.PlainHTTP)
 	}
 	dst.Parameters = recipe.Parameters
 	return nil
@@ -55,3 +58,42 @@ func (src *RecipeGetMetadata) ConvertTo() (v1.DataModelInterface, error) {
 		ResourceType: to.String(src.ResourceType),
 	}, nil
 }
+
+// ConvertTo converts from the versioned recipe plan request to version-agnostic datamodel.
+func (src *RecipePlanRequest) ConvertTo() (v1.DataModelInterface, error) {
+	return &datamodel.RecipePlanRequest{
+		Recipe: datamodel.Recipe{
+			Name:         to.String(src.Name),
+			ResourceType: to.String(src.ResourceType),
+		},
+		ResourceName: to.String(src.ResourceName),
+		Parameters:   src.Parameters,
+	}, nil
+}
+
+// ConvertTo returns an error as it does not support converting the recipe plan to a version-agnostic object.
+func (src *RecipePlanResponse) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, fmt.Errorf("converting the recipe plan to a version-agnostic object is not supported")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned recipe plan.
+func (dst *RecipePlanResponse) ConvertFrom(src v1.DataModelInterface) error {
+	plan, ok := src.(*datamodel.RecipePlan)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.Changes = []*RecipeResourceChange{}
+	for _, change := range plan.Changes {
+		versioned := &RecipeResourceChange{
+			Action:       to.Ptr(change.Action),
+			ResourceType: to.Ptr(change.ResourceType),
+			Name:         to.Ptr(change.Name),
+		}
+		if change.ResourceID != "" {
+			versioned.ResourceID = to.Ptr(change.ResourceID)
+		}
+		dst.Changes = append(dst.Changes, versioned)
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	types "github.com/radius-project/radius/pkg/recipes"
@@ -39,7 +40,7 @@ func TestEnvironmentRecipePropertiesConvertVersionedToDataModel(t *testing.T) {
 
 func TestEnvironmentRecipePropertiesConvertDataModelToVersioned(t *testing.T) {
 
-	files := []string{"environmentrecipepropertiesdatamodel.json", "environmentrecipepropertiesdatamodel-terraform.json"}
+	files := []string{"environmentrecipepropertiesdatamodel.json", "environmentrecipepropertiesdatamodel-terraform.json", "environmentrecipepropertiesdatamodel-helm.json"}
 	for _, filename := range files {
 		t.Run(filename, func(t *testing.T) {
 			rawPayload := testutil.ReadFixture(filename)
@@ -57,6 +58,10 @@ func TestEnvironmentRecipePropertiesConvertDataModelToVersioned(t *testing.T) {
 			if r.TemplateKind == types.TemplateKindTerraform {
 				require.Equal(t, r.TemplateVersion, string(*versioned.TemplateVersion))
 			}
+			if r.TemplateKind == types.TemplateKindHelm {
+				require.Equal(t, r.TemplateVersion, string(*versioned.TemplateVersion))
+				require.Equal(t, r.PlainHTTP, bool(*versioned.PlainHTTP))
+			}
 			require.Equal(t, r.Parameters, versioned.Parameters)
 		})
 	}
@@ -122,3 +127,55 @@ func TestRecipeConvertVersionedToDataModel(t *testing.T) {
 		require.Equal(t, expected, ct)
 	})
 }
+
+func TestRecipePlanRequestConvertVersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("recipeplanrequest.json")
+	r := &RecipePlanRequest{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	expected := &datamodel.RecipePlanRequest{
+		Recipe: datamodel.Recipe{
+			ResourceType: ds_ctrl.MongoDatabasesResourceType,
+			Name:         "mongo-azure",
+		},
+		ResourceName: "mongo0",
+		Parameters: map[string]any{
+			"throughput": float64(800),
+		},
+	}
+	require.Equal(t, expected, dm.(*datamodel.RecipePlanRequest))
+}
+
+func TestRecipePlanConvertDataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("recipeplandatamodel.json")
+	r := &datamodel.RecipePlan{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &RecipePlanResponse{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, versioned.Changes, 2)
+	require.Equal(t, "create", *versioned.Changes[0].Action)
+	require.Equal(t, "azurerm_cosmosdb_account", *versioned.Changes[0].ResourceType)
+	require.Equal(t, "module.mongo-azure.azurerm_cosmosdb_account.account", *versioned.Changes[0].Name)
+	require.Nil(t, versioned.Changes[0].ResourceID)
+	require.Equal(t, "update", *versioned.Changes[1].Action)
+	require.Equal(t, "mongo0", *versioned.Changes[1].Name)
+	require.Equal(t, r.Changes[1].ResourceID, *versioned.Changes[1].ResourceID)
+}
+
+func TestRecipePlanConvertDataModelToVersioned_InvalidModel(t *testing.T) {
+	versioned := &RecipePlanResponse{}
+	err := versioned.ConvertFrom(&datamodel.Recipe{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
@@ -26,6 +26,24 @@ func PossibleActionTypeValues() []ActionType {
 	}
 }
 
+// ApplicationGraphContainerKind - The kind of an application graph container.
+type ApplicationGraphContainerKind string
+
+const (
+	// ApplicationGraphContainerKindInit - A container that runs to completion before the main container starts.
+	ApplicationGraphContainerKindInit ApplicationGraphContainerKind = "init"
+	// ApplicationGraphContainerKindSidecar - A container that runs alongside the main container.
+	ApplicationGraphContainerKindSidecar ApplicationGraphContainerKind = "sidecar"
+)
+
+// PossibleApplicationGraphContainerKindValues returns the possible values for the ApplicationGraphContainerKind const type.
+func PossibleApplicationGraphContainerKindValues() []ApplicationGraphContainerKind {
+	return []ApplicationGraphContainerKind{	
+		ApplicationGraphContainerKindInit,
+		ApplicationGraphContainerKindSidecar,
+	}
+}
+
 // CertificateFormats - Represents certificate formats
 type CertificateFormats string
 
@@ -379,6 +397,51 @@ func PossibleTLSMinVersionValues() []TLSMinVersion {
 	}
 }
 
+// TerraformBackendKind - The kind of a Terraform backend.
+type TerraformBackendKind string
+
+const (
+	// TerraformBackendKindAzurerm - The state is stored in an Azure Blob Storage container.
+	TerraformBackendKindAzurerm TerraformBackendKind = "azurerm"
+	// TerraformBackendKindHTTP - The state is stored by a REST endpoint.
+	TerraformBackendKindHTTP TerraformBackendKind = "http"
+	// TerraformBackendKindKubernetes - The state is stored in Kubernetes secrets in the 'radius-system' namespace.
+	TerraformBackendKindKubernetes TerraformBackendKind = "kubernetes"
+	// TerraformBackendKindLocal - The state is stored in files on the local filesystem of Radius. Intended for testing only.
+	TerraformBackendKindLocal TerraformBackendKind = "local"
+	// TerraformBackendKindS3 - The state is stored in an Amazon S3 or S3-compatible bucket.
+	TerraformBackendKindS3 TerraformBackendKind = "s3"
+)
+
+// PossibleTerraformBackendKindValues returns the possible values for the TerraformBackendKind const type.
+func PossibleTerraformBackendKindValues() []TerraformBackendKind {
+	return []TerraformBackendKind{	
+		TerraformBackendKindAzurerm,
+		TerraformBackendKindHTTP,
+		TerraformBackendKindKubernetes,
+		TerraformBackendKindLocal,
+		TerraformBackendKindS3,
+	}
+}
+
+// TerraformProviderMirrorKind - The kind of a Terraform provider mirror.
+type TerraformProviderMirrorKind string
+
+const (
+	// TerraformProviderMirrorKindFilesystem - The providers are installed from a directory on the filesystem of Radius.
+	TerraformProviderMirrorKindFilesystem TerraformProviderMirrorKind = "filesystem"
+	// TerraformProviderMirrorKindOci - The providers are installed from an OCI artifact pulled from a container registry.
+	TerraformProviderMirrorKindOci TerraformProviderMirrorKind = "oci"
+)
+
+// PossibleTerraformProviderMirrorKindValues returns the possible values for the TerraformProviderMirrorKind const type.
+func PossibleTerraformProviderMirrorKindValues() []TerraformProviderMirrorKind {
+	return []TerraformProviderMirrorKind{	
+		TerraformProviderMirrorKindFilesystem,
+		TerraformProviderMirrorKindOci,
+	}
+}
+
 // Versions - Supported API versions for the Applications.Core resource provider.
 type Versions string
 
@@ -19,6 +19,18 @@ type ApplicationGraphConnection struct {
 	ID *string
 }
 
+// ApplicationGraphContainer - Describes an init container or a sidecar of an application graph resource.
+type ApplicationGraphContainer struct {
+	// REQUIRED; The container image.
+	Image *string
+
+	// REQUIRED; The kind of the container.
+	Kind *ApplicationGraphContainerKind
+
+	// REQUIRED; The container name.
+	Name *string
+}
+
 // ApplicationGraphOutputResource - Describes an output resource that comprises an application graph resource.
 type ApplicationGraphOutputResource struct {
 	// REQUIRED; The resource ID.
@@ -50,6 +62,9 @@ type ApplicationGraphResource struct {
 
 	// REQUIRED; The resource type.
 	Type *string
+
+	// The init containers and sidecars of the resource.
+	Containers []*ApplicationGraphContainer
 }
 
 // ApplicationGraphResponse - Describes the application architecture and its dependencies.
@@ -280,18 +295,28 @@ type Container struct {
 	// environment
 	Env map[string]*string
 
+	// Environment variables whose values are sourced from a secret store or a connection. Names must not also be set in env.
+	EnvValueFrom map[string]*EnvironmentVariableReference
+
 	// The pull policy for the container image
 	ImagePullPolicy *ImagePullPolicy
 
 	// liveness probe properties
 	LivenessProbe HealthProbePropertiesClassification
 
+	// The position of an init container in the sequence of the init containers, which run in ascending order. Required and
+// unique when there is more than one init container. Not supported for the main container and the sidecars.
+	Order *int32
+
 	// container ports
 	Ports map[string]*ContainerPortProperties
 
 	// readiness probe properties
 	ReadinessProbe HealthProbePropertiesClassification
 
+	// Compute resource requirements of the container
+	Resources *ContainerResources
+
 	// container volumes
 	Volumes map[string]VolumeClassification
 
@@ -351,6 +376,10 @@ type ContainerProperties struct {
 	// Configuration for supported external identity providers
 	Identity *IdentitySettings
 
+	// Containers that run to completion before the main container starts. They run sequentially in the ascending order of
+// their order property.
+	InitContainers map[string]*Container
+
 	// Specifies how the underlying container resource is provisioned and managed.
 	ResourceProvisioning *ContainerResourceProvisioning
 
@@ -363,6 +392,9 @@ type ContainerProperties struct {
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
 
+	// Containers that run alongside the main container for the lifetime of the workload.
+	Sidecars map[string]*Container
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 
@@ -403,6 +435,15 @@ type ContainerResourceListResult struct {
 	NextLink *string
 }
 
+// ContainerResourceQuantities - The amount of compute resources
+type ContainerResourceQuantities struct {
+	// The amount of CPU in the Kubernetes quantity format, such as '500m' or '2'
+	CPU *string
+
+	// The amount of memory in the Kubernetes quantity format, such as '256Mi' or '1Gi'
+	Memory *string
+}
+
 // ContainerResourceUpdate - The type used for update operations of the ContainerResource.
 type ContainerResourceUpdate struct {
 	// The updatable properties of the ContainerResource.
@@ -432,6 +473,10 @@ type ContainerResourceUpdateProperties struct {
 	// Configuration for supported external identity providers
 	Identity *IdentitySettingsUpdate
 
+	// Containers that run to completion before the main container starts. They run sequentially in the ascending order of
+// their order property.
+	InitContainers map[string]*ContainerUpdate
+
 	// Specifies how the underlying container resource is provisioned and managed.
 	ResourceProvisioning *ContainerResourceProvisioning
 
@@ -443,6 +488,18 @@ type ContainerResourceUpdateProperties struct {
 
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
+
+	// Containers that run alongside the main container for the lifetime of the workload.
+	Sidecars map[string]*ContainerUpdate
+}
+
+// ContainerResources - Compute resource requirements of the container
+type ContainerResources struct {
+	// The maximum amount of compute resources allowed for the container
+	Limits *ContainerResourceQuantities
+
+	// The minimum amount of compute resources required by the container
+	Requests *ContainerResourceQuantities
 }
 
 // ContainerUpdate - Definition of a container
@@ -456,6 +513,9 @@ type ContainerUpdate struct {
 	// environment
 	Env map[string]*string
 
+	// Environment variables whose values are sourced from a secret store or a connection. Names must not also be set in env.
+	EnvValueFrom map[string]*EnvironmentVariableReference
+
 	// The registry and image to download and run in your container
 	Image *string
 
@@ -465,12 +525,19 @@ type ContainerUpdate struct {
 	// liveness probe properties
 	LivenessProbe HealthProbePropertiesClassification
 
+	// The position of an init container in the sequence of the init containers, which run in ascending order. Required and
+// unique when there is more than one init container. Not supported for the main container and the sidecars.
+	Order *int32
+
 	// container ports
 	Ports map[string]*ContainerPortPropertiesUpdate
 
 	// readiness probe properties
 	ReadinessProbe HealthProbePropertiesClassification
 
+	// Compute resource requirements of the container
+	Resources *ContainerResources
+
 	// container volumes
 	Volumes map[string]VolumeClassification
 
@@ -620,6 +687,34 @@ type EnvironmentResourceUpdateProperties struct {
 	Simulated *bool
 }
 
+// EnvironmentVariableConnectionReference - Reference to an output value of a connection of the container
+type EnvironmentVariableConnectionReference struct {
+	// REQUIRED; The name of the connection
+	Name *string
+
+	// REQUIRED; The name of the output value of the connected resource, such as 'host' or 'password'
+	Property *string
+}
+
+// EnvironmentVariableReference - The source of the value of an environment variable. Exactly one of secretRef or connectionRef
+// must be set.
+type EnvironmentVariableReference struct {
+	// Reference to an output value of a connection of the container
+	ConnectionRef *EnvironmentVariableConnectionReference
+
+	// Reference to a key of an Applications.Core/secretStores resource
+	SecretRef *EnvironmentVariableSecretReference
+}
+
+// EnvironmentVariableSecretReference - Reference to a key of an Applications.Core/secretStores resource
+type EnvironmentVariableSecretReference struct {
+	// REQUIRED; The key of the secret in the secret store
+	Key *string
+
+	// REQUIRED; The ID of an Applications.Core/secretStores resource
+	Source *string
+}
+
 // EphemeralVolume - Specifies an ephemeral volume for a container
 type EphemeralVolume struct {
 	// REQUIRED; Discriminator property for Volume.
@@ -999,6 +1094,87 @@ type HealthProbeProperties struct {
 // GetHealthProbeProperties implements the HealthProbePropertiesClassification interface for type HealthProbeProperties.
 func (h *HealthProbeProperties) GetHealthProbeProperties() *HealthProbeProperties { return h }
 
+// HelmRecipeProperties - Represents Helm recipe properties.
+type HelmRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment.
+	Parameters map[string]any
+
+	// Connect to the OCI registry hosting the Helm chart using HTTP (not-HTTPS). This should be used when the registry is known
+// not to support HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
+	PlainHTTP *bool
+
+	// Version of the Helm chart to deploy. Defaults to the latest version of the chart.
+	TemplateVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
+// HelmRecipePropertiesUpdate - Represents Helm recipe properties.
+type HelmRecipePropertiesUpdate struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// Key/value parameters to pass to the recipe template at deployment.
+	Parameters map[string]any
+
+	// Connect to the OCI registry hosting the Helm chart using HTTP (not-HTTPS). This should be used when the registry is known
+// not to support HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
+	PlainHTTP *bool
+
+	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Version of the Helm chart to deploy. Defaults to the latest version of the chart.
+	TemplateVersion *string
+}
+
+// GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type HelmRecipePropertiesUpdate.
+func (h *HelmRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
+	return &RecipePropertiesUpdate{
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
+// HorizontalAutoscalingExtension - Specifies the container should be scaled horizontally based on its resource utilization
+type HorizontalAutoscalingExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// REQUIRED; The maximum replica count.
+	MaxReplicas *int32
+
+	// The minimum replica count. Defaults to 1.
+	MinReplicas *int32
+
+	// The target average CPU utilization as a percentage of the requested CPU.
+	TargetCPUUtilization *int32
+
+	// The target average memory utilization as a percentage of the requested memory.
+	TargetMemoryUtilization *int32
+}
+
+// GetExtension implements the ExtensionClassification interface for type HorizontalAutoscalingExtension.
+func (h *HorizontalAutoscalingExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: h.Kind,
+	}
+}
+
 // IamProperties - IAM properties
 type IamProperties struct {
 	// REQUIRED; The kind of IAM provider to configure
@@ -1255,6 +1431,9 @@ type Providers struct {
 
 	// The Azure cloud provider configuration.
 	Azure *ProvidersAzure
+
+	// The GCP cloud provider configuration.
+	Gcp *ProvidersGcp
 }
 
 // ProvidersAws - The AWS cloud provider definition.
@@ -1281,6 +1460,18 @@ type ProvidersAzureUpdate struct {
 	Scope *string
 }
 
+// ProvidersGcp - The GCP cloud provider definition.
+type ProvidersGcp struct {
+	// REQUIRED; Target scope for GCP resources to be deployed into. For example: '/planes/gcp/gcp/projects/my-project/regions/us-central1'.
+	Scope *string
+}
+
+// ProvidersGcpUpdate - The GCP cloud provider definition.
+type ProvidersGcpUpdate struct {
+	// Target scope for GCP resources to be deployed into. For example: '/planes/gcp/gcp/projects/my-project/regions/us-central1'.
+	Scope *string
+}
+
 // ProvidersUpdate - The Cloud providers configuration.
 type ProvidersUpdate struct {
 	// The AWS cloud provider configuration.
@@ -1288,6 +1479,9 @@ type ProvidersUpdate struct {
 
 	// The Azure cloud provider configuration.
 	Azure *ProvidersAzureUpdate
+
+	// The GCP cloud provider configuration.
+	Gcp *ProvidersGcpUpdate
 }
 
 // Recipe - The recipe used to automatically deploy underlying infrastructure for a portable resource
@@ -1301,6 +1495,10 @@ type Recipe struct {
 
 // RecipeConfigProperties - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
 type RecipeConfigProperties struct {
+	// Configuration for the detection of drift between the resources deployed by the recipes in the environment and their
+// live state.
+	Drift *RecipeDriftConfig
+
 	// Environment variables injected during Terraform Recipe execution for the recipes in the environment.
 	Env map[string]*string
 
@@ -1308,6 +1506,44 @@ type RecipeConfigProperties struct {
 	Terraform *TerraformConfigProperties
 }
 
+// RecipeDriftChange - A change re-executing a recipe would make to a drifted resource.
+type RecipeDriftChange struct {
+	// REQUIRED; The change to the resource. Allowed values: create, update, delete.
+	Action *string
+
+	// REQUIRED; The name or address of the resource within the recipe template.
+	Name *string
+
+	// REQUIRED; The type of the resource as reported by the recipe template.
+	ResourceType *string
+
+	// The identifier of the resource, if known.
+	ResourceID *string
+}
+
+// RecipeDriftStatus - Drift between the resources deployed by a recipe and their live state.
+type RecipeDriftStatus struct {
+	// REQUIRED; The drift state as of the last detection. Allowed values: InSync, Drifted, Remediating, Unknown.
+	State *string
+
+	// The changes re-executing the recipe would make to restore the deployed resources.
+	Changes []*RecipeDriftChange
+
+	// The time of the last drift detection.
+	LastCheckedAt *time.Time
+
+	// The reason the drift could not be detected, if the state is Unknown.
+	Message *string
+}
+
+// RecipeDriftConfig - Configuration for the detection of drift between the resources deployed by the recipes in the environment
+// and their live state.
+type RecipeDriftConfig struct {
+	// Re-executes the recipe of a portable resource when drift is detected, restoring the resources deployed by the recipe.
+// Defaults to false.
+	AutoRemediate *bool
+}
+
 // RecipeGetMetadata - Represents the request body of the getmetadata action.
 type RecipeGetMetadata struct {
 	// REQUIRED; The name of the recipe registered to the environment.
@@ -1322,7 +1558,7 @@ type RecipeGetMetadataResponse struct {
 	// REQUIRED; The key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
-	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform.
+	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 	TemplateKind *string
 
 	// REQUIRED; The path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
@@ -1337,7 +1573,29 @@ type RecipeGetMetadataResponse struct {
 	TemplateVersion *string
 }
 
-// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePlanRequest - Represents the request body of the planRecipe action.
+type RecipePlanRequest struct {
+	// REQUIRED; The name of the recipe registered to the environment.
+	Name *string
+
+	// REQUIRED; The name of the resource the recipe is planned for. The changes are computed against the resources the recipe
+// previously deployed for this resource.
+	ResourceName *string
+
+	// REQUIRED; Type of the resource this recipe can be consumed by. For example: 'Applications.Datastores/mongoDatabases'.
+	ResourceType *string
+
+	// The key/value parameters to pass to the recipe template. Overrides any parameters set by the environment.
+	Parameters map[string]any
+}
+
+// RecipePlanResponse - The changes to the resources a recipe would make if it was deployed.
+type RecipePlanResponse struct {
+	// REQUIRED; The list of resource changes, in the order they would be applied.
+	Changes []*RecipeResourceChange
+}
+
+// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 type RecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1352,7 +1610,7 @@ type RecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type RecipeProperties.
 func (r *RecipeProperties) GetRecipeProperties() *RecipeProperties { return r }
 
-// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 type RecipePropertiesUpdate struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1367,6 +1625,21 @@ type RecipePropertiesUpdate struct {
 // GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type RecipePropertiesUpdate.
 func (r *RecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate { return r }
 
+// RecipeResourceChange - A change to a resource deployed by a recipe.
+type RecipeResourceChange struct {
+	// REQUIRED; The change to the resource. Allowed values: create, update, delete.
+	Action *string
+
+	// REQUIRED; The name or address of the resource within the recipe template.
+	Name *string
+
+	// REQUIRED; The type of the resource as reported by the recipe template.
+	ResourceType *string
+
+	// The identifier of the resource. Omitted when the identifier is only known after the resource is created.
+	ResourceID *string
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 	// REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -1375,6 +1648,9 @@ type RecipeStatus struct {
 	// REQUIRED; TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
 	TemplatePath *string
 
+	// The result of the last drift detection of the resources deployed by the recipe.
+	Drift *RecipeDriftStatus
+
 	// TemplateVersion is the version number of the template.
 	TemplateVersion *string
 }
@@ -1608,16 +1884,60 @@ func (t *TCPHealthProbeProperties) GetHealthProbeProperties() *HealthProbeProper
 	}
 }
 
+// TerraformBackendConfig - Configuration for the Terraform backend storing the state of the Terraform Recipes. For more information,
+// please see: https://developer.hashicorp.com/terraform/language/settings/backends/configuration.
+type TerraformBackendConfig struct {
+	// REQUIRED; The kind of the Terraform backend.
+	Kind *TerraformBackendKind
+
+	// The non-sensitive settings of the backend, for example 'bucket' and 'region' for the s3 backend. The settings naming the
+// state of each Recipe, such as 'key' for the s3 and azurerm backends, are set by Radius.
+	Config map[string]*string
+
+	// The ID of an Applications.Core/SecretStore resource containing the credentials of the backend. Supported secrets are 'access_key',
+// 'secret_key' and 'token' for the s3 backend, 'access_key', 'sas_token', 'client_id' and 'client_secret' for the azurerm
+// backend, and 'username' and 'password' for the http backend.
+	Secret *string
+}
+
 // TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as
 // part of Recipe deployment.
 type TerraformConfigProperties struct {
 	// Authentication information used to access private Terraform module sources. Supported module sources: Git.
 	Authentication *AuthConfig
 
+	// Configuration for the Terraform backend storing the state of the Terraform Recipes in the environment. By default the state
+// is stored in Kubernetes secrets in the 'radius-system' namespace.
+	Backend *TerraformBackendConfig
+
 	// Configuration for Terraform Recipe Providers. Controls how Terraform interacts with cloud providers, SaaS providers, and
 // other APIs. For more information, please see:
 // https://developer.hashicorp.com/terraform/language/providers/configuration.
 	Providers map[string][]map[string]any
+
+	// Configuration for a mirror from which Terraform installs the providers of the Terraform Recipes in the environment instead
+// of the provider registries. Use a mirror to run Terraform Recipes without internet access.
+	ProviderMirror *TerraformProviderMirrorConfig
+}
+
+// TerraformProviderMirrorConfig - Configuration for a mirror of the Terraform providers, in the layout created by 'terraform
+// providers mirror'. When a mirror is configured the providers are only installed from the mirror. For more information,
+// please see: https://developer.hashicorp.com/terraform/cli/config/config-file#filesystem_mirror.
+type TerraformProviderMirrorConfig struct {
+	// REQUIRED; The kind of the provider mirror.
+	Kind *TerraformProviderMirrorKind
+
+	// REQUIRED; The path of the mirror directory for the filesystem kind, or the reference of the OCI artifact containing the
+// mirror directory for the oci kind, for example 'myregistry.azurecr.io/terraform/providers:1.0'.
+	Path *string
+
+	// Connect to the container registry using HTTP (not-HTTPS). This should be used when the registry is known not to support
+// HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
+	PlainHTTP *bool
+
+	// The ID of an Applications.Core/SecretStore resource containing the credentials of the container registry of the oci kind.
+// The secret store must have the secrets 'username' and 'password'. By default the registry is accessed anonymously.
+	Secret *string
 }
 
 // TerraformRecipeProperties - Represents Terraform recipe properties.
@@ -581,8 +581,8 @@ func (dp *deploymentProcessor) buildResourceDependency(resourceID resources.ID,
 			return ResourceData{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("application ID %q for the resource %q is not a valid id. Error: %s", applicationID, resourceID.String(), err.Error()))
 		}
 		appID = &parsedID
-	} else if rp_pr.IsValidPortableResourceType(resourceID.TypeSegments()[0].Type) {
-		// Application id is optional for portable resource types
+	} else if rp_pr.IsValidPortableResourceType(resourceID.TypeSegments()[0].Type) || strings.EqualFold(resourceID.Type(), corerp_dm.SecretStoreResourceType) {
+		// Application id is optional for portable resource types and secret stores, which can be scoped to an environment.
 		appID = nil
 	} else {
 		return ResourceData{}, fmt.Errorf("missing required application id for the resource %q", resourceID.String())
@@ -1091,6 +1091,39 @@ func Test_getResourceDataByID(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, resourceData.RecipeData, mongoResource.RecipeData)
 	})
+
+	t.Run("Get environment-scoped secret store", func(t *testing.T) {
+		mocks.dbProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Times(1).Return(mocks.db, nil)
+
+		depId, _ := resources.ParseResource("/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Core/secretStores/test-secret")
+		secretStore := &datamodel.SecretStore{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   depId.String(),
+					Name: "test-secret",
+					Type: datamodel.SecretStoreResourceType,
+				},
+			},
+			Properties: &datamodel.SecretStoreProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Environment: "/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Core/environments/env0",
+				},
+				Type: datamodel.SecretTypeGeneric,
+			},
+		}
+		sr := store.Object{
+			Metadata: store.Metadata{
+				ID: secretStore.ID,
+			},
+			Data: secretStore,
+		}
+
+		mocks.db.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(&sr, nil)
+
+		resourceData, err := dp.getResourceDataByID(ctx, depId)
+		require.NoError(t, err)
+		require.Nil(t, resourceData.AppID)
+	})
 }
 
 func Test_fetchSecrets(t *testing.T) {
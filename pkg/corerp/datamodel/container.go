@@ -73,6 +73,8 @@ type ContainerProperties struct {
 	rpv1.BasicResourceProperties
 	Connections          map[string]ConnectionProperties `json:"connections,omitempty"`
 	Container            Container                       `json:"container,omitempty"`
+	InitContainers       map[string]Container            `json:"initContainers,omitempty"`
+	Sidecars             map[string]Container            `json:"sidecars,omitempty"`
 	Extensions           []Extension                     `json:"extensions,omitempty"`
 	Identity             *rpv1.IdentitySettings          `json:"identity,omitempty"`
 	Runtimes             *RuntimeProperties              `json:"runtimes,omitempty"`
@@ -116,16 +118,56 @@ type ConnectionProperties struct {
 
 // Container - Definition of a container.
 type Container struct {
-	Image           string                      `json:"image,omitempty"`
-	ImagePullPolicy string                      `json:"imagePullPolicy,omitempty"`
-	Env             map[string]string           `json:"env,omitempty"`
-	LivenessProbe   HealthProbeProperties       `json:"livenessProbe,omitempty"`
-	Ports           map[string]ContainerPort    `json:"ports,omitempty"`
-	ReadinessProbe  HealthProbeProperties       `json:"readinessProbe,omitempty"`
-	Volumes         map[string]VolumeProperties `json:"volumes,omitempty"`
-	Command         []string                    `json:"command,omitempty"`
-	Args            []string                    `json:"args,omitempty"`
-	WorkingDir      string                      `json:"workingDir,omitempty"`
+	Image           string                                  `json:"image,omitempty"`
+	ImagePullPolicy string                                  `json:"imagePullPolicy,omitempty"`
+	Env             map[string]string                       `json:"env,omitempty"`
+	EnvValueFrom    map[string]EnvironmentVariableReference `json:"envValueFrom,omitempty"`
+	LivenessProbe   HealthProbeProperties                   `json:"livenessProbe,omitempty"`
+	Ports           map[string]ContainerPort                `json:"ports,omitempty"`
+	ReadinessProbe  HealthProbeProperties                   `json:"readinessProbe,omitempty"`
+	Volumes         map[string]VolumeProperties             `json:"volumes,omitempty"`
+	Command         []string                                `json:"command,omitempty"`
+	Args            []string                                `json:"args,omitempty"`
+	WorkingDir      string                                  `json:"workingDir,omitempty"`
+	Resources       *ContainerResources                     `json:"resources,omitempty"`
+
+	// Order is the position of an init container in the sequence of the init containers.
+	Order *int32 `json:"order,omitempty"`
+}
+
+// EnvironmentVariableReference - The source of the value of an environment variable. Exactly one of
+// SecretRef or ConnectionRef is set.
+type EnvironmentVariableReference struct {
+	SecretRef     *EnvironmentVariableSecretReference     `json:"secretRef,omitempty"`
+	ConnectionRef *EnvironmentVariableConnectionReference `json:"connectionRef,omitempty"`
+}
+
+// EnvironmentVariableSecretReference - Reference to a key of an Applications.Core/secretStores resource.
+type EnvironmentVariableSecretReference struct {
+	// Source is the resource ID of the secret store.
+	Source string `json:"source,omitempty"`
+	// Key is the key of the secret in the secret store.
+	Key string `json:"key,omitempty"`
+}
+
+// EnvironmentVariableConnectionReference - Reference to an output value of a connection of the container.
+type EnvironmentVariableConnectionReference struct {
+	// Name is the name of the connection.
+	Name string `json:"name,omitempty"`
+	// Property is the name of the computed or secret value of the connected resource.
+	Property string `json:"property,omitempty"`
+}
+
+// ContainerResources - Compute resource requirements of the container.
+type ContainerResources struct {
+	Requests *ContainerResourceQuantities `json:"requests,omitempty"`
+	Limits   *ContainerResourceQuantities `json:"limits,omitempty"`
+}
+
+// ContainerResourceQuantities - The amount of compute resources in the Kubernetes quantity format, such as '500m' or '256Mi'.
+type ContainerResourceQuantities struct {
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
 }
 
 // ContainerPort - Specifies a listening port for the container
@@ -252,6 +294,14 @@ type ManualScalingExtension struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 }
 
+// HorizontalAutoscalingExtension - Specifies the container should be scaled horizontally based on resource utilization
+type HorizontalAutoscalingExtension struct {
+	MinReplicas             *int32 `json:"minReplicas,omitempty"`
+	MaxReplicas             int32  `json:"maxReplicas,omitempty"`
+	TargetCPUUtilization    *int32 `json:"targetCpuUtilization,omitempty"`
+	TargetMemoryUtilization *int32 `json:"targetMemoryUtilization,omitempty"`
+}
+
 // DaprSidecarExtension - Specifies the resource should have a Dapr sidecar injected
 type DaprSidecarExtension struct {
 	AppID    string   `json:"appId,omitempty"`
@@ -57,3 +57,37 @@ func RecipeDataModelFromVersioned(content []byte, version string) (*datamodel.Re
 		return nil, v1.ErrUnsupportedAPIVersion
 	}
 }
+
+// RecipePlanRequestDataModelFromVersioned converts versioned recipe plan request model to datamodel.
+func RecipePlanRequestDataModelFromVersioned(content []byte, version string) (*datamodel.RecipePlanRequest, error) {
+	switch version {
+	case v20231001preview.Version:
+		am := &v20231001preview.RecipePlanRequest{}
+		if err := json.Unmarshal(content, am); err != nil {
+			return nil, err
+		}
+		dm, err := am.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		return dm.(*datamodel.RecipePlanRequest), nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
+
+// RecipePlanDataModelToVersioned converts version agnostic recipe plan datamodel to versioned model.
+func RecipePlanDataModelToVersioned(model *datamodel.RecipePlan, version string) (v1.VersionedModelInterface, error) {
+	switch version {
+	case v20231001preview.Version:
+		versioned := &v20231001preview.RecipePlanResponse{}
+		if err := versioned.ConvertFrom(model); err != nil {
+			return nil, err
+		}
+		return versioned, nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
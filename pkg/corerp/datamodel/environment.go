@@ -69,17 +69,61 @@ func (e *Recipe) ResourceTypeName() string {
 	return "Applications.Core/environments"
 }
 
+// RecipePlanRequest represents input properties for recipe planRecipe api.
+type RecipePlanRequest struct {
+	Recipe
+
+	// Name of the resource the recipe is planned for.
+	ResourceName string `json:"resourceName,omitempty"`
+
+	// Parameters to pass to the recipe template. Overrides any parameters set by the environment.
+	Parameters map[string]any `json:"parameters,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipePlanRequest instance.
+func (e *RecipePlanRequest) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipePlan represents the output of recipe planRecipe api.
+type RecipePlan struct {
+	// Changes to the resources the recipe would make if it was deployed, in the order they would be applied.
+	Changes []RecipeResourceChange `json:"changes"`
+}
+
+// RecipeResourceChange represents a change to a resource deployed by a recipe.
+type RecipeResourceChange struct {
+	// Action is the change to the resource. Allowed values: create, update, delete.
+	Action string `json:"action"`
+
+	// ResourceType is the type of the resource as reported by the recipe template.
+	ResourceType string `json:"resourceType"`
+
+	// Name is the name or address of the resource within the recipe template.
+	Name string `json:"name"`
+
+	// ResourceID is the identifier of the resource, empty when it is only known after the resource is created.
+	ResourceID string `json:"resourceId,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipePlan instance.
+func (e *RecipePlan) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
 // ResourceTypeName returns the resource type of the EnvironmentRecipeProperties instance.
 func (e *EnvironmentRecipeProperties) ResourceTypeName() string {
 	return "Applications.Core/environments"
 }
 
-// Providers represents configs for providers for the environment, eg azure,aws
+// Providers represents configs for providers for the environment, eg azure,aws,gcp
 type Providers struct {
 	// Azure provider information
 	Azure ProvidersAzure `json:"azure,omitempty"`
 	// AWS provider information
 	AWS ProvidersAWS `json:"aws,omitempty"`
+	// GCP provider information
+	GCP ProvidersGCP `json:"gcp,omitempty"`
 }
 
 // ProvidersAzure represents the azure provider configs
@@ -93,3 +137,9 @@ type ProvidersAWS struct {
 	// Scope is the target level for deploying the aws resources
 	Scope string `json:"scope,omitempty"`
 }
+
+// ProvidersGCP represents the gcp provider configs
+type ProvidersGCP struct {
+	// Scope is the target level for deploying the gcp resources
+	Scope string `json:"scope,omitempty"`
+}
@@ -21,6 +21,7 @@ type ExtensionKind string
 
 const (
 	ManualScaling                ExtensionKind = "manualScaling"
+	HorizontalAutoscaling        ExtensionKind = "horizontalAutoscaling"
 	DaprSidecar                  ExtensionKind = "daprSidecar"
 	KubernetesMetadata           ExtensionKind = "kubernetesMetadata"
 	KubernetesNamespaceExtension ExtensionKind = "kubernetesNamespace"
@@ -28,11 +29,12 @@ const (
 
 // Extension of a resource.
 type Extension struct {
-	Kind                ExtensionKind           `json:"kind,omitempty"`
-	ManualScaling       *ManualScalingExtension `json:"manualScaling,omitempty"`
-	DaprSidecar         *DaprSidecarExtension   `json:"daprSidecar,omitempty"`
-	KubernetesMetadata  *KubeMetadataExtension  `json:"kubernetesMetadata,omitempty"`
-	KubernetesNamespace *KubeNamespaceExtension `json:"kubernetesNamespace,omitempty"`
+	Kind                  ExtensionKind                   `json:"kind,omitempty"`
+	ManualScaling         *ManualScalingExtension         `json:"manualScaling,omitempty"`
+	HorizontalAutoscaling *HorizontalAutoscalingExtension `json:"horizontalAutoscaling,omitempty"`
+	DaprSidecar           *DaprSidecarExtension           `json:"daprSidecar,omitempty"`
+	KubernetesMetadata    *KubeMetadataExtension          `json:"kubernetesMetadata,omitempty"`
+	KubernetesNamespace   *KubeNamespaceExtension         `json:"kubernetesNamespace,omitempty"`
 }
 
 // KubeMetadataExtension represents the extension of kubernetes resource.
@@ -23,6 +23,16 @@ type RecipeConfigProperties struct {
 
 	// Env specifies the environment variables to be set during the Terraform Recipe execution.
 	Env EnvironmentVariables `json:"env,omitempty"`
+
+	// Drift configures the detection of drift between the resources deployed by the recipes and their live state.
+	Drift RecipeDriftConfig `json:"drift,omitempty"`
+}
+
+// RecipeDriftConfig - Configuration for the detection of drift between the resources deployed by the recipes in the
+// environment and their live state.
+type RecipeDriftConfig struct {
+	// AutoRemediate re-executes the recipe of a portable resource when drift is detected.
+	AutoRemediate bool `json:"autoRemediate,omitempty"`
 }
 
 // TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as
@@ -33,6 +43,44 @@ type TerraformConfigProperties struct {
 
 	// Providers specifies the Terraform provider configurations. Controls how Terraform interacts with cloud providers, SaaS providers, and other APIs: https://developer.hashicorp.com/terraform/language/providers/configuration.// Providers specifies the Terraform provider configurations.
 	Providers map[string][]ProviderConfigProperties `json:"providers,omitempty"`
+
+	// Backend specifies the Terraform backend storing the state of the Terraform recipes. The Kubernetes backend is used when no
+	// backend is configured.
+	Backend TerraformBackendConfig `json:"backend,omitempty"`
+
+	// ProviderMirror specifies a mirror from which Terraform installs the providers of the Terraform recipes instead of the
+	// provider registries.
+	ProviderMirror TerraformProviderMirrorConfig `json:"providerMirror,omitempty"`
+}
+
+// TerraformBackendConfig - Configuration for the Terraform backend storing the state of the Terraform recipes.
+type TerraformBackendConfig struct {
+	// Kind is the kind of the backend, which is also the name of the Terraform backend type. For example: "s3".
+	Kind string `json:"kind,omitempty"`
+
+	// Config contains the non-sensitive settings of the backend.
+	Config map[string]string `json:"config,omitempty"`
+
+	// Secret is the ID of an Applications.Core/SecretStore resource containing the credentials of the backend.
+	Secret string `json:"secret,omitempty"`
+}
+
+// TerraformProviderMirrorConfig - Configuration for a mirror of the Terraform providers, in the layout created by
+// 'terraform providers mirror'.
+type TerraformProviderMirrorConfig struct {
+	// Kind is the kind of the mirror: "filesystem" or "oci".
+	Kind string `json:"kind,omitempty"`
+
+	// Path is the path of the mirror directory for the filesystem kind, or the reference of the OCI artifact containing the
+	// mirror directory for the oci kind.
+	Path string `json:"path,omitempty"`
+
+	// PlainHTTP connects to the container registry of the oci kind using HTTP (not-HTTPS).
+	PlainHTTP bool `json:"plainHttp,omitempty"`
+
+	// Secret is the ID of an Applications.Core/SecretStore resource containing the 'username' and 'password' used to
+	// authenticate to the container registry of the oci kind.
+	Secret string `json:"secret,omitempty"`
 }
 
 // AuthConfig - Authentication information used to access private Terraform module sources. Supported module sources: Git.
@@ -44,6 +44,9 @@ const (
 	connectionsPath = "/properties/connections"
 	routesPath      = "/properties/routes"
 	portsPath       = "/properties/container/ports"
+
+	initContainersPath = "/properties/initContainers"
+	sidecarsPath       = "/properties/sidecars"
 )
 
 // resolver is a function type to resolve appgraph connection.
@@ -263,6 +266,7 @@ func computeGraph(applicationResources []generated.GenericResource, environmentR
 
 		applicationGraphResource.Connections = connections
 		applicationGraphResource.OutputResources = outputResourcesFromAPIData(resource)
+		applicationGraphResource.Containers = containersFromAPIData(resource)
 
 		applicationGraphResourcesByID[*resource.ID] = *applicationGraphResource
 	}
@@ -464,6 +468,60 @@ func outputResourcesFromAPIData(resource generated.GenericResource) []*corerpv20
 	return entries
 }
 
+// containersFromAPIData processes the generic resource representation returned by the Radius API
+// and produces a list of the init containers and sidecars of a container resource. It returns nil
+// if the resource has neither.
+func containersFromAPIData(resource generated.GenericResource) []*corerpv20231001preview.ApplicationGraphContainer {
+	var entries []*corerpv20231001preview.ApplicationGraphContainer
+	for _, group := range []struct {
+		path string
+		kind corerpv20231001preview.ApplicationGraphContainerKind
+	}{
+		{initContainersPath, corerpv20231001preview.ApplicationGraphContainerKindInit},
+		{sidecarsPath, corerpv20231001preview.ApplicationGraphContainerKindSidecar},
+	} {
+		p, err := jsonpointer.New(group.path)
+		if err != nil {
+			// This should never fail since we're hard-coding the path.
+			panic("parsing JSON pointer should not fail: " + err.Error())
+		}
+
+		raw, _, err := p.Get(&resource)
+		if err != nil {
+			// Not found, this is fine.
+			continue
+		}
+
+		containers := map[string]corerpv20231001preview.Container{}
+		err = toStronglyTypedData(raw, &containers)
+		if err != nil {
+			continue
+		}
+
+		names := make([]string, 0, len(containers))
+		for name := range containers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		// Init containers run in the ascending order of their order property, so this also shows the order in which
+		// they run. Sidecars don't have an order.
+		sort.SliceStable(names, func(i, j int) bool {
+			return to.Int32(containers[names[i]].Order) < to.Int32(containers[names[j]].Order)
+		})
+
+		for _, name := range names {
+			entries = append(entries, &corerpv20231001preview.ApplicationGraphContainer{
+				Name:  to.Ptr(name),
+				Image: to.Ptr(to.String(containers[name].Image)),
+				Kind:  to.Ptr(group.kind),
+			})
+		}
+	}
+
+	return entries
+}
+
 func resolveConnections(resource generated.GenericResource, jsonRefPath string, converter resolver) []*corerpv20231001preview.ApplicationGraphConnection {
 	// We need to access the connections in a weakly-typed way since the data type we're
 	// working with is a property bag.
@@ -137,6 +137,12 @@ func Test_computeGraph(t *testing.T) {
 			envResourceDataFile: "",
 			expectedDataFile:    "graph-app-gw-out.json",
 		},
+		{
+			name:                "with init containers and sidecars",
+			appResourceDataFile: "graph-app-sidecars-in.json",
+			envResourceDataFile: "",
+			expectedDataFile:    "graph-app-sidecars-out.json",
+		},
 	}
 
 	for _, tt := range tests {
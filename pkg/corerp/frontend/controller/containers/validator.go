@@ -20,9 +20,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -30,12 +33,17 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
 const (
-	manifestTargetProperty = "$.properties.runtimes.kubernetes.base"
-	podTargetProperty      = "$.properties.runtimes.kubernetes.pod"
+	manifestTargetProperty     = "$.properties.runtimes.kubernetes.base"
+	podTargetProperty          = "$.properties.runtimes.kubernetes.pod"
+	resourcesTargetProperty    = "$.properties.container.resources"
+	extensionsTargetProperty   = "$.properties.extensions"
+	envValueFromTargetProperty = "$.properties.container.envValueFrom"
 )
 
 // ValidateAndMutateRequest checks if the newResource has a user-defined identity and if so, returns a bad request
@@ -52,6 +60,22 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		newResource.Properties.Identity = oldResource.Properties.Identity
 	}
 
+	if err := validateResources(newResource.Properties.Container.Resources, resourcesTargetProperty); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	if err := validateHorizontalAutoscaling(newResource); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	if err := validateEnvValueFrom(newResource); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	if err := validateAdditionalContainers(newResource); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
 	runtimes := newResource.Properties.Runtimes
 	if runtimes != nil && runtimes.Kubernetes != nil {
 		if runtimes.Kubernetes.Base != "" {
@@ -72,6 +96,280 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 	return nil, nil
 }
 
+// validateResources validates that the compute resource quantities are in the Kubernetes quantity format and
+// the requests do not exceed the limits.
+func validateResources(res *datamodel.ContainerResources, target string) error {
+	if res == nil {
+		return nil
+	}
+
+	requests, err := parseResourceQuantities(res.Requests, target, "requests")
+	if err != nil {
+		return err
+	}
+	limits, err := parseResourceQuantities(res.Limits, target, "limits")
+	if err != nil {
+		return err
+	}
+
+	for name, request := range requests {
+		if limit, ok := limits[name]; ok && request.Cmp(limit) > 0 {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  target,
+				Message: fmt.Sprintf("%s request %s must be less than or equal to %s limit %s.", name, request.String(), name, limit.String()),
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseResourceQuantities(q *datamodel.ContainerResourceQuantities, target string, field string) (map[string]resource.Quantity, error) {
+	quantities := map[string]resource.Quantity{}
+	if q == nil {
+		return quantities, nil
+	}
+
+	for _, r := range []struct{ name, value string }{{"cpu", q.CPU}, {"memory", q.Memory}} {
+		name, value := r.name, r.value
+		if value == "" {
+			continue
+		}
+
+		parsed, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  fmt.Sprintf("%s.%s.%s", target, field, name),
+				Message: fmt.Sprintf("Invalid %s quantity %q: %s.", name, value, err.Error()),
+			}
+		}
+		quantities[name] = parsed
+	}
+
+	return quantities, nil
+}
+
+// validateHorizontalAutoscaling validates the horizontalAutoscaling extension. The extension cannot be combined with
+// the manualScaling extension and the utilization targets require the matching resource requests unless the base
+// manifest is given, which may define the requests.
+func validateHorizontalAutoscaling(newResource *datamodel.ContainerResource) error {
+	ext := datamodel.FindExtension(newResource.Properties.Extensions, datamodel.HorizontalAutoscaling)
+	if ext == nil || ext.HorizontalAutoscaling == nil {
+		return nil
+	}
+
+	invalid := func(message string) error {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  extensionsTargetProperty,
+			Message: message,
+		}
+	}
+
+	if datamodel.FindExtension(newResource.Properties.Extensions, datamodel.ManualScaling) != nil {
+		return invalid("horizontalAutoscaling extension cannot be used with manualScaling extension.")
+	}
+
+	hpa := ext.HorizontalAutoscaling
+	if hpa.MaxReplicas < 1 {
+		return invalid("maxReplicas must be greater than 0.")
+	}
+	if hpa.MinReplicas != nil && (*hpa.MinReplicas < 1 || *hpa.MinReplicas > hpa.MaxReplicas) {
+		return invalid("minReplicas must be greater than 0 and less than or equal to maxReplicas.")
+	}
+	if hpa.TargetCPUUtilization == nil && hpa.TargetMemoryUtilization == nil {
+		return invalid("horizontalAutoscaling extension requires targetCpuUtilization or targetMemoryUtilization.")
+	}
+
+	hasBase := newResource.Properties.Runtimes != nil && newResource.Properties.Runtimes.Kubernetes != nil && newResource.Properties.Runtimes.Kubernetes.Base != ""
+	requests := &datamodel.ContainerResourceQuantities{}
+	if newResource.Properties.Container.Resources != nil && newResource.Properties.Container.Resources.Requests != nil {
+		requests = newResource.Properties.Container.Resources.Requests
+	}
+
+	if hpa.TargetCPUUtilization != nil {
+		if *hpa.TargetCPUUtilization < 1 {
+			return invalid("targetCpuUtilization must be greater than 0.")
+		}
+		if requests.CPU == "" && !hasBase {
+			return invalid("targetCpuUtilization requires the cpu request in container resources.")
+		}
+	}
+
+	if hpa.TargetMemoryUtilization != nil {
+		if *hpa.TargetMemoryUtilization < 1 {
+			return invalid("targetMemoryUtilization must be greater than 0.")
+		}
+		if requests.Memory == "" && !hasBase {
+			return invalid("targetMemoryUtilization requires the memory request in container resources.")
+		}
+	}
+
+	return nil
+}
+
+// validateEnvValueFrom validates that each environment variable in envValueFrom references either a key of a
+// secret store or an output value of one of the container's resource connections, and that the name is not
+// already used in env.
+func validateEnvValueFrom(newResource *datamodel.ContainerResource) error {
+	return validateContainerEnvValueFrom(newResource.Properties.Container, newResource.Properties.Connections, envValueFromTargetProperty)
+}
+
+func validateContainerEnvValueFrom(container datamodel.Container, connections map[string]datamodel.ConnectionProperties, target string) error {
+	names := make([]string, 0, len(container.EnvValueFrom))
+	for name := range container.EnvValueFrom {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ref := container.EnvValueFrom[name]
+		invalid := func(message string) error {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  fmt.Sprintf("%s.%s", target, name),
+				Message: message,
+			}
+		}
+
+		if _, ok := container.Env[name]; ok {
+			return invalid(fmt.Sprintf("Environment variable %q cannot be set in both env and envValueFrom.", name))
+		}
+
+		if (ref.SecretRef == nil) == (ref.ConnectionRef == nil) {
+			return invalid(fmt.Sprintf("Environment variable %q must set exactly one of secretRef or connectionRef.", name))
+		}
+
+		if ref.SecretRef != nil {
+			id, err := resources.ParseResource(ref.SecretRef.Source)
+			if err != nil || !strings.EqualFold(id.Type(), datamodel.SecretStoreResourceType) {
+				return invalid(fmt.Sprintf("secretRef.source of environment variable %q must be the ID of an %s resource.", name, datamodel.SecretStoreResourceType))
+			}
+			if ref.SecretRef.Key == "" {
+				return invalid(fmt.Sprintf("secretRef.key of environment variable %q must not be empty.", name))
+			}
+			continue
+		}
+
+		connection, ok := connections[ref.ConnectionRef.Name]
+		if !ok {
+			return invalid(fmt.Sprintf("connectionRef.name of environment variable %q must be the name of a connection of the container.", name))
+		}
+		if _, err := resources.ParseResource(connection.Source); err != nil {
+			return invalid(fmt.Sprintf("connectionRef of environment variable %q must reference a connection to a resource.", name))
+		}
+		if ref.ConnectionRef.Property == "" {
+			return invalid(fmt.Sprintf("connectionRef.property of environment variable %q must not be empty.", name))
+		}
+	}
+
+	return nil
+}
+
+// validateAdditionalContainers validates the init containers and sidecars. Their names must be valid and unique
+// Kubernetes container names, init containers cannot have ports or health probes and need a unique order when there is
+// more than one of them, the ports of sidecars cannot reuse the port names of the main container, and persistent
+// volumes must also be defined by the main container.
+func validateAdditionalContainers(newResource *datamodel.ContainerResource) error {
+	properties := newResource.Properties
+	if properties.Container.Order != nil {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  "$.properties.container.order",
+			Message: "The order can only be set for init containers.",
+		}
+	}
+
+	orders := map[int32]string{}
+	containerNames := map[string]string{kubernetes.NormalizeResourceName(newResource.Name): "the main container"}
+	portNames := map[string]bool{}
+	for name := range properties.Container.Ports {
+		portNames[name] = true
+	}
+
+	for _, group := range []struct {
+		property   string
+		containers map[string]datamodel.Container
+	}{
+		{"initContainers", properties.InitContainers},
+		{"sidecars", properties.Sidecars},
+	} {
+		names := make([]string, 0, len(group.containers))
+		for name := range group.containers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			container := group.containers[name]
+			target := fmt.Sprintf("$.properties.%s.%s", group.property, name)
+			invalid := func(message string) error {
+				return v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  target,
+					Message: message,
+				}
+			}
+
+			if !kubernetes.IsValidObjectName(name) {
+				return invalid(fmt.Sprintf("%q is not a valid container name. It must be a lowercase RFC 1123 label.", name))
+			}
+			if other, ok := containerNames[name]; ok {
+				return invalid(fmt.Sprintf("Container name %q is already used by %s.", name, other))
+			}
+			containerNames[name] = fmt.Sprintf("%s.%s", group.property, name)
+
+			if group.property == "initContainers" {
+				if container.Order == nil && len(group.containers) > 1 {
+					return invalid("The order of the init container is required when there is more than one init container.")
+				}
+				if container.Order != nil {
+					if other, ok := orders[*container.Order]; ok {
+						return invalid(fmt.Sprintf("Order %d is already used by initContainers.%s.", *container.Order, other))
+					}
+					orders[*container.Order] = name
+				}
+				if len(container.Ports) > 0 {
+					return invalid("Init containers cannot define ports.")
+				}
+				if !container.ReadinessProbe.IsEmpty() || !container.LivenessProbe.IsEmpty() {
+					return invalid("Init containers cannot define readiness or liveness probes.")
+				}
+			} else if container.Order != nil {
+				return invalid("The order can only be set for init containers.")
+			}
+
+			for portName := range container.Ports {
+				if portNames[portName] {
+					return invalid(fmt.Sprintf("Port name %q is already used by another container.", portName))
+				}
+				portNames[portName] = true
+			}
+
+			for volumeName, volume := range container.Volumes {
+				if volume.Kind != datamodel.Persistent {
+					continue
+				}
+				if main, ok := properties.Container.Volumes[volumeName]; !ok || main.Kind != datamodel.Persistent {
+					return invalid(fmt.Sprintf("Persistent volume %q must also be defined by the main container.", volumeName))
+				}
+			}
+
+			if err := validateResources(container.Resources, target+".resources"); err != nil {
+				return err
+			}
+
+			if err := validateContainerEnvValueFrom(container, properties.Connections, target+".envValueFrom"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // validatePodSpec is doing only syntactic validation for PodSpec by deserialzing the given JSON patch
 // to PodSpec object at this time. The semantic validation will be done when Radius applies the
 // patched object to Kubernetes API server.
@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
 )
@@ -444,3 +445,388 @@ func TestValidatePodSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateResources(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources *datamodel.ContainerResources
+		err       error
+	}{
+		{
+			name:      "nil resources",
+			resources: nil,
+		},
+		{
+			name: "valid resources",
+			resources: &datamodel.ContainerResources{
+				Requests: &datamodel.ContainerResourceQuantities{CPU: "500m", Memory: "128Mi"},
+				Limits:   &datamodel.ContainerResourceQuantities{CPU: "1", Memory: "128Mi"},
+			},
+		},
+		{
+			name: "invalid quantity",
+			resources: &datamodel.ContainerResources{
+				Limits: &datamodel.ContainerResourceQuantities{Memory: "lots"},
+			},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.resources.limits.memory",
+				Message: "Invalid memory quantity \"lots\": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'.",
+			},
+		},
+		{
+			name: "request exceeds limit",
+			resources: &datamodel.ContainerResources{
+				Requests: &datamodel.ContainerResourceQuantities{CPU: "2"},
+				Limits:   &datamodel.ContainerResourceQuantities{CPU: "500m"},
+			},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  resourcesTargetProperty,
+				Message: "cpu request 2 must be less than or equal to cpu limit 500m.",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateResources(tc.resources, resourcesTargetProperty)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateHorizontalAutoscaling(t *testing.T) {
+	autoscaling := func(hpa datamodel.HorizontalAutoscalingExtension) datamodel.Extension {
+		return datamodel.Extension{Kind: datamodel.HorizontalAutoscaling, HorizontalAutoscaling: &hpa}
+	}
+	cpuRequest := &datamodel.ContainerResources{Requests: &datamodel.ContainerResourceQuantities{CPU: "250m"}}
+
+	tests := []struct {
+		name       string
+		container  datamodel.Container
+		runtimes   *datamodel.RuntimeProperties
+		extensions []datamodel.Extension
+		message    string
+	}{
+		{
+			name:       "no extension",
+			extensions: []datamodel.Extension{{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{}}},
+		},
+		{
+			name:      "valid extension",
+			container: datamodel.Container{Resources: cpuRequest},
+			extensions: []datamodel.Extension{
+				autoscaling(datamodel.HorizontalAutoscalingExtension{MinReplicas: to.Ptr[int32](2), MaxReplicas: 5, TargetCPUUtilization: to.Ptr[int32](70)}),
+			},
+		},
+		{
+			name:     "requests can be defined in base manifest",
+			runtimes: &datamodel.RuntimeProperties{Kubernetes: &datamodel.KubernetesRuntime{Base: "base"}},
+			extensions: []datamodel.Extension{
+				autoscaling(datamodel.HorizontalAutoscalingExtension{MaxReplicas: 5, TargetMemoryUtilization: to.Ptr[int32](70)}),
+			},
+		},
+		{
+			name:      "combined with manual scaling",
+			container: datamodel.Container{Resources: cpuRequest},
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{Replicas: to.Ptr[int32](2)}},
+				autoscaling(datamodel.HorizontalAutoscalingExtension{MaxReplicas: 5, TargetCPUUtilization: to.Ptr[int32](70)}),
+			},
+			message: "horizontalAutoscaling extension cannot be used with manualScaling extension.",
+		},
+		{
+			name:      "invalid max replicas",
+			container: datamodel.Container{Resources: cpuRequest},
+			extensions: []datamodel.Extension{
+				autoscaling(datamodel.HorizontalAutoscalingExtension{MaxReplicas: 0, TargetCPUUtilization: to.Ptr[int32](70)}),
+			},
+			message: "maxReplicas must be greater than 0.",
+		},
+		{
+			name:      "min replicas greater than max replicas",
+			container: datamodel.Container{Resources: cpuRequest},
+			extensions: []datamodel.Extension{
+				autoscaling(datamodel.HorizontalAutoscalingExtension{MinReplicas: to.Ptr[int32](6), MaxReplicas: 5, TargetCPUUtilization: to.Ptr[int32](70)}),
+			},
+			message: "minReplicas must be greater than 0 and less than or equal to maxReplicas.",
+		},
+		{
+			name:      "no target",
+			container: datamodel.Container{Resources: cpuRequest},
+			extensions: []datamodel.Extension{
+				autoscaling(datamodel.HorizontalAutoscalingExtension{MaxReplicas: 5}),
+			},
+			message: "horizontalAutoscaling extension requires targetCpuUtilization or targetMemoryUtilization.",
+		},
+		{
+			name:      "memory target without memory request",
+			container: datamodel.Container{Resources: cpuRequest},
+			extensions: []datamodel.Extension{
+				autoscaling(datamodel.HorizontalAutoscalingExtension{MaxReplicas: 5, TargetMemoryUtilization: to.Ptr[int32](70)}),
+			},
+			message: "targetMemoryUtilization requires the memory request in container resources.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource := &datamodel.ContainerResource{
+				Properties: datamodel.ContainerProperties{
+					Container:  tc.container,
+					Runtimes:   tc.runtimes,
+					Extensions: tc.extensions,
+				},
+			}
+
+			err := validateHorizontalAutoscaling(resource)
+			if tc.message == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  extensionsTargetProperty,
+				Message: tc.message,
+			}, err)
+		})
+	}
+}
+
+func TestValidateEnvValueFrom(t *testing.T) {
+	secretStoreID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/secret"
+	redisID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+	connections := map[string]datamodel.ConnectionProperties{
+		"redis":   {Source: redisID},
+		"backend": {Source: "http://backend:3000"},
+	}
+
+	tests := []struct {
+		name      string
+		env       map[string]string
+		reference datamodel.EnvironmentVariableReference
+		message   string
+	}{
+		{
+			name:      "valid secret reference",
+			reference: datamodel.EnvironmentVariableReference{SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID, Key: "password"}},
+		},
+		{
+			name:      "valid connection reference",
+			reference: datamodel.EnvironmentVariableReference{ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "redis", Property: "host"}},
+		},
+		{
+			name:      "name also set in env",
+			env:       map[string]string{"VALUE": "value"},
+			reference: datamodel.EnvironmentVariableReference{SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID, Key: "password"}},
+			message:   "Environment variable \"VALUE\" cannot be set in both env and envValueFrom.",
+		},
+		{
+			name:      "no reference",
+			reference: datamodel.EnvironmentVariableReference{},
+			message:   "Environment variable \"VALUE\" must set exactly one of secretRef or connectionRef.",
+		},
+		{
+			name: "both references",
+			reference: datamodel.EnvironmentVariableReference{
+				SecretRef:     &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID, Key: "password"},
+				ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "redis", Property: "host"},
+			},
+			message: "Environment variable \"VALUE\" must set exactly one of secretRef or connectionRef.",
+		},
+		{
+			name:      "secret reference to a non secret store",
+			reference: datamodel.EnvironmentVariableReference{SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: redisID, Key: "password"}},
+			message:   "secretRef.source of environment variable \"VALUE\" must be the ID of an Applications.Core/secretStores resource.",
+		},
+		{
+			name:      "secret reference without key",
+			reference: datamodel.EnvironmentVariableReference{SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID}},
+			message:   "secretRef.key of environment variable \"VALUE\" must not be empty.",
+		},
+		{
+			name:      "unknown connection",
+			reference: datamodel.EnvironmentVariableReference{ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "unknown", Property: "host"}},
+			message:   "connectionRef.name of environment variable \"VALUE\" must be the name of a connection of the container.",
+		},
+		{
+			name:      "connection to a URL",
+			reference: datamodel.EnvironmentVariableReference{ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "backend", Property: "host"}},
+			message:   "connectionRef of environment variable \"VALUE\" must reference a connection to a resource.",
+		},
+		{
+			name:      "connection reference without property",
+			reference: datamodel.EnvironmentVariableReference{ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "redis"}},
+			message:   "connectionRef.property of environment variable \"VALUE\" must not be empty.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource := &datamodel.ContainerResource{
+				Properties: datamodel.ContainerProperties{
+					Connections: connections,
+					Container: datamodel.Container{
+						Env:          tc.env,
+						EnvValueFrom: map[string]datamodel.EnvironmentVariableReference{"VALUE": tc.reference},
+					},
+				},
+			}
+
+			err := validateEnvValueFrom(resource)
+			if tc.message == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  envValueFromTargetProperty + ".VALUE",
+				Message: tc.message,
+			}, err)
+		})
+	}
+}
+
+func TestValidateAdditionalContainers(t *testing.T) {
+	persistentVolume := datamodel.VolumeProperties{Kind: datamodel.Persistent, Persistent: &datamodel.PersistentVolume{Source: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/volumes/azkeyvault"}}
+	ephemeralVolume := datamodel.VolumeProperties{Kind: datamodel.Ephemeral, Ephemeral: &datamodel.EphemeralVolume{}}
+
+	tests := []struct {
+		name           string
+		initContainers map[string]datamodel.Container
+		sidecars       map[string]datamodel.Container
+		target         string
+		message        string
+	}{
+		{
+			name:           "valid",
+			initContainers: map[string]datamodel.Container{"migrate": {Image: "migrate:latest", Volumes: map[string]datamodel.VolumeProperties{"data": persistentVolume}}},
+			sidecars:       map[string]datamodel.Container{"proxy": {Image: "proxy:latest", Ports: map[string]datamodel.ContainerPort{"proxy": {ContainerPort: 8080}}, Volumes: map[string]datamodel.VolumeProperties{"cache": ephemeralVolume}}},
+		},
+		{
+			name:           "invalid name",
+			initContainers: map[string]datamodel.Container{"Migrate_DB": {Image: "migrate:latest"}},
+			target:         "$.properties.initContainers.Migrate_DB",
+			message:        "\"Migrate_DB\" is not a valid container name. It must be a lowercase RFC 1123 label.",
+		},
+		{
+			name:     "name of main container",
+			sidecars: map[string]datamodel.Container{"frontend": {Image: "proxy:latest"}},
+			target:   "$.properties.sidecars.frontend",
+			message:  "Container name \"frontend\" is already used by the main container.",
+		},
+		{
+			name:           "duplicate name",
+			initContainers: map[string]datamodel.Container{"proxy": {Image: "migrate:latest"}},
+			sidecars:       map[string]datamodel.Container{"proxy": {Image: "proxy:latest"}},
+			target:         "$.properties.sidecars.proxy",
+			message:        "Container name \"proxy\" is already used by initContainers.proxy.",
+		},
+		{
+			name:           "init container with ports",
+			initContainers: map[string]datamodel.Container{"migrate": {Image: "migrate:latest", Ports: map[string]datamodel.ContainerPort{"http": {ContainerPort: 80}}}},
+			target:         "$.properties.initContainers.migrate",
+			message:        "Init containers cannot define ports.",
+		},
+		{
+			name: "init container with probe",
+			initContainers: map[string]datamodel.Container{"migrate": {
+				Image:          "migrate:latest",
+				ReadinessProbe: datamodel.HealthProbeProperties{Kind: datamodel.ExecHealthProbe, Exec: &datamodel.ExecHealthProbeProperties{Command: "true"}},
+			}},
+			target:  "$.properties.initContainers.migrate",
+			message: "Init containers cannot define readiness or liveness probes.",
+		},
+		{
+			name: "ordered init containers",
+			initContainers: map[string]datamodel.Container{
+				"migrate":      {Image: "migrate:latest", Order: to.Ptr[int32](2)},
+				"fetch-config": {Image: "fetch-config:latest", Order: to.Ptr[int32](1)},
+			},
+		},
+		{
+			name: "init container without order",
+			initContainers: map[string]datamodel.Container{
+				"migrate":      {Image: "migrate:latest"},
+				"fetch-config": {Image: "fetch-config:latest", Order: to.Ptr[int32](1)},
+			},
+			target:  "$.properties.initContainers.migrate",
+			message: "The order of the init container is required when there is more than one init container.",
+		},
+		{
+			name: "duplicate init container order",
+			initContainers: map[string]datamodel.Container{
+				"migrate":      {Image: "migrate:latest", Order: to.Ptr[int32](1)},
+				"fetch-config": {Image: "fetch-config:latest", Order: to.Ptr[int32](1)},
+			},
+			target:  "$.properties.initContainers.migrate",
+			message: "Order 1 is already used by initContainers.fetch-config.",
+		},
+		{
+			name:     "sidecar with order",
+			sidecars: map[string]datamodel.Container{"proxy": {Image: "proxy:latest", Order: to.Ptr[int32](1)}},
+			target:   "$.properties.sidecars.proxy",
+			message:  "The order can only be set for init containers.",
+		},
+		{
+			name:     "duplicate port name",
+			sidecars: map[string]datamodel.Container{"proxy": {Image: "proxy:latest", Ports: map[string]datamodel.ContainerPort{"web": {ContainerPort: 8080}}}},
+			target:   "$.properties.sidecars.proxy",
+			message:  "Port name \"web\" is already used by another container.",
+		},
+		{
+			name:     "persistent volume not defined by main container",
+			sidecars: map[string]datamodel.Container{"proxy": {Image: "proxy:latest", Volumes: map[string]datamodel.VolumeProperties{"certs": persistentVolume}}},
+			target:   "$.properties.sidecars.proxy",
+			message:  "Persistent volume \"certs\" must also be defined by the main container.",
+		},
+		{
+			name: "invalid resources",
+			sidecars: map[string]datamodel.Container{"proxy": {
+				Image: "proxy:latest",
+				Resources: &datamodel.ContainerResources{
+					Requests: &datamodel.ContainerResourceQuantities{CPU: "2"},
+					Limits:   &datamodel.ContainerResourceQuantities{CPU: "1"},
+				},
+			}},
+			target:  "$.properties.sidecars.proxy.resources",
+			message: "cpu request 2 must be less than or equal to cpu limit 1.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource := &datamodel.ContainerResource{
+				BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{Name: "frontend"}},
+				Properties: datamodel.ContainerProperties{
+					Container: datamodel.Container{
+						Image:   "frontend:latest",
+						Ports:   map[string]datamodel.ContainerPort{"web": {ContainerPort: 3000}},
+						Volumes: map[string]datamodel.VolumeProperties{"data": persistentVolume},
+					},
+					InitContainers: tc.initContainers,
+					Sidecars:       tc.sidecars,
+				},
+			}
+
+			err := validateAdditionalContainers(resource)
+			if tc.message == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  tc.target,
+				Message: tc.message,
+			}, err)
+		})
+	}
+}
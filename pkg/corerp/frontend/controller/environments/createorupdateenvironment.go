@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -27,10 +28,29 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/corerp/frontend/controller/util"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
+	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
 var _ ctrl.Controller = (*CreateOrUpdateEnvironment)(nil)
 
+// recipeResourceTypes is the list of portable resource types which can be provisioned by a recipe.
+var recipeResourceTypes = []string{
+	datamodel.ExtenderResourceType,
+	dapr_ctrl.DaprPubSubBrokersResourceType,
+	dapr_ctrl.DaprSecretStoresResourceType,
+	dapr_ctrl.DaprStateStoresResourceType,
+	ds_ctrl.MongoDatabasesResourceType,
+	ds_ctrl.RedisCachesResourceType,
+	ds_ctrl.SqlDatabasesResourceType,
+	msg_ctrl.RabbitMQQueuesResourceType,
+}
+
 // CreateOrUpdateEnvironments is the controller implementation to create or update environment resource.
 type CreateOrUpdateEnvironment struct {
 	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
@@ -49,7 +69,8 @@ func NewCreateOrUpdateEnvironment(opts ctrl.Options) (ctrl.Controller, error) {
 }
 
 // Run checks if a resource with the same namespace already exists, and if not, updates the resource with the new values.
-// If a resource with the same namespace already exists, a conflict response is returned.
+// If a resource with the same namespace already exists, a conflict response is returned. A conflict response is also
+// returned when the Terraform backend is changed while resources deployed by Terraform recipes exist in the environment.
 func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := e.GetResourceFromRequest(ctx, req)
@@ -69,6 +90,25 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		return rest.NewBadRequestResponse(err.Error()), nil
 	}
 
+	if err := backends.ValidateBackendConfig(newResource.Properties.RecipeConfig.Terraform.Backend); err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	if err := terraform.ValidateProviderMirrorConfig(newResource.Properties.RecipeConfig.Terraform.ProviderMirror); err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	// The Terraform state of the deployed recipes is stored in the backend, so changing the backend would orphan it.
+	if old != nil && !reflect.DeepEqual(old.Properties.RecipeConfig.Terraform.Backend, newResource.Properties.RecipeConfig.Terraform.Backend) {
+		resourceID, err := e.findTerraformRecipeResource(ctx, serviceCtx.ResourceID.PlaneScope(), old.ID)
+		if err != nil {
+			return nil, err
+		}
+		if resourceID != "" {
+			return rest.NewConflictResponse(fmt.Sprintf("The Terraform backend of environment %s cannot be changed while resources deployed by Terraform recipes exist in the environment. Delete resource %s and the other Terraform recipe resources first.", old.ID, resourceID)), nil
+		}
+	}
+
 	// Create Query filter to query kubernetes namespace used by the other environment resources.
 	namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
 	result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.compute.kubernetes.namespace", namespace, e.StorageClient())
@@ -97,3 +137,33 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 
 	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
+
+// findTerraformRecipeResource returns the ID of a resource of the environment deployed by a Terraform recipe, or an
+// empty string if there is none.
+func (e *CreateOrUpdateEnvironment) findTerraformRecipeResource(ctx context.Context, planeScope string, environmentID string) (string, error) {
+	for _, resourceType := range recipeResourceTypes {
+		storageClient, err := e.DataProvider().GetStorageClient(ctx, resourceType)
+		if err != nil {
+			return "", err
+		}
+
+		query := store.Query{
+			RootScope:      planeScope,
+			ScopeRecursive: true,
+			ResourceType:   resourceType,
+			Filters: []store.QueryFilter{
+				{Field: "properties.environment", Value: environmentID},
+				{Field: "properties.status.recipe.templateKind", Value: recipes.TemplateKindTerraform},
+			},
+		}
+		result, err := storageClient.Query(ctx, query)
+		if err != nil {
+			return "", err
+		}
+		if len(result.Items) > 0 {
+			return result.Items[0].ID, nil
+		}
+	}
+
+	return "", nil
+}
@@ -27,6 +27,8 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/store"
 
 	"github.com/google/uuid"
@@ -408,4 +410,139 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 			require.Equal(t, tt.expectedStatusCode, w.Result().StatusCode)
 		})
 	}
+
+	t.Run("invalid-terraform-backend", func(t *testing.T) {
+		envInput, _, _ := getTestModels20231001preview()
+		envInput.Properties.RecipeConfig = &v20231001preview.RecipeConfigProperties{
+			Terraform: &v20231001preview.TerraformConfigProperties{
+				Backend: &v20231001preview.TerraformBackendConfig{
+					Kind:   to.Ptr(v20231001preview.TerraformBackendKindS3),
+					Config: map[string]*string{"bucket": to.Ptr("tfstate")},
+				},
+			},
+		}
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return nil, &store.ErrNotFound{ID: id}
+			})
+
+		ctl, err := NewCreateOrUpdateEnvironment(ctrl.Options{StorageClient: mStorageClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		require.Contains(t, w.Body.String(), `the \"s3\" Terraform backend requires the setting \"region\"`)
+	})
+	t.Run("invalid-terraform-provider-mirror", func(t *testing.T) {
+		envInput, _, _ := getTestModels20231001preview()
+		envInput.Properties.RecipeConfig = &v20231001preview.RecipeConfigProperties{
+			Terraform: &v20231001preview.TerraformConfigProperties{
+				ProviderMirror: &v20231001preview.TerraformProviderMirrorConfig{
+					Kind: to.Ptr(v20231001preview.TerraformProviderMirrorKindFilesystem),
+					Path: to.Ptr("providers"),
+				},
+			},
+		}
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return nil, &store.ErrNotFound{ID: id}
+			})
+
+		ctl, err := NewCreateOrUpdateEnvironment(ctrl.Options{StorageClient: mStorageClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		require.Contains(t, w.Body.String(), `the path of the filesystem provider mirror must be absolute, got \"providers\"`)
+	})
+
+	backendChangeCases := []struct {
+		desc               string
+		recipeResources    []store.Object
+		expectedStatusCode int
+	}{
+		{"terraform-backend-change-without-recipe-resources", nil, 200},
+		{"terraform-backend-change-with-recipe-resources", []store.Object{{Metadata: store.Metadata{ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/extenders/ext"}}}, 409},
+	}
+
+	for _, tt := range backendChangeCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			envInput, envDataModel, _ := getTestModels20231001preview()
+			envInput.Properties.RecipeConfig = &v20231001preview.RecipeConfigProperties{
+				Terraform: &v20231001preview.TerraformConfigProperties{
+					Backend: &v20231001preview.TerraformBackendConfig{
+						Kind:   to.Ptr(v20231001preview.TerraformBackendKindS3),
+						Config: map[string]*string{"bucket": to.Ptr("tfstate"), "region": to.Ptr("us-west-2")},
+					},
+				},
+			}
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+			mStorageClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					return &store.Object{
+						Metadata: store.Metadata{ID: id, ETag: "resource-etag"},
+						Data:     envDataModel,
+					}, nil
+				})
+
+			mDataProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+			mDataProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(mStorageClient, nil).AnyTimes()
+			mStorageClient.
+				EXPECT().
+				Query(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+					if query.ResourceType == envDataModel.Type {
+						return &store.ObjectQueryResult{}, nil
+					}
+
+					require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000", query.RootScope)
+					require.True(t, query.ScopeRecursive)
+					require.Equal(t, "properties.environment", query.Filters[0].Field)
+					require.Equal(t, envDataModel.ID, query.Filters[0].Value)
+					return &store.ObjectQueryResult{Items: tt.recipeResources}, nil
+				}).
+				AnyTimes()
+
+			if tt.expectedStatusCode == 200 {
+				mStorageClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			}
+
+			ctl, err := NewCreateOrUpdateEnvironment(ctrl.Options{StorageClient: mStorageClient, DataProvider: mDataProvider})
+			require.NoError(t, err)
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.expectedStatusCode, w.Result().StatusCode)
+			if tt.expectedStatusCode == 409 {
+				require.Contains(t, w.Body.String(), "cannot be changed while resources deployed by Terraform recipes exist")
+			}
+		})
+	}
 }
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ ctrl.Controller = (*PlanRecipe)(nil)
+
+// PlanRecipe is the controller implementation to preview the changes to the resources a recipe would make if it was deployed.
+type PlanRecipe struct {
+	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	engine.Engine
+}
+
+// NewPlanRecipe creates a new controller for planning the deployment of a recipe registered to an environment.
+func NewPlanRecipe(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &PlanRecipe{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment]{
+				RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+				ResponseConverter: converter.EnvironmentDataModelToVersioned,
+			},
+		),
+		engine,
+	}, nil
+}
+
+// plannedResource is the part of a portable resource needed to plan the deployment of its recipe.
+type plannedResource struct {
+	Properties rpv1.BasicResourceProperties `json:"properties"`
+}
+
+// Run plans the deployment of the recipe for the resource named in the request, in the scope of the environment. If the
+// resource already exists, the plan is computed against the resources previously deployed by its recipe, and returns a
+// response containing the resource changes.
+func (r *PlanRecipe) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	planRequest, err := converter.RecipePlanRequestDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	recipe, exists := resource.Properties.Recipes[planRequest.ResourceType]
+	if exists {
+		_, exists = recipe[planRequest.Name]
+	}
+	if !exists {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("Either recipe with name %q or resource type %q not found on environment with id %q", planRequest.Name, planRequest.ResourceType, serviceCtx.ResourceID)), nil
+	}
+
+	resourceID, err := resources.ParseResource(serviceCtx.ResourceID.RootScope() + "/providers/" + planRequest.ResourceType + "/" + planRequest.ResourceName)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Invalid resource type %q or resource name %q: %s", planRequest.ResourceType, planRequest.ResourceName, err.Error())), nil
+	}
+
+	metadata := recipes.ResourceMetadata{
+		Name:          planRequest.Name,
+		EnvironmentID: resource.ID,
+		ResourceID:    resourceID.String(),
+		Parameters:    planRequest.Parameters,
+	}
+
+	// Plan against the resources deployed for the existing resource, so that resources which are no longer part of the
+	// recipe are reported as deleted.
+	prevState := []string{}
+	obj, err := r.StorageClient().Get(ctx, resourceID.String())
+	if err != nil && !errors.Is(&store.ErrNotFound{ID: resourceID.String()}, err) {
+		return nil, err
+	} else if err == nil {
+		existing := &plannedResource{}
+		if err := obj.As(existing); err != nil {
+			return nil, err
+		}
+		metadata.ApplicationID = existing.Properties.Application
+		for _, outputResource := range existing.Properties.Status.OutputResources {
+			prevState = append(prevState, outputResource.ID.String())
+		}
+	}
+
+	plan, err := r.Engine.Plan(ctx, engine.PlanOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: metadata,
+		},
+		PreviousState: prevState,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &datamodel.RecipePlan{Changes: []datamodel.RecipeResourceChange{}}
+	for _, change := range plan.Changes {
+		ret.Changes = append(ret.Changes, datamodel.RecipeResourceChange{
+			Action:       change.Action,
+			ResourceType: change.ResourceType,
+			Name:         change.Name,
+			ResourceID:   change.ResourceID,
+		})
+	}
+
+	versioned, err := converter.RecipePlanDataModelToVersioned(ret, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	return rest.NewOKResponse(versioned), nil
+}
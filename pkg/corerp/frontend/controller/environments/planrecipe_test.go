@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testPlanEnvironmentID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/environments/env0"
+	testPlanResourceID    = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Datastores/mongoDatabases/mongo0"
+)
+
+func TestPlanRecipeRun_20231001Preview(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	mEngine := engine.NewMockEngine(mctrl)
+	ctx := context.Background()
+
+	planChanges := []recipes.ResourceChange{
+		{
+			Action:       recipes.ResourceChangeCreate,
+			ResourceType: "Microsoft.DocumentDB/databaseAccounts",
+			Name:         "account0",
+			ResourceID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.DocumentDB/databaseAccounts/account0",
+		},
+		{
+			Action:       recipes.ResourceChangeDelete,
+			ResourceType: "Microsoft.DocumentDB/databaseAccounts",
+			Name:         "account1",
+			ResourceID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.DocumentDB/databaseAccounts/account1",
+		},
+	}
+
+	t.Run("plan recipe for new resource", func(t *testing.T) {
+		planInput, envDataModel, expectedOutput := getTestModelsPlanRecipe20231001preview()
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, planInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testPlanEnvironmentID).
+			Return(&store.Object{Metadata: store.Metadata{ID: testPlanEnvironmentID, ETag: "etag"}, Data: envDataModel}, nil)
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testPlanResourceID).
+			Return(nil, &store.ErrNotFound{ID: testPlanResourceID})
+		mEngine.EXPECT().Plan(ctx, engine.PlanOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: recipes.ResourceMetadata{
+					Name:          "mongo-parameters",
+					EnvironmentID: testPlanEnvironmentID,
+					ResourceID:    testPlanResourceID,
+					Parameters:    map[string]any{"mongodbName": "mongo0"},
+				},
+			},
+			PreviousState: []string{},
+		}).Return(&recipes.RecipePlan{Changes: planChanges}, nil)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actualOutput := &v20231001preview.RecipePlanResponse{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, expectedOutput, actualOutput)
+	})
+
+	t.Run("plan recipe for existing resource", func(t *testing.T) {
+		planInput, envDataModel, expectedOutput := getTestModelsPlanRecipe20231001preview()
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, planInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		applicationID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/applications/app0"
+		existing := map[string]any{
+			"id": testPlanResourceID,
+			"properties": map[string]any{
+				"application": applicationID,
+				"environment": testPlanEnvironmentID,
+				"status": map[string]any{
+					"outputResources": []any{
+						map[string]any{"id": planChanges[1].ResourceID},
+					},
+				},
+			},
+		}
+
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testPlanEnvironmentID).
+			Return(&store.Object{Metadata: store.Metadata{ID: testPlanEnvironmentID, ETag: "etag"}, Data: envDataModel}, nil)
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testPlanResourceID).
+			Return(&store.Object{Metadata: store.Metadata{ID: testPlanResourceID, ETag: "etag"}, Data: existing}, nil)
+		mEngine.EXPECT().Plan(ctx, engine.PlanOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: recipes.ResourceMetadata{
+					Name:          "mongo-parameters",
+					ApplicationID: applicationID,
+					EnvironmentID: testPlanEnvironmentID,
+					ResourceID:    testPlanResourceID,
+					Parameters:    map[string]any{"mongodbName": "mongo0"},
+				},
+			},
+			PreviousState: []string{planChanges[1].ResourceID},
+		}).Return(&recipes.RecipePlan{Changes: planChanges}, nil)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actualOutput := &v20231001preview.RecipePlanResponse{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, expectedOutput, actualOutput)
+	})
+
+	t.Run("plan recipe non existing recipe", func(t *testing.T) {
+		planInput, envDataModel, _ := getTestModelsPlanRecipe20231001preview()
+		planInput.Name = to.Ptr("mongodb")
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, planInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testPlanEnvironmentID).
+			Return(&store.Object{Metadata: store.Metadata{ID: testPlanEnvironmentID, ETag: "etag"}, Data: envDataModel}, nil)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 404, w.Result().StatusCode)
+
+		armerr := v1.ErrorResponse{}
+		err = json.Unmarshal(w.Body.Bytes(), &armerr)
+		require.NoError(t, err)
+		require.Equal(t, v1.CodeNotFound, armerr.Error.Code)
+		require.Contains(t, armerr.Error.Message, "Either recipe with name \"mongodb\" or resource type \"Applications.Datastores/mongoDatabases\" not found on environment with id")
+	})
+
+	t.Run("plan recipe engine failure", func(t *testing.T) {
+		planInput, envDataModel, _ := getTestModelsPlanRecipe20231001preview()
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, planInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testPlanEnvironmentID).
+			Return(&store.Object{Metadata: store.Metadata{ID: testPlanEnvironmentID, ETag: "etag"}, Data: envDataModel}, nil)
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testPlanResourceID).
+			Return(nil, &store.ErrNotFound{ID: testPlanResourceID})
+		engineErr := errors.New("could not find driver invalidDriver")
+		mEngine.EXPECT().Plan(ctx, gomock.Any()).Return(nil, engineErr)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+		require.NoError(t, err)
+		_, err = ctl.Run(ctx, w, req)
+		require.Equal(t, engineErr, err)
+	})
+}
@@ -27,6 +27,7 @@ import (
 const testHeaderfile = "requestheaders20231001preview.json"
 const testHeaderfilegetrecipemetadata = "requestheadersgetrecipemetadata20231001preview.json"
 const testHeaderfilegetrecipemetadatanotexisting = "requestheadersgetrecipemetadatanotexisting20231001preview.json"
+const testHeaderfileplanrecipe = "requestheadersplanrecipe20231001preview.json"
 
 func getTestModels20231001preview() (*v20231001preview.EnvironmentResource, *datamodel.Environment, *v20231001preview.EnvironmentResource) {
 	rawInput := testutil.ReadFixture("environment20231001preview_input.json")
@@ -87,3 +88,19 @@ func getTestModelsGetRecipeMetadataForNonExistingRecipe20231001preview() (*v2023
 
 	return envInput, envExistingDataModel
 }
+
+func getTestModelsPlanRecipe20231001preview() (*v20231001preview.RecipePlanRequest, *datamodel.Environment, *v20231001preview.RecipePlanResponse) {
+	rawInput := testutil.ReadFixture("environmentplanrecipe20231001preview_input.json")
+	planInput := &v20231001preview.RecipePlanRequest{}
+	_ = json.Unmarshal(rawInput, planInput)
+
+	rawExistingDataModel := testutil.ReadFixture("environmentgetrecipemetadata20231001preview_datamodel.json")
+	envExistingDataModel := &datamodel.Environment{}
+	_ = json.Unmarshal(rawExistingDataModel, envExistingDataModel)
+
+	rawExpectedOutput := testutil.ReadFixture("environmentplanrecipe20231001preview_output.json")
+	expectedOutput := &v20231001preview.RecipePlanResponse{}
+	_ = json.Unmarshal(rawExpectedOutput, expectedOutput)
+
+	return planInput, envExistingDataModel, expectedOutput
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Renderer is the renderers.Renderer implementation for the horizontalAutoscaling extension.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render checks if the DataModelInterface is a ContainerResource and if so, checks for a HorizontalAutoscaling
+// extension. When present, the replica count of the Deployment is left to the autoscaler and a
+// HorizontalPodAutoscaler targeting the Deployment is added to the output resources.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	var extension *datamodel.HorizontalAutoscalingExtension
+	for _, e := range resource.Properties.Extensions {
+		if e.Kind == datamodel.HorizontalAutoscaling && e.HorizontalAutoscaling != nil {
+			extension = e.HorizontalAutoscaling
+			break
+		}
+	}
+
+	if extension == nil {
+		return output, nil
+	}
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	if deployment == nil {
+		return renderers.RendererOutput{}, errors.New("horizontalAutoscaling extension requires a Kubernetes Deployment to scale")
+	}
+
+	// The autoscaler owns the replica count. Leaving it unset avoids fighting with the autoscaler
+	// every time the Deployment is applied.
+	deployment.Spec.Replicas = nil
+
+	hpa := makeHorizontalPodAutoscaler(deployment, extension)
+	or := rpv1.NewKubernetesOutputResource(rpv1.LocalIDHorizontalPodAutoscaler, hpa, hpa.ObjectMeta)
+	or.CreateResource.Dependencies = []string{rpv1.LocalIDDeployment}
+	output.Resources = append(output.Resources, or)
+
+	return output, nil
+}
+
+// makeHorizontalPodAutoscaler creates a HorizontalPodAutoscaler that scales the given Deployment.
+func makeHorizontalPodAutoscaler(deployment *appsv1.Deployment, extension *datamodel.HorizontalAutoscalingExtension) *autoscalingv2.HorizontalPodAutoscaler {
+	metrics := []autoscalingv2.MetricSpec{}
+	if extension.TargetCPUUtilization != nil {
+		metrics = append(metrics, makeUtilizationMetric(corev1.ResourceCPU, *extension.TargetCPUUtilization))
+	}
+	if extension.TargetMemoryUtilization != nil {
+		metrics = append(metrics, makeUtilizationMetric(corev1.ResourceMemory, *extension.TargetMemoryUtilization))
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       resources_kubernetes.KindHorizontalPodAutoscaler,
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployment.Name,
+			Namespace: deployment.Namespace,
+			Labels:    maps.Clone(deployment.Labels),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       resources_kubernetes.KindDeployment,
+				Name:       deployment.Name,
+			},
+			MinReplicas: extension.MinReplicas,
+			MaxReplicas: extension.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
+
+// makeUtilizationMetric creates a metric that targets the average utilization of the given resource.
+func makeUtilizationMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &utilization,
+			},
+		},
+	}
+}
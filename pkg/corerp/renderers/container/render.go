@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/url"
 	"sort"
@@ -27,6 +28,7 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -43,6 +45,7 @@ import (
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
 
@@ -109,6 +112,28 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 		}
 	}
 
+	containers := []datamodel.Container{properties.Container}
+	for _, c := range properties.InitContainers {
+		containers = append(containers, c)
+	}
+	for _, c := range properties.Sidecars {
+		containers = append(containers, c)
+	}
+
+	for _, c := range containers {
+		for name, ref := range c.EnvValueFrom {
+			if ref.SecretRef == nil {
+				continue
+			}
+
+			resourceID, err := resources.ParseResource(ref.SecretRef.Source)
+			if err != nil {
+				return nil, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid secret store source for environment variable %s: %s", name, ref.SecretRef.Source))
+			}
+			radiusResourceIDs = append(radiusResourceIDs, resourceID)
+		}
+	}
+
 	for _, volume := range properties.Container.Volumes {
 		switch volume.Kind {
 		case datamodel.Persistent:
@@ -191,6 +216,22 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 		needsServiceGeneration = true
 	}
 
+	// Ports of sidecars are exposed by the same service as the ports of the main container.
+	for _, sidecar := range properties.Sidecars {
+		for portName, port := range sidecar.Ports {
+			if port.ContainerPort == 0 {
+				return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid ports definition: must define a ContainerPort, but ContainerPort is: %d.", port.ContainerPort))
+			}
+
+			if port.Port == 0 {
+				port.Port = port.ContainerPort
+				sidecar.Ports[portName] = port
+			}
+
+			needsServiceGeneration = true
+		}
+	}
+
 	dependencies := options.Dependencies
 
 	// Connections might require a role assignment to grant access.
@@ -237,15 +278,22 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 
 	// If the container has an exposed port and uses DNS-SD, generate a service for it.
 	if needsServiceGeneration {
-		for portName, port := range resource.Properties.Container.Ports {
-			// store portNames and portValues for use in service generation.
-			servicePort := corev1.ServicePort{
-				Name:       portName,
-				Port:       port.Port,
-				TargetPort: intstr.FromInt(int(port.ContainerPort)),
-				Protocol:   corev1.ProtocolTCP,
+		containerPorts := []map[string]datamodel.ContainerPort{resource.Properties.Container.Ports}
+		for _, name := range getSortedContainerNames(resource.Properties.Sidecars) {
+			containerPorts = append(containerPorts, resource.Properties.Sidecars[name].Ports)
+		}
+
+		for _, ports := range containerPorts {
+			for portName, port := range ports {
+				// store portNames and portValues for use in service generation.
+				servicePort := corev1.ServicePort{
+					Name:       portName,
+					Port:       port.Port,
+					TargetPort: intstr.FromInt(int(port.ContainerPort)),
+					Protocol:   corev1.ProtocolTCP,
+				}
+				servicePorts = append(servicePorts, servicePort)
 			}
-			servicePorts = append(servicePorts, servicePort)
 		}
 
 		// if a container has an exposed port, then we need to create a service for it.
@@ -320,55 +368,24 @@ func (r Renderer) makeDeployment(
 		}
 	}
 
-	ports := []corev1.ContainerPort{}
-	for _, port := range properties.Container.Ports {
-		ports = append(ports, corev1.ContainerPort{
-			ContainerPort: port.ContainerPort,
-			Protocol:      corev1.ProtocolTCP,
-		})
-	}
-
-	container.Image = properties.Container.Image
-	container.Ports = append(container.Ports, ports...)
-	container.Command = properties.Container.Command
-	container.Args = properties.Container.Args
-	container.WorkingDir = properties.Container.WorkingDir
-
-	// If the user has specified an image pull policy, use it. Else, we will use Kubernetes default.
-	if properties.Container.ImagePullPolicy != "" {
-		container.ImagePullPolicy = corev1.PullPolicy(properties.Container.ImagePullPolicy)
-	}
-
-	var err error
-	if !properties.Container.ReadinessProbe.IsEmpty() {
-		container.ReadinessProbe, err = r.makeHealthProbe(properties.Container.ReadinessProbe)
-		if err != nil {
-			return []rpv1.OutputResource{}, nil, fmt.Errorf("readiness probe encountered errors: %w ", err)
-		}
-	}
-	if !properties.Container.LivenessProbe.IsEmpty() {
-		container.LivenessProbe, err = r.makeHealthProbe(properties.Container.LivenessProbe)
-		if err != nil {
-			return []rpv1.OutputResource{}, nil, fmt.Errorf("liveness probe encountered errors: %w ", err)
-		}
+	err := r.setContainerSpec(container, properties.Container)
+	if err != nil {
+		return []rpv1.OutputResource{}, nil, err
 	}
 
 	// We build the environment variable list in a stable order for testability
 	// For the values that come from connections we back them with secretData. We'll extract the values
 	// and return them.
-	env, secretData, err := getEnvVarsAndSecretData(resource, dependencies)
+	connectionEnv, secretData, err := getEnvVarsAndSecretData(resource, dependencies)
 	if err != nil {
 		return []rpv1.OutputResource{}, nil, fmt.Errorf("failed to obtain environment variables and secret data: %w", err)
 	}
 
-	for k, v := range properties.Container.Env {
-		env[k] = corev1.EnvVar{Name: k, Value: v}
-	}
-
-	// Append in sorted order
-	for _, key := range getSortedKeys(env) {
-		container.Env = append(container.Env, env[key])
+	env, err := makeContainerEnv(resource, properties.Container, "", connectionEnv, dependencies, deployment.Namespace, secretData)
+	if err != nil {
+		return []rpv1.OutputResource{}, nil, err
 	}
+	container.Env = append(container.Env, env...)
 
 	outputResources := []rpv1.OutputResource{}
 	deps := []string{}
@@ -571,6 +588,30 @@ func (r Renderer) makeDeployment(
 		MatchLabels: kubernetes.MakeSelectorLabels(applicationName, resource.Name),
 	})
 
+	// Init containers and sidecars share the connections and the volumes of the main container.
+	sharedVolumes := map[string]bool{}
+	for _, v := range volumes {
+		sharedVolumes[v.Name] = true
+	}
+
+	for _, name := range getOrderedInitContainerNames(properties.InitContainers) {
+		base := findContainer(podSpec.InitContainers, kubernetes.NormalizeResourceName(name))
+		c, err := r.makeAdditionalContainer(base, properties.InitContainers[name], resource, connectionEnv, dependencies, deployment.Namespace, secretData, sharedVolumes, &volumes)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, fmt.Errorf("init container %s encountered errors: %w", name, err)
+		}
+		podSpec.InitContainers = upsertContainer(podSpec.InitContainers, c)
+	}
+
+	for _, name := range getSortedContainerNames(properties.Sidecars) {
+		base := findContainer(podSpec.Containers, kubernetes.NormalizeResourceName(name))
+		c, err := r.makeAdditionalContainer(base, properties.Sidecars[name], resource, connectionEnv, dependencies, deployment.Namespace, secretData, sharedVolumes, &volumes)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, fmt.Errorf("sidecar %s encountered errors: %w", name, err)
+		}
+		podSpec.Containers = upsertContainer(podSpec.Containers, c)
+	}
+
 	podSpec.Volumes = append(podSpec.Volumes, volumes...)
 
 	// See: https://github.com/kubernetes/kubernetes/issues/92226 and
@@ -616,6 +657,197 @@ func (r Renderer) makeDeployment(
 	return outputResources, secretData, nil
 }
 
+// setContainerSpec applies the image, ports, command, resources and health probes of the container definition to the
+// Kubernetes container spec.
+func (r Renderer) setContainerSpec(container *corev1.Container, properties datamodel.Container) error {
+	ports := []corev1.ContainerPort{}
+	for _, port := range properties.Ports {
+		ports = append(ports, corev1.ContainerPort{
+			ContainerPort: port.ContainerPort,
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
+
+	container.Image = properties.Image
+	container.Ports = append(container.Ports, ports...)
+	container.Command = properties.Command
+	container.Args = properties.Args
+	container.WorkingDir = properties.WorkingDir
+
+	// If the user has specified an image pull policy, use it. Else, we will use Kubernetes default.
+	if properties.ImagePullPolicy != "" {
+		container.ImagePullPolicy = corev1.PullPolicy(properties.ImagePullPolicy)
+	}
+
+	var err error
+	if properties.Resources != nil {
+		container.Resources, err = makeResourceRequirements(container.Resources, properties.Resources)
+		if err != nil {
+			return fmt.Errorf("resources encountered errors: %w", err)
+		}
+	}
+
+	if !properties.ReadinessProbe.IsEmpty() {
+		container.ReadinessProbe, err = r.makeHealthProbe(properties.ReadinessProbe)
+		if err != nil {
+			return fmt.Errorf("readiness probe encountered errors: %w ", err)
+		}
+	}
+	if !properties.LivenessProbe.IsEmpty() {
+		container.LivenessProbe, err = r.makeHealthProbe(properties.LivenessProbe)
+		if err != nil {
+			return fmt.Errorf("liveness probe encountered errors: %w ", err)
+		}
+	}
+
+	return nil
+}
+
+// makeContainerEnv builds the environment variables of a container in a stable order from the connections, the env
+// and the envValueFrom of the container definition.
+func makeContainerEnv(resource *datamodel.ContainerResource, properties datamodel.Container, keyPrefix string, connectionEnv map[string]corev1.EnvVar, dependencies map[string]renderers.RendererDependency, namespace string, secretData map[string][]byte) ([]corev1.EnvVar, error) {
+	env := maps.Clone(connectionEnv)
+	for k, v := range properties.Env {
+		env[k] = corev1.EnvVar{Name: k, Value: v}
+	}
+
+	referencedEnv, err := getEnvVarsFromReferences(resource, properties.EnvValueFrom, keyPrefix, dependencies, namespace, secretData)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range referencedEnv {
+		env[k] = v
+	}
+
+	// Append in sorted order
+	result := []corev1.EnvVar{}
+	for _, key := range getSortedKeys(env) {
+		result = append(result, env[key])
+	}
+	return result, nil
+}
+
+// makeAdditionalContainer applies the definition of an init container or a sidecar to the given Kubernetes container,
+// which is either defined by the base manifest or only has a name. A volume with the name of a volume already in the
+// pod is shared, while new ephemeral volumes are added to the pod volumes. Values of connections referenced by
+// envValueFrom are stored in the secret of the main container under the container name prefix.
+func (r Renderer) makeAdditionalContainer(
+	container corev1.Container,
+	properties datamodel.Container,
+	resource *datamodel.ContainerResource,
+	connectionEnv map[string]corev1.EnvVar,
+	dependencies map[string]renderers.RendererDependency,
+	namespace string,
+	secretData map[string][]byte,
+	sharedVolumes map[string]bool,
+	volumes *[]corev1.Volume) (corev1.Container, error) {
+
+	if err := r.setContainerSpec(&container, properties); err != nil {
+		return corev1.Container{}, err
+	}
+
+	env, err := makeContainerEnv(resource, properties, container.Name+".", connectionEnv, dependencies, namespace, secretData)
+	if err != nil {
+		return corev1.Container{}, err
+	}
+	container.Env = append(container.Env, env...)
+
+	for _, volumeName := range getSortedVolumeNames(properties.Volumes) {
+		volumeProperties := properties.Volumes[volumeName]
+		switch volumeProperties.Kind {
+		case datamodel.Ephemeral:
+			volumeSpec, volumeMountSpec, err := makeEphemeralVolume(volumeName, volumeProperties.Ephemeral)
+			if err != nil {
+				return corev1.Container{}, fmt.Errorf("unable to create ephemeral volume spec for volume: %s - %w", volumeName, err)
+			}
+			container.VolumeMounts = append(container.VolumeMounts, volumeMountSpec)
+			if !sharedVolumes[volumeName] {
+				sharedVolumes[volumeName] = true
+				*volumes = append(*volumes, volumeSpec)
+			}
+		case datamodel.Persistent:
+			// Persistent volumes need role assignments and identities that are only created for the main container.
+			if !sharedVolumes[volumeName] {
+				return corev1.Container{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("persistent volume %s must also be defined by the main container", volumeName))
+			}
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: volumeName, MountPath: volumeProperties.Persistent.MountPath})
+		default:
+			return corev1.Container{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("Only ephemeral or persistent volumes are supported. Got kind: %v", volumeProperties.Kind))
+		}
+	}
+
+	return container, nil
+}
+
+// findContainer returns a copy of the container with the given name in the list, or a new container with the name.
+func findContainer(containers []corev1.Container, name string) corev1.Container {
+	for _, c := range containers {
+		if strings.EqualFold(c.Name, name) {
+			return *c.DeepCopy()
+		}
+	}
+	return corev1.Container{Name: name}
+}
+
+// upsertContainer replaces the container with the same name in the list, such as a container defined by the base
+// manifest, or appends it to the list.
+func upsertContainer(containers []corev1.Container, container corev1.Container) []corev1.Container {
+	for i, c := range containers {
+		if strings.EqualFold(c.Name, container.Name) {
+			containers[i] = container
+			return containers
+		}
+	}
+	return append(containers, container)
+}
+
+// makeResourceRequirements applies the compute resource requests and limits of the container to the resource
+// requirements of the base container spec. The values which are not specified in the container keep the base values.
+func makeResourceRequirements(base corev1.ResourceRequirements, res *datamodel.ContainerResources) (corev1.ResourceRequirements, error) {
+	requirements := *base.DeepCopy()
+
+	var err error
+	requirements.Requests, err = applyResourceQuantities(requirements.Requests, res.Requests)
+	if err != nil {
+		return corev1.ResourceRequirements{}, err
+	}
+
+	requirements.Limits, err = applyResourceQuantities(requirements.Limits, res.Limits)
+	if err != nil {
+		return corev1.ResourceRequirements{}, err
+	}
+
+	return requirements, nil
+}
+
+func applyResourceQuantities(list corev1.ResourceList, q *datamodel.ContainerResourceQuantities) (corev1.ResourceList, error) {
+	if q == nil {
+		return list, nil
+	}
+
+	for _, r := range []struct {
+		name  corev1.ResourceName
+		value string
+	}{{corev1.ResourceCPU, q.CPU}, {corev1.ResourceMemory, q.Memory}} {
+		name, value := r.name, r.value
+		if value == "" {
+			continue
+		}
+
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s quantity %q: %w", name, value, err)
+		}
+
+		if list == nil {
+			list = corev1.ResourceList{}
+		}
+		list[name] = quantity
+	}
+
+	return list, nil
+}
+
 func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, dependencies map[string]renderers.RendererDependency) (map[string]corev1.EnvVar, map[string][]byte, error) {
 	env := map[string]corev1.EnvVar{}
 	secretData := map[string][]byte{}
@@ -669,7 +901,7 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, dependencies
 					secretData[name] = []byte(v)
 					env[name] = corev1.EnvVar{Name: name, ValueFrom: &source}
 				case float64:
-					secretData[name] = []byte(strconv.Itoa(int(v)))
+					secretData[name] = []byte(strconv.FormatFloat(v, 'f', -1, 64))
 					env[name] = corev1.EnvVar{Name: name, ValueFrom: &source}
 				case int:
 					secretData[name] = []byte(strconv.Itoa(v))
@@ -682,6 +914,99 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, dependencies
 	return env, secretData, nil
 }
 
+// getEnvVarsFromReferences creates the environment variables defined in envValueFrom. Values of secret store keys are
+// referenced from the Kubernetes secret of the secret store, while output values of connections are stored in the
+// container's own secret data under the key prefix and the variable name, and referenced from there.
+func getEnvVarsFromReferences(resource *datamodel.ContainerResource, refs map[string]datamodel.EnvironmentVariableReference, keyPrefix string, dependencies map[string]renderers.RendererDependency, namespace string, secretData map[string][]byte) (map[string]corev1.EnvVar, error) {
+	env := map[string]corev1.EnvVar{}
+	properties := resource.Properties
+
+	for name, ref := range refs {
+		switch {
+		case ref.SecretRef != nil:
+			selector, err := getSecretStoreKeySelector(ref.SecretRef, dependencies, namespace)
+			if err != nil {
+				return nil, err
+			}
+			env[name] = corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: selector}}
+
+		case ref.ConnectionRef != nil:
+			connection, ok := properties.Connections[ref.ConnectionRef.Name]
+			if !ok {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("connection %q referenced by environment variable %s not found", ref.ConnectionRef.Name, name))
+			}
+
+			dependency, ok := dependencies[connection.Source]
+			if !ok {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("resource %q of connection %q not found", connection.Source, ref.ConnectionRef.Name))
+			}
+
+			var value []byte
+			switch v := dependency.ComputedValues[ref.ConnectionRef.Property].(type) {
+			case string:
+				value = []byte(v)
+			case float64:
+				value = []byte(strconv.FormatFloat(v, 'f', -1, 64))
+			case int:
+				value = []byte(strconv.Itoa(v))
+			default:
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("connection %q does not have a value for property %q referenced by environment variable %s", ref.ConnectionRef.Name, ref.ConnectionRef.Property, name))
+			}
+
+			key := keyPrefix + name
+			secretData[key] = value
+			env[name] = corev1.EnvVar{
+				Name: name,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: kubernetes.NormalizeResourceName(resource.Name),
+						},
+						Key: key,
+					},
+				},
+			}
+		}
+	}
+
+	return env, nil
+}
+
+// getSecretStoreKeySelector returns the selector of a key of the Kubernetes secret that backs the referenced secret store.
+func getSecretStoreKeySelector(ref *datamodel.EnvironmentVariableSecretReference, dependencies map[string]renderers.RendererDependency, namespace string) (*corev1.SecretKeySelector, error) {
+	dependency, ok := dependencies[ref.Source]
+	if !ok {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", ref.Source))
+	}
+
+	secretStore, ok := dependency.Resource.(*datamodel.SecretStore)
+	if !ok {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("%s is not a secretStore resource", ref.Source))
+	}
+
+	if secretStore.Properties == nil || secretStore.Properties.Data[ref.Key] == nil {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s does not have key %q", ref.Source, ref.Key))
+	}
+
+	secretResourceID, ok := dependency.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s is not backed by a Kubernetes secret", ref.Source))
+	}
+
+	// Kubernetes can only reference secrets from the namespace of the pod.
+	secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
+	if !strings.EqualFold(secretNamespace, namespace) {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s must be in the namespace %q of the container, but its secret is in %q", ref.Source, namespace, secretNamespace))
+	}
+
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: secretResourceID.Name(),
+		},
+		Key: ref.Key,
+	}, nil
+}
+
 func (r Renderer) makeHealthProbe(p datamodel.HealthProbeProperties) (*corev1.Probe, error) {
 	probeSpec := corev1.Probe{}
 
@@ -854,6 +1179,34 @@ func (r Renderer) makeRoleAssignmentsForResource(connection *datamodel.Connectio
 	return outputResources, nil
 }
 
+func getSortedContainerNames(containers map[string]datamodel.Container) []string {
+	names := []string{}
+	for name := range containers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getOrderedInitContainerNames returns the names of the init containers in the order they run, which is the ascending
+// order of their order property. The validation requires an order for each init container when there are more than one.
+func getOrderedInitContainerNames(containers map[string]datamodel.Container) []string {
+	names := getSortedContainerNames(containers)
+	sort.SliceStable(names, func(i, j int) bool {
+		return to.Int32(containers[names[i]].Order) < to.Int32(containers[names[j]].Order)
+	})
+	return names
+}
+
+func getSortedVolumeNames(volumes map[string]datamodel.VolumeProperties) []string {
+	names := []string{}
+	for name := range volumes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func getSortedKeys(env map[string]corev1.EnvVar) []string {
 	keys := []string{}
 	for k := range env {
@@ -41,6 +41,7 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -1637,6 +1638,382 @@ func Test_Render_ImagePullPolicySpecified(t *testing.T) {
 	})
 }
 
+func Test_Render_Resources(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Resources: &datamodel.ContainerResources{
+				Requests: &datamodel.ContainerResourceQuantities{CPU: "250m", Memory: "128Mi"},
+				Limits:   &datamodel.ContainerResourceQuantities{Memory: "512Mi"},
+			},
+		},
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Len(t, deployment.Spec.Template.Spec.Containers, 1)
+
+	expected := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    k8sresource.MustParse("250m"),
+			corev1.ResourceMemory: k8sresource.MustParse("128Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: k8sresource.MustParse("512Mi"),
+		},
+	}
+	require.Equal(t, expected, deployment.Spec.Template.Spec.Containers[0].Resources)
+}
+
+func Test_Render_EnvValueFrom(t *testing.T) {
+	secretStoreID := makeRadiusResourceID(t, "Applications.Core/secretStores", "secret").String()
+	redisID := makeRadiusResourceID(t, "Applications.Datastores/redisCaches", "redis").String()
+
+	makeProperties := func(property string) datamodel.ContainerProperties {
+		return datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: applicationResourceID,
+			},
+			Connections: map[string]datamodel.ConnectionProperties{
+				"redis": {
+					Source:                redisID,
+					DisableDefaultEnvVars: to.Ptr(true),
+				},
+			},
+			Container: datamodel.Container{
+				Image: "someimage:latest",
+				EnvValueFrom: map[string]datamodel.EnvironmentVariableReference{
+					"DB_PASSWORD": {
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID, Key: "password"},
+					},
+					"REDIS_HOST": {
+						ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "redis", Property: property},
+					},
+				},
+			},
+		}
+	}
+
+	makeDependencies := func(secretNamespace string) map[string]renderers.RendererDependency {
+		return map[string]renderers.RendererDependency{
+			secretStoreID: {
+				ResourceID: resources.MustParse(secretStoreID),
+				Resource: &datamodel.SecretStore{
+					Properties: &datamodel.SecretStoreProperties{
+						Type: datamodel.SecretTypeGeneric,
+						Data: map[string]*datamodel.SecretStoreDataValue{
+							"password": {},
+						},
+					},
+				},
+				OutputResources: map[string]resources.ID{
+					rpv1.LocalIDSecret: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", secretNamespace, "secret-store"),
+				},
+			},
+			redisID: {
+				ResourceID: resources.MustParse(redisID),
+				ComputedValues: map[string]any{
+					"host":   "redis.default.svc",
+					"weight": float64(0.25),
+					"size":   float64(6379),
+				},
+			},
+		}
+	}
+
+	t.Run("GetDependencyIDs", func(t *testing.T) {
+		radiusResourceIDs, _, err := Renderer{}.GetDependencyIDs(testcontext.New(t), makeResource(makeProperties("host")))
+		require.NoError(t, err)
+		require.ElementsMatch(t, []resources.ID{resources.MustParse(redisID), resources.MustParse(secretStoreID)}, radiusResourceIDs)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		options := renderers.RenderOptions{Dependencies: makeDependencies("default"), Environment: renderers.EnvironmentOptions{Namespace: "default"}}
+		output, err := Renderer{}.Render(testcontext.New(t), makeResource(makeProperties("host")), options)
+		require.NoError(t, err)
+
+		deployment, _ := kubernetes.FindDeployment(output.Resources)
+		require.NotNil(t, deployment)
+
+		expectedEnv := []corev1.EnvVar{
+			{
+				Name: "DB_PASSWORD",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret-store"},
+						Key:                  "password",
+					},
+				},
+			},
+			{
+				Name: "REDIS_HOST",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+						Key:                  "REDIS_HOST",
+					},
+				},
+			},
+		}
+		require.Equal(t, expectedEnv, deployment.Spec.Template.Spec.Containers[0].Env)
+
+		secret, _ := kubernetes.FindSecret(output.Resources)
+		require.NotNil(t, secret)
+		require.Equal(t, map[string][]byte{"REDIS_HOST": []byte("redis.default.svc")}, secret.Data)
+	})
+
+	t.Run("numeric connection values", func(t *testing.T) {
+		for property, expected := range map[string]string{"weight": "0.25", "size": "6379"} {
+			options := renderers.RenderOptions{Dependencies: makeDependencies("default"), Environment: renderers.EnvironmentOptions{Namespace: "default"}}
+			output, err := Renderer{}.Render(testcontext.New(t), makeResource(makeProperties(property)), options)
+			require.NoError(t, err)
+
+			secret, _ := kubernetes.FindSecret(output.Resources)
+			require.NotNil(t, secret)
+			require.Equal(t, map[string][]byte{"REDIS_HOST": []byte(expected)}, secret.Data)
+		}
+	})
+
+	t.Run("secret store in another namespace", func(t *testing.T) {
+		options := renderers.RenderOptions{Dependencies: makeDependencies("other"), Environment: renderers.EnvironmentOptions{Namespace: "default"}}
+		_, err := Renderer{}.Render(testcontext.New(t), makeResource(makeProperties("host")), options)
+		require.Equal(t, apiv1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s must be in the namespace \"default\" of the container, but its secret is in \"other\"", secretStoreID)), err)
+	})
+
+	t.Run("missing connection value", func(t *testing.T) {
+		options := renderers.RenderOptions{Dependencies: makeDependencies("default"), Environment: renderers.EnvironmentOptions{Namespace: "default"}}
+		_, err := Renderer{}.Render(testcontext.New(t), makeResource(makeProperties("port")), options)
+		require.Equal(t, apiv1.NewClientErrInvalidRequest("connection \"redis\" does not have a value for property \"port\" referenced by environment variable REDIS_HOST"), err)
+	})
+}
+
+func Test_Render_InitContainersAndSidecars(t *testing.T) {
+	secretStoreID := makeRadiusResourceID(t, "Applications.Core/secretStores", "secret").String()
+	redisID := makeRadiusResourceID(t, "Applications.Datastores/redisCaches", "redis").String()
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"redis": {
+				Source:                redisID,
+				DisableDefaultEnvVars: to.Ptr(true),
+			},
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Ports: map[string]datamodel.ContainerPort{
+				"web": {ContainerPort: 3000},
+			},
+			Volumes: map[string]datamodel.VolumeProperties{
+				"data": {
+					Kind: datamodel.Ephemeral,
+					Ephemeral: &datamodel.EphemeralVolume{
+						VolumeBase:   datamodel.VolumeBase{MountPath: "/data"},
+						ManagedStore: datamodel.ManagedStoreDisk,
+					},
+				},
+			},
+		},
+		InitContainers: map[string]datamodel.Container{
+			"migrate": {
+				Image:   "migrate:latest",
+				Command: []string{"/bin/migrate"},
+				Env:     map[string]string{"MODE": "up"},
+				EnvValueFrom: map[string]datamodel.EnvironmentVariableReference{
+					"DB_PASSWORD": {
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID, Key: "password"},
+					},
+				},
+				Volumes: map[string]datamodel.VolumeProperties{
+					"data": {
+						Kind: datamodel.Ephemeral,
+						Ephemeral: &datamodel.EphemeralVolume{
+							VolumeBase:   datamodel.VolumeBase{MountPath: "/migrations"},
+							ManagedStore: datamodel.ManagedStoreDisk,
+						},
+					},
+				},
+			},
+		},
+		Sidecars: map[string]datamodel.Container{
+			"proxy": {
+				Image: "proxy:latest",
+				Ports: map[string]datamodel.ContainerPort{
+					"proxy": {ContainerPort: 8080},
+				},
+				EnvValueFrom: map[string]datamodel.EnvironmentVariableReference{
+					"REDIS_HOST": {
+						ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "redis", Property: "host"},
+					},
+				},
+				Volumes: map[string]datamodel.VolumeProperties{
+					"cache": {
+						Kind: datamodel.Ephemeral,
+						Ephemeral: &datamodel.EphemeralVolume{
+							VolumeBase:   datamodel.VolumeBase{MountPath: "/cache"},
+							ManagedStore: datamodel.ManagedStoreMemory,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	dependencies := map[string]renderers.RendererDependency{
+		secretStoreID: {
+			ResourceID: resources.MustParse(secretStoreID),
+			Resource: &datamodel.SecretStore{
+				Properties: &datamodel.SecretStoreProperties{
+					Type: datamodel.SecretTypeGeneric,
+					Data: map[string]*datamodel.SecretStoreDataValue{
+						"password": {},
+					},
+				},
+			},
+			OutputResources: map[string]resources.ID{
+				rpv1.LocalIDSecret: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", "default", "secret-store"),
+			},
+		},
+		redisID: {
+			ResourceID: resources.MustParse(redisID),
+			ComputedValues: map[string]any{
+				"host": "redis.default.svc",
+			},
+		},
+	}
+
+	t.Run("GetDependencyIDs", func(t *testing.T) {
+		radiusResourceIDs, _, err := Renderer{}.GetDependencyIDs(testcontext.New(t), makeResource(properties))
+		require.NoError(t, err)
+		require.ElementsMatch(t, []resources.ID{resources.MustParse(redisID), resources.MustParse(secretStoreID)}, radiusResourceIDs)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		options := renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}}
+		output, err := Renderer{}.Render(testcontext.New(t), makeResource(properties), options)
+		require.NoError(t, err)
+
+		deployment, _ := kubernetes.FindDeployment(output.Resources)
+		require.NotNil(t, deployment)
+		podSpec := deployment.Spec.Template.Spec
+
+		require.Len(t, podSpec.InitContainers, 1)
+		migrate := podSpec.InitContainers[0]
+		require.Equal(t, "migrate", migrate.Name)
+		require.Equal(t, "migrate:latest", migrate.Image)
+		require.Equal(t, []string{"/bin/migrate"}, migrate.Command)
+		require.Equal(t, []corev1.EnvVar{
+			{
+				Name: "DB_PASSWORD",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret-store"},
+						Key:                  "password",
+					},
+				},
+			},
+			{Name: "MODE", Value: "up"},
+		}, migrate.Env)
+		require.Equal(t, []corev1.VolumeMount{{Name: "data", MountPath: "/migrations"}}, migrate.VolumeMounts)
+
+		require.Len(t, podSpec.Containers, 2)
+		require.Equal(t, resourceName, podSpec.Containers[0].Name)
+		proxy := podSpec.Containers[1]
+		require.Equal(t, "proxy", proxy.Name)
+		require.Equal(t, "proxy:latest", proxy.Image)
+		require.Equal(t, []corev1.ContainerPort{{ContainerPort: 8080, Protocol: corev1.ProtocolTCP}}, proxy.Ports)
+		require.Equal(t, []corev1.EnvVar{
+			{
+				Name: "REDIS_HOST",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+						Key:                  "proxy.REDIS_HOST",
+					},
+				},
+			},
+		}, proxy.Env)
+		require.Equal(t, []corev1.VolumeMount{{Name: "cache", MountPath: "/cache"}}, proxy.VolumeMounts)
+
+		volumeNames := []string{}
+		for _, v := range podSpec.Volumes {
+			volumeNames = append(volumeNames, v.Name)
+		}
+		require.ElementsMatch(t, []string{"data", "cache"}, volumeNames)
+
+		secret, _ := kubernetes.FindSecret(output.Resources)
+		require.NotNil(t, secret)
+		require.Equal(t, map[string][]byte{"proxy.REDIS_HOST": []byte("redis.default.svc")}, secret.Data)
+
+		service, _ := kubernetes.FindService(output.Resources)
+		require.NotNil(t, service)
+		servicePortNames := []string{}
+		for _, p := range service.Spec.Ports {
+			servicePortNames = append(servicePortNames, p.Name)
+		}
+		require.Equal(t, []string{"web", "proxy"}, servicePortNames)
+	})
+
+	t.Run("persistent volume not defined by the main container", func(t *testing.T) {
+		invalid := properties
+		invalid.Sidecars = map[string]datamodel.Container{
+			"proxy": {
+				Image: "proxy:latest",
+				Volumes: map[string]datamodel.VolumeProperties{
+					"certs": {
+						Kind: datamodel.Persistent,
+						Persistent: &datamodel.PersistentVolume{
+							VolumeBase: datamodel.VolumeBase{MountPath: "/certs"},
+							Source:     makeRadiusResourceID(t, "Applications.Core/volumes", "certs").String(),
+						},
+					},
+				},
+			},
+		}
+
+		options := renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}}
+		_, err := Renderer{}.Render(testcontext.New(t), makeResource(invalid), options)
+		clientErr := &apiv1.ErrClientRP{}
+		require.ErrorAs(t, err, &clientErr)
+		require.Equal(t, "persistent volume certs must also be defined by the main container", clientErr.Message)
+	})
+}
+
+func Test_MakeResourceRequirements(t *testing.T) {
+	base := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    k8sresource.MustParse("1"),
+			corev1.ResourceMemory: k8sresource.MustParse("1Gi"),
+		},
+	}
+
+	requirements, err := makeResourceRequirements(base, &datamodel.ContainerResources{
+		Limits: &datamodel.ContainerResourceQuantities{CPU: "2"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, k8sresource.MustParse("2"), requirements.Limits[corev1.ResourceCPU])
+	require.Equal(t, k8sresource.MustParse("1Gi"), requirements.Limits[corev1.ResourceMemory])
+
+	// The base requirements must not be modified.
+	require.Equal(t, k8sresource.MustParse("1"), base.Limits[corev1.ResourceCPU])
+
+	_, err = makeResourceRequirements(base, &datamodel.ContainerResources{
+		Requests: &datamodel.ContainerResourceQuantities{CPU: "invalid"},
+	})
+	require.Error(t, err)
+}
+
 func Test_Render_StrategicPatchMerge(t *testing.T) {
 	const containerPatchObject = `
 {
@@ -1832,3 +2209,15 @@ func getAppSetup() *setupMaps {
 type setupMaps struct {
 	appKubeMetadataExt *datamodel.KubeMetadataExtension
 }
+
+func Test_GetOrderedInitContainerNames(t *testing.T) {
+	containers := map[string]datamodel.Container{
+		"migrate":      {Image: "migrate:latest", Order: to.Ptr[int32](2)},
+		"wait-for-db":  {Image: "wait:latest", Order: to.Ptr[int32](0)},
+		"fetch-config": {Image: "fetch-config:latest", Order: to.Ptr[int32](1)},
+	}
+
+	// The init containers run in the ascending order of their order property, not in the alphabetical order of their names.
+	require.Equal(t, []string{"wait-for-db", "fetch-config", "migrate"}, getOrderedInitContainerNames(containers))
+	require.Equal(t, []string{"migrate"}, getOrderedInitContainerNames(map[string]datamodel.Container{"migrate": {Image: "migrate:latest"}}))
+}
@@ -112,6 +112,37 @@ func fromRecipeStatus(recipeStatus *rpv1.RecipeStatus) *RecipeStatus {
 		status.TemplateVersion = to.Ptr(recipeStatus.TemplateVersion)
 	}
 
+	status.Drift = fromRecipeDriftStatus(recipeStatus.Drift)
+
+	return status
+}
+
+func fromRecipeDriftStatus(drift *rpv1.RecipeDriftStatus) *RecipeDriftStatus {
+	if drift == nil {
+		return nil
+	}
+
+	status := &RecipeDriftStatus{
+		State:         to.Ptr(drift.State),
+		LastCheckedAt: drift.LastCheckedAt,
+	}
+
+	if drift.Message != "" {
+		status.Message = to.Ptr(drift.Message)
+	}
+
+	for _, change := range drift.Changes {
+		converted := &RecipeDriftChange{
+			Action:       to.Ptr(change.Action),
+			ResourceType: to.Ptr(change.ResourceType),
+			Name:         to.Ptr(change.Name),
+		}
+		if change.ResourceID != "" {
+			converted.ResourceID = to.Ptr(change.ResourceID)
+		}
+		status.Changes = append(status.Changes, converted)
+	}
+
 	return status
 }
 
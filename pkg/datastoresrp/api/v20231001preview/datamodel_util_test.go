@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/portableresources"
@@ -215,6 +216,7 @@ func TestFromResourceProvisiongDataModel(t *testing.T) {
 }
 
 func Test_fromRecipeStatus(t *testing.T) {
+	checkedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
 	testCases := []struct {
 		recipeStatus *rpv1.RecipeStatus
 		expected     *RecipeStatus
@@ -237,6 +239,44 @@ func Test_fromRecipeStatus(t *testing.T) {
 			TemplatePath:    to.Ptr("/path/to/template.bicep"),
 			TemplateVersion: nil,
 		}},
+		{&rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindTerraform,
+			TemplatePath: "/path/to/template.tf",
+			Drift: &rpv1.RecipeDriftStatus{
+				State:         rpv1.RecipeDriftStateDrifted,
+				LastCheckedAt: &checkedAt,
+				Changes: []rpv1.RecipeDriftChange{
+					{Action: "update", ResourceType: "aws_s3_bucket", Name: "aws_s3_bucket.main"},
+					{Action: "create", ResourceType: "Deployment", Name: "redis", ResourceID: "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"},
+				},
+			},
+		}, &RecipeStatus{
+			TemplateKind: to.Ptr(recipes.TemplateKindTerraform),
+			TemplatePath: to.Ptr("/path/to/template.tf"),
+			Drift: &RecipeDriftStatus{
+				State:         to.Ptr(rpv1.RecipeDriftStateDrifted),
+				LastCheckedAt: &checkedAt,
+				Changes: []*RecipeDriftChange{
+					{Action: to.Ptr("update"), ResourceType: to.Ptr("aws_s3_bucket"), Name: to.Ptr("aws_s3_bucket.main")},
+					{Action: to.Ptr("create"), ResourceType: to.Ptr("Deployment"), Name: to.Ptr("redis"), ResourceID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis")},
+				},
+			},
+		}},
+		{&rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindBicep,
+			TemplatePath: "/path/to/template.bicep",
+			Drift: &rpv1.RecipeDriftStatus{
+				State:   rpv1.RecipeDriftStateUnknown,
+				Message: "failed to plan recipe",
+			},
+		}, &RecipeStatus{
+			TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+			TemplatePath: to.Ptr("/path/to/template.bicep"),
+			Drift: &RecipeDriftStatus{
+				State:   to.Ptr(rpv1.RecipeDriftStateUnknown),
+				Message: to.Ptr("failed to plan recipe"),
+			},
+		}},
 	}
 
 	for _, tt := range testCases {
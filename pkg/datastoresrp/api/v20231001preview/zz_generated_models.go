@@ -298,6 +298,36 @@ type Recipe struct {
 	Parameters map[string]any
 }
 
+// RecipeDriftChange - A change re-executing a recipe would make to a drifted resource.
+type RecipeDriftChange struct {
+	// REQUIRED; The change to the resource. Allowed values: create, update, delete.
+	Action *string
+
+	// REQUIRED; The name or address of the resource within the recipe template.
+	Name *string
+
+	// REQUIRED; The type of the resource as reported by the recipe template.
+	ResourceType *string
+
+	// The identifier of the resource, if known.
+	ResourceID *string
+}
+
+// RecipeDriftStatus - Drift between the resources deployed by a recipe and their live state.
+type RecipeDriftStatus struct {
+	// REQUIRED; The drift state as of the last detection. Allowed values: InSync, Drifted, Remediating, Unknown.
+	State *string
+
+	// The changes re-executing the recipe would make to restore the deployed resources.
+	Changes []*RecipeDriftChange
+
+	// The time of the last drift detection.
+	LastCheckedAt *time.Time
+
+	// The reason the drift could not be detected, if the state is Unknown.
+	Message *string
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 	// REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -306,6 +336,9 @@ type RecipeStatus struct {
 	// REQUIRED; TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
 	TemplatePath *string
 
+	// The result of the last drift detection of the resources deployed by the recipe.
+	Drift *RecipeDriftStatus
+
 	// TemplateVersion is the version number of the template.
 	TemplateVersion *string
 }
@@ -44,6 +44,9 @@ const (
 
 	// AnnotationIdentityType is the annotation for supported identity.
 	AnnotationIdentityType = "radapp.io/identity-type"
+
+	// LabelRecipeOutput is the label marking the ConfigMaps and Secrets deployed by a Helm recipe that hold the outputs of the recipe.
+	LabelRecipeOutput = "radapp.io/recipe-output"
 )
 
 // NOTE: the difference between descriptive labels and selector labels
@@ -26,6 +26,7 @@ import (
 
 	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
@@ -49,6 +50,25 @@ func FindDeployment(resources []rpv1.OutputResource) (*appsv1.Deployment, rpv1.O
 	return nil, rpv1.OutputResource{}
 }
 
+// FindHorizontalPodAutoscaler searches through a slice of OutputResource objects and returns the first
+// HorizontalPodAutoscaler object and its associated OutputResource object.
+func FindHorizontalPodAutoscaler(resources []rpv1.OutputResource) (*autoscalingv2.HorizontalPodAutoscaler, rpv1.OutputResource) {
+	for _, r := range resources {
+		if r.GetResourceType().Type != resources_kubernetes.ResourceTypeHorizontalPodAutoscaler {
+			continue
+		}
+
+		hpa, ok := r.CreateResource.Data.(*autoscalingv2.HorizontalPodAutoscaler)
+		if !ok {
+			continue
+		}
+
+		return hpa, r
+	}
+
+	return nil, rpv1.OutputResource{}
+}
+
 // FindService searches through a slice of OutputResource objects and returns the first Service object found and the
 // OutputResource object it was found in.
 func FindService(resources []rpv1.OutputResource) (*corev1.Service, rpv1.OutputResource) {
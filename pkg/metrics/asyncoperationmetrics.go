@@ -40,18 +40,29 @@ const (
 	// ExtendedAsyncOperationCount is the metric name for extended async operation count.
 	ExtendedAsyncOperationCount = "asyncoperation.extended.operation"
 
+	// DeadLetteredAsyncOperationCount is the metric name for async operation count moved to the dead-letter queue.
+	DeadLetteredAsyncOperationCount = "asyncoperation.deadlettered.operation"
+
+	// TenantQueueDepth is the metric name for the number of queued async operations of each tenant.
+	TenantQueueDepth = "asyncoperation.tenant.queue.depth"
+
+	// TenantInFlightOperationCount is the metric name for the number of in-flight async operations of each tenant.
+	TenantInFlightOperationCount = "asyncoperation.tenant.inflight.operation"
+
 	// AsyncOperationDuration is the metric name for async operation duration.
 	AsnycOperationDuration = "asyncoperation.duration"
 )
 
 type asyncOperationMetrics struct {
 	counters       map[string]metric.Int64Counter
+	gauges         map[string]metric.Int64Gauge
 	valueRecorders map[string]metric.Float64Histogram
 }
 
 func newAsyncOperationMetrics() *asyncOperationMetrics {
 	return &asyncOperationMetrics{
 		counters:       make(map[string]metric.Int64Counter),
+		gauges:         make(map[string]metric.Int64Gauge),
 		valueRecorders: make(map[string]metric.Float64Histogram),
 	}
 }
@@ -77,6 +88,21 @@ func (a *asyncOperationMetrics) Init() error {
 		return err
 	}
 
+	a.counters[DeadLetteredAsyncOperationCount], err = meter.Int64Counter(DeadLetteredAsyncOperationCount)
+	if err != nil {
+		return err
+	}
+
+	a.gauges[TenantQueueDepth], err = meter.Int64Gauge(TenantQueueDepth)
+	if err != nil {
+		return err
+	}
+
+	a.gauges[TenantInFlightOperationCount], err = meter.Int64Gauge(TenantInFlightOperationCount)
+	if err != nil {
+		return err
+	}
+
 	a.valueRecorders[AsnycOperationDuration], err = meter.Float64Histogram(AsnycOperationDuration)
 	if err != nil {
 		return err
@@ -115,6 +141,30 @@ func (a *asyncOperationMetrics) RecordExtendedAsyncOperation(ctx context.Context
 	}
 }
 
+// RecordDeadLetteredAsyncOperation increments the DeadLetteredAsyncOperationCount metric for the given request. It
+// should be called when an async operation message is moved to the dead-letter queue.
+func (a *asyncOperationMetrics) RecordDeadLetteredAsyncOperation(ctx context.Context, req *ctrl.Request) {
+	if a.counters[DeadLetteredAsyncOperationCount] != nil {
+		a.counters[DeadLetteredAsyncOperationCount].Add(ctx, 1, metric.WithAttributes(newAsyncOperationCommonAttributes(req, nil)...))
+	}
+}
+
+// RecordTenantQueueDepth records the number of queued async operations of the tenant. It should be called whenever
+// the worker observes the queue depth.
+func (a *asyncOperationMetrics) RecordTenantQueueDepth(ctx context.Context, tenant string, depth int) {
+	if a.gauges[TenantQueueDepth] != nil {
+		a.gauges[TenantQueueDepth].Record(ctx, int64(depth), metric.WithAttributes(tenantAttrKey.String(normalizeAttrValue(tenant))))
+	}
+}
+
+// RecordTenantInFlightOperation records the number of in-flight async operations of the tenant. It should be called
+// when an async operation of the tenant is started or finished.
+func (a *asyncOperationMetrics) RecordTenantInFlightOperation(ctx context.Context, tenant string, count int) {
+	if a.gauges[TenantInFlightOperationCount] != nil {
+		a.gauges[TenantInFlightOperationCount].Record(ctx, int64(count), metric.WithAttributes(tenantAttrKey.String(normalizeAttrValue(tenant))))
+	}
+}
+
 // RecordAsyncOperationDuration records the duration of an asynchronous operation in milliseconds.
 func (a *asyncOperationMetrics) RecordAsyncOperationDuration(ctx context.Context, req *ctrl.Request, startTime time.Time) {
 	if a.valueRecorders[AsnycOperationDuration] != nil {
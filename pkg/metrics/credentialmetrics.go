@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// CredentialValidationCount is the metric name for the number of credential validations.
+	CredentialValidationCount = "ucp.credential.validation"
+
+	// CredentialCount is the metric name for the number of registered credentials in each health state.
+	CredentialCount = "ucp.credential.count"
+
+	// ExpiringCredentialCount is the metric name for the number of registered credentials expiring soon.
+	ExpiringCredentialCount = "ucp.credential.expiring"
+)
+
+type credentialMetrics struct {
+	counters map[string]metric.Int64Counter
+	gauges   map[string]metric.Int64Gauge
+}
+
+func newCredentialMetrics() *credentialMetrics {
+	return &credentialMetrics{
+		counters: make(map[string]metric.Int64Counter),
+		gauges:   make(map[string]metric.Int64Gauge),
+	}
+}
+
+// Init initializes the UCP credential metrics.
+func (m *credentialMetrics) Init() error {
+	meter := otel.GetMeterProvider().Meter("credential-metrics")
+
+	var err error
+	m.counters[CredentialValidationCount], err = meter.Int64Counter(CredentialValidationCount)
+	if err != nil {
+		return err
+	}
+
+	m.gauges[CredentialCount], err = meter.Int64Gauge(CredentialCount)
+	if err != nil {
+		return err
+	}
+
+	m.gauges[ExpiringCredentialCount], err = meter.Int64Gauge(ExpiringCredentialCount)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordCredentialValidation records a validation of a credential of the given resource type which resulted in the
+// given health state.
+func (m *credentialMetrics) RecordCredentialValidation(ctx context.Context, resourceType, health string) {
+	if m.counters[CredentialValidationCount] != nil {
+		m.counters[CredentialValidationCount].Add(ctx, 1, metric.WithAttributes(
+			resourceTypeAttrKey.String(normalizeAttrValue(resourceType)),
+			credentialHealthAttrKey.String(normalizeAttrValue(health)),
+		))
+	}
+}
+
+// RecordCredentialCount records the number of credentials of the given resource type in the given health state. It
+// should be called after every validation of all registered credentials.
+func (m *credentialMetrics) RecordCredentialCount(ctx context.Context, resourceType, health string, count int) {
+	if m.gauges[CredentialCount] != nil {
+		m.gauges[CredentialCount].Record(ctx, int64(count), metric.WithAttributes(
+			resourceTypeAttrKey.String(normalizeAttrValue(resourceType)),
+			credentialHealthAttrKey.String(normalizeAttrValue(health)),
+		))
+	}
+}
+
+// RecordExpiringCredentialCount records the number of credentials of the given resource type which expire within the
+// configured warning window. It should be called after every validation of all registered credentials.
+func (m *credentialMetrics) RecordExpiringCredentialCount(ctx context.Context, resourceType string, count int) {
+	if m.gauges[ExpiringCredentialCount] != nil {
+		m.gauges[ExpiringCredentialCount].Record(ctx, int64(count), metric.WithAttributes(
+			resourceTypeAttrKey.String(normalizeAttrValue(resourceType)),
+		))
+	}
+}
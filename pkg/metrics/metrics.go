@@ -22,6 +22,12 @@ var (
 
 	// DefaultRecipeEngineMetrics holds recipe engine metrics definitions.
 	DefaultRecipeEngineMetrics = newRecipeEngineMetrics()
+
+	// DefaultCredentialMetrics holds UCP credential metrics definitions.
+	DefaultCredentialMetrics = newCredentialMetrics()
+
+	// DefaultRateLimitMetrics holds the frontend server rate limit metrics definitions.
+	DefaultRateLimitMetrics = newRateLimitMetrics()
 )
 
 // InitMetrics initializes metrics for Radius.
@@ -34,5 +40,13 @@ func InitMetrics() error {
 		return err
 	}
 
+	if err := DefaultCredentialMetrics.Init(); err != nil {
+		return err
+	}
+
+	if err := DefaultRateLimitMetrics.Init(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// ThrottledRequestCount is the metric name for the number of requests throttled by the frontend server.
+	ThrottledRequestCount = "armrpc.throttled.request"
+)
+
+type rateLimitMetrics struct {
+	counters map[string]metric.Int64Counter
+}
+
+func newRateLimitMetrics() *rateLimitMetrics {
+	return &rateLimitMetrics{
+		counters: make(map[string]metric.Int64Counter),
+	}
+}
+
+// Init initializes the rate limit metrics.
+func (m *rateLimitMetrics) Init() error {
+	meter := otel.GetMeterProvider().Meter("rate-limit-metrics")
+
+	var err error
+	m.counters[ThrottledRequestCount], err = meter.Int64Counter(ThrottledRequestCount)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordThrottledRequest records a request for the given resource type which was throttled by the limit of the given
+// scope, such as "caller" or "resourceGroup".
+func (m *rateLimitMetrics) RecordThrottledRequest(ctx context.Context, resourceType, scope string) {
+	if m.counters[ThrottledRequestCount] != nil {
+		m.counters[ThrottledRequestCount].Add(ctx, 1, metric.WithAttributes(
+			resourceTypeAttrKey.String(normalizeAttrValue(resourceType)),
+			throttleScopeAttrKey.String(scope),
+		))
+	}
+}
@@ -33,6 +33,9 @@ const (
 	// operationErrorCodeAttrKey is the attribute name for the operation error code.
 	operationErrorCodeAttrKey = attribute.Key("operation_error_code")
 
+	// tenantAttrKey is the attribute name for the tenant (fairness key) of the async operation.
+	tenantAttrKey = attribute.Key("tenant")
+
 	// recipeNameAttrKey is the attribute name for the recipe name.
 	recipeNameAttrKey = attribute.Key("recipe_name")
 
@@ -42,6 +45,12 @@ const (
 	// recipeTemplatePathAttrKey is the attribute name for the recipe template path.
 	recipeTemplatePathAttrKey = attribute.Key("recipe_template_path")
 
+	// credentialHealthAttrKey is the attribute name for the health of a credential.
+	credentialHealthAttrKey = attribute.Key("credential_health")
+
+	// throttleScopeAttrKey is the attribute name for the scope of the limit which throttled a request.
+	throttleScopeAttrKey = attribute.Key("throttle_scope")
+
 	// TerraformVersionAttrKey is the attribute key for the Terraform version.
 	TerraformVersionAttrKey = attribute.Key("terraform_version")
 
@@ -17,15 +17,26 @@ limitations under the License.
 package middleware
 
 import (
+	"context"
 	"net/http"
 )
 
-// RemoveRemoteAddr is the middleware to remove remoteaddr to avoid high cardinality in metrics.
+type remoteAddrContextKey struct{}
+
+// RemoveRemoteAddr is the middleware to remove remoteaddr to avoid high cardinality in metrics. The remote address is
+// kept in the request context, see RemoteAddrFromContext.
 // This is a temporary workaround until opentelemetry-go fixes the issue - https://github.com/open-telemetry/opentelemetry-go-contrib/issues/3765
 func RemoveRemoteAddr(next http.Handler) http.Handler {
 	fn := func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(context.WithValue(r.Context(), remoteAddrContextKey{}, r.RemoteAddr))
 		r.RemoteAddr = ""
 		next.ServeHTTP(w, r)
 	}
 	return http.HandlerFunc(fn)
 }
+
+// RemoteAddrFromContext returns the remote address of the request removed by RemoveRemoteAddr, or an empty string.
+func RemoteAddrFromContext(ctx context.Context) string {
+	addr, _ := ctx.Value(remoteAddrContextKey{}).(string)
+	return addr
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	dapr_dm "github.com/radius-project/radius/pkg/daprrp/datamodel"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	ds_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msg_dm "github.com/radius-project/radius/pkg/messagingrp/datamodel"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultInterval is the default interval between drift detections of the recipe-backed portable resources.
+	DefaultInterval = 30 * time.Minute
+
+	// remediationTimeout is the timeout of the operation re-executing the recipe of a drifted resource. It is the same
+	// as the timeout of the create or update operations of the portable resources.
+	remediationTimeout = time.Duration(60) * time.Minute
+)
+
+// recipeResource is the data model of a portable resource that can be provisioned by a recipe.
+type recipeResource interface {
+	rpv1.RadiusResourceModel
+	datamodel.RecipeDataModel
+}
+
+// resourceTypes is the list of portable resource types checked for drift, with the constructor of their data model.
+var resourceTypes = []struct {
+	name string
+	new  func() recipeResource
+}{
+	{corerp_dm.ExtenderResourceType, func() recipeResource { return &corerp_dm.Extender{} }},
+	{dapr_ctrl.DaprPubSubBrokersResourceType, func() recipeResource { return &dapr_dm.DaprPubSubBroker{} }},
+	{dapr_ctrl.DaprSecretStoresResourceType, func() recipeResource { return &dapr_dm.DaprSecretStore{} }},
+	{dapr_ctrl.DaprStateStoresResourceType, func() recipeResource { return &dapr_dm.DaprStateStore{} }},
+	{ds_ctrl.MongoDatabasesResourceType, func() recipeResource { return &ds_dm.MongoDatabase{} }},
+	{ds_ctrl.RedisCachesResourceType, func() recipeResource { return &ds_dm.RedisCache{} }},
+	{ds_ctrl.SqlDatabasesResourceType, func() recipeResource { return &ds_dm.SqlDatabase{} }},
+	{msg_ctrl.RabbitMQQueuesResourceType, func() recipeResource { return &msg_dm.RabbitMQQueue{} }},
+}
+
+// Detector re-plans the recipes of the portable resources to detect the changes made to the deployed resources outside
+// of Radius, and records the drift in the recipe status of the resources. If the environment of a drifted resource
+// enables auto-remediation then the recipe is re-executed.
+type Detector struct {
+	// StorageProvider provides the storage clients of the portable resources.
+	StorageProvider dataprovider.DataStorageProvider
+
+	// StatusManager queues the operations re-executing the recipes of the drifted resources.
+	StatusManager statusmanager.StatusManager
+
+	// Engine plans the recipes.
+	Engine engine.Engine
+
+	// ConfigurationLoader loads the configuration of the environments of the resources.
+	ConfigurationLoader configloader.ConfigurationLoader
+
+	// LockChecker finds the management locks which block the remediation of the drifted resources.
+	LockChecker *locks.Checker
+
+	now func() time.Time
+}
+
+// NewDetector creates a new Detector.
+func NewDetector(storageProvider dataprovider.DataStorageProvider, statusManager statusmanager.StatusManager, eng engine.Engine, configurationLoader configloader.ConfigurationLoader, lockChecker *locks.Checker) *Detector {
+	return &Detector{
+		StorageProvider:     storageProvider,
+		StatusManager:       statusManager,
+		Engine:              eng,
+		ConfigurationLoader: configurationLoader,
+		LockChecker:         lockChecker,
+		now:                 time.Now,
+	}
+}
+
+// DetectAll detects the drift of all the recipe-backed portable resources. The detection of a resource does not stop
+// the detection of the others; the errors are returned together once all resources are processed.
+func (d *Detector) DetectAll(ctx context.Context) error {
+	var errs []error
+	for _, resourceType := range resourceTypes {
+		if err := d.detectResourceType(ctx, resourceType.name, resourceType.new); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (d *Detector) detectResourceType(ctx context.Context, resourceType string, newResource func() recipeResource) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	storageClient, err := d.StorageProvider.GetStorageClient(ctx, resourceType)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	query := store.Query{RootScope: "/planes/radius", ScopeRecursive: true, ResourceType: resourceType}
+	token := ""
+	for {
+		result, err := storageClient.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", resourceType, err)
+		}
+
+		for i := range result.Items {
+			obj := &result.Items[i]
+			resource := newResource()
+			if err := obj.As(resource); err != nil {
+				errs = append(errs, fmt.Errorf("failed to read resource %s: %w", obj.ID, err))
+				continue
+			}
+
+			if err := d.detect(ctx, storageClient, obj, resource); err != nil {
+				logger.Error(err, "failed to detect recipe drift", "id", obj.ID)
+				errs = append(errs, fmt.Errorf("failed to detect the drift of resource %s: %w", obj.ID, err))
+			}
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		token = result.PaginationToken
+	}
+
+	return errors.Join(errs...)
+}
+
+// detect plans the recipe of the given resource in refresh-only mode and saves the drift in the recipe status of the
+// resource. Resources which are provisioned manually, have not been deployed by a recipe yet, or have an operation in
+// progress are skipped. A drifted resource is not remediated if a lock blocks its update; the drift message reports the
+// lock instead.
+func (d *Detector) detect(ctx context.Context, storageClient store.StorageClient, obj *store.Object, resource recipeResource) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	recipe := resource.Recipe()
+	metadata := resource.ResourceMetadata()
+	if recipe == nil || metadata.Status.Recipe == nil || metadata.Status.Recipe.TemplatePath == "" {
+		return nil
+	}
+	if resource.ProvisioningState() != v1.ProvisioningStateSucceeded {
+		return nil
+	}
+
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          recipe.Name,
+		Parameters:    recipe.Parameters,
+		EnvironmentID: metadata.Environment,
+		ApplicationID: metadata.Application,
+		ResourceID:    resource.GetBaseResource().ID,
+	}
+
+	drift := &rpv1.RecipeDriftStatus{
+		State: rpv1.RecipeDriftStateInSync,
+	}
+
+	autoRemediate := false
+	configuration, err := d.ConfigurationLoader.LoadConfiguration(ctx, recipeMetadata)
+	if err != nil {
+		drift.State = rpv1.RecipeDriftStateUnknown
+		drift.Message = err.Error()
+	} else if configuration.Simulated {
+		// Recipes are not deployed in simulated environments.
+		return nil
+	} else {
+		autoRemediate = configuration.RecipeConfig.Drift.AutoRemediate
+		d.plan(ctx, recipeMetadata, metadata.Status.Recipe, resource.OutputResources(), drift)
+	}
+
+	now := d.now().UTC()
+	drift.LastCheckedAt = &now
+
+	remediate := autoRemediate && drift.State == rpv1.RecipeDriftStateDrifted
+	if remediate {
+		lock, err := d.checkLock(ctx, resource)
+		if err != nil {
+			remediate = false
+			drift.Message = fmt.Sprintf("The drift was not remediated: failed to check the locks of the resource: %s", err.Error())
+		} else if lock != nil {
+			remediate = false
+			drift.Message = locks.BlockedMessage("The remediation of the drift", lock)
+		}
+	}
+	if remediate {
+		drift.State = rpv1.RecipeDriftStateRemediating
+		resource.SetProvisioningState(v1.ProvisioningStateAccepted)
+	}
+	metadata.Status.Recipe.Drift = drift
+
+	// A concurrency error means that the resource was updated during the detection. The updated resource is checked
+	// in the next run.
+	updated := &store.Object{Metadata: store.Metadata{ID: obj.ID}, Data: resource}
+	err = storageClient.Save(ctx, updated, store.WithETag(obj.ETag))
+	if errors.Is(err, &store.ErrConcurrency{}) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !remediate {
+		return nil
+	}
+
+	logger.Info("Re-executing the recipe of the drifted resource", "id", obj.ID)
+	if err := d.queueRemediation(ctx, resource); err != nil {
+		resource.SetProvisioningState(v1.ProvisioningStateFailed)
+		rbErr := storageClient.Save(ctx, &store.Object{Metadata: store.Metadata{ID: obj.ID}, Data: resource}, store.WithETag(updated.ETag))
+		if rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+
+	return nil
+}
+
+// plan plans the template the resource was deployed with in refresh-only mode and updates the given drift status with
+// the result.
+func (d *Detector) plan(ctx context.Context, recipeMetadata recipes.ResourceMetadata, deployedRecipe *rpv1.RecipeStatus, outputResources []rpv1.OutputResource, drift *rpv1.RecipeDriftStatus) {
+	previousState := []string{}
+	for _, outputResource := range outputResources {
+		previousState = append(previousState, outputResource.ID.String())
+	}
+
+	plan, err := d.Engine.Plan(ctx, engine.PlanOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState:  previousState,
+		RefreshOnly:    true,
+		DeployedRecipe: deployedRecipe,
+	})
+	if err != nil {
+		drift.State = rpv1.RecipeDriftStateUnknown
+		drift.Message = err.Error()
+		return
+	}
+
+	for _, change := range plan.Changes {
+		drift.Changes = append(drift.Changes, rpv1.RecipeDriftChange{
+			Action:       change.Action,
+			ResourceType: change.ResourceType,
+			Name:         change.Name,
+			ResourceID:   change.ResourceID,
+		})
+	}
+	if len(drift.Changes) > 0 {
+		drift.State = rpv1.RecipeDriftStateDrifted
+	}
+}
+
+// checkLock returns the lock which blocks the update of the resource re-executing its recipe, or nil if there is none.
+func (d *Detector) checkLock(ctx context.Context, resource recipeResource) (*ucp_dm.Lock, error) {
+	id, err := resources.ParseResource(resource.GetBaseResource().ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.LockChecker.Check(ctx, http.MethodPut, id)
+}
+
+// queueRemediation queues the create or update operation of the resource to re-execute its recipe.
+func (d *Detector) queueRemediation(ctx context.Context, resource recipeResource) error {
+	id, err := resources.ParseResource(resource.GetBaseResource().ID)
+	if err != nil {
+		return err
+	}
+
+	apiVersion := resource.GetBaseResource().UpdatedAPIVersion
+	if apiVersion == "" {
+		apiVersion = resource.GetBaseResource().CreatedAPIVersion
+	}
+
+	return d.StatusManager.QueueAsyncOperation(ctx, &v1.ARMRequestContext{
+		ResourceID:    id,
+		OperationID:   uuid.New(),
+		OperationType: v1.OperationType{Type: id.Type(), Method: v1.OperationPut},
+		APIVersion:    apiVersion,
+		HomeTenantID:  resource.GetBaseResource().TenantID,
+	}, statusmanager.QueueOperationOptions{
+		OperationTimeout: remediationTimeout,
+		RetryAfter:       v1.DefaultRetryAfterDuration,
+	})
+}
@@ -0,0 +1,361 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	ds_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	testEnvironmentID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+	testResourceScope = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/"
+	testBucketID      = "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.S3/Bucket/redis-backup"
+)
+
+var testNow = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func toObject(t *testing.T, id string, resource any) store.Object {
+	b, err := json.Marshal(resource)
+	require.NoError(t, err)
+	data := map[string]any{}
+	require.NoError(t, json.Unmarshal(b, &data))
+	return store.Object{Metadata: store.Metadata{ID: id, ETag: "etag"}, Data: data}
+}
+
+func newRedisCache(name string) *ds_dm.RedisCache {
+	return &ds_dm.RedisCache{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: testResourceScope + name, Name: name, Type: ds_ctrl.RedisCachesResourceType},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion:      "2023-10-01-preview",
+				AsyncProvisioningState: v1.ProvisioningStateSucceeded,
+			},
+		},
+		Properties: ds_dm.RedisCacheProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Environment: testEnvironmentID,
+				Status: rpv1.ResourceStatus{
+					OutputResources: []rpv1.OutputResource{{ID: resources.MustParse(testBucketID)}},
+					Recipe: &rpv1.RecipeStatus{
+						TemplateKind: recipes.TemplateKindTerraform,
+						TemplatePath: "ghcr.io/radius-project/recipes/redis:1.0",
+					},
+				},
+			},
+			Recipe: portableresources.ResourceRecipe{Name: "default", Parameters: map[string]any{"port": 6379}},
+		},
+	}
+}
+
+type testDetector struct {
+	detector      *Detector
+	storageClient *store.MockStorageClient
+	lockClient    *store.MockStorageClient
+	statusManager *statusmanager.MockStatusManager
+	engine        *engine.MockEngine
+	configLoader  *configloader.MockConfigurationLoader
+}
+
+func newTestDetector(t *testing.T, items []store.Object) *testDetector {
+	mctrl := gomock.NewController(t)
+	storageProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+	storageClient := store.NewMockStorageClient(mctrl)
+	lockClient := store.NewMockStorageClient(mctrl)
+	statusManager := statusmanager.NewMockStatusManager(mctrl)
+	eng := engine.NewMockEngine(mctrl)
+	configLoader := configloader.NewMockConfigurationLoader(mctrl)
+
+	storageProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(storageClient, nil).Times(len(resourceTypes))
+	storageClient.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			if query.ResourceType == ds_ctrl.RedisCachesResourceType {
+				return &store.ObjectQueryResult{Items: items}, nil
+			}
+			return &store.ObjectQueryResult{}, nil
+		}).Times(len(resourceTypes))
+
+	detector := NewDetector(storageProvider, statusManager, eng, configLoader, locks.NewChecker(lockClient))
+	detector.now = func() time.Time { return testNow }
+
+	return &testDetector{
+		detector:      detector,
+		storageClient: storageClient,
+		lockClient:    lockClient,
+		statusManager: statusManager,
+		engine:        eng,
+		configLoader:  configLoader,
+	}
+}
+
+func (td *testDetector) expectLocks(items ...store.Object) {
+	td.lockClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local", ResourceType: ucp_dm.LockResourceType}).
+		Return(&store.ObjectQueryResult{Items: items}, nil).Times(1)
+}
+
+func (td *testDetector) expectSave(saved map[string]*ds_dm.RedisCache, times int) {
+	td.storageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			resource := *obj.Data.(*ds_dm.RedisCache)
+			saved[obj.ID] = &resource
+			return nil
+		}).Times(times)
+}
+
+func Test_DetectAll(t *testing.T) {
+	inSync := newRedisCache("in-sync")
+	drifted := newRedisCache("drifted")
+
+	manual := newRedisCache("manual")
+	manual.Properties.ResourceProvisioning = portableresources.ResourceProvisioningManual
+
+	updating := newRedisCache("updating")
+	updating.AsyncProvisioningState = v1.ProvisioningStateUpdating
+
+	notDeployed := newRedisCache("not-deployed")
+	notDeployed.Properties.Status.Recipe = nil
+
+	td := newTestDetector(t, []store.Object{
+		toObject(t, inSync.ID, inSync),
+		toObject(t, drifted.ID, drifted),
+		toObject(t, manual.ID, manual),
+		toObject(t, updating.ID, updating),
+		toObject(t, notDeployed.ID, notDeployed),
+	})
+
+	td.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{}, nil).Times(2)
+	td.engine.EXPECT().Plan(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts engine.PlanOptions) (*recipes.RecipePlan, error) {
+			require.True(t, opts.RefreshOnly)
+			require.Equal(t, []string{testBucketID}, opts.PreviousState)
+			require.Equal(t, "default", opts.Recipe.Name)
+			require.Equal(t, testEnvironmentID, opts.Recipe.EnvironmentID)
+			require.Equal(t, "ghcr.io/radius-project/recipes/redis:1.0", opts.DeployedRecipe.TemplatePath)
+
+			if opts.Recipe.ResourceID == drifted.ID {
+				return &recipes.RecipePlan{Changes: []recipes.ResourceChange{
+					{Action: recipes.ResourceChangeCreate, ResourceType: "AWS.S3/Bucket", Name: "redis-backup", ResourceID: testBucketID},
+				}}, nil
+			}
+			return &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, nil
+		}).Times(2)
+
+	saved := map[string]*ds_dm.RedisCache{}
+	td.expectSave(saved, 2)
+
+	err := td.detector.DetectAll(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, saved, 2)
+	require.Equal(t, &rpv1.RecipeDriftStatus{
+		State:         rpv1.RecipeDriftStateInSync,
+		LastCheckedAt: &testNow,
+	}, saved[inSync.ID].Properties.Status.Recipe.Drift)
+
+	require.Equal(t, &rpv1.RecipeDriftStatus{
+		State:         rpv1.RecipeDriftStateDrifted,
+		LastCheckedAt: &testNow,
+		Changes: []rpv1.RecipeDriftChange{
+			{Action: recipes.ResourceChangeCreate, ResourceType: "AWS.S3/Bucket", Name: "redis-backup", ResourceID: testBucketID},
+		},
+	}, saved[drifted.ID].Properties.Status.Recipe.Drift)
+	require.Equal(t, v1.ProvisioningStateSucceeded, saved[drifted.ID].ProvisioningState())
+}
+
+func Test_DetectAll_AutoRemediate(t *testing.T) {
+	drifted := newRedisCache("drifted")
+	td := newTestDetector(t, []store.Object{toObject(t, drifted.ID, drifted)})
+
+	config := &recipes.Configuration{
+		RecipeConfig: corerp_dm.RecipeConfigProperties{
+			Drift: corerp_dm.RecipeDriftConfig{AutoRemediate: true},
+		},
+	}
+	td.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(config, nil).Times(1)
+	td.engine.EXPECT().Plan(gomock.Any(), gomock.Any()).Return(&recipes.RecipePlan{Changes: []recipes.ResourceChange{
+		{Action: recipes.ResourceChangeUpdate, ResourceType: "AWS.S3/Bucket", Name: "redis-backup", ResourceID: testBucketID},
+	}}, nil).Times(1)
+	td.expectLocks()
+
+	saved := map[string]*ds_dm.RedisCache{}
+	td.expectSave(saved, 1)
+
+	td.statusManager.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+			require.Equal(t, drifted.ID, sCtx.ResourceID.String())
+			require.Equal(t, v1.OperationPut, sCtx.OperationType.Method)
+			require.Equal(t, ds_ctrl.RedisCachesResourceType, sCtx.OperationType.Type)
+			require.Equal(t, "2023-10-01-preview", sCtx.APIVersion)
+			require.Equal(t, remediationTimeout, options.OperationTimeout)
+			return nil
+		}).Times(1)
+
+	err := td.detector.DetectAll(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, rpv1.RecipeDriftStateRemediating, saved[drifted.ID].Properties.Status.Recipe.Drift.State)
+	require.Equal(t, v1.ProvisioningStateAccepted, saved[drifted.ID].ProvisioningState())
+}
+
+func Test_DetectAll_AutoRemediate_QueueFailure(t *testing.T) {
+	drifted := newRedisCache("drifted")
+	td := newTestDetector(t, []store.Object{toObject(t, drifted.ID, drifted)})
+
+	config := &recipes.Configuration{
+		RecipeConfig: corerp_dm.RecipeConfigProperties{
+			Drift: corerp_dm.RecipeDriftConfig{AutoRemediate: true},
+		},
+	}
+	td.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(config, nil).Times(1)
+	td.engine.EXPECT().Plan(gomock.Any(), gomock.Any()).Return(&recipes.RecipePlan{Changes: []recipes.ResourceChange{
+		{Action: recipes.ResourceChangeUpdate, ResourceType: "AWS.S3/Bucket", Name: "redis-backup", ResourceID: testBucketID},
+	}}, nil).Times(1)
+	td.expectLocks()
+	td.statusManager.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("queue is unavailable")).Times(1)
+
+	saved := map[string]*ds_dm.RedisCache{}
+	td.expectSave(saved, 2)
+
+	err := td.detector.DetectAll(context.Background())
+	require.ErrorContains(t, err, "queue is unavailable")
+
+	// The provisioning state is rolled back to a terminal state so that the resource can be updated again.
+	require.Equal(t, v1.ProvisioningStateFailed, saved[drifted.ID].ProvisioningState())
+}
+
+func Test_DetectAll_AutoRemediate_Locked(t *testing.T) {
+	drifted := newRedisCache("drifted")
+	td := newTestDetector(t, []store.Object{toObject(t, drifted.ID, drifted)})
+
+	config := &recipes.Configuration{
+		RecipeConfig: corerp_dm.RecipeConfigProperties{
+			Drift: corerp_dm.RecipeDriftConfig{AutoRemediate: true},
+		},
+	}
+	td.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(config, nil).Times(1)
+	td.engine.EXPECT().Plan(gomock.Any(), gomock.Any()).Return(&recipes.RecipePlan{Changes: []recipes.ResourceChange{
+		{Action: recipes.ResourceChangeUpdate, ResourceType: "AWS.S3/Bucket", Name: "redis-backup", ResourceID: testBucketID},
+	}}, nil).Times(1)
+	td.expectLocks(store.Object{
+		Data: &ucp_dm.Lock{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   "/planes/radius/local/providers/System.Authorization/locks/freeze",
+					Name: "freeze",
+					Type: ucp_dm.LockResourceType,
+				},
+			},
+			Properties: ucp_dm.LockProperties{
+				Level: ucp_dm.LockLevelReadOnly,
+				Scope: "/planes/radius/local/resourceGroups/test-rg",
+			},
+		},
+	})
+
+	// The remediation is not queued.
+	saved := map[string]*ds_dm.RedisCache{}
+	td.expectSave(saved, 1)
+
+	err := td.detector.DetectAll(context.Background())
+	require.NoError(t, err)
+
+	drift := saved[drifted.ID].Properties.Status.Recipe.Drift
+	require.Equal(t, rpv1.RecipeDriftStateDrifted, drift.State)
+	require.Equal(t, "The remediation of the drift is blocked by the ReadOnly lock \"freeze\" on the scope \"/planes/radius/local/resourceGroups/test-rg\".", drift.Message)
+	require.Equal(t, v1.ProvisioningStateSucceeded, saved[drifted.ID].ProvisioningState())
+}
+
+func Test_DetectAll_Unknown(t *testing.T) {
+	planFailed := newRedisCache("plan-failed")
+	configFailed := newRedisCache("config-failed")
+	td := newTestDetector(t, []store.Object{
+		toObject(t, planFailed.ID, planFailed),
+		toObject(t, configFailed.ID, configFailed),
+	})
+
+	td.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, metadata recipes.ResourceMetadata) (*recipes.Configuration, error) {
+			if metadata.ResourceID == configFailed.ID {
+				return nil, errors.New("environment not found")
+			}
+			return &recipes.Configuration{}, nil
+		}).Times(2)
+	td.engine.EXPECT().Plan(gomock.Any(), gomock.Any()).Return(nil, errors.New("terraform plan failure")).Times(1)
+
+	saved := map[string]*ds_dm.RedisCache{}
+	td.expectSave(saved, 2)
+
+	err := td.detector.DetectAll(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, &rpv1.RecipeDriftStatus{
+		State:         rpv1.RecipeDriftStateUnknown,
+		LastCheckedAt: &testNow,
+		Message:       "terraform plan failure",
+	}, saved[planFailed.ID].Properties.Status.Recipe.Drift)
+	require.Equal(t, &rpv1.RecipeDriftStatus{
+		State:         rpv1.RecipeDriftStateUnknown,
+		LastCheckedAt: &testNow,
+		Message:       "environment not found",
+	}, saved[configFailed.ID].Properties.Status.Recipe.Drift)
+}
+
+func Test_DetectAll_Simulated(t *testing.T) {
+	resource := newRedisCache("redis0")
+	td := newTestDetector(t, []store.Object{toObject(t, resource.ID, resource)})
+
+	td.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{Simulated: true}, nil).Times(1)
+
+	err := td.detector.DetectAll(context.Background())
+	require.NoError(t, err)
+}
+
+func Test_DetectAll_Errors(t *testing.T) {
+	resource := newRedisCache("redis0")
+	td := newTestDetector(t, []store.Object{toObject(t, resource.ID, resource)})
+
+	td.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{}, nil).Times(1)
+	td.engine.EXPECT().Plan(gomock.Any(), gomock.Any()).Return(&recipes.RecipePlan{}, nil).Times(1)
+	td.storageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("storage failure")).Times(1)
+
+	err := td.detector.DetectAll(context.Background())
+	require.ErrorContains(t, err, "failed to detect the drift of resource "+resource.ID)
+	require.ErrorContains(t, err, "storage failure")
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ServiceOptions represents the options of the drift detection service.
+type ServiceOptions struct {
+	// Config is the configuration of the drift detection.
+	Config hostoptions.DriftDetectionOptions
+
+	// StorageProviderOptions is the options of the data store of the resource providers.
+	StorageProviderOptions dataprovider.StorageProviderOptions
+
+	// QueueProviderOptions is the options of the queue of the async operations.
+	QueueProviderOptions qprovider.QueueProviderOptions
+
+	// Location is the location of the resource providers, used to record the status of the async operations.
+	Location string
+
+	// Engine plans the recipes.
+	Engine engine.Engine
+
+	// ConfigurationLoader loads the configuration of the environments of the resources.
+	ConfigurationLoader configloader.ConfigurationLoader
+}
+
+// Service is the hosting service which periodically detects the drift of the recipe-backed portable resources.
+type Service struct {
+	Options ServiceOptions
+}
+
+// NewService creates a new drift detection service with the given options.
+func NewService(options ServiceOptions) *Service {
+	return &Service{
+		Options: options,
+	}
+}
+
+// Name returns the name of the drift detection service.
+func (s *Service) Name() string {
+	return "Recipe Drift Detector"
+}
+
+// Run detects the drift of the recipe-backed portable resources when the service starts and then at every configured
+// interval until the context is cancelled. A failed detection is logged and does not stop the service.
+func (s *Service) Run(ctx context.Context) error {
+	storageProvider := dataprovider.NewStorageProvider(s.Options.StorageProviderOptions)
+	defer storageProvider.Close()
+
+	queueProvider := qprovider.New(s.Options.QueueProviderOptions)
+	defer queueProvider.Close()
+
+	queueClient, err := queueProvider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	interval := s.Options.Config.Interval
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+
+	lockStorageClient, err := storageProvider.GetStorageClient(ctx, ucp_dm.LockResourceType)
+	if err != nil {
+		return err
+	}
+
+	statusManager := statusmanager.New(storageProvider, queueClient, s.Options.Location)
+	detector := NewDetector(storageProvider, statusManager, s.Options.Engine, s.Options.ConfigurationLoader, locks.NewChecker(lockStorageClient))
+	return runPeriodically(ctx, interval, detector.DetectAll)
+}
+
+func runPeriodically(ctx context.Context, interval time.Duration, fn func(ctx context.Context) error) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := fn(ctx); err != nil {
+			logger.Error(err, "failed to detect recipe drift")
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("Recipe drift detector stopped...")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package controllerconfig
 
 import (
+	"path/filepath"
 	"strconv"
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
@@ -27,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/terraform"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/ucp/secret/provider"
@@ -102,10 +104,30 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 			),
 			recipes.TemplateKindTerraform: driver.NewTerraformDriver(options.UCPConnection, provider.NewSecretProvider(options.Config.SecretProvider),
 				driver.TerraformOptions{
-					Path: options.Config.Terraform.Path,
+					Path:  options.Config.Terraform.Path,
+					Cache: terraformCacheOptions(options.Config.Terraform),
 				}, cfg.K8sClients.ClientSet),
+			recipes.TemplateKindHelm: driver.NewHelmDriver(options.K8sConfig),
 		},
 	})
 
 	return cfg, nil
 }
+
+// terraformCacheOptions returns the options of the cache of the Terraform driver. The cache is stored under the Terraform
+// path by default.
+func terraformCacheOptions(options hostoptions.TerraformOptions) terraform.CacheOptions {
+	if !options.Cache.Enabled {
+		return terraform.CacheOptions{}
+	}
+
+	path := options.Cache.Path
+	if path == "" {
+		path = filepath.Join(options.Path, ".cache")
+	}
+
+	return terraform.CacheOptions{
+		Path:         path,
+		MaxSizeBytes: options.Cache.MaxSizeMB * 1024 * 1024,
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -373,6 +374,178 @@ func (d *bicepDriver) prepareRecipeResponse(templatePath string, outputs any, re
 	return recipeResponse, nil
 }
 
+// Plan fetches recipe contents from container registry, builds the deployment of the recipe the same way as Execute and
+// runs a what-if operation on it using UCP deployment client. The changes reported by the what-if operation are returned
+// along with the deletion of previously deployed resources that would be garbage collected after the deployment.
+func (d *bicepDriver) Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	recipeData := make(map[string]any)
+	err := util.ReadFromRegistry(ctx, opts.Definition, &recipeData, d.RegistryClient)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// create the context object to be passed to the recipe deployment
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	isContextParameterDefined := hasContextParameter(recipeData)
+	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
+
+	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
+	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
+
+	logger.Info("previewing bicep template deployment for recipe", "deploymentID", deploymentID)
+	poller, err := d.DeploymentClient.WhatIf(
+		ctx,
+		clients.Deployment{
+			Properties: &clients.DeploymentProperties{
+				Mode:           armresources.DeploymentModeIncremental,
+				ProviderConfig: &providerConfig,
+				Parameters:     parameters,
+				Template:       recipeData,
+			},
+		},
+		deploymentID.String(),
+		clients.DeploymentsClientAPIVersion,
+	)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: pollFrequency})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	var whatIfChanges []*armresources.WhatIfChange
+	if resp.Properties != nil {
+		whatIfChanges = resp.Properties.Changes
+	}
+
+	var changes []recipes.ResourceChange
+	if opts.RefreshOnly {
+		changes, err = getWhatIfDriftChanges(whatIfChanges)
+	} else {
+		changes, err = getWhatIfResourceChanges(whatIfChanges, opts.PrevState)
+	}
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return &recipes.RecipePlan{Changes: changes}, nil
+}
+
+// getWhatIfResourceChanges converts the changes reported by a what-if operation to recipe resource changes. Recipes are
+// deployed in incremental mode, so resources of the previous deployment that are no longer part of the template are deleted
+// by garbage collection after the deployment, and are reported as deletions.
+func getWhatIfResourceChanges(whatIfChanges []*armresources.WhatIfChange, previous []string) ([]recipes.ResourceChange, error) {
+	changes := []recipes.ResourceChange{}
+	current := []string{}
+	for _, change := range whatIfChanges {
+		if change == nil || change.ResourceID == nil || change.ChangeType == nil {
+			continue
+		}
+
+		var action string
+		switch *change.ChangeType {
+		case armresources.ChangeTypeCreate:
+			action = recipes.ResourceChangeCreate
+		case armresources.ChangeTypeModify, armresources.ChangeTypeDeploy:
+			action = recipes.ResourceChangeUpdate
+		case armresources.ChangeTypeDelete:
+			action = recipes.ResourceChangeDelete
+		}
+
+		if *change.ChangeType != armresources.ChangeTypeDelete {
+			current = append(current, *change.ResourceID)
+		}
+		if action == "" {
+			continue
+		}
+
+		resourceChange, err := newResourceChange(action, *change.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, resourceChange)
+	}
+
+	for _, prevResourceID := range previous {
+		found := false
+		for _, currentResourceID := range current {
+			if strings.EqualFold(prevResourceID, currentResourceID) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			resourceChange, err := newResourceChange(recipes.ResourceChangeDelete, prevResourceID)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, resourceChange)
+		}
+	}
+
+	return changes, nil
+}
+
+// getWhatIfDriftChanges converts the changes reported by a what-if operation of the template the resource was deployed with
+// to the recipe resource changes that would restore the deployed resources. Resources whose changes cannot be predicted
+// are not reported as drift.
+func getWhatIfDriftChanges(whatIfChanges []*armresources.WhatIfChange) ([]recipes.ResourceChange, error) {
+	changes := []recipes.ResourceChange{}
+	for _, change := range whatIfChanges {
+		if change == nil || change.ResourceID == nil || change.ChangeType == nil {
+			continue
+		}
+
+		var action string
+		switch *change.ChangeType {
+		case armresources.ChangeTypeCreate:
+			action = recipes.ResourceChangeCreate
+		case armresources.ChangeTypeModify:
+			action = recipes.ResourceChangeUpdate
+		default:
+			continue
+		}
+
+		resourceChange, err := newResourceChange(action, *change.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, resourceChange)
+	}
+
+	return changes, nil
+}
+
+// newResourceChange creates a resource change for the resource with the given ID.
+func newResourceChange(action string, resourceID string) (recipes.ResourceChange, error) {
+	id, err := resources.Parse(resourceID)
+	if err != nil {
+		return recipes.ResourceChange{}, err
+	}
+
+	return recipes.ResourceChange{
+		Action:       action,
+		ResourceType: id.Type(),
+		Name:         id.Name(),
+		ResourceID:   resourceID,
+	}, nil
+}
+
 // getGCOutputResources [GC stands for Garbage Collection] compares two slices of resource ids and
 // returns a slice of OutputResources that contains the elements that are in the "previous" slice but not in the "current".
 func (d *bicepDriver) getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
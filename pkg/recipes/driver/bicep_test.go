@@ -555,6 +555,58 @@ func Test_GetGCOutputResources_NoDiff(t *testing.T) {
 	require.Equal(t, exp, res)
 }
 
+func Test_GetWhatIfResourceChanges(t *testing.T) {
+	scope := "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/"
+	whatIfChanges := []*armresources.WhatIfChange{
+		{ResourceID: to.Ptr(scope + "resource1"), ChangeType: to.Ptr(armresources.ChangeTypeNoChange)},
+		{ResourceID: to.Ptr(scope + "resource3"), ChangeType: to.Ptr(armresources.ChangeTypeCreate)},
+		{ResourceID: to.Ptr(scope + "resource4"), ChangeType: to.Ptr(armresources.ChangeTypeModify)},
+		{ResourceID: to.Ptr(scope + "resource5"), ChangeType: to.Ptr(armresources.ChangeTypeIgnore)},
+	}
+	previous := []string{
+		scope + "resource1",
+		scope + "resource2",
+		scope + "RESOURCE4",
+	}
+
+	exp := []recipes.ResourceChange{
+		{Action: recipes.ResourceChangeCreate, ResourceType: "System.Test/testResources", Name: "resource3", ResourceID: scope + "resource3"},
+		{Action: recipes.ResourceChangeUpdate, ResourceType: "System.Test/testResources", Name: "resource4", ResourceID: scope + "resource4"},
+		{Action: recipes.ResourceChangeDelete, ResourceType: "System.Test/testResources", Name: "resource2", ResourceID: scope + "resource2"},
+	}
+	res, err := getWhatIfResourceChanges(whatIfChanges, previous)
+	require.NoError(t, err)
+	require.Equal(t, exp, res)
+}
+
+func Test_GetWhatIfResourceChanges_InvalidResourceID(t *testing.T) {
+	whatIfChanges := []*armresources.WhatIfChange{
+		{ResourceID: to.Ptr("invalid-id"), ChangeType: to.Ptr(armresources.ChangeTypeCreate)},
+	}
+
+	_, err := getWhatIfResourceChanges(whatIfChanges, nil)
+	require.Error(t, err)
+}
+
+func Test_GetWhatIfDriftChanges(t *testing.T) {
+	scope := "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/"
+	whatIfChanges := []*armresources.WhatIfChange{
+		{ResourceID: to.Ptr(scope + "resource1"), ChangeType: to.Ptr(armresources.ChangeTypeNoChange)},
+		{ResourceID: to.Ptr(scope + "resource2"), ChangeType: to.Ptr(armresources.ChangeTypeDeploy)},
+		{ResourceID: to.Ptr(scope + "resource3"), ChangeType: to.Ptr(armresources.ChangeTypeCreate)},
+		{ResourceID: to.Ptr(scope + "resource4"), ChangeType: to.Ptr(armresources.ChangeTypeModify)},
+		nil,
+	}
+
+	exp := []recipes.ResourceChange{
+		{Action: recipes.ResourceChangeCreate, ResourceType: "System.Test/testResources", Name: "resource3", ResourceID: scope + "resource3"},
+		{Action: recipes.ResourceChangeUpdate, ResourceType: "System.Test/testResources", Name: "resource4", ResourceID: scope + "resource4"},
+	}
+	res, err := getWhatIfDriftChanges(whatIfChanges)
+	require.NoError(t, err)
+	require.Equal(t, exp, res)
+}
+
 func Test_Bicep_Delete_Success_AfterRetry(t *testing.T) {
 	ctx := testcontext.New(t)
 	driver, client := setupDeleteInputs(t)
@@ -0,0 +1,365 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	kubernetesresources "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ Driver = (*helmDriver)(nil)
+
+// NewHelmDriver creates a new instance of driver to execute a Helm recipe on the Kubernetes cluster of the given REST config.
+func NewHelmDriver(k8sConfig *rest.Config) Driver {
+	return &helmDriver{
+		helmExecutor: helm.NewExecutor(k8sConfig),
+	}
+}
+
+// helmDriver represents a driver to interact with Helm Recipe - deploy recipe, delete resources, etc.
+type helmDriver struct {
+	// helmExecutor is used to download Helm charts and manage Helm releases.
+	helmExecutor helm.HelmExecutor
+}
+
+// Execute downloads the Helm chart of the recipe and installs or upgrades the Helm release of the resource with the recipe
+// parameters and the recipe context as values. The objects deployed by the release are returned as the output resources of
+// the recipe, and the outputs are read from the ConfigMaps (values) and Secrets (secrets) of the release labelled as recipe outputs.
+// Objects removed from the chart are deleted by the release upgrade, so no further garbage collection is needed.
+func (d *helmDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	releaseName, namespace, err := getHelmRelease(recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	chart, err := d.helmExecutor.LoadChart(ctx, helm.Options{EnvRecipe: &opts.Definition})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	values, err := createHelmValues(opts.Recipe.Parameters, opts.Definition.Parameters, helm.HasContextValue(chart), recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	objects, err := d.helmExecutor.Deploy(ctx, helm.Options{
+		EnvRecipe:   &opts.Definition,
+		ReleaseName: releaseName,
+		Namespace:   namespace,
+		Chart:       chart,
+		Values:      values,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	recipeResponse, err := d.prepareRecipeResponse(opts.Definition, objects)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe outputs: %s", err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return recipeResponse, nil
+}
+
+// Delete uninstalls the Helm release of the resource, deleting all of the objects deployed by the recipe.
+func (d *helmDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	releaseName, namespace, err := getHelmRelease(recipeContext)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	err = d.helmExecutor.Delete(ctx, helm.Options{
+		EnvRecipe:   &opts.Definition,
+		ReleaseName: releaseName,
+		Namespace:   namespace,
+	})
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return nil
+}
+
+// GetRecipeMetadata returns the Helm Recipe parameters read from the values schema and the default values of the chart.
+func (d *helmDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	chart, err := d.helmExecutor.LoadChart(ctx, helm.Options{EnvRecipe: &opts.Definition})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	parameters, err := helm.Parameters(chart)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return map[string]any{
+		recipeParameters: parameters,
+	}, nil
+}
+
+// Plan downloads the Helm chart of the recipe and runs a dry run of the installation or the upgrade of the Helm release of
+// the resource. Objects rendered by the dry run are reported as created or updated, and objects of the current release that
+// are no longer rendered are reported as deleted.
+func (d *helmDriver) Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	releaseName, namespace, err := getHelmRelease(recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	if opts.RefreshOnly {
+		missing, err := d.helmExecutor.Drift(ctx, helm.Options{
+			EnvRecipe:   &opts.Definition,
+			ReleaseName: releaseName,
+			Namespace:   namespace,
+		})
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+
+		// Upgrading the release creates the objects that were deleted outside of Helm again.
+		changes := []recipes.ResourceChange{}
+		for _, obj := range missing {
+			changes = append(changes, newHelmResourceChange(recipes.ResourceChangeCreate, objectResourceID(obj), obj))
+		}
+
+		return &recipes.RecipePlan{Changes: changes}, nil
+	}
+
+	chart, err := d.helmExecutor.LoadChart(ctx, helm.Options{EnvRecipe: &opts.Definition})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	values, err := createHelmValues(opts.Recipe.Parameters, opts.Definition.Parameters, helm.HasContextValue(chart), recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	current, planned, err := d.helmExecutor.Plan(ctx, helm.Options{
+		EnvRecipe:   &opts.Definition,
+		ReleaseName: releaseName,
+		Namespace:   namespace,
+		Chart:       chart,
+		Values:      values,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return &recipes.RecipePlan{Changes: getHelmResourceChanges(current, planned)}, nil
+}
+
+// getHelmResourceChanges compares the objects of the current Helm release with the objects of the planned release.
+// Planned objects that differ from the current object are updated, and objects that are identical are left unchanged.
+func getHelmResourceChanges(current []unstructured.Unstructured, planned []unstructured.Unstructured) []recipes.ResourceChange {
+	currentObjects := map[string]unstructured.Unstructured{}
+	for _, obj := range current {
+		currentObjects[objectResourceID(obj)] = obj
+	}
+
+	changes := []recipes.ResourceChange{}
+	plannedIDs := map[string]bool{}
+	for _, obj := range planned {
+		id := objectResourceID(obj)
+		plannedIDs[id] = true
+
+		action := recipes.ResourceChangeCreate
+		if currentObj, ok := currentObjects[id]; ok {
+			if reflect.DeepEqual(currentObj.Object, obj.Object) {
+				continue
+			}
+			action = recipes.ResourceChangeUpdate
+		}
+
+		changes = append(changes, newHelmResourceChange(action, id, obj))
+	}
+
+	for _, obj := range current {
+		id := objectResourceID(obj)
+		if !plannedIDs[id] {
+			changes = append(changes, newHelmResourceChange(recipes.ResourceChangeDelete, id, obj))
+		}
+	}
+
+	return changes
+}
+
+// newHelmResourceChange creates a resource change for the Kubernetes object with the given resource ID.
+func newHelmResourceChange(action string, id string, obj unstructured.Unstructured) recipes.ResourceChange {
+	return recipes.ResourceChange{
+		Action:       action,
+		ResourceType: obj.GetKind(),
+		Name:         obj.GetName(),
+		ResourceID:   id,
+	}
+}
+
+// objectResourceID returns the UCP resource ID of the Kubernetes object.
+func objectResourceID(obj unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	return kubernetesresources.IDFromParts(kubernetesresources.PlaneNameTODO, gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName()).String()
+}
+
+// prepareRecipeResponse populates the recipe response from the Kubernetes objects deployed by the Helm release. Every object is
+// an output resource of the recipe, and the data of the ConfigMaps and Secrets labelled as recipe outputs are the values and secrets.
+func (d *helmDriver) prepareRecipeResponse(definition recipes.EnvironmentDefinition, objects []unstructured.Unstructured) (*recipes.RecipeOutput, error) {
+	recipeResponse := &recipes.RecipeOutput{
+		Resources: []string{},
+		Secrets:   map[string]any{},
+		Values:    map[string]any{},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindHelm,
+			TemplatePath:    definition.TemplatePath,
+			TemplateVersion: definition.TemplateVersion,
+		},
+	}
+
+	for _, obj := range objects {
+		recipeResponse.Resources = append(recipeResponse.Resources, objectResourceID(obj))
+
+		gvk := obj.GroupVersionKind()
+		if obj.GetLabels()[kubernetes.LabelRecipeOutput] != "true" || gvk.Group != "" {
+			continue
+		}
+
+		switch gvk.Kind {
+		case "ConfigMap":
+			data, _, err := unstructured.NestedStringMap(obj.Object, "data")
+			if err != nil {
+				return nil, fmt.Errorf("failed to read ConfigMap %q: %w", obj.GetName(), err)
+			}
+			for key, value := range data {
+				recipeResponse.Values[key] = parseOutputValue(value)
+			}
+		case "Secret":
+			data, _, err := unstructured.NestedStringMap(obj.Object, "data")
+			if err != nil {
+				return nil, fmt.Errorf("failed to read Secret %q: %w", obj.GetName(), err)
+			}
+			for key, value := range data {
+				decoded, err := base64.StdEncoding.DecodeString(value)
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode the value of %q in Secret %q: %w", key, obj.GetName(), err)
+				}
+				recipeResponse.Secrets[key] = string(decoded)
+			}
+
+			stringData, _, err := unstructured.NestedStringMap(obj.Object, "stringData")
+			if err != nil {
+				return nil, fmt.Errorf("failed to read Secret %q: %w", obj.GetName(), err)
+			}
+			for key, value := range stringData {
+				recipeResponse.Secrets[key] = value
+			}
+		}
+	}
+
+	return recipeResponse, nil
+}
+
+// getHelmRelease returns the name and the namespace of the Helm release deploying the recipe. The release is deployed to the
+// namespace the resource consuming the recipe is deployed to.
+func getHelmRelease(recipeContext *recipecontext.Context) (name string, namespace string, err error) {
+	if recipeContext.Runtime.Kubernetes.Namespace == "" {
+		return "", "", errors.New("the environment must have a Kubernetes namespace to deploy a Helm recipe")
+	}
+
+	name, err = helm.ReleaseName(recipeContext.Resource.ID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return name, recipeContext.Runtime.Kubernetes.Namespace, nil
+}
+
+// createHelmValues creates the values to render the Helm chart of the recipe from the parameters set by the operator and the
+// developer. In case of conflict the developer parameter takes precedence. The recipe context is added as the "context" value
+// when the chart accepts it.
+func createHelmValues(devParams, operatorParams map[string]any, isCxtSet bool, recipeContext *recipecontext.Context) (map[string]any, error) {
+	values := map[string]any{}
+	for k, v := range operatorParams {
+		values[k] = v
+	}
+	for k, v := range devParams {
+		values[k] = v
+	}
+
+	if isCxtSet {
+		// Helm templates access values by their JSON names, so the context is converted to a map.
+		b, err := json.Marshal(recipeContext)
+		if err != nil {
+			return nil, err
+		}
+
+		contextValue := map[string]any{}
+		err = json.Unmarshal(b, &contextValue)
+		if err != nil {
+			return nil, err
+		}
+		values[recipecontext.RecipeContextParamKey] = contextValue
+	}
+
+	return values, nil
+}
+
+// parseOutputValue returns the value of a ConfigMap recipe output. Values holding JSON, such as numbers and booleans, are decoded
+// so that they can be bound to non-string properties of the resource, other values are returned as strings.
+func parseOutputValue(value string) any {
+	var decoded any
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+
+	return decoded
+}
@@ -0,0 +1,504 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"helm.sh/helm/v3/pkg/chart"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func setupHelm(t *testing.T) (*helm.MockHelmExecutor, helmDriver) {
+	ctrl := gomock.NewController(t)
+	helmExecutor := helm.NewMockHelmExecutor(ctrl)
+
+	return helmExecutor, helmDriver{helmExecutor: helmExecutor}
+}
+
+func buildHelmTestInputs() (recipes.Configuration, recipes.ResourceMetadata, recipes.EnvironmentDefinition) {
+	envConfig := recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace:            "default-app1",
+				EnvironmentNamespace: "default",
+			},
+		},
+	}
+
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "redis",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/applications.datastores/rediscaches/test-redis",
+		Parameters: map[string]any{
+			"replicaCount": 2,
+		},
+	}
+
+	envRecipe := recipes.EnvironmentDefinition{
+		Name:            "redis",
+		Driver:          recipes.TemplateKindHelm,
+		TemplatePath:    "oci://ghcr.io/sampleregistry/charts/redis",
+		TemplateVersion: "18.6.1",
+		ResourceType:    "Applications.Datastores/redisCaches",
+		Parameters: map[string]any{
+			"replicaCount": 1,
+			"image":        "redis:7",
+		},
+	}
+
+	return envConfig, recipeMetadata, envRecipe
+}
+
+func newObject(apiVersion, kind, namespace, name string, labels map[string]string, content map[string]any) unstructured.Unstructured {
+	obj := unstructured.Unstructured{Object: content}
+	if obj.Object == nil {
+		obj.Object = map[string]any{}
+	}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	return obj
+}
+
+func Test_Helm_Execute_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	releaseName, err := helm.ReleaseName(recipeMetadata.ResourceID)
+	require.NoError(t, err)
+
+	c := &chart.Chart{Metadata: &chart.Metadata{Name: "redis"}}
+	helmExecutor.EXPECT().LoadChart(ctx, helm.Options{EnvRecipe: &envRecipe}).Times(1).Return(c, nil)
+
+	outputLabels := map[string]string{"radapp.io/recipe-output": "true"}
+	objects := []unstructured.Unstructured{
+		newObject("apps/v1", "StatefulSet", "default-app1", "redis", nil, nil),
+		newObject("v1", "Service", "default-app1", "redis", nil, nil),
+		newObject("rbac.authorization.k8s.io/v1", "ClusterRole", "", "redis", nil, nil),
+		newObject("v1", "ConfigMap", "default-app1", "redis-config", nil, map[string]any{
+			"data": map[string]any{"ignored": "value"},
+		}),
+		newObject("v1", "ConfigMap", "default-app1", "redis-outputs", outputLabels, map[string]any{
+			"data": map[string]any{
+				"host": "redis.default-app1.svc.cluster.local",
+				"port": "6379",
+				"tls":  "false",
+			},
+		}),
+		newObject("v1", "Secret", "default-app1", "redis-credentials", outputLabels, map[string]any{
+			"data":       map[string]any{"password": "c2VjcmV0"},
+			"stringData": map[string]any{"username": "admin"},
+		}),
+	}
+
+	helmExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, options helm.Options) ([]unstructured.Unstructured, error) {
+			require.Equal(t, releaseName, options.ReleaseName)
+			require.Equal(t, "default-app1", options.Namespace)
+			require.Equal(t, c, options.Chart)
+			require.Equal(t, 2, options.Values["replicaCount"])
+			require.Equal(t, "redis:7", options.Values["image"])
+
+			recipeContext, ok := options.Values[recipecontext.RecipeContextParamKey].(map[string]any)
+			require.True(t, ok)
+			require.Equal(t, "test-redis", recipeContext["resource"].(map[string]any)["name"])
+			return objects, nil
+		})
+
+	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipeOutput{
+		Resources: []string{
+			"/planes/kubernetes/local/namespaces/default-app1/providers/apps/StatefulSet/redis",
+			"/planes/kubernetes/local/namespaces/default-app1/providers/core/Service/redis",
+			"/planes/kubernetes/local/providers/rbac.authorization.k8s.io/ClusterRole/redis",
+			"/planes/kubernetes/local/namespaces/default-app1/providers/core/ConfigMap/redis-config",
+			"/planes/kubernetes/local/namespaces/default-app1/providers/core/ConfigMap/redis-outputs",
+			"/planes/kubernetes/local/namespaces/default-app1/providers/core/Secret/redis-credentials",
+		},
+		Values: map[string]any{
+			"host": "redis.default-app1.svc.cluster.local",
+			"port": float64(6379),
+			"tls":  false,
+		},
+		Secrets: map[string]any{
+			"password": "secret",
+			"username": "admin",
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindHelm,
+			TemplatePath:    "oci://ghcr.io/sampleregistry/charts/redis",
+			TemplateVersion: "18.6.1",
+		},
+	}
+	require.Equal(t, expected, recipeOutput)
+}
+
+func Test_Helm_Execute_ContextNotAccepted(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	c := &chart.Chart{
+		Metadata: &chart.Metadata{Name: "redis"},
+		Schema:   []byte(`{"properties": {"replicaCount": {"type": "integer"}}, "additionalProperties": false}`),
+	}
+	helmExecutor.EXPECT().LoadChart(ctx, gomock.Any()).Times(1).Return(c, nil)
+	helmExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, options helm.Options) ([]unstructured.Unstructured, error) {
+			require.NotContains(t, options.Values, recipecontext.RecipeContextParamKey)
+			return []unstructured.Unstructured{}, nil
+		})
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func Test_Helm_Execute_NoKubernetesNamespace(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver := setupHelm(t)
+	_, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{},
+				},
+			},
+			Recipe:     recipeMetadata,
+			Definition: envRecipe,
+		},
+	})
+	require.Equal(t, &recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDeploymentFailed,
+			Message: "the environment must have a Kubernetes namespace to deploy a Helm recipe",
+		},
+		DeploymentStatus: "setupError",
+	}, err)
+}
+
+func Test_Helm_Execute_DownloadFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmExecutor.EXPECT().LoadChart(ctx, gomock.Any()).Times(1).Return(nil, errors.New("chart not found"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Equal(t, &recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDownloadFailed,
+			Message: "chart not found",
+		},
+		DeploymentStatus: "setupError",
+	}, err)
+}
+
+func Test_Helm_Execute_DeploymentFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmExecutor.EXPECT().LoadChart(ctx, gomock.Any()).Times(1).Return(&chart.Chart{Metadata: &chart.Metadata{Name: "redis"}}, nil)
+	helmExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(nil, errors.New("timed out waiting for the condition"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Equal(t, &recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDeploymentFailed,
+			Message: "timed out waiting for the condition",
+		},
+		DeploymentStatus: "executionError",
+	}, err)
+}
+
+func Test_Helm_Execute_InvalidOutputs(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmExecutor.EXPECT().LoadChart(ctx, gomock.Any()).Times(1).Return(&chart.Chart{Metadata: &chart.Metadata{Name: "redis"}}, nil)
+	helmExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return([]unstructured.Unstructured{
+		newObject("v1", "Secret", "default-app1", "redis-credentials", map[string]string{"radapp.io/recipe-output": "true"}, map[string]any{
+			"data": map[string]any{"password": "not base64"},
+		}),
+	}, nil)
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+	require.Contains(t, recipeError.ErrorDetails.Message, `failed to decode the value of "password" in Secret "redis-credentials"`)
+}
+
+func Test_Helm_Delete_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	releaseName, err := helm.ReleaseName(recipeMetadata.ResourceID)
+	require.NoError(t, err)
+
+	helmExecutor.EXPECT().Delete(ctx, helm.Options{
+		EnvRecipe:   &envRecipe,
+		ReleaseName: releaseName,
+		Namespace:   "default-app1",
+	}).Times(1).Return(nil)
+
+	err = driver.Delete(ctx, DeleteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func Test_Helm_Delete_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmExecutor.EXPECT().Delete(ctx, gomock.Any()).Times(1).Return(errors.New("failed to uninstall"))
+
+	err := driver.Delete(ctx, DeleteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Equal(t, &recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDeletionFailed,
+			Message: "failed to uninstall",
+		},
+	}, err)
+}
+
+func Test_Helm_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	c := &chart.Chart{Metadata: &chart.Metadata{Name: "redis"}}
+	helmExecutor.EXPECT().LoadChart(ctx, helm.Options{EnvRecipe: &envRecipe}).Times(1).Return(c, nil)
+
+	current := []unstructured.Unstructured{
+		newObject("apps/v1", "StatefulSet", "default-app1", "redis", nil, map[string]any{
+			"spec": map[string]any{"replicas": int64(1)},
+		}),
+		newObject("v1", "Service", "default-app1", "redis", nil, nil),
+		newObject("v1", "ConfigMap", "default-app1", "redis-scripts", nil, nil),
+	}
+	planned := []unstructured.Unstructured{
+		newObject("apps/v1", "StatefulSet", "default-app1", "redis", nil, map[string]any{
+			"spec": map[string]any{"replicas": int64(2)},
+		}),
+		newObject("v1", "Service", "default-app1", "redis", nil, nil),
+		newObject("v1", "Secret", "default-app1", "redis-credentials", nil, nil),
+	}
+	helmExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(current, planned, nil)
+
+	plan, err := driver.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				Action:       recipes.ResourceChangeUpdate,
+				ResourceType: "StatefulSet",
+				Name:         "redis",
+				ResourceID:   "/planes/kubernetes/local/namespaces/default-app1/providers/apps/StatefulSet/redis",
+			},
+			{
+				Action:       recipes.ResourceChangeCreate,
+				ResourceType: "Secret",
+				Name:         "redis-credentials",
+				ResourceID:   "/planes/kubernetes/local/namespaces/default-app1/providers/core/Secret/redis-credentials",
+			},
+			{
+				Action:       recipes.ResourceChangeDelete,
+				ResourceType: "ConfigMap",
+				Name:         "redis-scripts",
+				ResourceID:   "/planes/kubernetes/local/namespaces/default-app1/providers/core/ConfigMap/redis-scripts",
+			},
+		},
+	}
+	require.Equal(t, expected, plan)
+}
+
+func Test_Helm_Plan_RefreshOnly(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	missing := []unstructured.Unstructured{
+		newObject("v1", "Service", "default-app1", "redis", nil, nil),
+	}
+	helmExecutor.EXPECT().Drift(ctx, gomock.Any()).Times(1).Return(missing, nil)
+
+	plan, err := driver.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		RefreshOnly: true,
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				Action:       recipes.ResourceChangeCreate,
+				ResourceType: "Service",
+				Name:         "redis",
+				ResourceID:   "/planes/kubernetes/local/namespaces/default-app1/providers/core/Service/redis",
+			},
+		},
+	}
+	require.Equal(t, expected, plan)
+}
+
+func Test_Helm_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	c := &chart.Chart{Metadata: &chart.Metadata{Name: "redis"}}
+	helmExecutor.EXPECT().LoadChart(ctx, gomock.Any()).Times(1).Return(c, nil)
+	helmExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(nil, nil, errors.New("failed to render chart"))
+
+	_, err := driver.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Equal(t, &recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipePlanFailed,
+			Message: "failed to render chart",
+		},
+		DeploymentStatus: "executionError",
+	}, err)
+}
+
+func Test_Helm_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	_, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	c := &chart.Chart{
+		Metadata: &chart.Metadata{Name: "redis"},
+		Values:   map[string]any{"replicaCount": float64(1)},
+		Schema:   []byte(`{"properties": {"replicaCount": {"type": "integer", "minimum": 1}}}`),
+	}
+	helmExecutor.EXPECT().LoadChart(ctx, helm.Options{EnvRecipe: &envRecipe}).Times(1).Return(c, nil)
+
+	metadata, err := driver.GetRecipeMetadata(ctx, BaseOptions{
+		Recipe:     recipeMetadata,
+		Definition: envRecipe,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"parameters": map[string]any{
+			"replicaCount": map[string]any{
+				"type":         "integer",
+				"minValue":     float64(1),
+				"defaultValue": float64(1),
+			},
+		},
+	}, metadata)
+}
+
+func Test_Helm_GetRecipeMetadata_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+	_, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmExecutor.EXPECT().LoadChart(ctx, gomock.Any()).Times(1).Return(nil, errors.New("chart not found"))
+
+	_, err := driver.GetRecipeMetadata(ctx, BaseOptions{
+		Recipe:     recipeMetadata,
+		Definition: envRecipe,
+	})
+	require.Equal(t, &recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeGetMetadataFailed,
+			Message: "chart not found",
+		},
+	}, err)
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"golang.org/x/exp/slices"
 	"k8s.io/client-go/kubernetes"
@@ -51,7 +52,7 @@ var _ Driver = (*terraformDriver)(nil)
 // NewTerraformDriver creates a new instance of driver to execute a Terraform recipe.
 func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, options TerraformOptions, k8sClientSet kubernetes.Interface) Driver {
 	return &terraformDriver{
-		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet),
+		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet, terraform.NewCache(options.Cache)),
 		options:           options,
 	}
 }
@@ -60,6 +61,10 @@ func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.Sec
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string
+
+	// Cache is the options of the cache of the providers, modules and provider mirrors shared by the executions of the
+	// recipes. The cache is disabled if its path is empty.
+	Cache terraform.CacheOptions
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -93,10 +98,12 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 	}
 
 	tfState, err := d.terraformExecutor.Deploy(ctx, terraform.Options{
-		RootDir:        requestDirPath,
-		EnvConfig:      &opts.Configuration,
-		ResourceRecipe: &opts.Recipe,
-		EnvRecipe:      &opts.Definition,
+		RootDir:               requestDirPath,
+		EnvConfig:             &opts.Configuration,
+		ResourceRecipe:        &opts.Recipe,
+		EnvRecipe:             &opts.Definition,
+		BackendSecrets:        getSecretValues(opts.BackendSecrets),
+		ProviderMirrorSecrets: getSecretValues(opts.ProviderMirrorSecrets),
 	})
 
 	unsetError := unsetGitConfigForDir(requestDirPath, opts.Secrets, opts.Definition.TemplatePath)
@@ -137,10 +144,12 @@ func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error
 	}
 
 	err = d.terraformExecutor.Delete(ctx, terraform.Options{
-		RootDir:        requestDirPath,
-		EnvConfig:      &opts.Configuration,
-		ResourceRecipe: &opts.Recipe,
-		EnvRecipe:      &opts.Definition,
+		RootDir:               requestDirPath,
+		EnvConfig:             &opts.Configuration,
+		ResourceRecipe:        &opts.Recipe,
+		EnvRecipe:             &opts.Definition,
+		BackendSecrets:        getSecretValues(opts.BackendSecrets),
+		ProviderMirrorSecrets: getSecretValues(opts.ProviderMirrorSecrets),
 	})
 
 	unsetError := unsetGitConfigForDir(requestDirPath, opts.Secrets, opts.Definition.TemplatePath)
@@ -155,6 +164,143 @@ func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error
 	return nil
 }
 
+// Plan creates a unique directory for the execution of terraform and runs terraform plan on the recipe using
+// the Terraform CLI through terraform-exec. It returns the resource changes reported by the plan.
+func (d *terraformDriver) Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	// Add credential information to .gitconfig for module source of type git.
+	err = addSecretsToGitConfig(requestDirPath, opts.Secrets, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	tfPlan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:               requestDirPath,
+		EnvConfig:             &opts.Configuration,
+		ResourceRecipe:        &opts.Recipe,
+		EnvRecipe:             &opts.Definition,
+		BackendSecrets:        getSecretValues(opts.BackendSecrets),
+		ProviderMirrorSecrets: getSecretValues(opts.ProviderMirrorSecrets),
+		RefreshOnly:           opts.RefreshOnly,
+	})
+
+	unsetError := unsetGitConfigForDir(requestDirPath, opts.Secrets, opts.Definition.TemplatePath)
+	if unsetError != nil {
+		return nil, unsetError
+	}
+
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	if opts.RefreshOnly {
+		return &recipes.RecipePlan{Changes: getDriftedResourceChanges(tfPlan)}, nil
+	}
+
+	return &recipes.RecipePlan{Changes: getPlannedResourceChanges(tfPlan)}, nil
+}
+
+// getDriftedResourceChanges converts the resource drift of a refresh-only Terraform plan to the recipe resource changes
+// re-applying the recipe would make: a resource deleted outside of Terraform is created again, and a resource modified
+// outside of Terraform is updated.
+func getDriftedResourceChanges(tfPlan *tfjson.Plan) []recipes.ResourceChange {
+	changes := []recipes.ResourceChange{}
+	if tfPlan == nil {
+		return changes
+	}
+
+	for _, rc := range tfPlan.ResourceDrift {
+		if rc == nil || rc.Change == nil || rc.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		var action string
+		switch {
+		case rc.Change.Actions.Delete():
+			action = recipes.ResourceChangeCreate
+		case rc.Change.Actions.Update():
+			action = recipes.ResourceChangeUpdate
+		default:
+			continue
+		}
+
+		resourceID := ""
+		if before, ok := rc.Change.Before.(map[string]any); ok {
+			resourceID, _ = before["id"].(string)
+		}
+
+		changes = append(changes, recipes.ResourceChange{
+			Action:       action,
+			ResourceType: rc.Type,
+			Name:         rc.Address,
+			ResourceID:   resourceID,
+		})
+	}
+
+	return changes
+}
+
+// getPlannedResourceChanges converts the resource changes of the Terraform plan to recipe resource changes.
+// Data sources and resources without changes are skipped, and a replaced resource is reported as a deletion and a creation
+// in the order Terraform performs them.
+func getPlannedResourceChanges(tfPlan *tfjson.Plan) []recipes.ResourceChange {
+	changes := []recipes.ResourceChange{}
+	if tfPlan == nil {
+		return changes
+	}
+
+	for _, rc := range tfPlan.ResourceChanges {
+		if rc == nil || rc.Change == nil || rc.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		var actions []string
+		switch {
+		case rc.Change.Actions.Create():
+			actions = []string{recipes.ResourceChangeCreate}
+		case rc.Change.Actions.Update():
+			actions = []string{recipes.ResourceChangeUpdate}
+		case rc.Change.Actions.Delete():
+			actions = []string{recipes.ResourceChangeDelete}
+		case rc.Change.Actions.DestroyBeforeCreate():
+			actions = []string{recipes.ResourceChangeDelete, recipes.ResourceChangeCreate}
+		case rc.Change.Actions.CreateBeforeDestroy():
+			actions = []string{recipes.ResourceChangeCreate, recipes.ResourceChangeDelete}
+		default:
+			// No-op and read actions do not change the resource.
+			continue
+		}
+
+		// The identifier is only known for resources that already exist.
+		resourceID := ""
+		if before, ok := rc.Change.Before.(map[string]any); ok {
+			resourceID, _ = before["id"].(string)
+		}
+
+		for _, action := range actions {
+			changes = append(changes, recipes.ResourceChange{
+				Action:       action,
+				ResourceType: rc.Type,
+				Name:         rc.Address,
+				ResourceID:   resourceID,
+			})
+		}
+	}
+
+	return changes
+}
+
 // prepareRecipeResponse populates the recipe response from the module output named "result" and the
 // resources deployed by the Terraform module. The outputs and resources are retrieved from the input Terraform JSON state.
 func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, definition recipes.EnvironmentDefinition, tfState *tfjson.State) (*recipes.RecipeOutput, error) {
@@ -285,6 +431,18 @@ func (d *terraformDriver) FindSecretIDs(ctx context.Context, envConfig recipes.C
 	return GetSecretStoreID(envConfig, definition.TemplatePath)
 }
 
+// getSecretValues returns the values of the secrets loaded from a secret store, keyed by secret name.
+func getSecretValues(secrets v20231001preview.SecretStoresClientListSecretsResponse) map[string]string {
+	values := map[string]string{}
+	for name, secret := range secrets.Data {
+		if secret != nil && secret.Value != nil {
+			values[name] = *secret.Value
+		}
+	}
+
+	return values
+}
+
 // getDeployedOutputResources is used to the get the resource IDs by parsing the terraform state for resource information and using it to create UCP qualified IDs.
 // Currently only Azure, AWS and Kubernetes providers are supported by output resources.
 func (d *terraformDriver) getDeployedOutputResources(ctx context.Context, module *tfjson.StateModule) ([]string, error) {
@@ -27,9 +27,11 @@ import (
 	"github.com/google/uuid"
 	tfjson "github.com/hashicorp/terraform-json"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	gomock "go.uber.org/mock/gomock"
 
 	"github.com/radius-project/radius/pkg/recipes/terraform"
@@ -404,6 +406,42 @@ func Test_Terraform_Delete_Success(t *testing.T) {
 	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
 }
 
+func Test_Terraform_Delete_BackendSecrets(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Delete(ctx, gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, options terraform.Options) error {
+			require.Equal(t, map[string]string{"access_key": "id", "secret_key": "secret"}, options.BackendSecrets)
+			return nil
+		})
+
+	err := driver.Delete(ctx, DeleteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+			BackendSecrets: v20231001preview.SecretStoresClientListSecretsResponse{
+				SecretStoreListSecretsResult: v20231001preview.SecretStoreListSecretsResult{
+					Data: map[string]*v20231001preview.SecretValueProperties{
+						"access_key": {Value: to.Ptr("id")},
+						"secret_key": {Value: to.Ptr("secret")},
+						"empty":      nil,
+					},
+				},
+			},
+		},
+		OutputResources: []rpv1.OutputResource{},
+	})
+	require.NoError(t, err)
+}
+
 func Test_Terraform_Delete_EmptyPath(t *testing.T) {
 	_, driver := setup(t)
 	driver.options.Path = ""
@@ -461,6 +499,174 @@ func Test_Terraform_Delete_Failure(t *testing.T) {
 	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
 }
 
+func Test_Terraform_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	redisID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis-test"
+	tfPlan := &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "module.redis-azure.azurerm_redis_cache.redis",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_cache",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}, Before: map[string]any{"id": redisID}},
+			},
+			{
+				Address: "module.redis-azure.azurerm_redis_firewall_rule.rule",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_firewall_rule",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+			},
+			{
+				Address: "module.redis-azure.azurerm_resource_group.rg",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_resource_group",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}},
+			},
+			{
+				Address: "module.redis-azure.data.azurerm_client_config.current",
+				Mode:    tfjson.DataResourceMode,
+				Type:    "azurerm_client_config",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionRead}},
+			},
+			{
+				Address: "module.redis-azure.kubernetes_secret.keys",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "kubernetes_secret",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}, Before: map[string]any{"id": "default/keys"}},
+			},
+		},
+	}
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(tfPlan, nil)
+
+	expectedPlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{Action: recipes.ResourceChangeUpdate, ResourceType: "azurerm_redis_cache", Name: "module.redis-azure.azurerm_redis_cache.redis", ResourceID: redisID},
+			{Action: recipes.ResourceChangeCreate, ResourceType: "azurerm_redis_firewall_rule", Name: "module.redis-azure.azurerm_redis_firewall_rule.rule"},
+			{Action: recipes.ResourceChangeDelete, ResourceType: "kubernetes_secret", Name: "module.redis-azure.kubernetes_secret.keys", ResourceID: "default/keys"},
+			{Action: recipes.ResourceChangeCreate, ResourceType: "kubernetes_secret", Name: "module.redis-azure.kubernetes_secret.keys", ResourceID: "default/keys"},
+		},
+	}
+
+	plan, err := driver.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPlan, plan)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Plan_RefreshOnly(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	redisID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis-test"
+	tfPlan := &tfjson.Plan{
+		// Changes to the recipe configuration are not drift.
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "module.redis-azure.azurerm_redis_firewall_rule.rule",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_firewall_rule",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+			},
+		},
+		ResourceDrift: []*tfjson.ResourceChange{
+			{
+				Address: "module.redis-azure.azurerm_redis_cache.redis",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_cache",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}, Before: map[string]any{"id": redisID}},
+			},
+			{
+				Address: "module.redis-azure.kubernetes_secret.keys",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "kubernetes_secret",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}, Before: map[string]any{"id": "default/keys"}},
+			},
+			{
+				Address: "module.redis-azure.data.azurerm_client_config.current",
+				Mode:    tfjson.DataResourceMode,
+				Type:    "azurerm_client_config",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+			},
+		},
+	}
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, options terraform.Options) (*tfjson.Plan, error) {
+		require.True(t, options.RefreshOnly)
+		return tfPlan, nil
+	}).Times(1)
+
+	expectedPlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{Action: recipes.ResourceChangeUpdate, ResourceType: "azurerm_redis_cache", Name: "module.redis-azure.azurerm_redis_cache.redis", ResourceID: redisID},
+			{Action: recipes.ResourceChangeCreate, ResourceType: "kubernetes_secret", Name: "module.redis-azure.kubernetes_secret.keys", ResourceID: "default/keys"},
+		},
+	}
+
+	plan, err := driver.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		RefreshOnly: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPlan, plan)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).
+		Return(nil, errors.New("Failed to plan terraform module"))
+
+	expErr := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipePlanFailed,
+			Message: "Failed to plan terraform module",
+		},
+		DeploymentStatus: "executionError",
+	}
+
+	_, err := driver.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, &expErr, err)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
 func Test_Terraform_PrepareRecipeResponse(t *testing.T) {
 	d := &terraformDriver{}
 	tests := []struct {
@@ -43,6 +43,9 @@ type Driver interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error)
+
+	// Plan fetches the recipe contents and returns the changes the recipe deployment would make to its resources without deploying it.
+	Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error)
 }
 
 // DriverWithSecrets is an optional interface and used when the driver needs to load secrets for recipe deployment.
@@ -70,6 +73,13 @@ type BaseOptions struct {
 
 	// Secrets specifies the module authentication information stored in the secret store.
 	Secrets v20231001preview.SecretStoresClientListSecretsResponse
+
+	// BackendSecrets specifies the credentials of the Terraform backend stored in the secret store.
+	BackendSecrets v20231001preview.SecretStoresClientListSecretsResponse
+
+	// ProviderMirrorSecrets specifies the credentials of the container registry of the Terraform provider mirror stored in
+	// the secret store.
+	ProviderMirrorSecrets v20231001preview.SecretStoresClientListSecretsResponse
 }
 
 // ExecuteOptions is the options for the Execute method.
@@ -87,6 +97,16 @@ type DeleteOptions struct {
 	OutputResources []rpv1.OutputResource
 }
 
+// PlanOptions is the options for the Plan method.
+type PlanOptions struct {
+	BaseOptions
+	// Previously deployed state of output resource IDs.
+	PrevState []string
+	// RefreshOnly reports the changes re-executing the recipe would make to restore the deployed resources to the state
+	// they were deployed in, rather than the changes to the recipe itself. It is used to detect drift.
+	RefreshOnly bool
+}
+
 // GetSecretStoreID returns secretstore resource ID associated with git private terraform repository source.
 func GetSecretStoreID(envConfig recipes.Configuration, templatePath string) (string, error) {
 	if strings.HasPrefix(templatePath, "git::") {
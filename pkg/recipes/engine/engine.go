@@ -97,12 +97,24 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		return nil, nil, err
 	}
 
+	backendSecrets, err := e.getBackendSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	providerMirrorSecrets, err := e.getProviderMirrorSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	res, err := driver.Execute(ctx, recipedriver.ExecuteOptions{
 		BaseOptions: recipedriver.BaseOptions{
-			Configuration: *configuration,
-			Recipe:        recipe,
-			Definition:    *definition,
-			Secrets:       secrets,
+			Configuration:         *configuration,
+			Recipe:                recipe,
+			Definition:            *definition,
+			Secrets:               secrets,
+			BackendSecrets:        backendSecrets,
+			ProviderMirrorSecrets: providerMirrorSecrets,
 		},
 		PrevState: prevState,
 	})
@@ -156,12 +168,24 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 	if err != nil {
 		return nil, err
 	}
+
+	backendSecrets, err := e.getBackendSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	providerMirrorSecrets, err := e.getProviderMirrorSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, err
+	}
 	err = driver.Delete(ctx, recipedriver.DeleteOptions{
 		BaseOptions: recipedriver.BaseOptions{
-			Configuration: *configuration,
-			Recipe:        recipe,
-			Definition:    *definition,
-			Secrets:       secrets,
+			Configuration:         *configuration,
+			Recipe:                recipe,
+			Definition:            *definition,
+			Secrets:               secrets,
+			BackendSecrets:        backendSecrets,
+			ProviderMirrorSecrets: providerMirrorSecrets,
 		},
 		OutputResources: outputResources,
 	})
@@ -210,6 +234,90 @@ func (e *engine) getRecipeMetadataCore(ctx context.Context, opts GetRecipeMetada
 	})
 }
 
+// Plan loads the recipe definition from the environment, finds the driver associated with the recipe, and returns the
+// changes the recipe deployment would make to its resources as reported by the driver. The recipe is not deployed.
+func (e *engine) Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error) {
+	planStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	plan, definition, err := e.planCore(ctx, opts)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, planStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationPlan, opts.Recipe.Name,
+			definition, result))
+
+	return plan, err
+}
+
+// planCore function is the core logic of the Plan function.
+// Any changes to the core logic of the Plan function should be made here.
+func (e *engine) planCore(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, *recipes.EnvironmentDefinition, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	recipe := opts.Recipe
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// A simulated environment never deploys recipes, so there are no changes to preview.
+	if configuration.Simulated {
+		logger.Info("simulated environment enabled, skipping planning")
+		return &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, nil, nil
+	}
+
+	definition, driver, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts.DeployedRecipe != nil {
+		definition, driver, err = e.getDeployedDriver(definition, opts.DeployedRecipe)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	backendSecrets, err := e.getBackendSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	providerMirrorSecrets, err := e.getProviderMirrorSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan, err := driver.Plan(ctx, recipedriver.PlanOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration:         *configuration,
+			Recipe:                recipe,
+			Definition:            *definition,
+			Secrets:               secrets,
+			BackendSecrets:        backendSecrets,
+			ProviderMirrorSecrets: providerMirrorSecrets,
+		},
+		PrevState:   opts.PreviousState,
+		RefreshOnly: opts.RefreshOnly,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	return plan, definition, nil
+}
+
 func (e *engine) getDriver(ctx context.Context, recipeMetadata recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, recipedriver.Driver, error) {
 	// Load Recipe Definition from the environment.
 	definition, err := e.options.ConfigurationLoader.LoadRecipe(ctx, &recipeMetadata)
@@ -226,6 +334,25 @@ func (e *engine) getDriver(ctx context.Context, recipeMetadata recipes.ResourceM
 	return definition, driver, nil
 }
 
+// getDeployedDriver returns a copy of the recipe definition whose template is the one recorded in the deployed recipe
+// status, and the driver of its template kind. The recipe may have been re-registered in the environment with a
+// different template since the resource was deployed.
+func (e *engine) getDeployedDriver(definition *recipes.EnvironmentDefinition, deployed *rpv1.RecipeStatus) (*recipes.EnvironmentDefinition, recipedriver.Driver, error) {
+	deployedDefinition := *definition
+	deployedDefinition.TemplatePath = deployed.TemplatePath
+	deployedDefinition.TemplateVersion = deployed.TemplateVersion
+	if deployed.TemplateKind != "" {
+		deployedDefinition.Driver = deployed.TemplateKind
+	}
+
+	driver, ok := e.options.Drivers[deployedDefinition.Driver]
+	if !ok {
+		err := fmt.Errorf("could not find driver `%s`", deployedDefinition.Driver)
+		return nil, nil, recipes.NewRecipeError(recipes.RecipeDriverNotFoundFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	return &deployedDefinition, driver, nil
+}
+
 func (e *engine) getRecipeConfigSecrets(ctx context.Context, driver recipedriver.Driver, configuration *recipes.Configuration, definition *recipes.EnvironmentDefinition) (v20231001preview.SecretStoresClientListSecretsResponse, error) {
 	secrets := v20231001preview.SecretStoresClientListSecretsResponse{}
 	driverWithSecrets, ok := driver.(recipedriver.DriverWithSecrets)
@@ -247,3 +374,36 @@ func (e *engine) getRecipeConfigSecrets(ctx context.Context, driver recipedriver
 	}
 	return secrets, nil
 }
+
+// getBackendSecrets loads the credentials of the Terraform backend configured for the environment from the secret store
+// referenced by the backend configuration. Only drivers loading secrets use a Terraform backend.
+func (e *engine) getBackendSecrets(ctx context.Context, driver recipedriver.Driver, configuration *recipes.Configuration, definition *recipes.EnvironmentDefinition) (v20231001preview.SecretStoresClientListSecretsResponse, error) {
+	secretStore := configuration.RecipeConfig.Terraform.Backend.Secret
+	if _, ok := driver.(recipedriver.DriverWithSecrets); !ok || secretStore == "" {
+		return v20231001preview.SecretStoresClientListSecretsResponse{}, nil
+	}
+
+	secrets, err := e.options.SecretsLoader.LoadSecrets(ctx, secretStore)
+	if err != nil {
+		return v20231001preview.SecretStoresClientListSecretsResponse{}, recipes.NewRecipeError(recipes.LoadSecretsFailed, fmt.Sprintf("failed to fetch the Terraform backend credentials from the secret store resource id %s for Terraform recipe %s deployment: %s", secretStore, definition.TemplatePath, err.Error()), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	return secrets, nil
+}
+
+// getProviderMirrorSecrets loads the credentials of the container registry of the Terraform provider mirror configured
+// for the environment from the secret store referenced by the provider mirror configuration. Only drivers loading
+// secrets use a Terraform provider mirror.
+func (e *engine) getProviderMirrorSecrets(ctx context.Context, driver recipedriver.Driver, configuration *recipes.Configuration, definition *recipes.EnvironmentDefinition) (v20231001preview.SecretStoresClientListSecretsResponse, error) {
+	secretStore := configuration.RecipeConfig.Terraform.ProviderMirror.Secret
+	if _, ok := driver.(recipedriver.DriverWithSecrets); !ok || secretStore == "" {
+		return v20231001preview.SecretStoresClientListSecretsResponse{}, nil
+	}
+
+	secrets, err := e.options.SecretsLoader.LoadSecrets(ctx, secretStore)
+	if err != nil {
+		return v20231001preview.SecretStoresClientListSecretsResponse{}, recipes.NewRecipeError(recipes.LoadSecretsFailed, fmt.Sprintf("failed to fetch the Terraform provider mirror credentials from the secret store resource id %s for Terraform recipe %s deployment: %s", secretStore, definition.TemplatePath, err.Error()), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	return secrets, nil
+}
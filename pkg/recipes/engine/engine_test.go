@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	recipedriver "github.com/radius-project/radius/pkg/recipes/driver"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
@@ -988,6 +989,248 @@ func Test_GetRecipeMetadata_Driver_InvalidDriver(t *testing.T) {
 	require.Contains(t, err.Error(), "could not find driver invalid")
 }
 
+func Test_Engine_Plan_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	prevState := []string{
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipePlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				Action:       recipes.ResourceChangeCreate,
+				ResourceType: "System.Test/testResources",
+				Name:         "test2",
+				ResourceID:   "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test2",
+			},
+			{
+				Action:       recipes.ResourceChangeDelete,
+				ResourceType: "System.Test/testResources",
+				Name:         "test1",
+				ResourceID:   "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+			},
+		},
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, recipedriver.PlanOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    recipeDefinition,
+			},
+			PrevState: prevState,
+		}).
+		Times(1).
+		Return(recipePlan, nil)
+
+	result, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState: prevState,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipePlan, result)
+}
+
+func Test_Engine_Plan_RefreshOnly_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	prevState := []string{
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipePlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				Action:       recipes.ResourceChangeCreate,
+				ResourceType: "System.Test/testResources",
+				Name:         "test2",
+				ResourceID:   "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test2",
+			},
+			{
+				Action:       recipes.ResourceChangeDelete,
+				ResourceType: "System.Test/testResources",
+				Name:         "test1",
+				ResourceID:   "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+			},
+		},
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, recipedriver.PlanOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    recipeDefinition,
+			},
+			PrevState:   prevState,
+			RefreshOnly: true,
+		}).
+		Times(1).
+		Return(recipePlan, nil)
+
+	result, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState: prevState,
+		RefreshOnly:   true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipePlan, result)
+}
+
+func Test_Engine_Plan_RefreshOnly_DeployedRecipe(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	deployedRecipe := &rpv1.RecipeStatus{
+		TemplateKind: recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:0.9",
+	}
+	recipePlan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+
+	// The template the resource was deployed with is planned, not the template currently registered in the environment.
+	deployedDefinition := recipeDefinition
+	deployedDefinition.TemplatePath = deployedRecipe.TemplatePath
+	driver.EXPECT().
+		Plan(ctx, recipedriver.PlanOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    deployedDefinition,
+			},
+			PrevState:   []string{},
+			RefreshOnly: true,
+		}).
+		Times(1).
+		Return(recipePlan, nil)
+
+	result, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState:  []string{},
+		RefreshOnly:    true,
+		DeployedRecipe: deployedRecipe,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipePlan, result)
+}
+
+func Test_Engine_Plan_SimulatedEnv_Success(t *testing.T) {
+	recipeMetadata, _, _ := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+		Simulated: true,
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, _, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+
+	// Note: LoadRecipe is not called as the environment is simulated
+
+	result, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, result)
+}
+
+func Test_Engine_Plan_Error(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, gomock.Any()).
+		Times(1).
+		Return(nil, errors.New("failed to plan recipe"))
+
+	_, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, "failed to plan recipe", err.Error())
+}
+
 func getRecipeInputs() (recipes.ResourceMetadata, recipes.EnvironmentDefinition, []rpv1.OutputResource) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -1187,3 +1430,189 @@ func Test_Engine_Delete_With_Secrets_Success(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func Test_Engine_Execute_With_Backend_Secrets(t *testing.T) {
+	backendSecretStore := "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tfstate"
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				Backend: datamodel.TerraformBackendConfig{
+					Kind:   "s3",
+					Config: map[string]string{"bucket": "tfstate", "region": "us-west-2"},
+					Secret: backendSecretStore,
+				},
+			},
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindTerraform,
+		TemplatePath: "Azure/cosmosdb/azurerm",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	backendSecrets := v20231001preview.SecretStoresClientListSecretsResponse{
+		SecretStoreListSecretsResult: v20231001preview.SecretStoreListSecretsResult{
+			Data: map[string]*v20231001preview.SecretValueProperties{
+				"access_key": {Value: to.Ptr("id")},
+				"secret_key": {Value: to.Ptr("secret")},
+			},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		engine, configLoader, _, driverWithSecrets, secretsLoader := setup(t)
+		configLoader.EXPECT().
+			LoadConfiguration(ctx, recipeMetadata).
+			Times(1).
+			Return(envConfig, nil)
+		configLoader.EXPECT().
+			LoadRecipe(ctx, &recipeMetadata).
+			Times(1).
+			Return(recipeDefinition, nil)
+		driverWithSecrets.EXPECT().
+			FindSecretIDs(ctx, *envConfig, *recipeDefinition).
+			Times(1).
+			Return("", nil)
+		secretsLoader.EXPECT().
+			LoadSecrets(ctx, backendSecretStore).
+			Times(1).
+			Return(backendSecrets, nil)
+		driverWithSecrets.EXPECT().
+			Execute(ctx, recipedriver.ExecuteOptions{
+				BaseOptions: recipedriver.BaseOptions{
+					Configuration:  *envConfig,
+					Recipe:         recipeMetadata,
+					Definition:     *recipeDefinition,
+					BackendSecrets: backendSecrets,
+				},
+			}).
+			Times(1).
+			Return(&recipes.RecipeOutput{}, nil)
+
+		_, err := engine.Execute(ctx, ExecuteOptions{
+			BaseOptions: BaseOptions{
+				Recipe: recipeMetadata,
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("load secrets failure", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		engine, configLoader, _, driverWithSecrets, secretsLoader := setup(t)
+		configLoader.EXPECT().
+			LoadConfiguration(ctx, recipeMetadata).
+			Times(1).
+			Return(envConfig, nil)
+		configLoader.EXPECT().
+			LoadRecipe(ctx, &recipeMetadata).
+			Times(1).
+			Return(recipeDefinition, nil)
+		driverWithSecrets.EXPECT().
+			FindSecretIDs(ctx, *envConfig, *recipeDefinition).
+			Times(1).
+			Return("", nil)
+		secretsLoader.EXPECT().
+			LoadSecrets(ctx, backendSecretStore).
+			Times(1).
+			Return(v20231001preview.SecretStoresClientListSecretsResponse{}, errors.New("secret store not found"))
+
+		_, err := engine.Execute(ctx, ExecuteOptions{
+			BaseOptions: BaseOptions{
+				Recipe: recipeMetadata,
+			},
+		})
+		require.Error(t, err)
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.LoadSecretsFailed, recipeError.ErrorDetails.Code)
+		require.Contains(t, recipeError.ErrorDetails.Message, "failed to fetch the Terraform backend credentials from the secret store resource id "+backendSecretStore)
+	})
+}
+
+func Test_Engine_Execute_With_ProviderMirror_Secrets(t *testing.T) {
+	registrySecretStore := "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/registry"
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				ProviderMirror: datamodel.TerraformProviderMirrorConfig{
+					Kind:   "oci",
+					Path:   "myregistry.azurecr.io/terraform/providers:1.0",
+					Secret: registrySecretStore,
+				},
+			},
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindTerraform,
+		TemplatePath: "Azure/cosmosdb/azurerm",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	registrySecrets := v20231001preview.SecretStoresClientListSecretsResponse{
+		SecretStoreListSecretsResult: v20231001preview.SecretStoreListSecretsResult{
+			Data: map[string]*v20231001preview.SecretValueProperties{
+				"username": {Value: to.Ptr("user")},
+				"password": {Value: to.Ptr("pass")},
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, _, driverWithSecrets, secretsLoader := setup(t)
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driverWithSecrets.EXPECT().
+		FindSecretIDs(ctx, *envConfig, *recipeDefinition).
+		Times(1).
+		Return("", nil)
+	secretsLoader.EXPECT().
+		LoadSecrets(ctx, registrySecretStore).
+		Times(1).
+		Return(registrySecrets, nil)
+	driverWithSecrets.EXPECT().
+		Execute(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration:         *envConfig,
+				Recipe:                recipeMetadata,
+				Definition:            *recipeDefinition,
+				ProviderMirrorSecrets: registrySecrets,
+			},
+		}).
+		Times(1).
+		Return(&recipes.RecipeOutput{}, nil)
+
+	_, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.NoError(t, err)
+}
@@ -35,6 +35,10 @@ type Engine interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error)
+
+	// Plan gathers environment configuration, recipe definition and calls the driver to preview the changes the recipe
+	// deployment would make to its resources, without deploying the recipe.
+	Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error)
 }
 
 // BaseOptions is the base options for the engine operations.
@@ -64,3 +68,15 @@ type GetRecipeMetadataOptions struct {
 	BaseOptions
 	RecipeDefinition recipes.EnvironmentDefinition
 }
+
+// PlanOptions is the options for the Plan method.
+type PlanOptions struct {
+	BaseOptions
+	// PreviousState represents previously deployed state of output resource IDs.
+	PreviousState []string
+	// RefreshOnly previews the changes re-executing the recipe would make to restore the deployed resources, to detect drift.
+	RefreshOnly bool
+	// DeployedRecipe is the status of the recipe the resource was deployed with. When set, the template it records is
+	// planned instead of the template currently registered in the environment.
+	DeployedRecipe *rpv1.RecipeStatus
+}
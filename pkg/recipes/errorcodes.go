@@ -38,6 +38,9 @@ const (
 	// Used for errors encountered when getting recipe parameters.
 	RecipeGetMetadataFailed = "RecipeGetMetadataFailed"
 
+	// Used for errors encountered when planning the changes of a recipe deployment.
+	RecipePlanFailed = "RecipePlanFailed"
+
 	// Used for errors when checking the existence of a recipe.
 	RecipeNotFoundFailure = "RecipeNotFoundFailure"
 
@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/registry"
+
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// maxReleaseNameLength is the maximum length of a Helm release name.
+	maxReleaseNameLength = 53
+
+	// releaseNameHashLength is the length of the resource ID hash suffixed to the Helm release name.
+	releaseNameHashLength = 8
+)
+
+var invalidReleaseNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// valuesSchema represents the parts of the values schema (values.schema.json) of a Helm chart used by Radius.
+type valuesSchema struct {
+	Properties           map[string]map[string]any `json:"properties,omitempty"`
+	AdditionalProperties any                       `json:"additionalProperties,omitempty"`
+}
+
+// ReleaseName returns the name of the Helm release deploying the recipe of the given resource. The name of the resource is
+// suffixed with a hash of the resource ID, so that resources of different types sharing a name get separate releases.
+func ReleaseName(resourceID string) (string, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return "", err
+	}
+
+	hasher := sha1.New()
+	_, err = hasher.Write([]byte(strings.ToLower(id.String())))
+	if err != nil {
+		return "", err
+	}
+	suffix := fmt.Sprintf("%x", hasher.Sum(nil))[:releaseNameHashLength]
+
+	name := strings.Trim(invalidReleaseNameChars.ReplaceAllString(strings.ToLower(id.Name()), "-"), "-")
+	if maxLength := maxReleaseNameLength - releaseNameHashLength - 1; len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-")
+	}
+	if name == "" {
+		return suffix, nil
+	}
+
+	return name + "-" + suffix, nil
+}
+
+// chartReference splits the template path of a Helm recipe into the chart repository URL and the chart name used to locate
+// the chart. The template path is either a chart in an OCI registry (oci://ghcr.io/myregistry/charts/redis), the URL of a
+// chart archive (https://example.com/charts/redis-18.6.1.tgz) or a chart in a chart repository (https://charts.bitnami.com/bitnami/redis).
+func chartReference(templatePath string) (repoURL string, name string) {
+	templatePath = strings.TrimSuffix(templatePath, "/")
+	if registry.IsOCI(templatePath) || strings.HasSuffix(templatePath, ".tgz") {
+		return "", templatePath
+	}
+
+	i := strings.LastIndex(templatePath, "/")
+	if i < 0 {
+		return "", templatePath
+	}
+
+	return templatePath[:i], templatePath[i+1:]
+}
+
+// Parameters returns the parameters of the Helm chart, keyed by the name of the top level value. The parameter details are read
+// from the values schema of the chart, falling back to the default values of the chart (values.yaml) for the default value of each
+// parameter. The parameters of a chart without a values schema are its top level default values.
+func Parameters(c *chart.Chart) (map[string]any, error) {
+	parameters := map[string]any{}
+	if len(c.Schema) == 0 {
+		for name, value := range c.Values {
+			details := map[string]any{
+				"defaultValue": value,
+			}
+			if valueType := getValueType(value); valueType != "" {
+				details["type"] = valueType
+			}
+			parameters[name] = details
+		}
+
+		return parameters, nil
+	}
+
+	schema := valuesSchema{}
+	err := json.Unmarshal(c.Schema, &schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the values schema of the Helm chart %q: %w", c.Name(), err)
+	}
+
+	for name, property := range schema.Properties {
+		details := map[string]any{}
+		if valueType, ok := property["type"].(string); ok {
+			details["type"] = valueType
+		}
+		if description, ok := property["description"]; ok {
+			details["description"] = description
+		}
+		if minimum, ok := property["minimum"]; ok {
+			details["minValue"] = minimum
+		}
+		if maximum, ok := property["maximum"]; ok {
+			details["maxValue"] = maximum
+		}
+		if allowed, ok := property["enum"]; ok {
+			details["allowedValues"] = allowed
+		}
+		if defaultValue, ok := property["default"]; ok {
+			details["defaultValue"] = defaultValue
+		} else if defaultValue, ok := c.Values[name]; ok {
+			details["defaultValue"] = defaultValue
+		}
+		parameters[name] = details
+	}
+
+	return parameters, nil
+}
+
+// HasContextValue returns true if the recipe context can be passed to the Helm chart as the "context" value. This is the case
+// unless the values schema of the chart disallows additional values without declaring the context value.
+func HasContextValue(c *chart.Chart) bool {
+	if len(c.Schema) == 0 {
+		return true
+	}
+
+	schema := valuesSchema{}
+	if err := json.Unmarshal(c.Schema, &schema); err != nil {
+		return true
+	}
+
+	if _, ok := schema.Properties[recipecontext.RecipeContextParamKey]; ok {
+		return true
+	}
+
+	additional, ok := schema.AdditionalProperties.(bool)
+	return !ok || additional
+}
+
+func getValueType(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case int, int64, float64:
+		return "number"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"strings"
+	"testing"
+
+	"helm.sh/helm/v3/pkg/chart"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReleaseName(t *testing.T) {
+	t.Run("resource name is suffixed with a hash of the resource ID", func(t *testing.T) {
+		name, err := ReleaseName("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/My_Redis")
+		require.NoError(t, err)
+		require.Regexp(t, `^my-redis-[0-9a-f]{8}$`, name)
+	})
+
+	t.Run("resources of different types get different names", func(t *testing.T) {
+		redis, err := ReleaseName("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/db")
+		require.NoError(t, err)
+		mongo, err := ReleaseName("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/db")
+		require.NoError(t, err)
+		require.NotEqual(t, redis, mongo)
+	})
+
+	t.Run("resource ID casing is ignored", func(t *testing.T) {
+		lower, err := ReleaseName("/planes/radius/local/resourcegroups/test-rg/providers/applications.datastores/rediscaches/db")
+		require.NoError(t, err)
+		upper, err := ReleaseName("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/db")
+		require.NoError(t, err)
+		require.Equal(t, lower, upper)
+	})
+
+	t.Run("long names are truncated", func(t *testing.T) {
+		name, err := ReleaseName("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/" + strings.Repeat("a", 100))
+		require.NoError(t, err)
+		require.Len(t, name, maxReleaseNameLength)
+	})
+
+	t.Run("invalid resource ID", func(t *testing.T) {
+		_, err := ReleaseName("invalid")
+		require.Error(t, err)
+	})
+}
+
+func Test_chartReference(t *testing.T) {
+	tests := []struct {
+		templatePath string
+		repoURL      string
+		name         string
+	}{
+		{
+			templatePath: "oci://ghcr.io/myregistry/charts/redis",
+			name:         "oci://ghcr.io/myregistry/charts/redis",
+		},
+		{
+			templatePath: "https://example.com/charts/redis-18.6.1.tgz",
+			name:         "https://example.com/charts/redis-18.6.1.tgz",
+		},
+		{
+			templatePath: "https://charts.bitnami.com/bitnami/redis",
+			repoURL:      "https://charts.bitnami.com/bitnami",
+			name:         "redis",
+		},
+		{
+			templatePath: "https://charts.bitnami.com/bitnami/redis/",
+			repoURL:      "https://charts.bitnami.com/bitnami",
+			name:         "redis",
+		},
+		{
+			templatePath: "redis",
+			name:         "redis",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.templatePath, func(t *testing.T) {
+			repoURL, name := chartReference(tc.templatePath)
+			require.Equal(t, tc.repoURL, repoURL)
+			require.Equal(t, tc.name, name)
+		})
+	}
+}
+
+func Test_Parameters(t *testing.T) {
+	t.Run("values schema", func(t *testing.T) {
+		c := &chart.Chart{
+			Metadata: &chart.Metadata{Name: "redis"},
+			Values: map[string]any{
+				"replicaCount": float64(1),
+				"image":        "redis:7",
+			},
+			Schema: []byte(`{
+				"properties": {
+					"replicaCount": {"type": "integer", "minimum": 1, "maximum": 5, "description": "Number of replicas."},
+					"image": {"type": "string"},
+					"mode": {"type": "string", "enum": ["standalone", "cluster"], "default": "standalone"}
+				}
+			}`),
+		}
+
+		parameters, err := Parameters(c)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"replicaCount": map[string]any{
+				"type":         "integer",
+				"minValue":     float64(1),
+				"maxValue":     float64(5),
+				"description":  "Number of replicas.",
+				"defaultValue": float64(1),
+			},
+			"image": map[string]any{
+				"type":         "string",
+				"defaultValue": "redis:7",
+			},
+			"mode": map[string]any{
+				"type":          "string",
+				"allowedValues": []any{"standalone", "cluster"},
+				"defaultValue":  "standalone",
+			},
+		}, parameters)
+	})
+
+	t.Run("default values without schema", func(t *testing.T) {
+		c := &chart.Chart{
+			Metadata: &chart.Metadata{Name: "redis"},
+			Values: map[string]any{
+				"replicaCount": float64(1),
+				"auth":         map[string]any{"enabled": true},
+				"extraFlags":   []any{},
+				"nameOverride": nil,
+			},
+		}
+
+		parameters, err := Parameters(c)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"replicaCount": map[string]any{"type": "number", "defaultValue": float64(1)},
+			"auth":         map[string]any{"type": "object", "defaultValue": map[string]any{"enabled": true}},
+			"extraFlags":   map[string]any{"type": "array", "defaultValue": []any{}},
+			"nameOverride": map[string]any{"defaultValue": nil},
+		}, parameters)
+	})
+
+	t.Run("invalid values schema", func(t *testing.T) {
+		c := &chart.Chart{
+			Metadata: &chart.Metadata{Name: "redis"},
+			Schema:   []byte(`{`),
+		}
+
+		_, err := Parameters(c)
+		require.ErrorContains(t, err, `failed to parse the values schema of the Helm chart "redis"`)
+	})
+}
+
+func Test_HasContextValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		expected bool
+	}{
+		{
+			name:     "no values schema",
+			expected: true,
+		},
+		{
+			name:     "additional values allowed",
+			schema:   `{"properties": {"image": {"type": "string"}}}`,
+			expected: true,
+		},
+		{
+			name:     "context value declared",
+			schema:   `{"properties": {"context": {"type": "object"}}, "additionalProperties": false}`,
+			expected: true,
+		},
+		{
+			name:     "additional values disallowed",
+			schema:   `{"properties": {"image": {"type": "string"}}, "additionalProperties": false}`,
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &chart.Chart{Metadata: &chart.Metadata{Name: "redis"}}
+			if tc.schema != "" {
+				c.Schema = []byte(tc.schema)
+			}
+			require.Equal(t, tc.expected, HasContextValue(c))
+		})
+	}
+}
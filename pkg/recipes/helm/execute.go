@@ -0,0 +1,310 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// helmStorageDriver configures Helm to store the release information in Kubernetes secrets.
+	helmStorageDriver = "secret"
+
+	installTimeout   = 10 * time.Minute
+	uninstallTimeout = 5 * time.Minute
+
+	// dryRunOption renders the chart against the Kubernetes cluster, so that lookups in templates return the existing objects.
+	dryRunOption = "server"
+)
+
+var _ HelmExecutor = (*executor)(nil)
+
+// NewExecutor creates a new executor to deploy Helm recipes to the Kubernetes cluster of the given REST config.
+func NewExecutor(restConfig *rest.Config) *executor {
+	return &executor{restConfig: restConfig}
+}
+
+type executor struct {
+	// restConfig is the REST config of the Kubernetes cluster the Helm releases are deployed to.
+	restConfig *rest.Config
+}
+
+// LoadChart downloads the Helm chart referenced by the recipe template path and version to a temporary directory and loads it.
+func (e *executor) LoadChart(ctx context.Context, options Options) (*chart.Chart, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	dir, err := os.MkdirTemp("", "helm-recipe-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory to download the Helm chart: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Helm chart directory %q. Err: %s", dir, err.Error()))
+		}
+	}()
+
+	// Isolate the repository and registry configuration of each download from the Helm configuration of the process.
+	settings := cli.New()
+	settings.RepositoryConfig = filepath.Join(dir, "repositories.yaml")
+	settings.RepositoryCache = filepath.Join(dir, "cache")
+	settings.RegistryConfig = filepath.Join(dir, "registry.json")
+
+	registryOptions := []registry.ClientOption{registry.ClientOptCredentialsFile(settings.RegistryConfig)}
+	if options.EnvRecipe.PlainHTTP {
+		registryOptions = append(registryOptions, registry.ClientOptPlainHTTP())
+	}
+	registryClient, err := registry.NewClient(registryOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	repoURL, name := chartReference(options.EnvRecipe.TemplatePath)
+	install := action.NewInstall(&action.Configuration{})
+	install.SetRegistryClient(registryClient)
+	install.ChartPathOptions.RepoURL = repoURL
+	install.ChartPathOptions.Version = options.EnvRecipe.TemplateVersion
+	install.ChartPathOptions.PlainHTTP = options.EnvRecipe.PlainHTTP
+
+	logger.Info(fmt.Sprintf("Downloading Helm chart %q, version: %q", options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion))
+	chartPath, err := install.ChartPathOptions.LocateChart(name, settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download the Helm chart %q: %w", options.EnvRecipe.TemplatePath, err)
+	}
+
+	return loader.Load(chartPath)
+}
+
+// Deploy installs the Helm chart as a new release, or upgrades the existing release. Upgrading a release deletes the objects
+// that are no longer rendered by the chart.
+func (e *executor) Deploy(ctx context.Context, options Options) ([]unstructured.Unstructured, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg, err := e.actionConfig(ctx, options.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	history := action.NewHistory(cfg)
+	history.Max = 1
+	_, err = history.Run(options.ReleaseName)
+	if err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, fmt.Errorf("failed to retrieve the history of Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	var deployedRelease *release.Release
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		logger.Info(fmt.Sprintf("Installing Helm release %q in namespace %q", options.ReleaseName, options.Namespace))
+		install := action.NewInstall(cfg)
+		install.ReleaseName = options.ReleaseName
+		install.Namespace = options.Namespace
+		install.CreateNamespace = true
+		install.Wait = true
+		install.Timeout = installTimeout
+
+		deployedRelease, err = install.RunWithContext(ctx, options.Chart, options.Values)
+	} else {
+		logger.Info(fmt.Sprintf("Upgrading Helm release %q in namespace %q", options.ReleaseName, options.Namespace))
+		upgrade := action.NewUpgrade(cfg)
+		upgrade.Namespace = options.Namespace
+		upgrade.Wait = true
+		upgrade.Timeout = installTimeout
+
+		deployedRelease, err = upgrade.RunWithContext(ctx, options.ReleaseName, options.Chart, options.Values)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	objects, err := buildObjects(cfg, deployedRelease.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the objects deployed by Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	return objects, nil
+}
+
+// Plan runs a server-side dry run of the installation or the upgrade of the Helm release, and returns the objects of the
+// current release and the objects rendered by the dry run.
+func (e *executor) Plan(ctx context.Context, options Options) ([]unstructured.Unstructured, []unstructured.Unstructured, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg, err := e.actionConfig(ctx, options.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currentRelease, err := action.NewGet(cfg).Run(options.ReleaseName)
+	if err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, nil, fmt.Errorf("failed to retrieve Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	current := []unstructured.Unstructured{}
+	var plannedRelease *release.Release
+	if currentRelease == nil {
+		logger.Info(fmt.Sprintf("Planning the installation of Helm release %q in namespace %q", options.ReleaseName, options.Namespace))
+		install := action.NewInstall(cfg)
+		install.ReleaseName = options.ReleaseName
+		install.Namespace = options.Namespace
+		install.DryRun = true
+		install.DryRunOption = dryRunOption
+
+		plannedRelease, err = install.RunWithContext(ctx, options.Chart, options.Values)
+	} else {
+		current, err = buildObjects(cfg, currentRelease.Manifest)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read the objects deployed by Helm release %q: %w", options.ReleaseName, err)
+		}
+
+		logger.Info(fmt.Sprintf("Planning the upgrade of Helm release %q in namespace %q", options.ReleaseName, options.Namespace))
+		upgrade := action.NewUpgrade(cfg)
+		upgrade.Namespace = options.Namespace
+		upgrade.DryRun = true
+		upgrade.DryRunOption = dryRunOption
+
+		plannedRelease, err = upgrade.RunWithContext(ctx, options.ReleaseName, options.Chart, options.Values)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to plan Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	planned, err := buildObjects(cfg, plannedRelease.Manifest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the objects planned for Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	return current, planned, nil
+}
+
+// Drift reads the objects of the current Helm release and returns the objects that are missing from the Kubernetes cluster.
+// Changes made to existing objects outside of Helm are not reported.
+func (e *executor) Drift(ctx context.Context, options Options) ([]unstructured.Unstructured, error) {
+	cfg, err := e.actionConfig(ctx, options.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	currentRelease, err := action.NewGet(cfg).Run(options.ReleaseName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	infos, err := cfg.KubeClient.Build(bytes.NewBufferString(currentRelease.Manifest), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the objects deployed by Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	missing := []unstructured.Unstructured{}
+	for _, info := range infos {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(info.Object)
+		if err != nil {
+			return nil, err
+		}
+
+		obj := unstructured.Unstructured{Object: content}
+		obj.SetNamespace(info.Namespace)
+
+		err = info.Get()
+		if apierrors.IsNotFound(err) {
+			missing = append(missing, obj)
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to retrieve %s %q deployed by Helm release %q: %w", obj.GetKind(), obj.GetName(), options.ReleaseName, err)
+		}
+	}
+
+	return missing, nil
+}
+
+// Delete uninstalls the Helm release. Deleting a release that does not exist is not an error.
+func (e *executor) Delete(ctx context.Context, options Options) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg, err := e.actionConfig(ctx, options.Namespace)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("Uninstalling Helm release %q in namespace %q", options.ReleaseName, options.Namespace))
+	uninstall := action.NewUninstall(cfg)
+	uninstall.Wait = true
+	uninstall.Timeout = uninstallTimeout
+	uninstall.IgnoreNotFound = true
+
+	_, err = uninstall.Run(options.ReleaseName)
+	if err != nil {
+		return fmt.Errorf("failed to uninstall Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	return nil
+}
+
+// buildObjects reads the Kubernetes objects of a release manifest. Building the manifest resolves the namespace of each
+// object, cluster-scoped objects have no namespace.
+func buildObjects(cfg *action.Configuration, manifest string) ([]unstructured.Unstructured, error) {
+	infos, err := cfg.KubeClient.Build(bytes.NewBufferString(manifest), false)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []unstructured.Unstructured{}
+	for _, info := range infos {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(info.Object)
+		if err != nil {
+			return nil, err
+		}
+
+		obj := unstructured.Unstructured{Object: content}
+		obj.SetNamespace(info.Namespace)
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// actionConfig returns the Helm configuration to manage the releases in the given namespace.
+func (e *executor) actionConfig(ctx context.Context, namespace string) (*action.Configuration, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg := &action.Configuration{}
+	err := cfg.Init(&restClientGetter{config: e.restConfig, namespace: namespace}, namespace, helmStorageDriver, func(format string, v ...any) {
+		logger.V(ucplog.LevelDebug).Info(fmt.Sprintf(format, v...))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Helm: %w", err)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,237 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/radius-project/radius/pkg/recipes/helm (interfaces: HelmExecutor)
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=./mock_executor.go -package=helm -self_package github.com/radius-project/radius/pkg/recipes/helm github.com/radius-project/radius/pkg/recipes/helm HelmExecutor
+//
+
+// Package helm is a generated GoMock package.
+package helm
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	chart "helm.sh/helm/v3/pkg/chart"
+	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// MockHelmExecutor is a mock of HelmExecutor interface.
+type MockHelmExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockHelmExecutorMockRecorder
+}
+
+// MockHelmExecutorMockRecorder is the mock recorder for MockHelmExecutor.
+type MockHelmExecutorMockRecorder struct {
+	mock *MockHelmExecutor
+}
+
+// NewMockHelmExecutor creates a new mock instance.
+func NewMockHelmExecutor(ctrl *gomock.Controller) *MockHelmExecutor {
+	mock := &MockHelmExecutor{ctrl: ctrl}
+	mock.recorder = &MockHelmExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHelmExecutor) EXPECT() *MockHelmExecutorMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockHelmExecutor) Delete(arg0 context.Context, arg1 Options) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockHelmExecutorMockRecorder) Delete(arg0, arg1 any) *MockHelmExecutorDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockHelmExecutor)(nil).Delete), arg0, arg1)
+	return &MockHelmExecutorDeleteCall{Call: call}
+}
+
+// MockHelmExecutorDeleteCall wrap *gomock.Call
+type MockHelmExecutorDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHelmExecutorDeleteCall) Return(arg0 error) *MockHelmExecutorDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHelmExecutorDeleteCall) Do(f func(context.Context, Options) error) *MockHelmExecutorDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHelmExecutorDeleteCall) DoAndReturn(f func(context.Context, Options) error) *MockHelmExecutorDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Deploy mocks base method.
+func (m *MockHelmExecutor) Deploy(arg0 context.Context, arg1 Options) ([]unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deploy", arg0, arg1)
+	ret0, _ := ret[0].([]unstructured.Unstructured)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deploy indicates an expected call of Deploy.
+func (mr *MockHelmExecutorMockRecorder) Deploy(arg0, arg1 any) *MockHelmExecutorDeployCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockHelmExecutor)(nil).Deploy), arg0, arg1)
+	return &MockHelmExecutorDeployCall{Call: call}
+}
+
+// MockHelmExecutorDeployCall wrap *gomock.Call
+type MockHelmExecutorDeployCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHelmExecutorDeployCall) Return(arg0 []unstructured.Unstructured, arg1 error) *MockHelmExecutorDeployCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHelmExecutorDeployCall) Do(f func(context.Context, Options) ([]unstructured.Unstructured, error)) *MockHelmExecutorDeployCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHelmExecutorDeployCall) DoAndReturn(f func(context.Context, Options) ([]unstructured.Unstructured, error)) *MockHelmExecutorDeployCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Drift mocks base method.
+func (m *MockHelmExecutor) Drift(arg0 context.Context, arg1 Options) ([]unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drift", arg0, arg1)
+	ret0, _ := ret[0].([]unstructured.Unstructured)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drift indicates an expected call of Drift.
+func (mr *MockHelmExecutorMockRecorder) Drift(arg0, arg1 any) *MockHelmExecutorDriftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drift", reflect.TypeOf((*MockHelmExecutor)(nil).Drift), arg0, arg1)
+	return &MockHelmExecutorDriftCall{Call: call}
+}
+
+// MockHelmExecutorDriftCall wrap *gomock.Call
+type MockHelmExecutorDriftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHelmExecutorDriftCall) Return(arg0 []unstructured.Unstructured, arg1 error) *MockHelmExecutorDriftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHelmExecutorDriftCall) Do(f func(context.Context, Options) ([]unstructured.Unstructured, error)) *MockHelmExecutorDriftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHelmExecutorDriftCall) DoAndReturn(f func(context.Context, Options) ([]unstructured.Unstructured, error)) *MockHelmExecutorDriftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LoadChart mocks base method.
+func (m *MockHelmExecutor) LoadChart(arg0 context.Context, arg1 Options) (*chart.Chart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadChart", arg0, arg1)
+	ret0, _ := ret[0].(*chart.Chart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadChart indicates an expected call of LoadChart.
+func (mr *MockHelmExecutorMockRecorder) LoadChart(arg0, arg1 any) *MockHelmExecutorLoadChartCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadChart", reflect.TypeOf((*MockHelmExecutor)(nil).LoadChart), arg0, arg1)
+	return &MockHelmExecutorLoadChartCall{Call: call}
+}
+
+// MockHelmExecutorLoadChartCall wrap *gomock.Call
+type MockHelmExecutorLoadChartCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHelmExecutorLoadChartCall) Return(arg0 *chart.Chart, arg1 error) *MockHelmExecutorLoadChartCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHelmExecutorLoadChartCall) Do(f func(context.Context, Options) (*chart.Chart, error)) *MockHelmExecutorLoadChartCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHelmExecutorLoadChartCall) DoAndReturn(f func(context.Context, Options) (*chart.Chart, error)) *MockHelmExecutorLoadChartCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Plan mocks base method.
+func (m *MockHelmExecutor) Plan(arg0 context.Context, arg1 Options) ([]unstructured.Unstructured, []unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1)
+	ret0, _ := ret[0].([]unstructured.Unstructured)
+	ret1, _ := ret[1].([]unstructured.Unstructured)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockHelmExecutorMockRecorder) Plan(arg0, arg1 any) *MockHelmExecutorPlanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockHelmExecutor)(nil).Plan), arg0, arg1)
+	return &MockHelmExecutorPlanCall{Call: call}
+}
+
+// MockHelmExecutorPlanCall wrap *gomock.Call
+type MockHelmExecutorPlanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHelmExecutorPlanCall) Return(arg0, arg1 []unstructured.Unstructured, arg2 error) *MockHelmExecutorPlanCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHelmExecutorPlanCall) Do(f func(context.Context, Options) ([]unstructured.Unstructured, []unstructured.Unstructured, error)) *MockHelmExecutorPlanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHelmExecutorPlanCall) DoAndReturn(f func(context.Context, Options) ([]unstructured.Unstructured, []unstructured.Unstructured, error)) *MockHelmExecutorPlanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+//go:generate mockgen -typed -destination=./mock_executor.go -package=helm -self_package github.com/radius-project/radius/pkg/recipes/helm github.com/radius-project/radius/pkg/recipes/helm HelmExecutor
+type HelmExecutor interface {
+	// LoadChart downloads and loads the Helm chart referenced by the recipe.
+	LoadChart(ctx context.Context, options Options) (*chart.Chart, error)
+
+	// Deploy installs the Helm chart as a new release, or upgrades the release if it already exists, and waits for the
+	// deployed objects to become ready. It returns the Kubernetes objects deployed by the release.
+	Deploy(ctx context.Context, options Options) ([]unstructured.Unstructured, error)
+
+	// Plan renders the Helm chart against the Kubernetes cluster without deploying it. It returns the Kubernetes objects
+	// currently deployed by the release, which is empty if the release does not exist, and the objects the release would deploy.
+	Plan(ctx context.Context, options Options) (current []unstructured.Unstructured, planned []unstructured.Unstructured, err error)
+
+	// Drift returns the Kubernetes objects deployed by the Helm release that no longer exist in the Kubernetes cluster.
+	Drift(ctx context.Context, options Options) ([]unstructured.Unstructured, error)
+
+	// Delete uninstalls the Helm release and deletes the Kubernetes objects deployed by it.
+	Delete(ctx context.Context, options Options) error
+}
+
+// Options represents the options required to interact with Helm.
+type Options struct {
+	// EnvRecipe is the recipe metadata associated with the Radius Environment, the template path of the recipe is the reference to the Helm chart.
+	EnvRecipe *recipes.EnvironmentDefinition
+
+	// ReleaseName is the name of the Helm release of the recipe.
+	ReleaseName string
+
+	// Namespace is the Kubernetes namespace of the Helm release.
+	Namespace string
+
+	// Chart is the Helm chart to deploy.
+	Chart *chart.Chart
+
+	// Values are the values used to render the Helm chart.
+	Values map[string]any
+}
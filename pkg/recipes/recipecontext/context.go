@@ -24,6 +24,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_aws "github.com/radius-project/radius/pkg/ucp/resources/aws"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
+	resources_gcp "github.com/radius-project/radius/pkg/ucp/resources/gcp"
 )
 
 var (
@@ -105,5 +106,16 @@ func New(metadata *recipes.ResourceMetadata, config *recipes.Configuration) (*Co
 		}
 	}
 
+	if providers.GCP != (coredm.ProvidersGCP{}) {
+		p, err := resources.ParseScope(providers.GCP.Scope)
+		if err != nil {
+			return nil, fmt.Errorf(ErrParseFormat, "GCP scope", providers.GCP.Scope, err)
+		}
+		recipeContext.GCP = &ProviderGCP{
+			Region:  p.FindScope(resources_gcp.ScopeRegions),
+			Project: p.FindScope(resources_gcp.ScopeProjects),
+		}
+	}
+
 	return &recipeContext, nil
 }
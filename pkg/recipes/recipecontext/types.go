@@ -41,6 +41,8 @@ type Context struct {
 	Azure *ProviderAzure `json:"azure,omitempty"`
 	// AWS represents AWS provider scope.
 	AWS *ProviderAWS `json:"aws,omitempty"`
+	// GCP represents GCP provider scope.
+	GCP *ProviderGCP `json:"gcp,omitempty"`
 }
 
 // Resource contains the information needed to deploy a recipe.
@@ -91,3 +93,11 @@ type ProviderAWS struct {
 	// Account represents the account id of the AWS account.
 	Account string `json:"account"`
 }
+
+// ProviderGCP contains GCP project provider scope for recipe context.
+type ProviderGCP struct {
+	// Region represents the region of the GCP project.
+	Region string `json:"region"`
+	// Project represents the project id of the GCP project.
+	Project string `json:"project"`
+}
@@ -0,0 +1,560 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// providersCacheSubDir is the directory of the provider plugin cache of Terraform. Terraform stores the providers
+	// in the HOSTNAME/NAMESPACE/TYPE/VERSION/TARGET layout, so the entries of the cache are the VERSION directories.
+	providersCacheSubDir = "providers"
+	providersCacheDepth  = 4
+
+	// modulesCacheSubDir is the directory of the module cache. Each entry is a copy of the modules downloaded by
+	// Terraform for a recipe, named by the digest of its content. The entries are found by the key of the recipe in the
+	// modulesKeysSubDir directory, see moduleCacheKey.
+	modulesCacheSubDir = "modules"
+
+	// modulesKeysSubDir is the directory, under the module cache, of the files mapping the key of a recipe to the
+	// digest of its cached modules.
+	modulesKeysSubDir = ".keys"
+
+	// mirrorsCacheSubDir is the directory of the provider mirrors pulled from OCI registries. Each entry is named by
+	// the digest of the manifest of the mirror.
+	mirrorsCacheSubDir = "mirrors"
+
+	// providersLockFileName is the name of the file locked while Terraform installs the providers. The provider plugin
+	// cache of Terraform is not safe for concurrent use, so the executions of all the processes sharing the cache take
+	// turns to install the providers.
+	providersLockFileName = ".providers.lock"
+
+	// providersLockRetryDelay is the delay between the attempts to take the lock of the provider plugin cache.
+	providersLockRetryDelay = 500 * time.Millisecond
+
+	// minEvictionAge is the minimum time since the last use of a cache entry before it can be evicted. Terraform links
+	// the providers from the cache into the working directory, so an entry must not be removed while an execution using
+	// it can still be running. It is the same as the timeout of the operations of the portable resources.
+	minEvictionAge = 60 * time.Minute
+)
+
+var (
+	// semverPattern matches a semantic version, with an optional "v" prefix.
+	semverPattern = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+	// commitSHAPattern matches the full SHA-1 or SHA-256 hash of a git commit.
+	commitSHAPattern = regexp.MustCompile(`^([0-9a-f]{40}|[0-9a-f]{64})$`)
+
+	// sha256Pattern matches a hex-encoded SHA-256 digest.
+	sha256Pattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+)
+
+// CacheOptions represents the options of the cache shared by the Terraform executions.
+type CacheOptions struct {
+	// Path is the directory of the cache. The cache is disabled if the path is empty.
+	Path string
+
+	// MaxSizeBytes is the maximum size of each of the provider, module and mirror caches. The least recently used
+	// entries are evicted when a cache exceeds it. Zero means unlimited.
+	MaxSizeBytes int64
+}
+
+// Cache caches the provider plugins, modules and provider mirrors downloaded by Terraform so that they are shared by the
+// executions of the recipes instead of being downloaded by each execution.
+type Cache struct {
+	options CacheOptions
+
+	// mu serializes the writes to the module and mirror caches. The provider plugin cache is protected by a file lock
+	// instead, see lockProviders.
+	mu sync.Mutex
+
+	now func() time.Time
+}
+
+// NewCache creates a new Cache with the given options. It returns nil if the path of the cache is empty.
+func NewCache(options CacheOptions) *Cache {
+	if options.Path == "" {
+		return nil
+	}
+
+	// Terraform resolves a relative path of the plugin cache from the working directory of each execution.
+	if path, err := filepath.Abs(options.Path); err == nil {
+		options.Path = path
+	}
+
+	return &Cache{options: options, now: time.Now}
+}
+
+// ProvidersDir returns the directory of the provider plugin cache of Terraform.
+func (c *Cache) ProvidersDir() string {
+	return filepath.Join(c.options.Path, providersCacheSubDir)
+}
+
+// Init runs Terraform init and marks the providers installed in the working directory as used. The modules are
+// downloaded first without any lock, since they are only written to the working directory, and then the providers are
+// installed while holding the lock of the provider plugin cache.
+func (c *Cache) Init(ctx context.Context, tf *tfexec.Terraform, opts ...tfexec.InitOption) error {
+	if err := tf.Get(ctx); err != nil {
+		return err
+	}
+
+	unlock, err := c.lockProviders(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := tf.Init(ctx, append(opts, tfexec.Get(false))...); err != nil {
+		return err
+	}
+
+	c.touchProviders(ctx, tf.WorkingDir())
+	c.evict(ctx, providersCacheSubDir, providersCacheDepth)
+	return nil
+}
+
+// lockProviders takes the lock of the provider plugin cache and returns the function releasing it. The lock is a file
+// lock in the cache directory, so it is also held against the other processes sharing the cache.
+func (c *Cache) lockProviders(ctx context.Context) (func(), error) {
+	if err := os.MkdirAll(c.options.Path, workingDirFileMode); err != nil {
+		return nil, fmt.Errorf("failed to create the Terraform cache directory: %w", err)
+	}
+
+	fileLock := flock.New(filepath.Join(c.options.Path, providersLockFileName))
+	if _, err := fileLock.TryLockContext(ctx, providersLockRetryDelay); err != nil {
+		return nil, fmt.Errorf("failed to lock the Terraform provider cache: %w", err)
+	}
+
+	return func() {
+		if err := fileLock.Unlock(); err != nil {
+			ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Failed to unlock the Terraform provider cache: %s", err.Error()))
+		}
+	}, nil
+}
+
+// RestoreModules copies the cached modules of the recipe of the given environment to the working directory. It returns
+// false if the modules of the recipe are not cached. Modules with a source that is not pinned to a version are not
+// cached since the source can change. The content of the cached modules is verified against its digest before it is
+// restored.
+func (c *Cache) RestoreModules(ctx context.Context, workingDir string, environmentID string, recipe *recipes.EnvironmentDefinition) (bool, error) {
+	key, ok := moduleCacheKey(environmentID, recipe)
+	if !ok {
+		return false, nil
+	}
+
+	b, err := os.ReadFile(filepath.Join(c.options.Path, modulesCacheSubDir, modulesKeysSubDir, key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to read the Terraform module cache key: %w", err)
+	}
+	digest := string(b)
+	if !sha256Pattern.MatchString(digest) {
+		return false, fmt.Errorf("invalid digest %q in the Terraform module cache key", digest)
+	}
+
+	entry, ok := c.lookup(modulesCacheSubDir, digest)
+	if !ok {
+		return false, nil
+	}
+
+	actual, err := hashDir(entry)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify cached Terraform modules: %w", err)
+	}
+	if actual != digest {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		_ = os.RemoveAll(entry)
+		return false, fmt.Errorf("cached Terraform modules %q do not match their digest and were removed", entry)
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Restoring cached Terraform modules from %q", entry))
+	if err := copyDir(entry, filepath.Join(workingDir, moduleRootDir)); err != nil {
+		return false, fmt.Errorf("failed to restore cached Terraform modules: %w", err)
+	}
+
+	return true, nil
+}
+
+// SaveModules copies the modules of the recipe of the given environment downloaded in the working directory to the
+// cache. The modules are stored by the digest of their content, so the environments using the same modules share the
+// cache entry.
+func (c *Cache) SaveModules(ctx context.Context, workingDir string, environmentID string, recipe *recipes.EnvironmentDefinition) error {
+	key, ok := moduleCacheKey(environmentID, recipe)
+	if !ok {
+		return nil
+	}
+
+	modulesDir := filepath.Join(workingDir, moduleRootDir)
+	digest, err := hashDir(modulesDir)
+	if err != nil {
+		return fmt.Errorf("failed to hash Terraform modules: %w", err)
+	}
+
+	if _, err := c.store(ctx, modulesCacheSubDir, digest, func(dir string) error {
+		return copyDir(modulesDir, dir)
+	}); err != nil {
+		return err
+	}
+
+	keysDir := filepath.Join(c.options.Path, modulesCacheSubDir, modulesKeysSubDir)
+	if err := os.MkdirAll(keysDir, workingDirFileMode); err != nil {
+		return fmt.Errorf("failed to create the Terraform module cache directory: %w", err)
+	}
+
+	// The key is written to a temporary file first so that a partially written key is never read.
+	tmp := filepath.Join(keysDir, ".tmp-"+uuid.NewString())
+	defer os.Remove(tmp)
+	if err := os.WriteFile(tmp, []byte(digest), 0600); err != nil {
+		return fmt.Errorf("failed to save the Terraform module cache key: %w", err)
+	}
+
+	return os.Rename(tmp, filepath.Join(keysDir, key))
+}
+
+// moduleCacheKey returns the key of the modules of the recipe of the given environment in the cache, and false if the
+// source of the recipe is not pinned to a version. Terraform records the modules by the name of the module in the
+// configuration, which is the name of the recipe, so the name is a part of the key. The environment is a part of the key
+// since the modules are downloaded with the credentials of the environment, so an environment must not use the modules
+// downloaded for another one without having access to their source.
+func moduleCacheKey(environmentID string, recipe *recipes.EnvironmentDefinition) (string, bool) {
+	if !isPinnedModule(recipe) {
+		return "", false
+	}
+
+	hash := sha256.Sum256([]byte(strings.ToLower(environmentID) + "\n" + recipe.Name + "\n" + recipe.TemplatePath + "\n" + recipe.TemplateVersion))
+	return hex.EncodeToString(hash[:]), true
+}
+
+// isPinnedModule returns true if the source of the module is pinned to an exact version: a semantic version for the
+// modules of a registry, or the ref of a git source that is a semantic version tag or a commit SHA. Branches, such as
+// "ref=main", and version constraints, such as "~> 1.0", can resolve to a different module over time.
+func isPinnedModule(recipe *recipes.EnvironmentDefinition) bool {
+	if recipe.TemplateVersion != "" {
+		return semverPattern.MatchString(strings.TrimPrefix(recipe.TemplateVersion, "="))
+	}
+
+	_, query, ok := strings.Cut(recipe.TemplatePath, "?")
+	if !ok {
+		return false
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return false
+	}
+
+	ref := values.Get("ref")
+	return semverPattern.MatchString(ref) || commitSHAPattern.MatchString(ref)
+}
+
+// hashDir returns the SHA-256 digest of the content of the directory: the paths, permissions and content of its files,
+// directories and symbolic links.
+func hashDir(dir string) (string, error) {
+	h := sha256.New()
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s\x00%s\x00", filepath.ToSlash(rel), info.Mode().String())
+
+		switch {
+		case d.Type()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(h, "%s\x00", link)
+		case d.Type().IsRegular():
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if _, err := io.Copy(h, f); err != nil {
+				return err
+			}
+			fmt.Fprintf(h, "\x00")
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// lookup returns the directory of the entry with the given key in the given cache, and marks the entry as used.
+func (c *Cache) lookup(cache, key string) (string, bool) {
+	entry := filepath.Join(c.options.Path, cache, key)
+	if _, err := os.Stat(entry); err != nil {
+		return "", false
+	}
+
+	now := c.now()
+	_ = os.Chtimes(entry, now, now)
+	return entry, true
+}
+
+// store creates the entry with the given key in the given cache, if it does not exist, by calling fill with a directory
+// which is then moved to the cache. It returns the directory of the entry.
+func (c *Cache) store(ctx context.Context, cache, key string, fill func(dir string) error) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.lookup(cache, key); ok {
+		return entry, nil
+	}
+
+	cacheDir := filepath.Join(c.options.Path, cache)
+	if err := os.MkdirAll(cacheDir, workingDirFileMode); err != nil {
+		return "", fmt.Errorf("failed to create the Terraform cache directory: %w", err)
+	}
+
+	// The entry is filled in a temporary directory so that a partially filled entry is never used.
+	tmp := filepath.Join(cacheDir, ".tmp-"+uuid.NewString())
+	defer os.RemoveAll(tmp)
+
+	if err := fill(tmp); err != nil {
+		return "", err
+	}
+
+	entry := filepath.Join(cacheDir, key)
+	if err := os.Rename(tmp, entry); err != nil {
+		return "", fmt.Errorf("failed to save the Terraform cache entry: %w", err)
+	}
+
+	now := c.now()
+	_ = os.Chtimes(entry, now, now)
+
+	c.evict(ctx, cache, 1)
+	return entry, nil
+}
+
+// touchProviders marks the providers installed in the working directory as used. Terraform installs the providers in
+// the working directory in the same layout as the plugin cache.
+func (c *Cache) touchProviders(ctx context.Context, workingDir string) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	installed, err := listEntries(filepath.Join(workingDir, ".terraform", "providers"), providersCacheDepth)
+	if err != nil {
+		logger.Info(fmt.Sprintf("Failed to list the installed Terraform providers: %s", err.Error()))
+		return
+	}
+
+	now := c.now()
+	for _, dir := range installed {
+		rel, err := filepath.Rel(filepath.Join(workingDir, ".terraform", "providers"), dir)
+		if err != nil {
+			continue
+		}
+		_ = os.Chtimes(filepath.Join(c.ProvidersDir(), rel), now, now)
+	}
+}
+
+// evict removes the least recently used entries of the given cache until the cache is smaller than the maximum size.
+// The entries are the directories at the given depth of the cache directory. Entries used recently are never evicted,
+// so the cache can exceed its size while they are in use. The caller must hold the lock of the cache, which is the file
+// lock for the provider plugin cache.
+func (c *Cache) evict(ctx context.Context, cache string, depth int) {
+	if c.options.MaxSizeBytes <= 0 {
+		return
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	type entry struct {
+		path     string
+		size     int64
+		lastUsed time.Time
+	}
+
+	dirs, err := listEntries(filepath.Join(c.options.Path, cache), depth)
+	if err != nil {
+		logger.Info(fmt.Sprintf("Failed to list the Terraform %s cache: %s", cache, err.Error()))
+		return
+	}
+
+	entries := []entry{}
+	total := int64(0)
+	for _, dir := range dirs {
+		info, err := os.Stat(dir)
+		if err != nil {
+			continue
+		}
+		size, err := dirSize(dir)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry{path: dir, size: size, lastUsed: info.ModTime()})
+		total += size
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].lastUsed.Before(entries[j].lastUsed)
+	})
+
+	for _, e := range entries {
+		if total <= c.options.MaxSizeBytes {
+			return
+		}
+		if c.now().Sub(e.lastUsed) < minEvictionAge {
+			logger.Info(fmt.Sprintf("Terraform %s cache exceeds its maximum size, but the remaining entries are in use", cache))
+			return
+		}
+
+		logger.Info(fmt.Sprintf("Evicting Terraform cache entry %q", e.path))
+		if err := os.RemoveAll(e.path); err != nil {
+			logger.Info(fmt.Sprintf("Failed to evict Terraform cache entry %q: %s", e.path, err.Error()))
+			continue
+		}
+		total -= e.size
+	}
+}
+
+// listEntries returns the directories at the given depth under the root directory. Directories with a name starting
+// with a dot are skipped. It returns no directories if the root directory does not exist.
+func listEntries(root string, depth int) ([]string, error) {
+	dirs := []string{root}
+	for i := 0; i < depth; i++ {
+		next := []string{}
+		for _, dir := range dirs {
+			children, err := os.ReadDir(dir)
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+
+			for _, child := range children {
+				if child.IsDir() && !strings.HasPrefix(child.Name(), ".") {
+					next = append(next, filepath.Join(dir, child.Name()))
+				}
+			}
+		}
+		dirs = next
+	}
+
+	return dirs, nil
+}
+
+// dirSize returns the total size of the files in the directory.
+func dirSize(dir string) (int64, error) {
+	size := int64(0)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+
+	return size, err
+}
+
+// copyDir copies the files, directories and symbolic links of the source directory to the destination directory.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm()|0700)
+		case d.Type()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case d.Type().IsRegular():
+			return copyFile(path, target, info.Mode().Perm())
+		default:
+			return nil
+		}
+	})
+}
+
+// copyFile copies the source file to the destination file with the given permissions.
+func copyFile(src, dst string, perm fs.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
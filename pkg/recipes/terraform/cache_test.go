@@ -0,0 +1,263 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCache(t *testing.T) {
+	require.Nil(t, NewCache(CacheOptions{}))
+
+	cache := NewCache(CacheOptions{Path: "cache"})
+	require.NotNil(t, cache)
+	require.True(t, filepath.IsAbs(cache.ProvidersDir()))
+}
+
+const testEnvironmentID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+
+func TestCache_Modules(t *testing.T) {
+	ctx := testcontext.New(t)
+	cache := NewCache(CacheOptions{Path: t.TempDir()})
+
+	recipe := &recipes.EnvironmentDefinition{
+		Name:            "redis",
+		TemplatePath:    "Azure/redis/azurerm",
+		TemplateVersion: "1.0.0",
+	}
+
+	// Nothing is cached yet.
+	restored, err := cache.RestoreModules(ctx, t.TempDir(), testEnvironmentID, recipe)
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	workingDir := t.TempDir()
+	writeFile(t, filepath.Join(workingDir, moduleRootDir, "modules.json"), `{"Modules":[]}`)
+	writeFile(t, filepath.Join(workingDir, moduleRootDir, "redis", "main.tf"), `variable "context" {}`)
+	require.NoError(t, cache.SaveModules(ctx, workingDir, testEnvironmentID, recipe))
+
+	newWorkingDir := t.TempDir()
+	restored, err = cache.RestoreModules(ctx, newWorkingDir, testEnvironmentID, recipe)
+	require.NoError(t, err)
+	require.True(t, restored)
+
+	b, err := os.ReadFile(filepath.Join(newWorkingDir, moduleRootDir, "redis", "main.tf"))
+	require.NoError(t, err)
+	require.Equal(t, `variable "context" {}`, string(b))
+
+	// A different version of the module is not restored.
+	restored, err = cache.RestoreModules(ctx, t.TempDir(), testEnvironmentID, &recipes.EnvironmentDefinition{
+		Name:            "redis",
+		TemplatePath:    "Azure/redis/azurerm",
+		TemplateVersion: "2.0.0",
+	})
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	// The modules cached for an environment are not restored for another environment.
+	otherEnvironmentID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/other-env"
+	restored, err = cache.RestoreModules(ctx, t.TempDir(), otherEnvironmentID, recipe)
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	// The environments caching the same modules share the content of the cache.
+	require.NoError(t, cache.SaveModules(ctx, workingDir, otherEnvironmentID, recipe))
+	entries, err := listEntries(filepath.Join(cache.options.Path, modulesCacheSubDir), 1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// Modules whose content was modified in the cache are not restored.
+	writeFile(t, filepath.Join(entries[0], "redis", "main.tf"), `resource "null_resource" "tampered" {}`)
+	restored, err = cache.RestoreModules(ctx, t.TempDir(), testEnvironmentID, recipe)
+	require.Error(t, err)
+	require.False(t, restored)
+	require.NoDirExists(t, entries[0])
+}
+
+func TestCache_Modules_Unpinned(t *testing.T) {
+	ctx := testcontext.New(t)
+	cache := NewCache(CacheOptions{Path: t.TempDir()})
+
+	recipe := &recipes.EnvironmentDefinition{
+		Name:         "redis",
+		TemplatePath: "git::https://github.com/project/module",
+	}
+
+	workingDir := t.TempDir()
+	writeFile(t, filepath.Join(workingDir, moduleRootDir, "redis", "main.tf"), "")
+	require.NoError(t, cache.SaveModules(ctx, workingDir, testEnvironmentID, recipe))
+
+	restored, err := cache.RestoreModules(ctx, t.TempDir(), testEnvironmentID, recipe)
+	require.NoError(t, err)
+	require.False(t, restored)
+}
+
+func TestModuleCacheKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		recipe recipes.EnvironmentDefinition
+		cached bool
+	}{
+		{
+			name:   "registry module with version",
+			recipe: recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "Azure/redis/azurerm", TemplateVersion: "1.0.0"},
+			cached: true,
+		},
+		{
+			name:   "registry module with version constraint",
+			recipe: recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "Azure/redis/azurerm", TemplateVersion: "~> 1.0"},
+			cached: false,
+		},
+		{
+			name:   "git module with tag",
+			recipe: recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "git::https://github.com/project/module?ref=v1.0.0"},
+			cached: true,
+		},
+		{
+			name:   "git module with commit",
+			recipe: recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "git::https://github.com/project/module//redis?ref=51d462976d84fdea54b47d80dcabbf680badcdb8"},
+			cached: true,
+		},
+		{
+			name:   "git module with branch",
+			recipe: recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "git::https://github.com/project/module?ref=main"},
+			cached: false,
+		},
+		{
+			name:   "git module without ref",
+			recipe: recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "git::https://github.com/project/module"},
+			cached: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			key, ok := moduleCacheKey(testEnvironmentID, &tc.recipe)
+			require.Equal(t, tc.cached, ok)
+			if tc.cached {
+				require.Len(t, key, 64)
+			}
+		})
+	}
+}
+
+func TestCache_Evict(t *testing.T) {
+	ctx := testcontext.New(t)
+	root := t.TempDir()
+	cache := NewCache(CacheOptions{Path: root, MaxSizeBytes: 250})
+
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	entries := []struct {
+		key      string
+		lastUsed time.Time
+	}{
+		{"oldest", now.Add(-4 * time.Hour)},
+		{"old", now.Add(-3 * time.Hour)},
+		{"recent", now.Add(-2 * time.Hour)},
+		{"in-use", now.Add(-time.Minute)},
+	}
+	for _, e := range entries {
+		dir := filepath.Join(root, modulesCacheSubDir, e.key)
+		writeFile(t, filepath.Join(dir, "main.tf"), string(make([]byte, 100)))
+		require.NoError(t, os.Chtimes(dir, e.lastUsed, e.lastUsed))
+	}
+
+	cache.mu.Lock()
+	cache.evict(ctx, modulesCacheSubDir, 1)
+	cache.mu.Unlock()
+
+	require.NoDirExists(t, filepath.Join(root, modulesCacheSubDir, "oldest"))
+	require.NoDirExists(t, filepath.Join(root, modulesCacheSubDir, "old"))
+	require.DirExists(t, filepath.Join(root, modulesCacheSubDir, "recent"))
+	require.DirExists(t, filepath.Join(root, modulesCacheSubDir, "in-use"))
+
+	// Entries used recently are not evicted even if the cache exceeds its size.
+	cache.options.MaxSizeBytes = 50
+	cache.mu.Lock()
+	cache.evict(ctx, modulesCacheSubDir, 1)
+	cache.mu.Unlock()
+
+	require.NoDirExists(t, filepath.Join(root, modulesCacheSubDir, "recent"))
+	require.DirExists(t, filepath.Join(root, modulesCacheSubDir, "in-use"))
+}
+
+func TestCache_Evict_Providers(t *testing.T) {
+	ctx := testcontext.New(t)
+	root := t.TempDir()
+	cache := NewCache(CacheOptions{Path: root, MaxSizeBytes: 150})
+
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	oldVersion := filepath.Join(cache.ProvidersDir(), "registry.terraform.io", "hashicorp", "aws", "4.0.0")
+	newVersion := filepath.Join(cache.ProvidersDir(), "registry.terraform.io", "hashicorp", "aws", "5.0.0")
+	writeFile(t, filepath.Join(oldVersion, "linux_amd64", "terraform-provider-aws"), string(make([]byte, 100)))
+	writeFile(t, filepath.Join(newVersion, "linux_amd64", "terraform-provider-aws"), string(make([]byte, 100)))
+	require.NoError(t, os.Chtimes(oldVersion, now.Add(-3*time.Hour), now.Add(-3*time.Hour)))
+	require.NoError(t, os.Chtimes(newVersion, now.Add(-2*time.Hour), now.Add(-2*time.Hour)))
+
+	// The working directory uses the old version, which is marked as used.
+	workingDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(workingDir, ".terraform", "providers", "registry.terraform.io", "hashicorp", "aws", "4.0.0"), 0700))
+
+	unlock, err := cache.lockProviders(ctx)
+	require.NoError(t, err)
+	cache.touchProviders(ctx, workingDir)
+	cache.evict(ctx, providersCacheSubDir, providersCacheDepth)
+	unlock()
+
+	require.DirExists(t, oldVersion)
+	require.NoDirExists(t, newVersion)
+}
+
+func TestCache_LockProviders(t *testing.T) {
+	ctx := testcontext.New(t)
+	root := t.TempDir()
+	cache := NewCache(CacheOptions{Path: root})
+
+	unlock, err := cache.lockProviders(ctx)
+	require.NoError(t, err)
+
+	// The lock is a file lock, so it is also held against another cache sharing the directory, such as the cache of
+	// another process.
+	other := NewCache(CacheOptions{Path: root})
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = other.lockProviders(timeoutCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	unlock()
+
+	unlock, err = other.lockProviders(ctx)
+	require.NoError(t, err)
+	unlock()
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
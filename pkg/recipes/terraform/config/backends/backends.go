@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	BackendS3      = "s3"
+	BackendAzureRM = "azurerm"
+	BackendHTTP    = "http"
+	BackendLocal   = "local"
+)
+
+// backendSettings describes the settings accepted by a kind of backend.
+type backendSettings struct {
+	// required is the list of settings that must be configured.
+	required []string
+
+	// reserved is the list of settings that are set by Radius and can't be configured.
+	reserved []string
+
+	// secrets is the list of credentials that are read from the secret store. Credentials can't be set in the
+	// non-sensitive configuration of the backend.
+	secrets []string
+}
+
+var settings = map[string]backendSettings{
+	BackendKubernetes: {},
+	BackendS3: {
+		required: []string{"bucket", "region"},
+		reserved: []string{"key"},
+		secrets:  []string{"access_key", "secret_key", "token"},
+	},
+	BackendAzureRM: {
+		required: []string{"storage_account_name", "container_name"},
+		reserved: []string{"key"},
+		secrets:  []string{"access_key", "sas_token", "client_id", "client_secret"},
+	},
+	BackendHTTP: {
+		required: []string{"address"},
+		secrets:  []string{"username", "password"},
+	},
+	BackendLocal: {
+		required: []string{"path"},
+	},
+}
+
+// ValidateBackendConfig validates the Terraform backend configuration of an environment. An empty configuration is
+// valid and selects the Kubernetes backend.
+func ValidateBackendConfig(config datamodel.TerraformBackendConfig) error {
+	kind := config.Kind
+	if kind == "" {
+		kind = BackendKubernetes
+	}
+
+	s, ok := settings[kind]
+	if !ok {
+		return fmt.Errorf("unsupported Terraform backend kind %q, supported kinds are: %s", config.Kind, strings.Join(supportedKinds(), ", "))
+	}
+
+	if kind == BackendKubernetes && len(config.Config) > 0 {
+		return fmt.Errorf("the %q Terraform backend does not accept any configuration", kind)
+	}
+
+	if len(s.secrets) == 0 && config.Secret != "" {
+		return fmt.Errorf("the %q Terraform backend does not accept any credentials", kind)
+	}
+
+	for _, key := range s.required {
+		if config.Config[key] == "" {
+			return fmt.Errorf("the %q Terraform backend requires the setting %q", kind, key)
+		}
+	}
+
+	for key := range config.Config {
+		if slices.Contains(s.reserved, key) {
+			return fmt.Errorf("the setting %q of the %q Terraform backend is set by Radius and can't be configured", key, kind)
+		}
+
+		if slices.Contains(s.secrets, key) {
+			return fmt.Errorf("the setting %q of the %q Terraform backend is a credential and must be stored in the secret store referenced by the backend configuration", key, kind)
+		}
+	}
+
+	return nil
+}
+
+// NewBackend creates the Terraform backend configured in the recipe configuration of the environment. The Kubernetes
+// backend is used when no backend is configured. secrets contains the credentials of the backend loaded from the
+// secret store referenced by the configuration; secrets that are not credentials of the backend are ignored.
+func NewBackend(config datamodel.TerraformBackendConfig, secrets map[string]string, k8sClientSet kubernetes.Interface) (Backend, error) {
+	if err := ValidateBackendConfig(config); err != nil {
+		return nil, err
+	}
+
+	credentials := map[string]string{}
+	for _, key := range settings[config.Kind].secrets {
+		if value, ok := secrets[key]; ok {
+			credentials[key] = value
+		}
+	}
+
+	switch config.Kind {
+	case BackendS3, BackendAzureRM:
+		return NewRemoteBackend(config.Kind, config.Config, credentials), nil
+	case BackendHTTP:
+		return NewHTTPBackend(config.Config, credentials), nil
+	case BackendLocal:
+		return NewLocalBackend(config.Config["path"]), nil
+	default:
+		return NewKubernetesBackend(k8sClientSet), nil
+	}
+}
+
+// SaveCredentials writes the credentials of a backend to a backend configuration file at the given path, which is
+// passed to Terraform init with the -backend-config option. The file is only readable by its owner.
+// https://developer.hashicorp.com/terraform/language/settings/backends/configuration#file
+func SaveCredentials(path string, credentials map[string]string) error {
+	keys := maps.Keys(credentials)
+	slices.Sort(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&b, "%s = \"%s\"\n", key, escapeString(credentials[key]))
+	}
+
+	return os.WriteFile(path, []byte(b.String()), 0600)
+}
+
+// escapeString escapes a value for a quoted string of the Terraform language, including the template sequences.
+func escapeString(value string) string {
+	var b strings.Builder
+	for i, r := range value {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		case (r == '$' || r == '%') && strings.HasPrefix(value[i+1:], "{"):
+			// "${" and "%{" start a template sequence, and are escaped by doubling their first character.
+			b.WriteRune(r)
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// StateName returns the name of the Terraform state of the resource deploying the recipe. The name is unique for the
+// combination of environment, application and resource, and is used to name the state in every kind of backend.
+func StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	secretSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return KubernetesBackendNamePrefix + secretSuffix, nil
+}
+
+func supportedKinds() []string {
+	kinds := maps.Keys(settings)
+	slices.Sort(kinds)
+	return kinds
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_ValidateBackendConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config datamodel.TerraformBackendConfig
+		err    string
+	}{
+		{
+			name:   "default",
+			config: datamodel.TerraformBackendConfig{},
+		},
+		{
+			name:   "kubernetes",
+			config: datamodel.TerraformBackendConfig{Kind: BackendKubernetes},
+		},
+		{
+			name: "s3",
+			config: datamodel.TerraformBackendConfig{
+				Kind:   BackendS3,
+				Config: map[string]string{"bucket": "tfstate", "region": "us-west-2", "endpoint": "https://minio.example.com"},
+				Secret: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/tfstate",
+			},
+		},
+		{
+			name: "local",
+			config: datamodel.TerraformBackendConfig{
+				Kind:   BackendLocal,
+				Config: map[string]string{"path": "/tmp/tfstate"},
+			},
+		},
+		{
+			name:   "unsupported kind",
+			config: datamodel.TerraformBackendConfig{Kind: "consul"},
+			err:    `unsupported Terraform backend kind "consul", supported kinds are: azurerm, http, kubernetes, local, s3`,
+		},
+		{
+			name:   "kubernetes with configuration",
+			config: datamodel.TerraformBackendConfig{Kind: BackendKubernetes, Config: map[string]string{"namespace": "default"}},
+			err:    `the "kubernetes" Terraform backend does not accept any configuration`,
+		},
+		{
+			name:   "local with credentials",
+			config: datamodel.TerraformBackendConfig{Kind: BackendLocal, Config: map[string]string{"path": "/tmp/tfstate"}, Secret: "secret"},
+			err:    `the "local" Terraform backend does not accept any credentials`,
+		},
+		{
+			name:   "missing required setting",
+			config: datamodel.TerraformBackendConfig{Kind: BackendAzureRM, Config: map[string]string{"storage_account_name": "tfstate"}},
+			err:    `the "azurerm" Terraform backend requires the setting "container_name"`,
+		},
+		{
+			name:   "reserved setting",
+			config: datamodel.TerraformBackendConfig{Kind: BackendS3, Config: map[string]string{"bucket": "tfstate", "region": "us-west-2", "key": "state"}},
+			err:    `the setting "key" of the "s3" Terraform backend is set by Radius and can't be configured`,
+		},
+		{
+			name:   "credential in configuration",
+			config: datamodel.TerraformBackendConfig{Kind: BackendHTTP, Config: map[string]string{"address": "https://state.example.com", "password": "p@ss"}},
+			err:    `the setting "password" of the "http" Terraform backend is a credential and must be stored in the secret store referenced by the backend configuration`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBackendConfig(tt.config)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func Test_NewBackend(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+
+	b, err := NewBackend(datamodel.TerraformBackendConfig{}, nil, clientset)
+	require.NoError(t, err)
+	require.Equal(t, &kubernetesBackend{k8sClientSet: clientset}, b)
+
+	b, err = NewBackend(datamodel.TerraformBackendConfig{
+		Kind:   BackendS3,
+		Config: map[string]string{"bucket": "tfstate", "region": "us-west-2"},
+	}, map[string]string{"access_key": "id", "secret_key": "secret", "unrelated": "value"}, clientset)
+	require.NoError(t, err)
+	require.Equal(t, &remoteBackend{
+		kind:        BackendS3,
+		config:      map[string]string{"bucket": "tfstate", "region": "us-west-2"},
+		credentials: map[string]string{"access_key": "id", "secret_key": "secret"},
+	}, b)
+
+	b, err = NewBackend(datamodel.TerraformBackendConfig{Kind: BackendLocal, Config: map[string]string{"path": "/tmp/tfstate"}}, nil, clientset)
+	require.NoError(t, err)
+	require.Equal(t, &localBackend{dir: "/tmp/tfstate"}, b)
+
+	_, err = NewBackend(datamodel.TerraformBackendConfig{Kind: "consul"}, nil, clientset)
+	require.Error(t, err)
+}
+
+func Test_StateName(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	name, err := StateName(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, KubernetesBackendNamePrefix+suffix, name)
+
+	resourceRecipe.ResourceID = "invalid"
+	_, err = StateName(&resourceRecipe)
+	require.Error(t, err)
+}
+
+func Test_SaveCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backend.tfbackend")
+	err := SaveCredentials(path, map[string]string{
+		"secret_key": "a\"b\\c\nd${e}%{f}$g",
+		"access_key": "id",
+	})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "access_key = \"id\"\nsecret_key = \"a\\\"b\\\\c\\nd$${e}%%{f}$g\"\n", string(content))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
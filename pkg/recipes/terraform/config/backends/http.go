@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+var _ Backend = (*httpBackend)(nil)
+
+// stateAddressKeys are the settings of the http backend holding the address of an endpoint for the state. The name of
+// the state is appended to each of them so that every resource has its own state.
+var stateAddressKeys = []string{"address", "lock_address", "unlock_address"}
+
+type httpBackend struct {
+	config      map[string]string
+	credentials map[string]string
+	client      *http.Client
+}
+
+// NewHTTPBackend creates a backend storing the Terraform state with a REST endpoint. config contains the non-sensitive
+// settings of the backend and credentials contains the username and password used to authenticate to the endpoint.
+func NewHTTPBackend(config map[string]string, credentials map[string]string) Backend {
+	return &httpBackend{config: config, credentials: credentials, client: http.DefaultClient}
+}
+
+// BuildBackend generates the Terraform backend configuration for the http backend, without the credentials. The name
+// of the state is appended to the configured addresses.
+// https://developer.hashicorp.com/terraform/language/settings/backends/http
+func (b *httpBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	name, err := StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]any{}
+	for key, value := range b.config {
+		values[key] = value
+	}
+	for _, key := range stateAddressKeys {
+		if address, ok := b.config[key]; ok {
+			values[key] = stateAddress(address, name)
+		}
+	}
+
+	return map[string]any{BackendHTTP: values}, nil
+}
+
+// BuildBackendCredentials returns the username and password used to authenticate to the endpoint.
+func (b *httpBackend) BuildBackendCredentials() map[string]string {
+	return b.credentials
+}
+
+// ValidateBackendExists checks if the endpoint returns a state for the given state name.
+func (b *httpBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	resp, err := b.do(ctx, http.MethodGet, name)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNoContent:
+		return false, nil
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return true, nil
+	default:
+		return false, fmt.Errorf("unexpected status code %d retrieving the Terraform state %q", resp.StatusCode, name)
+	}
+}
+
+// DeleteBackend deletes the state from the endpoint. A state that does not exist is ignored.
+func (b *httpBackend) DeleteBackend(ctx context.Context, name string) error {
+	resp, err := b.do(ctx, http.MethodDelete, name)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || (resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return nil
+	}
+
+	return fmt.Errorf("unexpected status code %d deleting the Terraform state %q", resp.StatusCode, name)
+}
+
+func (b *httpBackend) do(ctx context.Context, method string, name string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, stateAddress(b.config["address"], name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if username, ok := b.credentials["username"]; ok {
+		req.SetBasicAuth(username, b.credentials["password"])
+	}
+
+	return b.client.Do(req)
+}
+
+func stateAddress(address string, name string) string {
+	return strings.TrimSuffix(address, "/") + "/" + name
+}
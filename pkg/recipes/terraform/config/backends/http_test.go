@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HTTPBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	name, err := StateName(&resourceRecipe)
+	require.NoError(t, err)
+
+	b := NewHTTPBackend(
+		map[string]string{"address": "https://state.example.com/states/", "lock_address": "https://state.example.com/locks", "lock_method": "PUT"},
+		map[string]string{"username": "user", "password": "pass"})
+
+	config, err := b.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		BackendHTTP: map[string]any{
+			"address":      "https://state.example.com/states/" + name,
+			"lock_address": "https://state.example.com/locks/" + name,
+			"lock_method":  "PUT",
+		},
+	}, config)
+	require.Equal(t, map[string]string{"username": "user", "password": "pass"}, b.BuildBackendCredentials())
+}
+
+func Test_HTTPBackend_ValidateAndDelete(t *testing.T) {
+	var mutex sync.Mutex
+	states := map[string]bool{"/states/exists": true}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if !states[r.URL.Path] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Method == http.MethodDelete {
+			delete(states, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	b := NewHTTPBackend(map[string]string{"address": server.URL + "/states"}, map[string]string{"username": "user", "password": "pass"})
+	ctx := context.Background()
+
+	exists, err := b.ValidateBackendExists(ctx, "exists")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = b.ValidateBackendExists(ctx, "missing")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, b.DeleteBackend(ctx, "exists"))
+	require.NoError(t, b.DeleteBackend(ctx, "exists"))
+
+	exists, err = b.ValidateBackendExists(ctx, "exists")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	unauthorized := NewHTTPBackend(map[string]string{"address": server.URL + "/states"}, map[string]string{})
+	_, err = unauthorized.ValidateBackendExists(ctx, "exists")
+	require.EqualError(t, err, `unexpected status code 401 retrieving the Terraform state "exists"`)
+
+	err = unauthorized.DeleteBackend(ctx, "exists")
+	require.EqualError(t, err, `unexpected status code 401 deleting the Terraform state "exists"`)
+}
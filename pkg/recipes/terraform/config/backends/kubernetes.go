@@ -70,6 +70,11 @@ func (p *kubernetesBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadat
 	return generateKubernetesBackendConfig(secretSuffix)
 }
 
+// BuildBackendCredentials returns no credentials: Terraform uses the in-cluster configuration of Radius.
+func (p *kubernetesBackend) BuildBackendCredentials() map[string]string {
+	return nil
+}
+
 // ValidateBackendExists checks if the Kubernetes secret for Terraform state file exists.
 // name is the name of the backend Kubernetes secret resource that is created as a part of terraform apply
 // during recipe deployment.
@@ -89,6 +94,16 @@ func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name stri
 	return true, nil
 }
 
+// DeleteBackend deletes the Kubernetes secret storing the Terraform state file.
+func (p *kubernetesBackend) DeleteBackend(ctx context.Context, name string) error {
+	err := p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+	}
+
+	return nil
+}
+
 // generateSecretSuffix returns a unique string from the resourceID, environmentID, and applicationID
 // which is used as key for kubernetes secret in defining terraform backend.
 func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, error) {
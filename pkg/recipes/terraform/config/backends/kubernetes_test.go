@@ -156,3 +156,23 @@ func Test_ValidateBackendExists(t *testing.T) {
 	require.True(t, k8s_errors.IsServerTimeout(err))
 	require.False(t, exists)
 }
+
+func Test_KubernetesBackend_DeleteBackend(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-secret",
+			Namespace: RadiusNamespace,
+		},
+	})
+
+	b := NewKubernetesBackend(clientset)
+	err := b.DeleteBackend(context.Background(), "test-secret")
+	require.NoError(t, err)
+
+	exists, err := b.ValidateBackendExists(context.Background(), "test-secret")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	err = b.DeleteBackend(context.Background(), "test-secret")
+	require.ErrorContains(t, err, "error deleting kubernetes secret for terraform state")
+}
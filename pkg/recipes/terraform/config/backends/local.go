@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+var _ Backend = (*localBackend)(nil)
+
+type localBackend struct {
+	dir string
+}
+
+// NewLocalBackend creates a backend storing the Terraform state in files in the given directory. The local backend is
+// intended for testing: the state is lost when the filesystem of Radius is.
+func NewLocalBackend(dir string) Backend {
+	return &localBackend{dir: dir}
+}
+
+// BuildBackend generates the Terraform backend configuration for the local backend.
+// https://developer.hashicorp.com/terraform/language/settings/backends/local
+func (b *localBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	name, err := StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		BackendLocal: map[string]any{
+			"path": b.statePath(name),
+		},
+	}, nil
+}
+
+// BuildBackendCredentials returns no credentials: the local backend does not have any.
+func (b *localBackend) BuildBackendCredentials() map[string]string {
+	return nil
+}
+
+// ValidateBackendExists checks if the state file exists.
+func (b *localBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	_, err := os.Stat(b.statePath(name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteBackend deletes the state file. A state file that does not exist is ignored.
+func (b *localBackend) DeleteBackend(ctx context.Context, name string) error {
+	err := os.Remove(b.statePath(name))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (b *localBackend) statePath(name string) string {
+	return filepath.Join(b.dir, name+".tfstate")
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LocalBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	name, err := StateName(&resourceRecipe)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	b := NewLocalBackend(dir)
+	ctx := context.Background()
+
+	config, err := b.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	statePath := filepath.Join(dir, name+".tfstate")
+	require.Equal(t, map[string]any{BackendLocal: map[string]any{"path": statePath}}, config)
+
+	exists, err := b.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	err = os.WriteFile(statePath, []byte("{}"), 0600)
+	require.NoError(t, err)
+
+	exists, err = b.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	require.NoError(t, b.DeleteBackend(ctx, name))
+	require.NoFileExists(t, statePath)
+
+	// Deleting a state that does not exist is not an error.
+	require.NoError(t, b.DeleteBackend(ctx, name))
+}
@@ -79,6 +79,82 @@ func (c *MockBackendBuildBackendCall) DoAndReturn(f func(*recipes.ResourceMetada
 	return c
 }
 
+// BuildBackendCredentials mocks base method.
+func (m *MockBackend) BuildBackendCredentials() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildBackendCredentials")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// BuildBackendCredentials indicates an expected call of BuildBackendCredentials.
+func (mr *MockBackendMockRecorder) BuildBackendCredentials() *MockBackendBuildBackendCredentialsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildBackendCredentials", reflect.TypeOf((*MockBackend)(nil).BuildBackendCredentials))
+	return &MockBackendBuildBackendCredentialsCall{Call: call}
+}
+
+// MockBackendBuildBackendCredentialsCall wrap *gomock.Call
+type MockBackendBuildBackendCredentialsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackendBuildBackendCredentialsCall) Return(arg0 map[string]string) *MockBackendBuildBackendCredentialsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackendBuildBackendCredentialsCall) Do(f func() map[string]string) *MockBackendBuildBackendCredentialsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackendBuildBackendCredentialsCall) DoAndReturn(f func() map[string]string) *MockBackendBuildBackendCredentialsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteBackend mocks base method.
+func (m *MockBackend) DeleteBackend(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackend", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBackend indicates an expected call of DeleteBackend.
+func (mr *MockBackendMockRecorder) DeleteBackend(arg0, arg1 any) *MockBackendDeleteBackendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackend", reflect.TypeOf((*MockBackend)(nil).DeleteBackend), arg0, arg1)
+	return &MockBackendDeleteBackendCall{Call: call}
+}
+
+// MockBackendDeleteBackendCall wrap *gomock.Call
+type MockBackendDeleteBackendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackendDeleteBackendCall) Return(arg0 error) *MockBackendDeleteBackendCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackendDeleteBackendCall) Do(f func(context.Context, string) error) *MockBackendDeleteBackendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackendDeleteBackendCall) DoAndReturn(f func(context.Context, string) error) *MockBackendDeleteBackendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ValidateBackendExists mocks base method.
 func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ Backend = (*remoteBackend)(nil)
+
+// remoteBackend is a backend storing the Terraform state as an object of a cloud storage service, such as an S3 bucket
+// or an Azure Blob Storage container.
+type remoteBackend struct {
+	kind        string
+	config      map[string]string
+	credentials map[string]string
+}
+
+// NewRemoteBackend creates a backend of the given kind storing the Terraform state in a cloud storage service. config
+// contains the non-sensitive settings of the backend and credentials contains the credentials used to access the service.
+func NewRemoteBackend(kind string, config map[string]string, credentials map[string]string) Backend {
+	return &remoteBackend{kind: kind, config: config, credentials: credentials}
+}
+
+// BuildBackend generates the Terraform backend configuration for the backend, without the credentials. The state of
+// each resource is stored in its own object, named after the state name of the resource.
+// https://developer.hashicorp.com/terraform/language/settings/backends/s3
+// https://developer.hashicorp.com/terraform/language/settings/backends/azurerm
+func (b *remoteBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	name, err := StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]any{}
+	for key, value := range b.config {
+		values[key] = value
+	}
+	values["key"] = name + ".tfstate"
+
+	return map[string]any{b.kind: values}, nil
+}
+
+// BuildBackendCredentials returns the credentials used to access the storage service.
+func (b *remoteBackend) BuildBackendCredentials() map[string]string {
+	return b.credentials
+}
+
+// ValidateBackendExists always returns true: Terraform reads an empty state when the state object does not exist, so
+// the recipe resources can be deleted without checking that the object exists.
+func (b *remoteBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	return true, nil
+}
+
+// DeleteBackend leaves the state object in the storage service. The state is empty once the recipe resources are
+// destroyed, and the object is owned by the storage service, which can expire it with a lifecycle policy.
+func (b *remoteBackend) DeleteBackend(ctx context.Context, name string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Keeping the empty Terraform state %q in the %q backend", name, b.kind))
+	return nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RemoteBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	name, err := StateName(&resourceRecipe)
+	require.NoError(t, err)
+
+	b := NewRemoteBackend(BackendAzureRM,
+		map[string]string{"storage_account_name": "tfstate", "container_name": "radius"},
+		map[string]string{"access_key": "key"})
+
+	config, err := b.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		BackendAzureRM: map[string]any{
+			"storage_account_name": "tfstate",
+			"container_name":       "radius",
+			"key":                  name + ".tfstate",
+		},
+	}, config)
+	require.Equal(t, map[string]string{"access_key": "key"}, b.BuildBackendCredentials())
+
+	exists, err := b.ValidateBackendExists(context.Background(), name)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	require.NoError(t, b.DeleteBackend(context.Background(), name))
+}
@@ -31,8 +31,17 @@ type Backend interface {
 	// Returns an error if the backend configuration cannot be generated.
 	BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error)
 
+	// BuildBackendCredentials returns the credentials of the backend. They are not part of the configuration returned
+	// by BuildBackend, so that they are never written to the Terraform configuration file, and are passed to Terraform
+	// init as a partial backend configuration instead.
+	BuildBackendCredentials() map[string]string
+
 	// ValidateBackendExists checks if the Terraform state file backend source exists.
 	// For example, for Kubernetes backend, it checks if the Kubernetes secret for Terraform state file exists.
 	// returns true if backend is found, false otherwise.
 	ValidateBackendExists(ctx context.Context, name string) (bool, error)
+
+	// DeleteBackend deletes the Terraform state file stored in the backend once the resources deployed by the recipe
+	// are deleted. name is the name of the state returned by StateName.
+	DeleteBackend(ctx context.Context, name string) error
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
@@ -41,6 +42,14 @@ const (
 	awsRegionParam    = "region"
 	awsAccessKeyParam = "access_key"
 	awsSecretKeyParam = "secret_key"
+
+	awsAssumeRoleWithWebIdentityParam = "assume_role_with_web_identity"
+	awsRoleARNParam                   = "role_arn"
+	awsWebIdentityTokenFileParam      = "web_identity_token_file"
+
+	// awsWebIdentityTokenFileEnv is the environment variable containing the path to the projected service account
+	// token used by IAM roles for service accounts (IRSA).
+	awsWebIdentityTokenFileEnv = "AWS_WEB_IDENTITY_TOKEN_FILE"
 )
 
 var _ Provider = (*awsProvider)(nil)
@@ -118,6 +127,15 @@ func fetchAWSCredentials(ctx context.Context, awsCredentialsProvider credentials
 		return nil, err
 	}
 
+	if credentials != nil && credentials.IsIRSA() {
+		if credentials.RoleARN == "" {
+			logger.Info("AWS IRSA role is not registered, skipping credentials configuration.")
+			return nil, nil
+		}
+
+		return credentials, nil
+	}
+
 	if credentials == nil || credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" {
 		logger.Info("AWS credentials are not registered, skipping credentials configuration.")
 		return nil, nil
@@ -132,7 +150,15 @@ func (p *awsProvider) generateProviderConfigMap(credentials *credentials.AWSCred
 		config[awsRegionParam] = region
 	}
 
-	if credentials != nil && credentials.AccessKeyID != "" && credentials.SecretAccessKey != "" {
+	if credentials != nil && credentials.IsIRSA() && credentials.RoleARN != "" {
+		webIdentity := map[string]any{
+			awsRoleARNParam: credentials.RoleARN,
+		}
+		if tokenFile := os.Getenv(awsWebIdentityTokenFileEnv); tokenFile != "" {
+			webIdentity[awsWebIdentityTokenFileParam] = tokenFile
+		}
+		config[awsAssumeRoleWithWebIdentityParam] = webIdentity
+	} else if credentials != nil && credentials.AccessKeyID != "" && credentials.SecretAccessKey != "" {
 		config[awsAccessKeyParam] = credentials.AccessKeyID
 		config[awsSecretKeyParam] = credentials.SecretAccessKey
 	}
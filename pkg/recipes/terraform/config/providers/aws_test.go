@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/sdk"
 	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
@@ -278,8 +279,24 @@ func TestAWSProvider_generateProviderConfigMap(t *testing.T) {
 			},
 			expectedConfig: map[string]any{},
 		},
+		{
+			desc:   "irsa credentials",
+			region: testRegion,
+			credentials: ucp_credentials.AWSCredential{
+				Kind:    ucp_datamodel.AWSIRSACredentialKind,
+				RoleARN: "arn:aws:iam::000000000000:role/radius",
+			},
+			expectedConfig: map[string]any{
+				awsRegionParam: testRegion,
+				awsAssumeRoleWithWebIdentityParam: map[string]any{
+					awsRoleARNParam:              "arn:aws:iam::000000000000:role/radius",
+					awsWebIdentityTokenFileParam: "/var/run/secrets/eks.amazonaws.com/serviceaccount/token",
+				},
+			},
+		},
 	}
 
+	t.Setenv(awsWebIdentityTokenFileEnv, "/var/run/secrets/eks.amazonaws.com/serviceaccount/token")
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
 			p := &awsProvider{}
@@ -288,6 +305,7 @@ func TestAWSProvider_generateProviderConfigMap(t *testing.T) {
 			require.Equal(t, tt.expectedConfig[awsRegionParam], config[awsRegionParam])
 			require.Equal(t, tt.expectedConfig[awsAccessKeyParam], config[awsAccessKeyParam])
 			require.Equal(t, tt.expectedConfig[awsSecretKeyParam], config[awsSecretKeyParam])
+			require.Equal(t, tt.expectedConfig[awsAssumeRoleWithWebIdentityParam], config[awsAssumeRoleWithWebIdentityParam])
 		})
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
@@ -43,6 +44,12 @@ const (
 	azureClientIDParam     = "client_id"
 	azureClientSecretParam = "client_secret"
 	azureTenantIDParam     = "tenant_id"
+	azureUseOIDCParam      = "use_oidc"
+	azureOIDCTokenFilePath = "oidc_token_file_path"
+
+	// azureFederatedTokenFileEnv is the environment variable containing the path to the federated token
+	// projected by Azure workload identity.
+	azureFederatedTokenFileEnv = "AZURE_FEDERATED_TOKEN_FILE"
 )
 
 var _ Provider = (*azureProvider)(nil)
@@ -126,7 +133,7 @@ func fetchAzureCredentials(ctx context.Context, azureCredentialsProvider credent
 		return nil, err
 	}
 
-	if credentials == nil || credentials.ClientID == "" || credentials.TenantID == "" || credentials.ClientSecret == "" {
+	if credentials == nil || credentials.ClientID == "" || credentials.TenantID == "" || (!credentials.IsWorkloadIdentity() && credentials.ClientSecret == "") {
 		logger.Info("Azure credentials are not registered, skipping credentials configuration.")
 		return nil, nil
 	}
@@ -139,7 +146,14 @@ func (p *azureProvider) generateProviderConfigMap(configMap map[string]any, cred
 		configMap[azureSubIDParam] = subscriptionID
 	}
 
-	if credentials != nil && credentials.ClientID != "" && credentials.TenantID != "" && credentials.ClientSecret != "" {
+	if credentials != nil && credentials.IsWorkloadIdentity() && credentials.ClientID != "" && credentials.TenantID != "" {
+		configMap[azureClientIDParam] = credentials.ClientID
+		configMap[azureTenantIDParam] = credentials.TenantID
+		configMap[azureUseOIDCParam] = true
+		if tokenFile := os.Getenv(azureFederatedTokenFileEnv); tokenFile != "" {
+			configMap[azureOIDCTokenFilePath] = tokenFile
+		}
+	} else if credentials != nil && credentials.ClientID != "" && credentials.TenantID != "" && credentials.ClientSecret != "" {
 		configMap[azureClientIDParam] = credentials.ClientID
 		configMap[azureClientSecretParam] = credentials.ClientSecret
 		configMap[azureTenantIDParam] = credentials.TenantID
@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/sdk"
 	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
@@ -290,8 +291,26 @@ func TestAzureProvider_generateProviderConfigMap(t *testing.T) {
 				azureFeaturesParam: map[string]any{},
 			},
 		},
+		{
+			desc:         "workload identity credentials",
+			subscription: testSubscription,
+			credentials: ucp_credentials.AzureCredential{
+				Kind:     ucp_datamodel.AzureWorkloadIdentityCredentialKind,
+				TenantID: testAzureCredentials.TenantID,
+				ClientID: testAzureCredentials.ClientID,
+			},
+			expectedConfig: map[string]any{
+				azureFeaturesParam:     map[string]any{},
+				azureSubIDParam:        testSubscription,
+				azureTenantIDParam:     testAzureCredentials.TenantID,
+				azureClientIDParam:     testAzureCredentials.ClientID,
+				azureUseOIDCParam:      true,
+				azureOIDCTokenFilePath: "/var/run/secrets/azure/tokens/azure-identity-token",
+			},
+		},
 	}
 
+	t.Setenv(azureFederatedTokenFileEnv, "/var/run/secrets/azure/tokens/azure-identity-token")
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
 			p := &azureProvider{}
@@ -305,6 +324,8 @@ func TestAzureProvider_generateProviderConfigMap(t *testing.T) {
 			require.Equal(t, tt.expectedConfig[azureClientIDParam], config[azureClientIDParam])
 			require.Equal(t, tt.expectedConfig[azureClientSecretParam], config[azureClientSecretParam])
 			require.Equal(t, tt.expectedConfig[azureTenantIDParam], config[azureTenantIDParam])
+			require.Equal(t, tt.expectedConfig[azureUseOIDCParam], config[azureUseOIDCParam])
+			require.Equal(t, tt.expectedConfig[azureOIDCTokenFilePath], config[azureOIDCTokenFilePath])
 		})
 	}
 }
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_gcp "github.com/radius-project/radius/pkg/ucp/resources/gcp"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Provider's config parameters need to match the values expected by Terraform
+// https://registry.terraform.io/providers/hashicorp/google/latest/docs/guides/provider_reference
+const (
+	GCPProviderName = "google"
+
+	gcpProjectParam     = "project"
+	gcpRegionParam      = "region"
+	gcpCredentialsParam = "credentials"
+)
+
+var _ Provider = (*gcpProvider)(nil)
+
+type gcpProvider struct {
+	ucpConn        sdk.Connection
+	secretProvider *ucp_provider.SecretProvider
+}
+
+// NewGCPProvider creates a new GCPProvider instance.
+func NewGCPProvider(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider) Provider {
+	return &gcpProvider{ucpConn: ucpConn, secretProvider: secretProvider}
+}
+
+// BuildConfig generates the Terraform provider configuration for GCP provider. It checks if the GCP provider/scope
+// is configured on the Environment and if so, parses the scope to get the project and region. If a service account
+// key is registered with UCP it is passed to the provider as credentials.
+// https://registry.terraform.io/providers/hashicorp/google/latest/docs/guides/provider_reference
+func (p *gcpProvider) BuildConfig(ctx context.Context, envConfig *recipes.Configuration) (map[string]any, error) {
+	project, region, err := p.parseScope(ctx, envConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialsProvider, err := p.getCredentialsProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := fetchGCPCredentials(ctx, credentialsProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.generateProviderConfigMap(credentials, project, region), nil
+}
+
+// parseScope parses a GCP provider scope and returns the associated project and region. Region is optional.
+// Example scope: /planes/gcp/gcp/projects/my-project/regions/us-central1
+func (p *gcpProvider) parseScope(ctx context.Context, envConfig *recipes.Configuration) (string, string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if (envConfig == nil) || (envConfig.Providers == datamodel.Providers{}) || (envConfig.Providers.GCP == datamodel.ProvidersGCP{}) || envConfig.Providers.GCP.Scope == "" {
+		logger.Info("GCP provider/scope is not configured on the Environment, skipping GCP project configuration.")
+		return "", "", nil
+	}
+
+	scope := envConfig.Providers.GCP.Scope
+	parsedScope, err := resources.Parse(scope)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid GCP provider scope %q is configured on the Environment, error parsing: %s", scope, err.Error())
+	}
+
+	project := parsedScope.FindScope(resources_gcp.ScopeProjects)
+	if project == "" {
+		return "", "", fmt.Errorf("invalid GCP provider scope %q is configured on the Environment, project is required in the scope", scope)
+	}
+
+	return project, parsedScope.FindScope(resources_gcp.ScopeRegions), nil
+}
+
+func (p *gcpProvider) getCredentialsProvider() (*credentials.GCPCredentialProvider, error) {
+	return credentials.NewGCPCredentialProvider(p.secretProvider, p.ucpConn, &tokencredentials.AnonymousCredential{})
+}
+
+// fetchGCPCredentials fetches GCP credentials from UCP. Returns nil if credentials not found error is received or the credentials are empty.
+func fetchGCPCredentials(ctx context.Context, gcpCredentialsProvider credentials.CredentialProvider[credentials.GCPCredential]) (*credentials.GCPCredential, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	credentials, err := gcpCredentialsProvider.Fetch(ctx, credentials.GCPPublic, "default")
+	if err != nil {
+		if errors.Is(err, &secret.ErrNotFound{}) {
+			logger.Info("GCP credentials are not registered, skipping credentials configuration.")
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if credentials == nil || credentials.ServiceAccountKey == "" {
+		logger.Info("GCP credentials are not registered, skipping credentials configuration.")
+		return nil, nil
+	}
+
+	return credentials, nil
+}
+
+func (p *gcpProvider) generateProviderConfigMap(credentials *credentials.GCPCredential, project, region string) map[string]any {
+	config := make(map[string]any)
+	if project != "" {
+		config[gcpProjectParam] = project
+	}
+
+	if region != "" {
+		config[gcpRegionParam] = region
+	}
+
+	if credentials != nil && credentials.ServiceAccountKey != "" {
+		config[gcpCredentialsParam] = credentials.ServiceAccountKey
+	}
+
+	return config
+}
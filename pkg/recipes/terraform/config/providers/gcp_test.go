@@ -0,0 +1,256 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testGCPProject     = "test-project"
+	testGCPRegion      = "us-central1"
+	testGCPCredentials = ucp_credentials.GCPCredential{
+		Kind:              ucp_datamodel.GCPCredentialKind,
+		ServiceAccountKey: `{"type":"service_account","project_id":"test-project"}`,
+	}
+)
+
+type mockGCPCredentialsProvider struct {
+	testCredential *ucp_credentials.GCPCredential
+	err            error
+}
+
+// Fetch returns mock GCP credentials for testing.
+func (p *mockGCPCredentialsProvider) Fetch(ctx context.Context, planeName, name string) (*ucp_credentials.GCPCredential, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	if p.testCredential == nil {
+		return nil, &secret.ErrNotFound{}
+	}
+
+	return p.testCredential, nil
+}
+
+func TestGCPProvider_BuildConfig_InvalidScope_Error(t *testing.T) {
+	envConfig := &recipes.Configuration{
+		Providers: datamodel.Providers{
+			GCP: datamodel.ProvidersGCP{
+				Scope: "/planes/gcp/gcp/regions/us-central1",
+			},
+		},
+	}
+	p := &gcpProvider{}
+	config, err := p.BuildConfig(testcontext.New(t), envConfig)
+	require.Nil(t, config)
+	require.ErrorContains(t, err, "invalid GCP provider scope \"/planes/gcp/gcp/regions/us-central1\" is configured on the Environment, project is required in the scope")
+}
+
+func TestGCPProvider_ParseScope(t *testing.T) {
+	tests := []struct {
+		desc            string
+		envConfig       *recipes.Configuration
+		expectedProject string
+		expectedRegion  string
+		expectedErrMsg  string
+	}{
+		{
+			desc: "valid config scope",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "/planes/gcp/gcp/projects/test-project/regions/us-central1",
+					},
+				},
+			},
+			expectedProject: testGCPProject,
+			expectedRegion:  testGCPRegion,
+		},
+		{
+			desc: "project only scope",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "/planes/gcp/gcp/projects/test-project",
+					},
+				},
+			},
+			expectedProject: testGCPProject,
+		},
+		{
+			desc:      "nil config - no error",
+			envConfig: nil,
+		},
+		{
+			desc: "missing GCP provider config - no error",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{},
+			},
+		},
+		{
+			desc: "missing project segment - error",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "/planes/gcp/gcp/regions/us-central1",
+					},
+				},
+			},
+			expectedErrMsg: "invalid GCP provider scope \"/planes/gcp/gcp/regions/us-central1\" is configured on the Environment, project is required in the scope",
+		},
+		{
+			desc: "invalid scope - error",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "invalid",
+					},
+				},
+			},
+			expectedErrMsg: "invalid GCP provider scope \"invalid\" is configured on the Environment, error parsing: 'invalid' is not a valid resource id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			p := &gcpProvider{}
+			project, region, err := p.parseScope(testcontext.New(t), tt.envConfig)
+			if tt.expectedErrMsg != "" {
+				require.ErrorContains(t, err, tt.expectedErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedProject, project)
+				require.Equal(t, tt.expectedRegion, region)
+			}
+		})
+	}
+}
+
+func TestGCPProvider_getCredentialsProvider(t *testing.T) {
+	connection, err := sdk.NewDirectConnection("http://example.com")
+	require.NoError(t, err)
+
+	provider := &gcpProvider{
+		ucpConn: connection,
+	}
+	gcpCredentialProvider, err := provider.getCredentialsProvider()
+	require.NotNil(t, gcpCredentialProvider)
+	require.NoError(t, err)
+}
+
+func TestGCPProvider_FetchCredentials(t *testing.T) {
+	tests := []struct {
+		desc                string
+		credentialsProvider *mockGCPCredentialsProvider
+		expectedCreds       *ucp_credentials.GCPCredential
+		expectedErr         bool
+	}{
+		{
+			desc:                "valid credentials",
+			credentialsProvider: &mockGCPCredentialsProvider{testCredential: &testGCPCredentials},
+			expectedCreds:       &testGCPCredentials,
+		},
+		{
+			desc:                "credentials not found - no error",
+			credentialsProvider: &mockGCPCredentialsProvider{},
+		},
+		{
+			desc: "empty values - no error",
+			credentialsProvider: &mockGCPCredentialsProvider{
+				testCredential: &ucp_credentials.GCPCredential{Kind: ucp_datamodel.GCPCredentialKind},
+			},
+		},
+		{
+			desc:                "fetch credential error",
+			credentialsProvider: &mockGCPCredentialsProvider{err: errors.New("failed to fetch credential")},
+			expectedErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c, err := fetchGCPCredentials(testcontext.New(t), tt.credentialsProvider)
+			if tt.expectedErr {
+				require.Error(t, err)
+				require.Nil(t, c)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedCreds, c)
+			}
+		})
+	}
+}
+
+func TestGCPProvider_generateProviderConfigMap(t *testing.T) {
+	tests := []struct {
+		desc           string
+		project        string
+		region         string
+		credentials    *ucp_credentials.GCPCredential
+		expectedConfig map[string]any
+	}{
+		{
+			desc:        "valid config",
+			project:     testGCPProject,
+			region:      testGCPRegion,
+			credentials: &testGCPCredentials,
+			expectedConfig: map[string]any{
+				gcpProjectParam:     testGCPProject,
+				gcpRegionParam:      testGCPRegion,
+				gcpCredentialsParam: testGCPCredentials.ServiceAccountKey,
+			},
+		},
+		{
+			desc:        "missing project and region",
+			credentials: &testGCPCredentials,
+			expectedConfig: map[string]any{
+				gcpCredentialsParam: testGCPCredentials.ServiceAccountKey,
+			},
+		},
+		{
+			desc:    "missing credentials",
+			project: testGCPProject,
+			expectedConfig: map[string]any{
+				gcpProjectParam: testGCPProject,
+			},
+		},
+		{
+			desc:           "empty",
+			expectedConfig: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			p := &gcpProvider{}
+			config := p.generateProviderConfigMap(tt.credentials, tt.project, tt.region)
+			require.Equal(t, tt.expectedConfig, config)
+		})
+	}
+}
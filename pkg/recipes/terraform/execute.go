@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -37,7 +38,6 @@ import (
 	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -49,8 +49,9 @@ var (
 var _ TerraformExecutor = (*executor)(nil)
 
 // NewExecutor creates a new Executor with the given UCP connection and secret provider, to execute a Terraform recipe.
-func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface) *executor {
-	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet}
+// The cache is optional; when nil every execution downloads the providers and modules of the recipe.
+func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface, cache *Cache) *executor {
+	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet, cache: cache}
 }
 
 type executor struct {
@@ -62,6 +63,10 @@ type executor struct {
 
 	// k8sClientSet is the Kubernetes client.
 	k8sClientSet kubernetes.Interface
+
+	// cache is the cache of the providers, modules and provider mirrors shared by the executions. It is nil if the cache
+	// is disabled.
+	cache *Cache
 }
 
 // Deploy installs Terraform, creates a working directory, generates a config, and runs Terraform init and
@@ -83,33 +88,36 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 		return nil, err
 	}
 
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	stateName, err := e.generateConfig(ctx, tf, options, backend)
 	if err != nil {
 		return nil, err
 	}
 
-	if options.EnvConfig != nil {
-		// Set environment variables for the Terraform process.
-		err = e.setEnvironmentVariables(tf, &options.EnvConfig.RecipeConfig)
-		if err != nil {
-			return nil, err
-		}
+	// Set environment variables for the Terraform process.
+	err = e.configureEnvironment(ctx, tf, options)
+	if err != nil {
+		return nil, err
 	}
 
 	// Run TF Init and Apply in the working directory
-	state, err := initAndApply(ctx, tf)
+	state, err := initAndApply(ctx, tf, e.cache, backend.BuildBackendCredentials())
 	if err != nil {
 		return nil, err
 	}
 
 	// Validate that the terraform state file backend source exists.
-	// Currently only Kubernetes secret backend is supported, which is created by Terraform as a part of Terraform apply.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	// The state is created in the backend by Terraform as a part of Terraform apply.
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving kubernetes secret for terraform state: %w", err)
+		return nil, fmt.Errorf("error retrieving terraform state %q from the backend: %w", stateName, err)
 	} else if !backendExists {
-		return nil, errors.New("expected kubernetes secret for terraform state is not found")
+		return nil, fmt.Errorf("expected terraform state %q is not found in the backend", stateName)
 	}
 
 	return state, nil
@@ -134,8 +142,13 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 		return err
 	}
 
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return err
+	}
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	stateName, err := e.generateConfig(ctx, tf, options, backend)
 	if err != nil {
 		return err
 	}
@@ -143,7 +156,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
 	// If the state file source has been deleted or wasn't created due to a failure during apply then
 	// terraform initialization will fail due to missing backend source.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
 		// Continue with the delete flow for all errors other than backend not found.
 		// If it is an intermittent error then the delete flow will fail and should be retried from the client.
@@ -154,21 +167,60 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 		return nil
 	}
 
+	// Set environment variables for the Terraform process.
+	err = e.configureEnvironment(ctx, tf, options)
+	if err != nil {
+		return err
+	}
+
 	// Run TF Destroy in the working directory to delete the resources deployed by the recipe
-	err = initAndDestroy(ctx, tf)
+	err = initAndDestroy(ctx, tf, e.cache, backend.BuildBackendCredentials())
 	if err != nil {
 		return err
 	}
 
-	// Delete the kubernetes secret created for terraform state file.
-	err = e.k8sClientSet.CoreV1().
-		Secrets(backends.RadiusNamespace).
-		Delete(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix, metav1.DeleteOptions{})
+	// Delete the terraform state file from the backend.
+	return backend.DeleteBackend(ctx, stateName)
+}
+
+// Plan installs Terraform, creates a working directory, generates a config, and runs Terraform init and plan
+// in the working directory, returning the plan of the changes Terraform would make to the recipe resources.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Install Terraform
+	i := install.NewInstaller()
+	tf, err := Install(ctx, i, options.RootDir)
+	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
+	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
+	defer func() {
+		if err := i.Remove(ctx); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+		}
+	}()
 	if err != nil {
-		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+		return nil, err
 	}
 
-	return nil
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Terraform config in the working directory
+	_, err = e.generateConfig(ctx, tf, options, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set environment variables for the Terraform process.
+	err = e.configureEnvironment(ctx, tf, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run TF Init and Plan in the working directory
+	return initAndPlan(ctx, tf, e.cache, backend.BuildBackendCredentials(), options.RefreshOnly)
 }
 
 func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
@@ -193,7 +245,7 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 		return nil, err
 	}
 
-	result, err := downloadAndInspect(ctx, tf, options)
+	result, err := downloadAndInspect(ctx, tf, options, e.cache)
 	if err != nil {
 		return nil, err
 	}
@@ -203,15 +255,41 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 	}, nil
 }
 
+// configureEnvironment generates the Terraform CLI configuration of the provider plugin cache and the provider mirror of
+// the environment, and sets the environment variables of the Terraform process.
+func (e *executor) configureEnvironment(ctx context.Context, tf *tfexec.Terraform, options Options) error {
+	var recipeConfig *datamodel.RecipeConfigProperties
+	mirror := datamodel.TerraformProviderMirrorConfig{}
+	if options.EnvConfig != nil {
+		recipeConfig = &options.EnvConfig.RecipeConfig
+		mirror = recipeConfig.Terraform.ProviderMirror
+	}
+
+	cliConfigFile, err := writeCLIConfig(ctx, options.RootDir, e.cache, mirror, options.ProviderMirrorSecrets)
+	if err != nil {
+		return err
+	}
+
+	return e.setEnvironmentVariables(tf, recipeConfig, cliConfigFile)
+}
+
 // setEnvironmentVariables sets environment variables for the Terraform process by reading values from the recipe configuration.
-// Terraform process will use environment variables as input for the recipe deployment.
-func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, recipeConfig *datamodel.RecipeConfigProperties) error {
-	if recipeConfig != nil && recipeConfig.Env.AdditionalProperties != nil && len(recipeConfig.Env.AdditionalProperties) > 0 {
+// Terraform process will use environment variables as input for the recipe deployment. If cliConfigFile is not empty Terraform
+// is configured to read its CLI configuration from the file.
+func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, recipeConfig *datamodel.RecipeConfigProperties, cliConfigFile string) error {
+	hasRecipeEnv := recipeConfig != nil && len(recipeConfig.Env.AdditionalProperties) > 0
+	if hasRecipeEnv || cliConfigFile != "" {
 		// populate envVars with the environment variables from current process
 		envVars := splitEnvVar(os.Environ())
 
-		for key, value := range recipeConfig.Env.AdditionalProperties {
-			envVars[key] = value
+		if hasRecipeEnv {
+			for key, value := range recipeConfig.Env.AdditionalProperties {
+				envVars[key] = value
+			}
+		}
+
+		if cliConfigFile != "" {
+			envVars[cliConfigFileEnvVar] = cliConfigFile
 		}
 
 		if err := tf.SetEnv(envVars); err != nil {
@@ -235,8 +313,19 @@ func splitEnvVar(envVars []string) map[string]string {
 	return parsedEnvVars
 }
 
+// getBackend returns the Terraform backend configured for the environment, or the Kubernetes backend if no backend is configured.
+func (e *executor) getBackend(options Options) (backends.Backend, error) {
+	backendConfig := datamodel.TerraformBackendConfig{}
+	if options.EnvConfig != nil {
+		backendConfig = options.EnvConfig.RecipeConfig.Terraform.Backend
+	}
+
+	return backends.NewBackend(backendConfig, options.BackendSecrets, e.k8sClientSet)
+}
+
 // generateConfig generates Terraform configuration with required inputs for the module, providers and backend to be initialized and applied.
-func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options) (string, error) {
+// It returns the name of the Terraform state of the resource in the backend.
+func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options, backend backends.Backend) (string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	workingDir := tf.WorkingDir()
 
@@ -245,7 +334,7 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		return "", err
 	}
 
-	loadedModule, err := downloadAndInspect(ctx, tf, options)
+	loadedModule, err := downloadAndInspect(ctx, tf, options, e.cache)
 	if err != nil {
 		return "", err
 	}
@@ -257,19 +346,14 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		return "", err
 	}
 
-	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backends.NewKubernetesBackend(e.k8sClientSet))
+	_, err = tfConfig.AddTerraformBackend(options.ResourceRecipe, backend)
 	if err != nil {
 		return "", err
 	}
 
-	// Retrieving the secret_suffix property from backend config to use it to verify secret creation during terraform init.
-	// This is only used for the backend of type kubernetes and should be moved inside an if block when we add more backends.
-	var secretSuffix string
-	if backendDetails, ok := backendConfig[backends.BackendKubernetes]; ok {
-		backendMap := backendDetails.(map[string]any)
-		if secret, ok := backendMap["secret_suffix"]; ok {
-			secretSuffix = secret.(string)
-		}
+	stateName, err := backends.StateName(options.ResourceRecipe)
+	if err != nil {
+		return "", err
 	}
 
 	// Add recipe context parameter to the generated Terraform config's module parameters.
@@ -300,7 +384,7 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		return "", err
 	}
 
-	return secretSuffix, nil
+	return stateName, nil
 }
 
 // getTerraformConfig initializes the Terraform json config with provided module source and saves it
@@ -331,13 +415,13 @@ func getTerraformConfig(ctx context.Context, workingDir string, options Options)
 }
 
 // initAndApply runs Terraform init and apply in the provided working directory.
-func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, error) {
+func initAndApply(ctx context.Context, tf *tfexec.Terraform, cache *Cache, backendCredentials map[string]string) (*tfjson.State, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Initialize Terraform
 	logger.Info("Initializing Terraform")
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	if err := initTerraform(ctx, tf, cache, backendCredentials); err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
@@ -357,14 +441,47 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, err
 	return tf.Show(ctx)
 }
 
+// initAndPlan runs Terraform init and plan in the provided working directory, and reads the saved plan as JSON.
+// A refresh-only plan reports the changes made to the resources outside of Terraform in the ResourceDrift of the plan.
+func initAndPlan(ctx context.Context, tf *tfexec.Terraform, cache *Cache, backendCredentials map[string]string, refreshOnly bool) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+	terraformInitStartTime := time.Now()
+	if err := initTerraform(ctx, tf, cache, backendCredentials); err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
+
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
+
+	// Plan Terraform configuration, saving the plan in the working directory so that it can be read as JSON.
+	logger.Info("Running Terraform plan")
+	planFile := filepath.Join(tf.WorkingDir(), planFileName)
+	if _, err := tf.Plan(ctx, tfexec.Out(planFile), tfexec.RefreshOnly(refreshOnly)); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	logger.Info("Fetching Terraform plan")
+	plan, err := tf.ShowPlanFile(ctx, planFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read terraform plan: %w", err)
+	}
+
+	return plan, nil
+}
+
 // initAndDestroy runs Terraform init and destroy in the provided working directory.
-func initAndDestroy(ctx context.Context, tf *tfexec.Terraform) error {
+func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, cache *Cache, backendCredentials map[string]string) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Initialize Terraform
 	logger.Info("Initializing Terraform")
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	if err := initTerraform(ctx, tf, cache, backendCredentials); err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
@@ -380,3 +497,25 @@ func initAndDestroy(ctx context.Context, tf *tfexec.Terraform) error {
 
 	return nil
 }
+
+// initTerraform runs Terraform init, through the cache if the cache is enabled. The credentials of the backend are
+// passed in a backend configuration file which is deleted once Terraform has initialized the backend, so that they are
+// not written to the Terraform configuration.
+func initTerraform(ctx context.Context, tf *tfexec.Terraform, cache *Cache, backendCredentials map[string]string) error {
+	opts := []tfexec.InitOption{}
+	if len(backendCredentials) > 0 {
+		backendConfigFile := filepath.Join(tf.WorkingDir(), backendConfigFileName)
+		if err := backends.SaveCredentials(backendConfigFile, backendCredentials); err != nil {
+			return fmt.Errorf("failed to save the Terraform backend credentials: %w", err)
+		}
+		defer os.Remove(backendConfigFile)
+
+		opts = append(opts, tfexec.BackendConfig(backendConfigFile))
+	}
+
+	if cache == nil {
+		return tf.Init(ctx, opts...)
+	}
+
+	return cache.Init(ctx, tf, opts...)
+}
@@ -57,7 +57,7 @@ func TestGenerateConfig(t *testing.T) {
 			require.NoError(t, err)
 
 			e := executor{}
-			_, err = e.generateConfig(ctx, tf, tc.opts)
+			_, err = e.generateConfig(ctx, tf, tc.opts, nil)
 			require.Error(t, err)
 			require.ErrorContains(t, err, tc.err)
 		})
@@ -119,8 +119,9 @@ func Test_GetTerraformConfig_InvalidDirectory(t *testing.T) {
 
 func TestSetEnvironmentVariables(t *testing.T) {
 	testCase := []struct {
-		name string
-		opts Options
+		name          string
+		opts          Options
+		cliConfigFile string
 	}{
 		{
 			name: "set environment variables",
@@ -157,6 +158,15 @@ func TestSetEnvironmentVariables(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "CLI configuration file",
+			opts: Options{
+				EnvConfig: &recipes.Configuration{
+					RecipeConfig: dm.RecipeConfigProperties{},
+				},
+			},
+			cliConfigFile: "/terraform/test/terraform.tfrc",
+		},
 	}
 
 	for _, tc := range testCase {
@@ -168,7 +178,7 @@ func TestSetEnvironmentVariables(t *testing.T) {
 
 			e := executor{}
 
-			err = e.setEnvironmentVariables(tf, &tc.opts.EnvConfig.RecipeConfig)
+			err = e.setEnvironmentVariables(tf, &tc.opts.EnvConfig.RecipeConfig, tc.cliConfigFile)
 
 			require.NoError(t, err)
 		})
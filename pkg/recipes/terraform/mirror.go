@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
+)
+
+const (
+	// ProviderMirrorKindFilesystem is the kind of a provider mirror in a directory on the filesystem of Radius.
+	ProviderMirrorKindFilesystem = "filesystem"
+
+	// ProviderMirrorKindOCI is the kind of a provider mirror in an OCI artifact.
+	ProviderMirrorKindOCI = "oci"
+
+	// cliConfigFileName is the name of the Terraform CLI configuration file generated in the root directory of an execution.
+	cliConfigFileName = "terraform.tfrc"
+
+	// cliConfigFileEnvVar is the environment variable with the path of the Terraform CLI configuration file.
+	cliConfigFileEnvVar = "TF_CLI_CONFIG_FILE"
+
+	// mirrorSubDir is the directory in the root directory of an execution in which an OCI provider mirror is pulled when
+	// the cache is disabled.
+	mirrorSubDir = "mirror"
+)
+
+// writeCLIConfig generates the Terraform CLI configuration file in the root directory of the execution, configuring the
+// provider plugin cache and the provider mirror of the environment. It returns the path of the file, or an empty path if
+// neither the cache nor a mirror are configured.
+//
+// A provider mirror configured for the environment is the only installation method of the providers, so that Terraform
+// does not try to reach the provider registries. mirrorSecrets contains the credentials of the container registry of an
+// OCI provider mirror.
+func writeCLIConfig(ctx context.Context, rootDir string, cache *Cache, mirror datamodel.TerraformProviderMirrorConfig, mirrorSecrets map[string]string) (string, error) {
+	if cache == nil && mirror.Kind == "" {
+		return "", nil
+	}
+
+	var b strings.Builder
+	if cache != nil {
+		if err := os.MkdirAll(cache.ProvidersDir(), workingDirFileMode); err != nil {
+			return "", fmt.Errorf("failed to create the Terraform provider plugin cache directory: %w", err)
+		}
+
+		// The execution directories don't have a dependency lock file, so Terraform would otherwise not use the cache.
+		// https://developer.hashicorp.com/terraform/cli/config/config-file#allowing-the-provider-plugin-cache-to-break-the-dependency-lock-file
+		fmt.Fprintf(&b, "plugin_cache_dir = %q\n", cache.ProvidersDir())
+		b.WriteString("plugin_cache_may_break_dependency_lock_file = true\n")
+	}
+
+	if mirror.Kind != "" {
+		mirrorPath, err := getProviderMirrorPath(ctx, rootDir, cache, mirror, mirrorSecrets)
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString("provider_installation {\n")
+		b.WriteString("  filesystem_mirror {\n")
+		fmt.Fprintf(&b, "    path = %q\n", mirrorPath)
+		b.WriteString("  }\n")
+		b.WriteString("}\n")
+	}
+
+	configFile := filepath.Join(rootDir, cliConfigFileName)
+	if err := os.WriteFile(configFile, []byte(b.String()), 0600); err != nil {
+		return "", fmt.Errorf("failed to write the Terraform CLI configuration file: %w", err)
+	}
+
+	return configFile, nil
+}
+
+// ValidateProviderMirrorConfig validates the Terraform provider mirror configuration of an environment. An empty
+// configuration is valid and means that no mirror is used.
+func ValidateProviderMirrorConfig(mirror datamodel.TerraformProviderMirrorConfig) error {
+	switch mirror.Kind {
+	case "":
+		if mirror != (datamodel.TerraformProviderMirrorConfig{}) {
+			return fmt.Errorf("the kind of the provider mirror is required, supported kinds are %q and %q", ProviderMirrorKindFilesystem, ProviderMirrorKindOCI)
+		}
+		return nil
+
+	case ProviderMirrorKindFilesystem:
+		if !filepath.IsAbs(mirror.Path) {
+			return fmt.Errorf("the path of the filesystem provider mirror must be absolute, got %q", mirror.Path)
+		}
+		if mirror.PlainHTTP || mirror.Secret != "" {
+			return fmt.Errorf("the plainHttp and secret settings are only supported by the %q provider mirror kind", ProviderMirrorKindOCI)
+		}
+		return nil
+
+	case ProviderMirrorKindOCI:
+		reference, err := registry.ParseReference(mirror.Path)
+		if err != nil {
+			return fmt.Errorf("invalid provider mirror reference %q: %w", mirror.Path, err)
+		}
+		if reference.Reference == "" {
+			return fmt.Errorf("the provider mirror reference %q must have a tag or a digest", mirror.Path)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported provider mirror kind %q, supported kinds are %q and %q", mirror.Kind, ProviderMirrorKindFilesystem, ProviderMirrorKindOCI)
+	}
+}
+
+// getProviderMirrorPath returns the path of the directory of the provider mirror. A mirror in an OCI artifact is pulled to
+// the cache, or to the root directory of the execution if the cache is disabled.
+func getProviderMirrorPath(ctx context.Context, rootDir string, cache *Cache, mirror datamodel.TerraformProviderMirrorConfig, mirrorSecrets map[string]string) (string, error) {
+	if err := ValidateProviderMirrorConfig(mirror); err != nil {
+		return "", err
+	}
+
+	if mirror.Kind == ProviderMirrorKindOCI {
+		return pullProviderMirror(ctx, rootDir, cache, mirror, mirrorSecrets)
+	}
+
+	return mirror.Path, nil
+}
+
+// pullProviderMirror pulls the OCI artifact of the provider mirror and returns the directory it is extracted to. The
+// artifact is expected to contain the hostname directories of the mirror, as pushed by 'oras push <reference> <hostname>'.
+// The registry is accessed with the 'username' and 'password' of mirrorSecrets, or anonymously if they are not set.
+func pullProviderMirror(ctx context.Context, rootDir string, cache *Cache, mirror datamodel.TerraformProviderMirrorConfig, mirrorSecrets map[string]string) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	repo, err := remote.NewRepository(mirror.Path)
+	if err != nil {
+		return "", fmt.Errorf("invalid provider mirror reference %q: %w", mirror.Path, err)
+	}
+	repo.PlainHTTP = mirror.PlainHTTP
+	if client := newRegistryClient(repo.Reference.Registry, mirrorSecrets); client != nil {
+		repo.Client = client
+	}
+
+	root, err := repo.Resolve(ctx, repo.Reference.Reference)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve provider mirror %q: %w", mirror.Path, err)
+	}
+
+	pull := func(dir string) error {
+		logger.Info(fmt.Sprintf("Pulling Terraform provider mirror %q (%s)", mirror.Path, root.Digest))
+		if err := os.MkdirAll(dir, workingDirFileMode); err != nil {
+			return err
+		}
+
+		store, err := file.New(dir)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		if err := oras.CopyGraph(ctx, repo, store, root, oras.DefaultCopyGraphOptions); err != nil {
+			return fmt.Errorf("failed to pull provider mirror %q: %w", mirror.Path, err)
+		}
+		return nil
+	}
+
+	if cache == nil {
+		dir := filepath.Join(rootDir, mirrorSubDir)
+		if err := pull(dir); err != nil {
+			return "", err
+		}
+		return dir, nil
+	}
+
+	if entry, ok := cache.lookup(mirrorsCacheSubDir, root.Digest.Encoded()); ok {
+		return entry, nil
+	}
+
+	return cache.store(ctx, mirrorsCacheSubDir, root.Digest.Encoded(), pull)
+}
+
+// newRegistryClient returns a client authenticating to the registry with the 'username' and 'password' of the secrets,
+// or nil if the secrets don't contain any credentials.
+func newRegistryClient(registry string, secrets map[string]string) *auth.Client {
+	if secrets["username"] == "" && secrets["password"] == "" {
+		return nil
+	}
+
+	return &auth.Client{
+		Client: retry.DefaultClient,
+		Cache:  auth.NewCache(),
+		Credential: auth.StaticCredential(registry, auth.Credential{
+			Username: secrets["username"],
+			Password: secrets["password"],
+		}),
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WriteCLIConfig(t *testing.T) {
+	cacheDir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		cache    *Cache
+		mirror   datamodel.TerraformProviderMirrorConfig
+		expected string
+		err      string
+	}{
+		{
+			name: "no cache or mirror",
+		},
+		{
+			name:  "cache",
+			cache: NewCache(CacheOptions{Path: cacheDir}),
+			expected: fmt.Sprintf("plugin_cache_dir = %q\n", filepath.Join(cacheDir, providersCacheSubDir)) +
+				"plugin_cache_may_break_dependency_lock_file = true\n",
+		},
+		{
+			name:   "filesystem mirror",
+			mirror: datamodel.TerraformProviderMirrorConfig{Kind: ProviderMirrorKindFilesystem, Path: "/mnt/providers"},
+			expected: "provider_installation {\n" +
+				"  filesystem_mirror {\n" +
+				"    path = \"/mnt/providers\"\n" +
+				"  }\n" +
+				"}\n",
+		},
+		{
+			name:   "cache and filesystem mirror",
+			cache:  NewCache(CacheOptions{Path: cacheDir}),
+			mirror: datamodel.TerraformProviderMirrorConfig{Kind: ProviderMirrorKindFilesystem, Path: "/mnt/providers"},
+			expected: fmt.Sprintf("plugin_cache_dir = %q\n", filepath.Join(cacheDir, providersCacheSubDir)) +
+				"plugin_cache_may_break_dependency_lock_file = true\n" +
+				"provider_installation {\n" +
+				"  filesystem_mirror {\n" +
+				"    path = \"/mnt/providers\"\n" +
+				"  }\n" +
+				"}\n",
+		},
+		{
+			name:   "relative filesystem mirror path",
+			mirror: datamodel.TerraformProviderMirrorConfig{Kind: ProviderMirrorKindFilesystem, Path: "providers"},
+			err:    "the path of the filesystem provider mirror must be absolute, got \"providers\"",
+		},
+		{
+			name:   "unsupported mirror kind",
+			mirror: datamodel.TerraformProviderMirrorConfig{Kind: "network", Path: "https://mirror.example.com"},
+			err:    "unsupported provider mirror kind \"network\", supported kinds are \"filesystem\" and \"oci\"",
+		},
+		{
+			name:   "invalid oci mirror reference",
+			mirror: datamodel.TerraformProviderMirrorConfig{Kind: ProviderMirrorKindOCI, Path: "not a reference"},
+			err:    "invalid provider mirror reference \"not a reference\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			rootDir := t.TempDir()
+
+			configFile, err := writeCLIConfig(ctx, rootDir, tc.cache, tc.mirror, nil)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.expected == "" {
+				require.Empty(t, configFile)
+				return
+			}
+
+			require.Equal(t, filepath.Join(rootDir, cliConfigFileName), configFile)
+			b, err := os.ReadFile(configFile)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(b))
+
+			if tc.cache != nil {
+				require.DirExists(t, tc.cache.ProvidersDir())
+			}
+		})
+	}
+}
+
+func Test_PullProviderMirror_Credentials(t *testing.T) {
+	tests := []struct {
+		name     string
+		secrets  map[string]string
+		expected bool
+	}{
+		{name: "anonymous"},
+		{name: "credentials", secrets: map[string]string{"username": "user", "password": "pass"}, expected: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var mutex sync.Mutex
+			authenticated := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				username, password, ok := r.BasicAuth()
+				if !ok {
+					w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				mutex.Lock()
+				authenticated = username == "user" && password == "pass"
+				mutex.Unlock()
+				w.WriteHeader(http.StatusNotFound)
+			}))
+			defer server.Close()
+
+			ctx := testcontext.New(t)
+			mirror := datamodel.TerraformProviderMirrorConfig{
+				Kind:      ProviderMirrorKindOCI,
+				Path:      strings.TrimPrefix(server.URL, "http://") + "/terraform/providers:1.0",
+				PlainHTTP: true,
+			}
+			_, err := pullProviderMirror(ctx, t.TempDir(), nil, mirror, tc.secrets)
+			require.ErrorContains(t, err, "failed to resolve provider mirror")
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			require.Equal(t, tc.expected, authenticated)
+		})
+	}
+}
+
+func Test_ValidateProviderMirrorConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		mirror datamodel.TerraformProviderMirrorConfig
+		err    string
+	}{
+		{
+			name: "no mirror",
+		},
+		{
+			name:   "filesystem",
+			mirror: datamodel.TerraformProviderMirrorConfig{Kind: ProviderMirrorKindFilesystem, Path: "/mnt/providers"},
+		},
+		{
+			name:   "oci",
+			mirror: datamodel.TerraformProviderMirrorConfig{Kind: ProviderMirrorKindOCI, Path: "myregistry.azurecr.io/terraform/providers:1.0", Secret: "registry"},
+		},
+		{
+			name:   "missing kind",
+			mirror: datamodel.TerraformProviderMirrorConfig{Path: "/mnt/providers"},
+			err:    "the kind of the provider mirror is required, supported kinds are \"filesystem\" and \"oci\"",
+		},
+		{
+			name:   "filesystem with secret",
+			mirror: datamodel.TerraformProviderMirrorConfig{Kind: ProviderMirrorKindFilesystem, Path: "/mnt/providers", Secret: "registry"},
+			err:    "the plainHttp and secret settings are only supported by the \"oci\" provider mirror kind",
+		},
+		{
+			name:   "oci without tag",
+			mirror: datamodel.TerraformProviderMirrorConfig{Kind: ProviderMirrorKindOCI, Path: "myregistry.azurecr.io/terraform/providers"},
+			err:    "the provider mirror reference \"myregistry.azurecr.io/terraform/providers\" must have a tag or a digest",
+		},
+		{
+			name:   "oci with invalid reference",
+			mirror: datamodel.TerraformProviderMirrorConfig{Kind: ProviderMirrorKindOCI, Path: "not a reference"},
+			err:    "invalid provider mirror reference \"not a reference\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateProviderMirrorConfig(tc.mirror)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
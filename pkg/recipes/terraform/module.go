@@ -56,12 +56,28 @@ type moduleInspectResult struct {
 	// Any other module information required in the future can be added here.
 }
 
-// downloadAndInspect handles downloading the TF module and retrieving the necessary information
-func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Options) (*moduleInspectResult, error) {
+// downloadAndInspect handles downloading the TF module and retrieving the necessary information. If the cache is enabled
+// the module is restored from the cache when it was downloaded by a previous execution, and is cached otherwise.
+func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Options, cache *Cache) (*moduleInspectResult, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	environmentID := ""
+	if options.ResourceRecipe != nil {
+		environmentID = options.ResourceRecipe.EnvironmentID
+	}
+
+	restored := false
+	if cache != nil {
+		var err error
+		restored, err = cache.RestoreModules(ctx, tf.WorkingDir(), environmentID, options.EnvRecipe)
+		if err != nil {
+			// The module is downloaded instead.
+			logger.Info(err.Error())
+		}
+	}
+
 	// Run Terraform Get command to download the module from the source specified in the config.
-	// The downloaded module is stored in the working directory.
+	// The downloaded module is stored in the working directory. Modules restored from the cache are not downloaded again.
 	logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
 	downloadStartTime := time.Now()
 	if err := tf.Get(ctx); err != nil {
@@ -77,6 +93,12 @@ func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Optio
 		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
 			options.EnvRecipe, metrics.SuccessfulOperationState))
 
+	if cache != nil && !restored {
+		if err := cache.SaveModules(ctx, tf.WorkingDir(), environmentID, options.EnvRecipe); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cache Terraform module: %s", err.Error()))
+		}
+	}
+
 	// Load the downloaded module to retrieve providers and variables required by the module.
 	// This is needed to add the appropriate providers config and populate the value of recipe context variable.
 	logger.Info(fmt.Sprintf("Inspecting the downloaded Terraform module: %s", options.EnvRecipe.TemplatePath))
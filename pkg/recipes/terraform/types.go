@@ -30,8 +30,10 @@ import (
 )
 
 const (
-	executionSubDir                = "deploy"
-	workingDirFileMode fs.FileMode = 0700
+	executionSubDir                   = "deploy"
+	planFileName                      = "recipe.tfplan"
+	backendConfigFileName             = "backend.tfbackend"
+	workingDirFileMode    fs.FileMode = 0700
 )
 
 //go:generate mockgen -typed -destination=./mock_executor.go -package=terraform -self_package github.com/radius-project/radius/pkg/recipes/terraform github.com/radius-project/radius/pkg/recipes/terraform TerraformExecutor
@@ -40,11 +42,15 @@ type TerraformExecutor interface {
 	Deploy(ctx context.Context, options Options) (*tfjson.State, error)
 
 	// Delete installs terraform and runs terraform destroy on the terraform module referenced by the recipe using terraform-exec,
-	// and deletes the Kubernetes secret created for terraform state store.
+	// and deletes the terraform state from the backend storing it.
 	Delete(ctx context.Context, options Options) error
 
 	// GetRecipeMetadata installs terraform and runs terraform get to retrieve information on the terraform module
 	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
+
+	// Plan installs terraform and runs terraform init and plan on the terraform module referenced by the recipe using terraform-exec,
+	// and returns the JSON representation of the plan. No changes are applied.
+	Plan(ctx context.Context, options Options) (*tfjson.Plan, error)
 }
 
 // Options represents the options required to build inputs to interact with Terraform.
@@ -60,6 +66,18 @@ type Options struct {
 
 	// ResourceRecipe is recipe metadata associated with the Radius resource deploying the Terraform recipe.
 	ResourceRecipe *recipes.ResourceMetadata
+
+	// BackendSecrets contains the credentials of the Terraform backend configured for the Radius Environment, read from
+	// the secret store referenced by the backend configuration.
+	BackendSecrets map[string]string
+
+	// ProviderMirrorSecrets contains the credentials of the container registry of the Terraform provider mirror configured
+	// for the Radius Environment, read from the secret store referenced by the provider mirror configuration.
+	ProviderMirrorSecrets map[string]string
+
+	// RefreshOnly runs the plan in refresh-only mode, comparing the Terraform state with the live resources instead of
+	// the recipe configuration. It is only used by Plan.
+	RefreshOnly bool
 }
 
 // NewTerraform creates a working directory for Terraform execution and new Terraform executor with Terraform logs enabled.
@@ -84,13 +84,23 @@ type ResourceMetadata struct {
 const (
 	TemplateKindBicep     = "bicep"
 	TemplateKindTerraform = "terraform"
+	TemplateKindHelm      = "helm"
 
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
 )
 
+const (
+	// ResourceChangeCreate is the action of a resource change when the resource would be created.
+	ResourceChangeCreate = "create"
+	// ResourceChangeUpdate is the action of a resource change when the existing resource would be updated.
+	ResourceChangeUpdate = "update"
+	// ResourceChangeDelete is the action of a resource change when the existing resource would be deleted.
+	ResourceChangeDelete = "delete"
+)
+
 var (
-	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform}
+	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform, TemplateKindHelm}
 )
 
 // RecipeOutput represents recipe deployment output.
@@ -108,6 +118,24 @@ type RecipeOutput struct {
 	Status *rpv1.RecipeStatus
 }
 
+// RecipePlan represents the changes to the resources a recipe would make if it was executed.
+type RecipePlan struct {
+	// Changes represents the list of resource changes, in the order they would be applied.
+	Changes []ResourceChange
+}
+
+// ResourceChange represents a change to a single resource deployed by a recipe.
+type ResourceChange struct {
+	// Action represents the change to the resource. Allowed values: create, update, delete.
+	Action string
+	// ResourceType represents the type of the resource as reported by the recipe language, for example 'Microsoft.Cache/redis' or 'azurerm_redis_cache'.
+	ResourceType string
+	// Name represents the name or address of the resource within the recipe template.
+	Name string
+	// ResourceID represents the identifier of the resource. It is empty when the identifier is only known after the resource is created.
+	ResourceID string
+}
+
 // PrepareRecipeOutput populates the recipe output from the recipe deployment output stored in the "result" object.
 // outputs map is the value of "result" output from the recipe deployment response.
 func (ro *RecipeOutput) PrepareRecipeResponse(resultValue map[string]any) error {
@@ -16,6 +16,19 @@ limitations under the License.
 
 package v1
 
+import "time"
+
+const (
+	// RecipeDriftStateInSync is the drift state of a recipe whose deployed resources match their live state.
+	RecipeDriftStateInSync = "InSync"
+	// RecipeDriftStateDrifted is the drift state of a recipe whose deployed resources were changed or deleted outside of Radius.
+	RecipeDriftStateDrifted = "Drifted"
+	// RecipeDriftStateRemediating is the drift state of a recipe that is being re-executed to remediate drift.
+	RecipeDriftStateRemediating = "Remediating"
+	// RecipeDriftStateUnknown is the drift state of a recipe when drift could not be detected.
+	RecipeDriftStateUnknown = "Unknown"
+)
+
 // RecipeStatus defines the status of the recipe
 type RecipeStatus struct {
 	// TemplateKind specifies the kind of template used for the recipe.
@@ -26,4 +39,31 @@ type RecipeStatus struct {
 
 	// TemplateVersion specifies the version of the template used for the recipe.
 	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// Drift is the result of the last drift detection of the resources deployed by the recipe.
+	Drift *RecipeDriftStatus `json:"drift,omitempty"`
+}
+
+// RecipeDriftStatus is the drift between the resources deployed by a recipe and their live state.
+type RecipeDriftStatus struct {
+	// State is the drift state as of the last detection.
+	State string `json:"state,omitempty"`
+	// LastCheckedAt is the time of the last detection.
+	LastCheckedAt *time.Time `json:"lastCheckedAt,omitempty"`
+	// Message is the reason the drift could not be detected, if the state is unknown.
+	Message string `json:"message,omitempty"`
+	// Changes are the changes re-executing the recipe would make to restore the deployed resources.
+	Changes []RecipeDriftChange `json:"changes,omitempty"`
+}
+
+// RecipeDriftChange is a change re-executing a recipe would make to a drifted resource.
+type RecipeDriftChange struct {
+	// Action is the change to the resource: create, update or delete.
+	Action string `json:"action"`
+	// ResourceType is the type of the resource as reported by the recipe template.
+	ResourceType string `json:"resourceType"`
+	// Name is the name or address of the resource within the recipe template.
+	Name string `json:"name"`
+	// ResourceID is the identifier of the resource, if known.
+	ResourceID string `json:"resourceId,omitempty"`
 }
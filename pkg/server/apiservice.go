@@ -22,10 +22,14 @@ import (
 
 	"github.com/go-chi/chi/v5"
 
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/locks"
 )
 
 // APIService is the restful API server for Radius Resource Provider.
@@ -57,11 +61,26 @@ func (s *APIService) Run(ctx context.Context) error {
 		return err
 	}
 
+	auditSink, err := audit.NewSink(ctx, s.Options.Config.Audit, s.StorageProvider)
+	if err != nil {
+		return err
+	}
+
+	// Locks are stored by UCP in the storage shared with the resource providers.
+	lockStorageClient, err := s.StorageProvider.GetStorageClient(ctx, datamodel.LockResourceType)
+	if err != nil {
+		return err
+	}
+
 	address := fmt.Sprintf("%s:%d", s.Options.Config.Server.Host, s.Options.Config.Server.Port)
 	return s.Start(ctx, server.Options{
-		Location: s.Options.Config.Env.RoleLocation,
-		Address:  address,
-		PathBase: s.Options.Config.Server.PathBase,
+		ServiceName: s.ProviderName,
+		Location:    s.Options.Config.Env.RoleLocation,
+		AuditSink:   auditSink,
+		RateLimiter: ratelimit.New(s.Options.Config.RateLimit),
+		LockChecker: locks.NewChecker(lockStorageClient),
+		Address:     address,
+		PathBase:    s.Options.Config.Server.PathBase,
 		Configure: func(r chi.Router) error {
 			for _, b := range s.handlerBuilder {
 				opts := apictrl.Options{
@@ -69,6 +88,9 @@ func (s *APIService) Run(ctx context.Context) error {
 					DataProvider:  s.StorageProvider,
 					KubeClient:    s.KubeClient,
 					StatusManager: s.OperationStatusManager,
+
+					MaxInFlightOperationsPerResourceGroup: s.Options.Config.RateLimit.InFlightOperationQuota(),
+					AsyncOperationPriorities:              s.Options.Config.AsyncOperation.Priorities,
 				}
 
 				validator, err := builder.NewOpenAPIValidator(ctx, opts.PathBase, b.Namespace())
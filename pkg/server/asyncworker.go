@@ -88,6 +88,9 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 		if w.Options.Config.WorkerServer.MaxOperationConcurrency != nil {
 			workerOpts.MaxOperationConcurrency = *w.Options.Config.WorkerServer.MaxOperationConcurrency
 		}
+		if w.Options.Config.WorkerServer.MaxOperationConcurrencyPerTenant != nil {
+			workerOpts.MaxOperationConcurrencyPerTenant = *w.Options.Config.WorkerServer.MaxOperationConcurrencyPerTenant
+		}
 		if w.Options.Config.WorkerServer.MaxOperationRetryCount != nil {
 			workerOpts.MaxOperationRetryCount = *w.Options.Config.WorkerServer.MaxOperationRetryCount
 		}
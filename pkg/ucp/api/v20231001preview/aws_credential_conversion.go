@@ -17,8 +17,6 @@ limitations under the License.
 package v20231001preview
 
 import (
-	"fmt"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -62,32 +60,35 @@ func (cr *AwsCredentialResource) getDataModelCredentialProperties() (*datamodel.
 
 	switch p := cr.Properties.(type) {
 	case *AwsAccessKeyCredentialProperties:
-		var storage *datamodel.CredentialStorageProperties
-
-		switch c := p.Storage.(type) {
-		case *InternalCredentialStorageProperties:
-			if c.Kind == nil {
-				return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
-			}
-			storage = &datamodel.CredentialStorageProperties{
-				Kind: datamodel.InternalStorageKind,
-				InternalCredential: &datamodel.InternalCredentialStorageProperties{
-					SecretName: to.String(c.SecretName),
-				},
-			}
-		case nil:
-			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"}
-		default:
-			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())}
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
 		}
 
 		return &datamodel.AWSCredentialResourceProperties{
 			Kind: datamodel.AWSCredentialKind,
 			AWSCredential: &datamodel.AWSCredentialProperties{
+				Kind:            datamodel.AWSCredentialKind,
 				AccessKeyID:     to.String(p.AccessKeyID),
 				SecretAccessKey: to.String(p.SecretAccessKey),
 			},
-			Storage: storage,
+			Storage:   storage,
+			ExpiresAt: p.ExpiresAt,
+		}, nil
+	case *AwsIRSACredentialProperties:
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.AWSCredentialResourceProperties{
+			Kind: datamodel.AWSIRSACredentialKind,
+			AWSCredential: &datamodel.AWSCredentialProperties{
+				Kind:    datamodel.AWSIRSACredentialKind,
+				RoleARN: to.String(p.RoleARN),
+			},
+			Storage:   storage,
+			ExpiresAt: p.ExpiresAt,
 		}, nil
 	default:
 		return nil, v1.ErrInvalidModelConversion
@@ -107,15 +108,9 @@ func (dst *AwsCredentialResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Location = &dm.Location
 	dst.Tags = *to.StringMapPtr(dm.Tags)
 
-	var storage CredentialStoragePropertiesClassification
-	switch dm.Properties.Storage.Kind {
-	case datamodel.InternalStorageKind:
-		storage = &InternalCredentialStorageProperties{
-			Kind:       to.Ptr(CredentialStorageKindInternal),
-			SecretName: to.Ptr(dm.Properties.Storage.InternalCredential.SecretName),
-		}
-	default:
-		return v1.ErrInvalidModelConversion
+	storage, err := fromCredentialStorageDataModel(dm.Properties.Storage)
+	if err != nil {
+		return err
 	}
 
 	// DO NOT convert any secret values to versioned model.
@@ -125,6 +120,16 @@ func (dst *AwsCredentialResource) ConvertFrom(src v1.DataModelInterface) error {
 			Kind:        to.Ptr(AWSCredentialKind(dm.Properties.Kind)),
 			AccessKeyID: to.Ptr(dm.Properties.AWSCredential.AccessKeyID),
 			Storage:     storage,
+			ExpiresAt:   dm.Properties.ExpiresAt,
+			Status:      fromCredentialStatusDataModel(dm.Properties.Status),
+		}
+	case datamodel.AWSIRSACredentialKind:
+		dst.Properties = &AwsIRSACredentialProperties{
+			Kind:      to.Ptr(AWSCredentialKind(dm.Properties.Kind)),
+			RoleARN:   to.Ptr(dm.Properties.AWSCredential.RoleARN),
+			Storage:   storage,
+			ExpiresAt: dm.Properties.ExpiresAt,
+			Status:    fromCredentialStatusDataModel(dm.Properties.Status),
 		}
 	default:
 		return v1.ErrInvalidModelConversion
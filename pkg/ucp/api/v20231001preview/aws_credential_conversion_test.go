@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -55,6 +56,7 @@ func TestAWSCredentialConvertVersionedToDataModel(t *testing.T) {
 				Properties: &datamodel.AWSCredentialResourceProperties{
 					Kind: "AccessKey",
 					AWSCredential: &datamodel.AWSCredentialProperties{
+						Kind:            datamodel.AWSCredentialKind,
 						AccessKeyID:     "00000000-0000-0000-0000-000000000000",
 						SecretAccessKey: "00000000-0000-0000-0000-000000000000",
 					},
@@ -65,6 +67,68 @@ func TestAWSCredentialConvertVersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "credentialresource-aws-irsa.json",
+			expected: &datamodel.AWSCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/aws/aws/providers/System.AWS/credentials/default",
+						Name:     "default",
+						Type:     "System.AWS/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AWSCredentialResourceProperties{
+					Kind: datamodel.AWSIRSACredentialKind,
+					AWSCredential: &datamodel.AWSCredentialProperties{
+						Kind:    datamodel.AWSIRSACredentialKind,
+						RoleARN: "arn:aws:iam::000000000000:role/radius",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresource-aws-expiry.json",
+			expected: &datamodel.AWSCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/aws/aws/providers/System.AWS/credentials/default",
+						Name:     "default",
+						Type:     "System.AWS/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AWSCredentialResourceProperties{
+					Kind: "AccessKey",
+					AWSCredential: &datamodel.AWSCredentialProperties{
+						Kind:            datamodel.AWSCredentialKind,
+						AccessKeyID:     "00000000-0000-0000-0000-000000000000",
+						SecretAccessKey: "00000000-0000-0000-0000-000000000000",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+					ExpiresAt: to.Ptr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
 		{
 			filename: "credentialresource-other.json",
 			err:      v1.ErrInvalidModelConversion,
@@ -132,6 +196,54 @@ func TestAWSCredentialConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "credentialresourcedatamodel-aws-irsa.json",
+			expected: &AwsCredentialResource{
+				ID:       to.Ptr("/planes/aws/aws/providers/System.AWS/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.AWS/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AwsIRSACredentialProperties{
+					Kind:    to.Ptr(AWSCredentialKindIRSA),
+					RoleARN: to.Ptr("arn:aws:iam::000000000000:role/radius"),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("aws-awscloud-default"),
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-aws-status.json",
+			expected: &AwsCredentialResource{
+				ID:       to.Ptr("/planes/aws/aws/providers/System.AWS/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.AWS/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AwsAccessKeyCredentialProperties{
+					Kind:        to.Ptr(AWSCredentialKindAccessKey),
+					AccessKeyID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("aws-awscloud-default"),
+					},
+					ExpiresAt: to.Ptr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+					Status: &CredentialStatus{
+						CreatedAt:     to.Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+						RotatedAt:     to.Ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+						Health:        to.Ptr(CredentialHealthStateUnhealthy),
+						LastCheckedAt: to.Ptr(time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)),
+						Message:       to.Ptr("InvalidClientTokenId: The security token included in the request is invalid."),
+					},
+				},
+			},
+		},
 		{
 			filename: "credentialresourcedatamodel-default.json",
 			err:      v1.ErrInvalidModelConversion,
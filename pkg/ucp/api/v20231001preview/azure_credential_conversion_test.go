@@ -55,6 +55,7 @@ func TestAzureCredentialConvertVersionedToDataModel(t *testing.T) {
 				Properties: &datamodel.AzureCredentialResourceProperties{
 					Kind: "ServicePrincipal",
 					AzureCredential: &datamodel.AzureCredentialProperties{
+						Kind:         datamodel.AzureCredentialKind,
 						TenantID:     "00000000-0000-0000-0000-000000000000",
 						ClientID:     "00000000-0000-0000-0000-000000000000",
 						ClientSecret: "secret",
@@ -66,6 +67,76 @@ func TestAzureCredentialConvertVersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "credentialresource-azure-workloadidentity.json",
+			expected: &datamodel.AzureCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/azure/azurecloud/providers/System.Azure/credentials/default",
+						Name:     "default",
+						Type:     "System.Azure/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AzureCredentialResourceProperties{
+					Kind: datamodel.AzureWorkloadIdentityCredentialKind,
+					AzureCredential: &datamodel.AzureCredentialProperties{
+						Kind:     datamodel.AzureWorkloadIdentityCredentialKind,
+						TenantID: "00000000-0000-0000-0000-000000000000",
+						ClientID: "00000000-0000-0000-0000-000000000000",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresource-azure-vault.json",
+			expected: &datamodel.AzureCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/azure/azurecloud/providers/System.Azure/credentials/default",
+						Name:     "default",
+						Type:     "System.Azure/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AzureCredentialResourceProperties{
+					Kind: datamodel.AzureCredentialKind,
+					AzureCredential: &datamodel.AzureCredentialProperties{
+						Kind:     datamodel.AzureCredentialKind,
+						TenantID: "00000000-0000-0000-0000-000000000000",
+						ClientID: "00000000-0000-0000-0000-000000000000",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind: datamodel.VaultStorageKind,
+						VaultCredential: &datamodel.VaultCredentialStorageProperties{
+							Address: "https://vault.example.com:8200",
+							Mount:   datamodel.DefaultVaultMount,
+							Path:    "radius/azure",
+						},
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresource-invalid-vault-azure.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.storage.address", ValidValue: "not empty"},
+		},
 		{
 			filename: "credentialresource-other.json",
 			err:      v1.ErrInvalidModelConversion,
@@ -134,6 +205,50 @@ func TestAzureCredentialConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "credentialresourcedatamodel-azure-workloadidentity.json",
+			expected: &AzureCredentialResource{
+				ID:       to.Ptr("/planes/azure/azurecloud/providers/System.Azure/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.Azure/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AzureWorkloadIdentityProperties{
+					Kind:     to.Ptr(AzureCredentialKindWorkloadIdentity),
+					ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+					TenantID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("azure-azurecloud-default"),
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-azure-vault.json",
+			expected: &AzureCredentialResource{
+				ID:       to.Ptr("/planes/azure/azurecloud/providers/System.Azure/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.Azure/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AzureServicePrincipalProperties{
+					Kind:     to.Ptr(AzureCredentialKindServicePrincipal),
+					ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+					TenantID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+					Storage: &VaultCredentialStorageProperties{
+						Kind:    to.Ptr(CredentialStorageKindVault),
+						Address: to.Ptr("https://vault.example.com:8200"),
+						Mount:   to.Ptr("kv"),
+						Path:    to.Ptr("radius/azure"),
+					},
+				},
+			},
+		},
 		{
 			filename: "credentialresourcedatamodel-default.json",
 			err:      v1.ErrInvalidModelConversion,
@@ -17,8 +17,11 @@ limitations under the License.
 package v20231001preview
 
 import (
+	"fmt"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
 func fromProvisioningStateDataModel(state v1.ProvisioningState) *ProvisioningState {
@@ -40,3 +43,81 @@ func fromSystemDataModel(s v1.SystemData) *SystemData {
 		LastModifiedAt:     v1.UnmarshalTimeString(s.LastModifiedAt),
 	}
 }
+
+func toCredentialStorageDataModel(s CredentialStoragePropertiesClassification) (*datamodel.CredentialStorageProperties, error) {
+	switch c := s.(type) {
+	case *InternalCredentialStorageProperties:
+		if c.Kind == nil {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
+		}
+		return &datamodel.CredentialStorageProperties{
+			Kind: datamodel.InternalStorageKind,
+			InternalCredential: &datamodel.InternalCredentialStorageProperties{
+				SecretName: to.String(c.SecretName),
+			},
+		}, nil
+	case *VaultCredentialStorageProperties:
+		if c.Address == nil || *c.Address == "" {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.address", ValidValue: "not empty"}
+		}
+		if c.Path == nil || *c.Path == "" {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.path", ValidValue: "not empty"}
+		}
+		mount := to.String(c.Mount)
+		if mount == "" {
+			mount = datamodel.DefaultVaultMount
+		}
+		return &datamodel.CredentialStorageProperties{
+			Kind: datamodel.VaultStorageKind,
+			VaultCredential: &datamodel.VaultCredentialStorageProperties{
+				Address: *c.Address,
+				Mount:   mount,
+				Path:    *c.Path,
+			},
+		}, nil
+	case nil:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"}
+	default:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())}
+	}
+}
+
+func fromCredentialStorageDataModel(s *datamodel.CredentialStorageProperties) (CredentialStoragePropertiesClassification, error) {
+	switch s.Kind {
+	case datamodel.InternalStorageKind:
+		return &InternalCredentialStorageProperties{
+			Kind:       to.Ptr(CredentialStorageKindInternal),
+			SecretName: to.Ptr(s.InternalCredential.SecretName),
+		}, nil
+	case datamodel.VaultStorageKind:
+		return &VaultCredentialStorageProperties{
+			Kind:    to.Ptr(CredentialStorageKindVault),
+			Address: to.Ptr(s.VaultCredential.Address),
+			Mount:   to.Ptr(s.VaultCredential.Mount),
+			Path:    to.Ptr(s.VaultCredential.Path),
+		}, nil
+	default:
+		return nil, v1.ErrInvalidModelConversion
+	}
+}
+
+func fromCredentialStatusDataModel(s *datamodel.CredentialStatus) *CredentialStatus {
+	if s == nil {
+		return nil
+	}
+
+	converted := &CredentialStatus{
+		CreatedAt:     s.CreatedAt,
+		RotatedAt:     s.RotatedAt,
+		LastCheckedAt: s.LastCheckedAt,
+		Health:        to.Ptr(CredentialHealthState(datamodel.CredentialHealthUnknown)),
+	}
+	if s.Health != "" {
+		converted.Health = to.Ptr(CredentialHealthState(s.Health))
+	}
+	if s.Message != "" {
+		converted.Message = to.Ptr(s.Message)
+	}
+
+	return converted
+}
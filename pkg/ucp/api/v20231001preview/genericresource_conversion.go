@@ -44,6 +44,9 @@ func (dst *GenericResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.ID = to.Ptr(entry.Properties.ID)
 	dst.Name = to.Ptr(entry.Properties.Name)
 	dst.Type = to.Ptr(entry.Properties.Type)
+	if len(entry.Properties.Tags) > 0 {
+		dst.Tags = *to.StringMapPtr(entry.Properties.Tags)
+	}
 
 	return nil
 }
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Lock_ConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.Lock
+		err      error
+	}{
+		{
+			filename: "lock-resource.json",
+			expected: &datamodel.Lock{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/radius/local/providers/System.Authorization/locks/prod-db",
+						Name:     "prod-db",
+						Type:     "System.Authorization/locks",
+						Location: "global",
+						Tags:     map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.LockProperties{
+					Level: datamodel.LockLevelCanNotDelete,
+					Scope: "/planes/radius/local/resourcegroups/prod/providers/Applications.Datastores/sqlDatabases/db",
+				},
+			},
+		},
+		{
+			filename: "lock-resource-no-properties.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &LockResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+				ct := dm.(*datamodel.Lock)
+				require.Equal(t, tt.expected, ct)
+			}
+		})
+	}
+}
+
+func Test_Lock_ConvertDataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *LockResource
+		err      error
+	}{
+		{
+			filename: "lock-datamodel.json",
+			expected: &LockResource{
+				ID:       to.Ptr("/planes/radius/local/providers/System.Authorization/locks/prod-readonly"),
+				Name:     to.Ptr("prod-readonly"),
+				Type:     to.Ptr("System.Authorization/locks"),
+				Location: to.Ptr("global"),
+				Tags:     map[string]*string{},
+				Properties: &LockProperties{
+					ProvisioningState: fromProvisioningStateDataModel(v1.ProvisioningStateSucceeded),
+					Level:             to.Ptr(LockLevelReadOnly),
+					Scope:             to.Ptr("/planes/radius/local/resourcegroups/prod"),
+					Notes:             to.Ptr("Production is frozen."),
+				},
+			},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			dm := &datamodel.Lock{}
+			err := json.Unmarshal(rawPayload, dm)
+			require.NoError(t, err)
+
+			resource := &LockResource{}
+			err = resource.ConvertFrom(dm)
+
+			// Avoid hardcoding the SystemData field in tests.
+			tt.expected.SystemData = fromSystemDataModel(dm.SystemData)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, resource)
+			}
+		})
+	}
+}
+
+func Test_Lock_ConvertFrom_InvalidModel(t *testing.T) {
+	resource := &LockResource{}
+	err := resource.ConvertFrom(&datamodel.ResourceGroup{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
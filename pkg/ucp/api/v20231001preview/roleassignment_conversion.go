@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	RoleAssignmentType = "System.Authorization/roleAssignments"
+)
+
+// ConvertTo converts from the versioned role assignment resource to version-agnostic datamodel.
+func (src *RoleAssignmentResource) ConvertTo() (v1.DataModelInterface, error) {
+	if src.Properties == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
+	}
+
+	converted := &datamodel.RoleAssignment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     to.String(src.Type),
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.RoleAssignmentProperties{
+			PrincipalID: to.String(src.Properties.PrincipalID),
+			Scope:       to.String(src.Properties.Scope),
+		},
+	}
+
+	if src.Properties.PrincipalType != nil {
+		converted.Properties.PrincipalType = string(*src.Properties.PrincipalType)
+	}
+	if src.Properties.RoleDefinitionName != nil {
+		converted.Properties.RoleDefinitionName = string(*src.Properties.RoleDefinitionName)
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned role assignment resource.
+func (dst *RoleAssignmentResource) ConvertFrom(src v1.DataModelInterface) error {
+	assignment, ok := src.(*datamodel.RoleAssignment)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(assignment.ID)
+	dst.Name = to.Ptr(assignment.Name)
+	dst.Type = to.Ptr(assignment.Type)
+	dst.Location = to.Ptr(assignment.Location)
+	dst.Tags = *to.StringMapPtr(assignment.Tags)
+	dst.SystemData = fromSystemDataModel(assignment.SystemData)
+
+	dst.Properties = &RoleAssignmentProperties{
+		ProvisioningState:  fromProvisioningStateDataModel(assignment.InternalMetadata.AsyncProvisioningState),
+		PrincipalID:        to.Ptr(assignment.Properties.PrincipalID),
+		PrincipalType:      to.Ptr(PrincipalType(assignment.Properties.PrincipalType)),
+		RoleDefinitionName: to.Ptr(RoleDefinitionName(assignment.Properties.RoleDefinitionName)),
+		Scope:              to.Ptr(assignment.Properties.Scope),
+	}
+
+	return nil
+}
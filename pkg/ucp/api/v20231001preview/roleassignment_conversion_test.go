@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RoleAssignment_ConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.RoleAssignment
+		err      error
+	}{
+		{
+			filename: "roleassignment-resource.json",
+			expected: &datamodel.RoleAssignment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/radius/local/providers/System.Authorization/roleAssignments/alice-contributor",
+						Name:     "alice-contributor",
+						Type:     "System.Authorization/roleAssignments",
+						Location: "global",
+						Tags:     map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.RoleAssignmentProperties{
+					PrincipalID:        "alice@example.com",
+					PrincipalType:      datamodel.PrincipalTypeUser,
+					RoleDefinitionName: datamodel.RoleContributor,
+					Scope:              "/planes/radius/local/resourcegroups/rg1",
+				},
+			},
+		},
+		{
+			filename: "roleassignment-resource-no-properties.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &RoleAssignmentResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+				ct := dm.(*datamodel.RoleAssignment)
+				require.Equal(t, tt.expected, ct)
+			}
+		})
+	}
+}
+
+func Test_RoleAssignment_ConvertDataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *RoleAssignmentResource
+		err      error
+	}{
+		{
+			filename: "roleassignment-datamodel.json",
+			expected: &RoleAssignmentResource{
+				ID:       to.Ptr("/planes/radius/local/providers/System.Authorization/roleAssignments/operators-reader"),
+				Name:     to.Ptr("operators-reader"),
+				Type:     to.Ptr("System.Authorization/roleAssignments"),
+				Location: to.Ptr("global"),
+				Tags:     map[string]*string{},
+				Properties: &RoleAssignmentProperties{
+					ProvisioningState:  fromProvisioningStateDataModel(v1.ProvisioningStateSucceeded),
+					PrincipalID:        to.Ptr("operators"),
+					PrincipalType:      to.Ptr(PrincipalTypeGroup),
+					RoleDefinitionName: to.Ptr(RoleDefinitionNameReader),
+					Scope:              to.Ptr("/planes/radius/local"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			dm := &datamodel.RoleAssignment{}
+			err := json.Unmarshal(rawPayload, dm)
+			require.NoError(t, err)
+
+			resource := &RoleAssignmentResource{}
+			err = resource.ConvertFrom(dm)
+
+			// Avoid hardcoding the SystemData field in tests.
+			tt.expected.SystemData = fromSystemDataModel(dm.SystemData)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, resource)
+			}
+		})
+	}
+}
+
+func Test_RoleAssignment_ConvertFrom_InvalidModel(t *testing.T) {
+	resource := &RoleAssignmentResource{}
+	err := resource.ConvertFrom(&datamodel.ResourceGroup{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
@@ -54,6 +54,21 @@ func (c *ClientFactory) NewAzurePlanesClient() *AzurePlanesClient {
 	return subClient
 }
 
+func (c *ClientFactory) NewGcpCredentialsClient() *GcpCredentialsClient {
+	subClient, _ := NewGcpCredentialsClient(c.credential, c.options)
+	return subClient
+}
+
+func (c *ClientFactory) NewGcpPlanesClient() *GcpPlanesClient {
+	subClient, _ := NewGcpPlanesClient(c.credential, c.options)
+	return subClient
+}
+
+func (c *ClientFactory) NewLocksClient() *LocksClient {
+	subClient, _ := NewLocksClient(c.credential, c.options)
+	return subClient
+}
+
 func (c *ClientFactory) NewPlanesClient() *PlanesClient {
 	subClient, _ := NewPlanesClient(c.credential, c.options)
 	return subClient
@@ -74,3 +89,8 @@ func (c *ClientFactory) NewResourcesClient() *ResourcesClient {
 	return subClient
 }
 
+
+func (c *ClientFactory) NewRoleAssignmentsClient() *RoleAssignmentsClient {
+	subClient, _ := NewRoleAssignmentsClient(c.credential, c.options)
+	return subClient
+}
@@ -18,12 +18,16 @@ type AWSCredentialKind string
 const (
 	// AWSCredentialKindAccessKey - The AWS Access Key credential
 	AWSCredentialKindAccessKey AWSCredentialKind = "AccessKey"
+	// AWSCredentialKindIRSA - AWS IAM roles for service accounts. For more information, please see:
+// https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html
+	AWSCredentialKindIRSA AWSCredentialKind = "IRSA"
 )
 
 // PossibleAWSCredentialKindValues returns the possible values for the AWSCredentialKind const type.
 func PossibleAWSCredentialKindValues() []AWSCredentialKind {
 	return []AWSCredentialKind{	
 		AWSCredentialKindAccessKey,
+		AWSCredentialKindIRSA,
 	}
 }
 
@@ -33,12 +37,15 @@ type AzureCredentialKind string
 const (
 	// AzureCredentialKindServicePrincipal - The Service Principal Credential
 	AzureCredentialKindServicePrincipal AzureCredentialKind = "ServicePrincipal"
+	// AzureCredentialKindWorkloadIdentity - The Workload Identity Credential
+	AzureCredentialKindWorkloadIdentity AzureCredentialKind = "WorkloadIdentity"
 )
 
 // PossibleAzureCredentialKindValues returns the possible values for the AzureCredentialKind const type.
 func PossibleAzureCredentialKindValues() []AzureCredentialKind {
 	return []AzureCredentialKind{	
 		AzureCredentialKindServicePrincipal,
+		AzureCredentialKindWorkloadIdentity,
 	}
 }
 
@@ -62,18 +69,97 @@ func PossibleCreatedByTypeValues() []CreatedByType {
 	}
 }
 
+// CredentialHealthState - The health of a credential reported by the credential validation.
+type CredentialHealthState string
+
+const (
+	// CredentialHealthStateExpired - The credential has expired.
+	CredentialHealthStateExpired CredentialHealthState = "Expired"
+	// CredentialHealthStateHealthy - The credential authenticated successfully.
+	CredentialHealthStateHealthy CredentialHealthState = "Healthy"
+	// CredentialHealthStateUnhealthy - The credential failed to authenticate.
+	CredentialHealthStateUnhealthy CredentialHealthState = "Unhealthy"
+	// CredentialHealthStateUnknown - The credential has not been validated yet.
+	CredentialHealthStateUnknown CredentialHealthState = "Unknown"
+)
+
+// PossibleCredentialHealthStateValues returns the possible values for the CredentialHealthState const type.
+func PossibleCredentialHealthStateValues() []CredentialHealthState {
+	return []CredentialHealthState{	
+		CredentialHealthStateExpired,
+		CredentialHealthStateHealthy,
+		CredentialHealthStateUnhealthy,
+		CredentialHealthStateUnknown,
+	}
+}
+
 // CredentialStorageKind - Credential store kinds supported.
 type CredentialStorageKind string
 
 const (
 	// CredentialStorageKindInternal - Internal credential storage
 	CredentialStorageKindInternal CredentialStorageKind = "Internal"
+	// CredentialStorageKindVault - HashiCorp Vault KV version 2 credential storage
+	CredentialStorageKindVault CredentialStorageKind = "Vault"
 )
 
 // PossibleCredentialStorageKindValues returns the possible values for the CredentialStorageKind const type.
 func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	return []CredentialStorageKind{	
 		CredentialStorageKindInternal,
+		CredentialStorageKindVault,
+	}
+}
+
+// GCPCredentialKind - GCP credential kind
+type GCPCredentialKind string
+
+const (
+	// GCPCredentialKindServiceAccountKey - The GCP service account key credential. For more information, please see:
+// https://cloud.google.com/iam/docs/keys-create-delete
+	GCPCredentialKindServiceAccountKey GCPCredentialKind = "ServiceAccountKey"
+)
+
+// PossibleGCPCredentialKindValues returns the possible values for the GCPCredentialKind const type.
+func PossibleGCPCredentialKindValues() []GCPCredentialKind {
+	return []GCPCredentialKind{	
+		GCPCredentialKindServiceAccountKey,
+	}
+}
+
+// LockLevel - The level of a management lock.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete - The locked scope can be read and modified but not deleted.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+	// LockLevelReadOnly - The locked scope can be read but not modified or deleted.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// PossibleLockLevelValues returns the possible values for the LockLevel const type.
+func PossibleLockLevelValues() []LockLevel {
+	return []LockLevel{	
+		LockLevelCanNotDelete,
+		LockLevelReadOnly,
+	}
+}
+
+// PrincipalType - The kind of principal a role is assigned to.
+type PrincipalType string
+
+const (
+	// PrincipalTypeGroup - A group of users.
+	PrincipalTypeGroup PrincipalType = "Group"
+	// PrincipalTypeUser - A user.
+	PrincipalTypeUser PrincipalType = "User"
+)
+
+// PossiblePrincipalTypeValues returns the possible values for the PrincipalType const type.
+func PossiblePrincipalTypeValues() []PrincipalType {
+	return []PrincipalType{	
+		PrincipalTypeGroup,
+		PrincipalTypeUser,
 	}
 }
 
@@ -110,6 +196,28 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 	}
 }
 
+// RoleDefinitionName - The built-in roles which can be assigned to a principal.
+type RoleDefinitionName string
+
+const (
+	// RoleDefinitionNameContributor - Allows reading, creating, updating and deleting resources. Does not allow managing role
+	// assignments.
+	RoleDefinitionNameContributor RoleDefinitionName = "Contributor"
+	// RoleDefinitionNameOwner - Allows all operations, including managing role assignments.
+	RoleDefinitionNameOwner RoleDefinitionName = "Owner"
+	// RoleDefinitionNameReader - Allows reading resources.
+	RoleDefinitionNameReader RoleDefinitionName = "Reader"
+)
+
+// PossibleRoleDefinitionNameValues returns the possible values for the RoleDefinitionName const type.
+func PossibleRoleDefinitionNameValues() []RoleDefinitionName {
+	return []RoleDefinitionName{	
+		RoleDefinitionNameContributor,
+		RoleDefinitionNameOwner,
+		RoleDefinitionNameReader,
+	}
+}
+
 // Versions - Supported API versions for Universal Control Plane resource provider.
 type Versions string
 
@@ -0,0 +1,309 @@
+//go:build go1.18
+// +build go1.18
+
+// Licensed under the Apache License, Version 2.0 . See LICENSE in the repository root for license information.
+// Code generated by Microsoft (R) AutoRest Code Generator. DO NOT EDIT.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+package v20231001preview
+
+import (
+	"context"
+	"errors"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GcpCredentialsClient contains the methods for the GcpCredentials group.
+// Don't use this type directly, use NewGcpCredentialsClient() instead.
+type GcpCredentialsClient struct {
+	internal *arm.Client
+}
+
+// NewGcpCredentialsClient creates a new instance of GcpCredentialsClient with the specified values.
+//   - credential - used to authorize requests. Usually a credential from azidentity.
+//   - options - pass nil to accept the default values.
+func NewGcpCredentialsClient(credential azcore.TokenCredential, options *arm.ClientOptions) (*GcpCredentialsClient, error) {
+	cl, err := arm.NewClient(moduleName+".GcpCredentialsClient", moduleVersion, credential, options)
+	if err != nil {
+		return nil, err
+	}
+	client := &GcpCredentialsClient{
+	internal: cl,
+	}
+	return client, nil
+}
+
+// CreateOrUpdate - Create or update a GCP credential
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of GCP plane
+//   - credentialName - The GCP credential name.
+//   - resource - Resource create parameters.
+//   - options - GcpCredentialsClientCreateOrUpdateOptions contains the optional parameters for the GcpCredentialsClient.CreateOrUpdate
+//     method.
+func (client *GcpCredentialsClient) CreateOrUpdate(ctx context.Context, planeName string, credentialName string, resource GcpCredentialResource, options *GcpCredentialsClientCreateOrUpdateOptions) (GcpCredentialsClientCreateOrUpdateResponse, error) {
+	var err error
+	req, err := client.createOrUpdateCreateRequest(ctx, planeName, credentialName, resource, options)
+	if err != nil {
+		return GcpCredentialsClientCreateOrUpdateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return GcpCredentialsClientCreateOrUpdateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusCreated) {
+		err = runtime.NewResponseError(httpResp)
+		return GcpCredentialsClientCreateOrUpdateResponse{}, err
+	}
+	resp, err := client.createOrUpdateHandleResponse(httpResp)
+	return resp, err
+}
+
+// createOrUpdateCreateRequest creates the CreateOrUpdate request.
+func (client *GcpCredentialsClient) createOrUpdateCreateRequest(ctx context.Context, planeName string, credentialName string, resource GcpCredentialResource, options *GcpCredentialsClientCreateOrUpdateOptions) (*policy.Request, error) {
+	urlPath := "/planes/gcp/{planeName}/providers/System.GCP/credentials/{credentialName}"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if credentialName == "" {
+		return nil, errors.New("parameter credentialName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{credentialName}", url.PathEscape(credentialName))
+	req, err := runtime.NewRequest(ctx, http.MethodPut, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, resource); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// createOrUpdateHandleResponse handles the CreateOrUpdate response.
+func (client *GcpCredentialsClient) createOrUpdateHandleResponse(resp *http.Response) (GcpCredentialsClientCreateOrUpdateResponse, error) {
+	result := GcpCredentialsClientCreateOrUpdateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.GcpCredentialResource); err != nil {
+		return GcpCredentialsClientCreateOrUpdateResponse{}, err
+	}
+	return result, nil
+}
+
+// Delete - Delete a GCP credential
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of GCP plane
+//   - credentialName - The GCP credential name.
+//   - options - GcpCredentialsClientDeleteOptions contains the optional parameters for the GcpCredentialsClient.Delete method.
+func (client *GcpCredentialsClient) Delete(ctx context.Context, planeName string, credentialName string, options *GcpCredentialsClientDeleteOptions) (GcpCredentialsClientDeleteResponse, error) {
+	var err error
+	req, err := client.deleteCreateRequest(ctx, planeName, credentialName, options)
+	if err != nil {
+		return GcpCredentialsClientDeleteResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return GcpCredentialsClientDeleteResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return GcpCredentialsClientDeleteResponse{}, err
+	}
+	return GcpCredentialsClientDeleteResponse{}, nil
+}
+
+// deleteCreateRequest creates the Delete request.
+func (client *GcpCredentialsClient) deleteCreateRequest(ctx context.Context, planeName string, credentialName string, options *GcpCredentialsClientDeleteOptions) (*policy.Request, error) {
+	urlPath := "/planes/gcp/{planeName}/providers/System.GCP/credentials/{credentialName}"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if credentialName == "" {
+		return nil, errors.New("parameter credentialName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{credentialName}", url.PathEscape(credentialName))
+	req, err := runtime.NewRequest(ctx, http.MethodDelete, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// Get - Get a GCP credential
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of GCP plane
+//   - credentialName - The GCP credential name.
+//   - options - GcpCredentialsClientGetOptions contains the optional parameters for the GcpCredentialsClient.Get method.
+func (client *GcpCredentialsClient) Get(ctx context.Context, planeName string, credentialName string, options *GcpCredentialsClientGetOptions) (GcpCredentialsClientGetResponse, error) {
+	var err error
+	req, err := client.getCreateRequest(ctx, planeName, credentialName, options)
+	if err != nil {
+		return GcpCredentialsClientGetResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return GcpCredentialsClientGetResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return GcpCredentialsClientGetResponse{}, err
+	}
+	resp, err := client.getHandleResponse(httpResp)
+	return resp, err
+}
+
+// getCreateRequest creates the Get request.
+func (client *GcpCredentialsClient) getCreateRequest(ctx context.Context, planeName string, credentialName string, options *GcpCredentialsClientGetOptions) (*policy.Request, error) {
+	urlPath := "/planes/gcp/{planeName}/providers/System.GCP/credentials/{credentialName}"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if credentialName == "" {
+		return nil, errors.New("parameter credentialName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{credentialName}", url.PathEscape(credentialName))
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// getHandleResponse handles the Get response.
+func (client *GcpCredentialsClient) getHandleResponse(resp *http.Response) (GcpCredentialsClientGetResponse, error) {
+	result := GcpCredentialsClientGetResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.GcpCredentialResource); err != nil {
+		return GcpCredentialsClientGetResponse{}, err
+	}
+	return result, nil
+}
+
+// NewListPager - List GCP credentials
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of GCP plane
+//   - options - GcpCredentialsClientListOptions contains the optional parameters for the GcpCredentialsClient.NewListPager method.
+func (client *GcpCredentialsClient) NewListPager(planeName string, options *GcpCredentialsClientListOptions) (*runtime.Pager[GcpCredentialsClientListResponse]) {
+	return runtime.NewPager(runtime.PagingHandler[GcpCredentialsClientListResponse]{
+		More: func(page GcpCredentialsClientListResponse) bool {
+			return page.NextLink != nil && len(*page.NextLink) > 0
+		},
+		Fetcher: func(ctx context.Context, page *GcpCredentialsClientListResponse) (GcpCredentialsClientListResponse, error) {
+			var req *policy.Request
+			var err error
+			if page == nil {
+				req, err = client.listCreateRequest(ctx, planeName, options)
+			} else {
+				req, err = runtime.NewRequest(ctx, http.MethodGet, *page.NextLink)
+			}
+			if err != nil {
+				return GcpCredentialsClientListResponse{}, err
+			}
+			resp, err := client.internal.Pipeline().Do(req)
+			if err != nil {
+				return GcpCredentialsClientListResponse{}, err
+			}
+			if !runtime.HasStatusCode(resp, http.StatusOK) {
+				return GcpCredentialsClientListResponse{}, runtime.NewResponseError(resp)
+			}
+			return client.listHandleResponse(resp)
+		},
+	})
+}
+
+// listCreateRequest creates the List request.
+func (client *GcpCredentialsClient) listCreateRequest(ctx context.Context, planeName string, options *GcpCredentialsClientListOptions) (*policy.Request, error) {
+	urlPath := "/planes/gcp/{planeName}/providers/System.GCP/credentials"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// listHandleResponse handles the List response.
+func (client *GcpCredentialsClient) listHandleResponse(resp *http.Response) (GcpCredentialsClientListResponse, error) {
+	result := GcpCredentialsClientListResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.GcpCredentialResourceListResult); err != nil {
+		return GcpCredentialsClientListResponse{}, err
+	}
+	return result, nil
+}
+
+// Update - Update a GCP credential
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of GCP plane
+//   - credentialName - The GCP credential name.
+//   - properties - The resource properties to be updated.
+//   - options - GcpCredentialsClientUpdateOptions contains the optional parameters for the GcpCredentialsClient.Update method.
+func (client *GcpCredentialsClient) Update(ctx context.Context, planeName string, credentialName string, properties GcpCredentialResourceTagsUpdate, options *GcpCredentialsClientUpdateOptions) (GcpCredentialsClientUpdateResponse, error) {
+	var err error
+	req, err := client.updateCreateRequest(ctx, planeName, credentialName, properties, options)
+	if err != nil {
+		return GcpCredentialsClientUpdateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return GcpCredentialsClientUpdateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return GcpCredentialsClientUpdateResponse{}, err
+	}
+	resp, err := client.updateHandleResponse(httpResp)
+	return resp, err
+}
+
+// updateCreateRequest creates the Update request.
+func (client *GcpCredentialsClient) updateCreateRequest(ctx context.Context, planeName string, credentialName string, properties GcpCredentialResourceTagsUpdate, options *GcpCredentialsClientUpdateOptions) (*policy.Request, error) {
+	urlPath := "/planes/gcp/{planeName}/providers/System.GCP/credentials/{credentialName}"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if credentialName == "" {
+		return nil, errors.New("parameter credentialName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{credentialName}", url.PathEscape(credentialName))
+	req, err := runtime.NewRequest(ctx, http.MethodPatch, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, properties); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// updateHandleResponse handles the Update response.
+func (client *GcpCredentialsClient) updateHandleResponse(resp *http.Response) (GcpCredentialsClientUpdateResponse, error) {
+	result := GcpCredentialsClientUpdateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.GcpCredentialResource); err != nil {
+		return GcpCredentialsClientUpdateResponse{}, err
+	}
+	return result, nil
+}
+
@@ -0,0 +1,336 @@
+//go:build go1.18
+// +build go1.18
+
+// Licensed under the Apache License, Version 2.0 . See LICENSE in the repository root for license information.
+// Code generated by Microsoft (R) AutoRest Code Generator. DO NOT EDIT.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+package v20231001preview
+
+import (
+	"context"
+	"errors"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GcpPlanesClient contains the methods for the GcpPlanes group.
+// Don't use this type directly, use NewGcpPlanesClient() instead.
+type GcpPlanesClient struct {
+	internal *arm.Client
+}
+
+// NewGcpPlanesClient creates a new instance of GcpPlanesClient with the specified values.
+//   - credential - used to authorize requests. Usually a credential from azidentity.
+//   - options - pass nil to accept the default values.
+func NewGcpPlanesClient(credential azcore.TokenCredential, options *arm.ClientOptions) (*GcpPlanesClient, error) {
+	cl, err := arm.NewClient(moduleName+".GcpPlanesClient", moduleVersion, credential, options)
+	if err != nil {
+		return nil, err
+	}
+	client := &GcpPlanesClient{
+	internal: cl,
+	}
+	return client, nil
+}
+
+// BeginCreateOrUpdate - Create or update a plane
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - resource - Resource create parameters.
+//   - options - GcpPlanesClientBeginCreateOrUpdateOptions contains the optional parameters for the GcpPlanesClient.BeginCreateOrUpdate
+//     method.
+func (client *GcpPlanesClient) BeginCreateOrUpdate(ctx context.Context, planeName string, resource GcpPlaneResource, options *GcpPlanesClientBeginCreateOrUpdateOptions) (*runtime.Poller[GcpPlanesClientCreateOrUpdateResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.createOrUpdate(ctx, planeName, resource, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[GcpPlanesClientCreateOrUpdateResponse]{
+			FinalStateVia: runtime.FinalStateViaAzureAsyncOp,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[GcpPlanesClientCreateOrUpdateResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// CreateOrUpdate - Create or update a plane
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *GcpPlanesClient) createOrUpdate(ctx context.Context, planeName string, resource GcpPlaneResource, options *GcpPlanesClientBeginCreateOrUpdateOptions) (*http.Response, error) {
+	var err error
+	req, err := client.createOrUpdateCreateRequest(ctx, planeName, resource, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusCreated) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// createOrUpdateCreateRequest creates the CreateOrUpdate request.
+func (client *GcpPlanesClient) createOrUpdateCreateRequest(ctx context.Context, planeName string, resource GcpPlaneResource, options *GcpPlanesClientBeginCreateOrUpdateOptions) (*policy.Request, error) {
+	urlPath := "/planes/gcp/{planeName}"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	req, err := runtime.NewRequest(ctx, http.MethodPut, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, resource); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// BeginDelete - Delete a plane
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - options - GcpPlanesClientBeginDeleteOptions contains the optional parameters for the GcpPlanesClient.BeginDelete method.
+func (client *GcpPlanesClient) BeginDelete(ctx context.Context, planeName string, options *GcpPlanesClientBeginDeleteOptions) (*runtime.Poller[GcpPlanesClientDeleteResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.deleteOperation(ctx, planeName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[GcpPlanesClientDeleteResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[GcpPlanesClientDeleteResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// Delete - Delete a plane
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *GcpPlanesClient) deleteOperation(ctx context.Context, planeName string, options *GcpPlanesClientBeginDeleteOptions) (*http.Response, error) {
+	var err error
+	req, err := client.deleteCreateRequest(ctx, planeName, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// deleteCreateRequest creates the Delete request.
+func (client *GcpPlanesClient) deleteCreateRequest(ctx context.Context, planeName string, options *GcpPlanesClientBeginDeleteOptions) (*policy.Request, error) {
+	urlPath := "/planes/gcp/{planeName}"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	req, err := runtime.NewRequest(ctx, http.MethodDelete, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// Get - Get a plane by name
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - options - GcpPlanesClientGetOptions contains the optional parameters for the GcpPlanesClient.Get method.
+func (client *GcpPlanesClient) Get(ctx context.Context, planeName string, options *GcpPlanesClientGetOptions) (GcpPlanesClientGetResponse, error) {
+	var err error
+	req, err := client.getCreateRequest(ctx, planeName, options)
+	if err != nil {
+		return GcpPlanesClientGetResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return GcpPlanesClientGetResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return GcpPlanesClientGetResponse{}, err
+	}
+	resp, err := client.getHandleResponse(httpResp)
+	return resp, err
+}
+
+// getCreateRequest creates the Get request.
+func (client *GcpPlanesClient) getCreateRequest(ctx context.Context, planeName string, options *GcpPlanesClientGetOptions) (*policy.Request, error) {
+	urlPath := "/planes/gcp/{planeName}"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// getHandleResponse handles the Get response.
+func (client *GcpPlanesClient) getHandleResponse(resp *http.Response) (GcpPlanesClientGetResponse, error) {
+	result := GcpPlanesClientGetResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.GcpPlaneResource); err != nil {
+		return GcpPlanesClientGetResponse{}, err
+	}
+	return result, nil
+}
+
+// NewListPager - List GCP planes
+//
+// Generated from API version 2023-10-01-preview
+//   - options - GcpPlanesClientListOptions contains the optional parameters for the GcpPlanesClient.NewListPager method.
+func (client *GcpPlanesClient) NewListPager(options *GcpPlanesClientListOptions) (*runtime.Pager[GcpPlanesClientListResponse]) {
+	return runtime.NewPager(runtime.PagingHandler[GcpPlanesClientListResponse]{
+		More: func(page GcpPlanesClientListResponse) bool {
+			return page.NextLink != nil && len(*page.NextLink) > 0
+		},
+		Fetcher: func(ctx context.Context, page *GcpPlanesClientListResponse) (GcpPlanesClientListResponse, error) {
+			var req *policy.Request
+			var err error
+			if page == nil {
+				req, err = client.listCreateRequest(ctx, options)
+			} else {
+				req, err = runtime.NewRequest(ctx, http.MethodGet, *page.NextLink)
+			}
+			if err != nil {
+				return GcpPlanesClientListResponse{}, err
+			}
+			resp, err := client.internal.Pipeline().Do(req)
+			if err != nil {
+				return GcpPlanesClientListResponse{}, err
+			}
+			if !runtime.HasStatusCode(resp, http.StatusOK) {
+				return GcpPlanesClientListResponse{}, runtime.NewResponseError(resp)
+			}
+			return client.listHandleResponse(resp)
+		},
+	})
+}
+
+// listCreateRequest creates the List request.
+func (client *GcpPlanesClient) listCreateRequest(ctx context.Context, options *GcpPlanesClientListOptions) (*policy.Request, error) {
+	urlPath := "/planes/gcp"
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// listHandleResponse handles the List response.
+func (client *GcpPlanesClient) listHandleResponse(resp *http.Response) (GcpPlanesClientListResponse, error) {
+	result := GcpPlanesClientListResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.GcpPlaneResourceListResult); err != nil {
+		return GcpPlanesClientListResponse{}, err
+	}
+	return result, nil
+}
+
+// BeginUpdate - Update a plane
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - properties - The resource properties to be updated.
+//   - options - GcpPlanesClientBeginUpdateOptions contains the optional parameters for the GcpPlanesClient.BeginUpdate method.
+func (client *GcpPlanesClient) BeginUpdate(ctx context.Context, planeName string, properties GcpPlaneResourceTagsUpdate, options *GcpPlanesClientBeginUpdateOptions) (*runtime.Poller[GcpPlanesClientUpdateResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.update(ctx, planeName, properties, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[GcpPlanesClientUpdateResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[GcpPlanesClientUpdateResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// Update - Update a plane
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *GcpPlanesClient) update(ctx context.Context, planeName string, properties GcpPlaneResourceTagsUpdate, options *GcpPlanesClientBeginUpdateOptions) (*http.Response, error) {
+	var err error
+	req, err := client.updateCreateRequest(ctx, planeName, properties, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// updateCreateRequest creates the Update request.
+func (client *GcpPlanesClient) updateCreateRequest(ctx context.Context, planeName string, properties GcpPlaneResourceTagsUpdate, options *GcpPlanesClientBeginUpdateOptions) (*policy.Request, error) {
+	urlPath := "/planes/gcp/{planeName}"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	req, err := runtime.NewRequest(ctx, http.MethodPatch, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, properties); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
@@ -10,7 +10,7 @@ package v20231001preview
 // AwsCredentialPropertiesClassification provides polymorphic access to related types.
 // Call the interface's GetAwsCredentialProperties() method to access the common type.
 // Use a type switch to determine the concrete type.  The possible types are:
-// - *AwsAccessKeyCredentialProperties, *AwsCredentialProperties
+// - *AwsAccessKeyCredentialProperties, *AwsCredentialProperties, *AwsIRSACredentialProperties
 type AwsCredentialPropertiesClassification interface {
 	// GetAwsCredentialProperties returns the AwsCredentialProperties content of the underlying type.
 	GetAwsCredentialProperties() *AwsCredentialProperties
@@ -19,7 +19,7 @@ type AwsCredentialPropertiesClassification interface {
 // AzureCredentialPropertiesClassification provides polymorphic access to related types.
 // Call the interface's GetAzureCredentialProperties() method to access the common type.
 // Use a type switch to determine the concrete type.  The possible types are:
-// - *AzureCredentialProperties, *AzureServicePrincipalProperties
+// - *AzureCredentialProperties, *AzureServicePrincipalProperties, *AzureWorkloadIdentityProperties
 type AzureCredentialPropertiesClassification interface {
 	// GetAzureCredentialProperties returns the AzureCredentialProperties content of the underlying type.
 	GetAzureCredentialProperties() *AzureCredentialProperties
@@ -28,9 +28,18 @@ type AzureCredentialPropertiesClassification interface {
 // CredentialStoragePropertiesClassification provides polymorphic access to related types.
 // Call the interface's GetCredentialStorageProperties() method to access the common type.
 // Use a type switch to determine the concrete type.  The possible types are:
-// - *CredentialStorageProperties, *InternalCredentialStorageProperties
+// - *CredentialStorageProperties, *InternalCredentialStorageProperties, *VaultCredentialStorageProperties
 type CredentialStoragePropertiesClassification interface {
 	// GetCredentialStorageProperties returns the CredentialStorageProperties content of the underlying type.
 	GetCredentialStorageProperties() *CredentialStorageProperties
 }
 
+// GcpCredentialPropertiesClassification provides polymorphic access to related types.
+// Call the interface's GetGcpCredentialProperties() method to access the common type.
+// Use a type switch to determine the concrete type.  The possible types are:
+// - *GcpCredentialProperties, *GcpServiceAccountKeyCredentialProperties
+type GcpCredentialPropertiesClassification interface {
+	// GetGcpCredentialProperties returns the GcpCredentialProperties content of the underlying type.
+	GetGcpCredentialProperties() *GcpCredentialProperties
+}
+
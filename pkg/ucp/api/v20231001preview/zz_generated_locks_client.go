@@ -0,0 +1,263 @@
+//go:build go1.18
+// +build go1.18
+
+// Licensed under the Apache License, Version 2.0 . See LICENSE in the repository root for license information.
+// Code generated by Microsoft (R) AutoRest Code Generator. DO NOT EDIT.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+package v20231001preview
+
+import (
+	"context"
+	"errors"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"net/http"
+	"net/url"
+		"strings"
+)
+
+// LocksClient contains the methods for the Locks group.
+// Don't use this type directly, use NewLocksClient() instead.
+type LocksClient struct {
+	internal *arm.Client
+}
+
+// NewLocksClient creates a new instance of LocksClient with the specified values.
+//   - credential - used to authorize requests. Usually a credential from azidentity.
+//   - options - pass nil to accept the default values.
+func NewLocksClient(credential azcore.TokenCredential, options *arm.ClientOptions) (*LocksClient, error) {
+	cl, err := arm.NewClient(moduleName+".LocksClient", moduleVersion, credential, options)
+	if err != nil {
+		return nil, err
+	}
+	client := &LocksClient{
+	internal: cl,
+	}
+	return client, nil
+}
+
+// CreateOrUpdate - Create or update a lock
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - lockName - The lock name.
+//   - resource - Resource create parameters.
+//   - options - LocksClientCreateOrUpdateOptions contains the optional parameters for the LocksClient.CreateOrUpdate
+//     method.
+func (client *LocksClient) CreateOrUpdate(ctx context.Context, planeName string, lockName string, resource LockResource, options *LocksClientCreateOrUpdateOptions) (LocksClientCreateOrUpdateResponse, error) {
+	var err error
+	req, err := client.createOrUpdateCreateRequest(ctx, planeName, lockName, resource, options)
+	if err != nil {
+		return LocksClientCreateOrUpdateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return LocksClientCreateOrUpdateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusCreated) {
+		err = runtime.NewResponseError(httpResp)
+		return LocksClientCreateOrUpdateResponse{}, err
+	}
+	resp, err := client.createOrUpdateHandleResponse(httpResp)
+	return resp, err
+}
+
+// createOrUpdateCreateRequest creates the CreateOrUpdate request.
+func (client *LocksClient) createOrUpdateCreateRequest(ctx context.Context, planeName string, lockName string, resource LockResource, options *LocksClientCreateOrUpdateOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/providers/System.Authorization/locks/{lockName}"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if lockName == "" {
+		return nil, errors.New("parameter lockName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{lockName}", url.PathEscape(lockName))
+	req, err := runtime.NewRequest(ctx, http.MethodPut, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, resource); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// createOrUpdateHandleResponse handles the CreateOrUpdate response.
+func (client *LocksClient) createOrUpdateHandleResponse(resp *http.Response) (LocksClientCreateOrUpdateResponse, error) {
+	result := LocksClientCreateOrUpdateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.LockResource); err != nil {
+		return LocksClientCreateOrUpdateResponse{}, err
+	}
+	return result, nil
+}
+
+// Delete - Delete a lock
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - lockName - The lock name.
+//   - options - LocksClientDeleteOptions contains the optional parameters for the LocksClient.Delete method.
+func (client *LocksClient) Delete(ctx context.Context, planeName string, lockName string, options *LocksClientDeleteOptions) (LocksClientDeleteResponse, error) {
+	var err error
+	req, err := client.deleteCreateRequest(ctx, planeName, lockName, options)
+	if err != nil {
+		return LocksClientDeleteResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return LocksClientDeleteResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return LocksClientDeleteResponse{}, err
+	}
+	return LocksClientDeleteResponse{}, nil
+}
+
+// deleteCreateRequest creates the Delete request.
+func (client *LocksClient) deleteCreateRequest(ctx context.Context, planeName string, lockName string, options *LocksClientDeleteOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/providers/System.Authorization/locks/{lockName}"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if lockName == "" {
+		return nil, errors.New("parameter lockName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{lockName}", url.PathEscape(lockName))
+	req, err := runtime.NewRequest(ctx, http.MethodDelete, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// Get - Get a lock
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - lockName - The lock name.
+//   - options - LocksClientGetOptions contains the optional parameters for the LocksClient.Get method.
+func (client *LocksClient) Get(ctx context.Context, planeName string, lockName string, options *LocksClientGetOptions) (LocksClientGetResponse, error) {
+	var err error
+	req, err := client.getCreateRequest(ctx, planeName, lockName, options)
+	if err != nil {
+		return LocksClientGetResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return LocksClientGetResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return LocksClientGetResponse{}, err
+	}
+	resp, err := client.getHandleResponse(httpResp)
+	return resp, err
+}
+
+// getCreateRequest creates the Get request.
+func (client *LocksClient) getCreateRequest(ctx context.Context, planeName string, lockName string, options *LocksClientGetOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/providers/System.Authorization/locks/{lockName}"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if lockName == "" {
+		return nil, errors.New("parameter lockName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{lockName}", url.PathEscape(lockName))
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// getHandleResponse handles the Get response.
+func (client *LocksClient) getHandleResponse(resp *http.Response) (LocksClientGetResponse, error) {
+	result := LocksClientGetResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.LockResource); err != nil {
+		return LocksClientGetResponse{}, err
+	}
+	return result, nil
+}
+
+// NewListPager - List locks
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - options - LocksClientListOptions contains the optional parameters for the LocksClient.NewListPager method.
+func (client *LocksClient) NewListPager(planeName string, options *LocksClientListOptions) (*runtime.Pager[LocksClientListResponse]) {
+	return runtime.NewPager(runtime.PagingHandler[LocksClientListResponse]{
+		More: func(page LocksClientListResponse) bool {
+			return page.NextLink != nil && len(*page.NextLink) > 0
+		},
+		Fetcher: func(ctx context.Context, page *LocksClientListResponse) (LocksClientListResponse, error) {
+			var req *policy.Request
+			var err error
+			if page == nil {
+				req, err = client.listCreateRequest(ctx, planeName, options)
+			} else {
+				req, err = runtime.NewRequest(ctx, http.MethodGet, *page.NextLink)
+			}
+			if err != nil {
+				return LocksClientListResponse{}, err
+			}
+			resp, err := client.internal.Pipeline().Do(req)
+			if err != nil {
+				return LocksClientListResponse{}, err
+			}
+			if !runtime.HasStatusCode(resp, http.StatusOK) {
+				return LocksClientListResponse{}, runtime.NewResponseError(resp)
+			}
+			return client.listHandleResponse(resp)
+		},
+	})
+}
+
+// listCreateRequest creates the List request.
+func (client *LocksClient) listCreateRequest(ctx context.Context, planeName string, options *LocksClientListOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/providers/System.Authorization/locks"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// listHandleResponse handles the List response.
+func (client *LocksClient) listHandleResponse(resp *http.Response) (LocksClientListResponse, error) {
+	result := LocksClientListResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.LockResourceListResult); err != nil {
+		return LocksClientListResponse{}, err
+	}
+	return result, nil
+}
@@ -23,15 +23,24 @@ type AwsAccessKeyCredentialProperties struct {
 	// REQUIRED; The storage properties
 	Storage CredentialStoragePropertiesClassification
 
+	// The timestamp at which the credential expires. The credential is reported as expired by the credential validation
+	// after this time.
+	ExpiresAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The rotation, expiry and health status of the credential.
+	Status *CredentialStatus
 }
 
 // GetAwsCredentialProperties implements the AwsCredentialPropertiesClassification interface for type AwsAccessKeyCredentialProperties.
 func (a *AwsAccessKeyCredentialProperties) GetAwsCredentialProperties() *AwsCredentialProperties {
 	return &AwsCredentialProperties{
+		ExpiresAt: a.ExpiresAt,
 		Kind: a.Kind,
 		ProvisioningState: a.ProvisioningState,
+		Status: a.Status,
 	}
 }
 
@@ -40,8 +49,15 @@ type AwsCredentialProperties struct {
 	// REQUIRED; The AWS credential kind
 	Kind *AWSCredentialKind
 
+	// The timestamp at which the credential expires. The credential is reported as expired by the credential validation
+	// after this time.
+	ExpiresAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The rotation, expiry and health status of the credential.
+	Status *CredentialStatus
 }
 
 // GetAwsCredentialProperties implements the AwsCredentialPropertiesClassification interface for type AwsCredentialProperties.
@@ -87,6 +103,39 @@ type AwsCredentialResourceTagsUpdate struct {
 	Tags map[string]*string
 }
 
+// AwsIRSACredentialProperties - AWS credential storage properties for IAM roles for service accounts. Radius assumes the
+// role with the web identity token projected into its pods.
+type AwsIRSACredentialProperties struct {
+	// REQUIRED; The AWS credential kind
+	Kind *AWSCredentialKind
+
+	// REQUIRED; RoleARN for AWS IRSA identity
+	RoleARN *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// The timestamp at which the credential expires. The credential is reported as expired by the credential validation
+	// after this time.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The rotation, expiry and health status of the credential.
+	Status *CredentialStatus
+}
+
+// GetAwsCredentialProperties implements the AwsCredentialPropertiesClassification interface for type AwsIRSACredentialProperties.
+func (a *AwsIRSACredentialProperties) GetAwsCredentialProperties() *AwsCredentialProperties {
+	return &AwsCredentialProperties{
+		ExpiresAt: a.ExpiresAt,
+		Kind: a.Kind,
+		ProvisioningState: a.ProvisioningState,
+		Status: a.Status,
+	}
+}
+
 // AwsPlaneResource - The AWS plane resource
 type AwsPlaneResource struct {
 	// REQUIRED; The geo-location where the resource lives
@@ -137,8 +186,15 @@ type AzureCredentialProperties struct {
 	// REQUIRED; The kind of Azure credential
 	Kind *AzureCredentialKind
 
+	// The timestamp at which the credential expires. The credential is reported as expired by the credential validation
+	// after this time.
+	ExpiresAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The rotation, expiry and health status of the credential.
+	Status *CredentialStatus
 }
 
 // GetAzureCredentialProperties implements the AzureCredentialPropertiesClassification interface for type AzureCredentialProperties.
@@ -248,15 +304,60 @@ type AzureServicePrincipalProperties struct {
 	// REQUIRED; tenantId for ServicePrincipal
 	TenantID *string
 
+	// The timestamp at which the credential expires. The credential is reported as expired by the credential validation
+	// after this time.
+	ExpiresAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The rotation, expiry and health status of the credential.
+	Status *CredentialStatus
 }
 
 // GetAzureCredentialProperties implements the AzureCredentialPropertiesClassification interface for type AzureServicePrincipalProperties.
 func (a *AzureServicePrincipalProperties) GetAzureCredentialProperties() *AzureCredentialProperties {
 	return &AzureCredentialProperties{
+		ExpiresAt: a.ExpiresAt,
+		Kind: a.Kind,
+		ProvisioningState: a.ProvisioningState,
+		Status: a.Status,
+	}
+}
+
+// AzureWorkloadIdentityProperties - The properties of Workload Identity credential storage. Radius exchanges the federated
+// service account token projected into its pods for an access token of the application.
+type AzureWorkloadIdentityProperties struct {
+	// REQUIRED; clientId for the application or managed identity that trusts the federated token
+	ClientID *string
+
+	// REQUIRED; The kind of Azure credential
+	Kind *AzureCredentialKind
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// REQUIRED; tenantId for the application or managed identity that trusts the federated token
+	TenantID *string
+
+	// The timestamp at which the credential expires. The credential is reported as expired by the credential validation
+	// after this time.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The rotation, expiry and health status of the credential.
+	Status *CredentialStatus
+}
+
+// GetAzureCredentialProperties implements the AzureCredentialPropertiesClassification interface for type AzureWorkloadIdentityProperties.
+func (a *AzureWorkloadIdentityProperties) GetAzureCredentialProperties() *AzureCredentialProperties {
+	return &AzureCredentialProperties{
+		ExpiresAt: a.ExpiresAt,
 		Kind: a.Kind,
 		ProvisioningState: a.ProvisioningState,
+		Status: a.Status,
 	}
 }
 
@@ -279,6 +380,24 @@ type ComponentsKhmx01SchemasGenericresourceAllof0 struct {
 	Type *string
 }
 
+// CredentialStatus - The rotation, expiry and health status of a credential.
+type CredentialStatus struct {
+	// The timestamp at which the credential was first registered.
+	CreatedAt *time.Time
+
+	// The health of the credential as of the last validation.
+	Health *CredentialHealthState
+
+	// The timestamp of the last validation.
+	LastCheckedAt *time.Time
+
+	// The reason for the health of the credential, if it is not healthy.
+	Message *string
+
+	// The timestamp at which the credential was last registered or rotated.
+	RotatedAt *time.Time
+}
+
 // CredentialStorageProperties - The base credential storage properties
 type CredentialStorageProperties struct {
 	// REQUIRED; The kind of credential storage
@@ -322,6 +441,142 @@ type ErrorResponse struct {
 	Error *ErrorDetail
 }
 
+// GcpCredentialProperties - GCP Credential properties
+type GcpCredentialProperties struct {
+	// REQUIRED; The GCP credential kind
+	Kind *GCPCredentialKind
+
+	// The timestamp at which the credential expires. The credential is reported as expired by the credential validation
+	// after this time.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The rotation, expiry and health status of the credential.
+	Status *CredentialStatus
+}
+
+// GetGcpCredentialProperties implements the GcpCredentialPropertiesClassification interface for type GcpCredentialProperties.
+func (g *GcpCredentialProperties) GetGcpCredentialProperties() *GcpCredentialProperties { return g }
+
+// GcpCredentialResource - Concrete tracked resource types can be created by aliasing this type using a specific property
+// type.
+type GcpCredentialResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// REQUIRED; The resource-specific properties for this resource.
+	Properties GcpCredentialPropertiesClassification
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// GcpCredentialResourceListResult - The response of a GcpCredentialResource list operation.
+type GcpCredentialResourceListResult struct {
+	// REQUIRED; The GcpCredentialResource items on this page
+	Value []*GcpCredentialResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// GcpCredentialResourceTagsUpdate - The type used for updating tags in GcpCredentialResource resources.
+type GcpCredentialResourceTagsUpdate struct {
+	// Resource tags.
+	Tags map[string]*string
+}
+
+// GcpPlaneResource - The GCP plane resource
+type GcpPlaneResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// REQUIRED; The resource-specific properties for this resource.
+	Properties *GcpPlaneResourceProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// GcpPlaneResourceListResult - The response of a GcpPlaneResource list operation.
+type GcpPlaneResourceListResult struct {
+	// REQUIRED; The GcpPlaneResource items on this page
+	Value []*GcpPlaneResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// GcpPlaneResourceProperties - The Plane properties.
+type GcpPlaneResourceProperties struct {
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GcpPlaneResourceTagsUpdate - The type used for updating tags in GcpPlaneResource resources.
+type GcpPlaneResourceTagsUpdate struct {
+	// Resource tags.
+	Tags map[string]*string
+}
+
+// GcpServiceAccountKeyCredentialProperties - GCP credential storage properties for a service account key
+type GcpServiceAccountKeyCredentialProperties struct {
+	// REQUIRED; The GCP credential kind
+	Kind *GCPCredentialKind
+
+	// REQUIRED; The JSON key file contents of the GCP service account
+	ServiceAccountKey *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// The timestamp at which the credential expires. The credential is reported as expired by the credential validation
+	// after this time.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The rotation, expiry and health status of the credential.
+	Status *CredentialStatus
+}
+
+// GetGcpCredentialProperties implements the GcpCredentialPropertiesClassification interface for type GcpServiceAccountKeyCredentialProperties.
+func (g *GcpServiceAccountKeyCredentialProperties) GetGcpCredentialProperties() *GcpCredentialProperties {
+	return &GcpCredentialProperties{
+		ExpiresAt: g.ExpiresAt,
+		Kind: g.Kind,
+		ProvisioningState: g.ProvisioningState,
+		Status: g.Status,
+	}
+}
+
 // GenericPlaneResource - The generic representation of a plane resource
 type GenericPlaneResource struct {
 	// REQUIRED; The geo-location where the resource lives
@@ -366,6 +621,9 @@ type GenericResource struct {
 	// The resource-specific properties for this resource.
 	Properties map[string]any
 
+	// Resource tags.
+	Tags map[string]*string
+
 	// READ-ONLY; The name of resource
 	Name *string
 
@@ -404,6 +662,71 @@ func (i *InternalCredentialStorageProperties) GetCredentialStorageProperties() *
 	}
 }
 
+// LockProperties - The management lock properties.
+type LockProperties struct {
+	// REQUIRED; The level of the lock.
+	Level *LockLevel
+
+	// Notes describing why the lock exists.
+	Notes *string
+
+	// The ID of the plane, resource group or resource the lock applies to. The scope must be within the plane of the lock.
+	// Defaults to the plane.
+	Scope *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// LockResource - The management lock resource. A lock prevents a scope of a Radius plane from being deleted or modified.
+type LockResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// REQUIRED; The resource-specific properties for this resource.
+	Properties *LockProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// LockResourceListResult - The response of a LockResource list operation.
+type LockResourceListResult struct {
+	// REQUIRED; The LockResource items on this page
+	Value []*LockResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// MoveResourcesRequest - The request to move resources from one resource group to another.
+type MoveResourcesRequest struct {
+	// REQUIRED; The fully-qualified IDs of the resources to move.
+	Resources []*string
+
+	// REQUIRED; The fully-qualified ID of the resource group the resources are moved to. The resource group must be in the
+// same plane.
+	TargetResourceGroup *string
+}
+
+// MoveResourcesResult - The result of moving resources from one resource group to another.
+type MoveResourcesResult struct {
+	// REQUIRED; The fully-qualified IDs of the moved resources in the target resource group.
+	Resources []*string
+}
+
 // PlaneNameParameter - The Plane Name parameter.
 type PlaneNameParameter struct {
 	// REQUIRED; The name of the plane
@@ -534,6 +857,59 @@ type ResourceGroupResourceTagsUpdate struct {
 	Tags map[string]*string
 }
 
+// RoleAssignmentProperties - The role assignment properties.
+type RoleAssignmentProperties struct {
+	// REQUIRED; The name of the user or group the role is assigned to.
+	PrincipalID *string
+
+	// REQUIRED; The kind of principal the role is assigned to.
+	PrincipalType *PrincipalType
+
+	// REQUIRED; The name of the built-in role which is assigned.
+	RoleDefinitionName *RoleDefinitionName
+
+	// The ID of the plane, resource group or resource the role assignment applies to. The scope must be within the plane of
+	// the role assignment. Defaults to the plane.
+	Scope *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// RoleAssignmentResource - The role assignment resource. A role assignment grants a built-in role to a principal at a scope
+// of a Radius plane.
+type RoleAssignmentResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// REQUIRED; The resource-specific properties for this resource.
+	Properties *RoleAssignmentProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// RoleAssignmentResourceListResult - The response of a RoleAssignmentResource list operation.
+type RoleAssignmentResourceListResult struct {
+	// REQUIRED; The RoleAssignmentResource items on this page
+	Value []*RoleAssignmentResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // SystemData - Metadata pertaining to creation and last modification of the resource.
 type SystemData struct {
 	// The timestamp of resource creation (UTC).
@@ -577,3 +953,27 @@ type TrackedResource struct {
 	Type *string
 }
 
+// VaultCredentialStorageProperties - HashiCorp Vault credential storage properties. The secret values of the credential
+// are read from a KV version 2 secret engine, and the token used to authenticate with Vault is read from the VAULT_TOKEN
+// environment variable of Radius.
+type VaultCredentialStorageProperties struct {
+	// REQUIRED; The address of the Vault server, for example https://vault.example.com:8200.
+	Address *string
+
+	// REQUIRED; The kind of credential storage
+	Kind *CredentialStorageKind
+
+	// REQUIRED; The path of the secret within the secret engine.
+	Path *string
+
+	// The mount path of the KV version 2 secret engine. Defaults to 'secret'.
+	Mount *string
+}
+
+// GetCredentialStorageProperties implements the CredentialStoragePropertiesClassification interface for type VaultCredentialStorageProperties.
+func (v *VaultCredentialStorageProperties) GetCredentialStorageProperties() *CredentialStorageProperties {
+	return &CredentialStorageProperties{
+		Kind: v.Kind,
+	}
+}
+
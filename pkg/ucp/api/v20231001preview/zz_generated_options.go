@@ -117,6 +117,81 @@ type AzurePlanesClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// GcpCredentialsClientCreateOrUpdateOptions contains the optional parameters for the GcpCredentialsClient.CreateOrUpdate
+// method.
+type GcpCredentialsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientDeleteOptions contains the optional parameters for the GcpCredentialsClient.Delete method.
+type GcpCredentialsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientGetOptions contains the optional parameters for the GcpCredentialsClient.Get method.
+type GcpCredentialsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientListOptions contains the optional parameters for the GcpCredentialsClient.NewListPager method.
+type GcpCredentialsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientUpdateOptions contains the optional parameters for the GcpCredentialsClient.Update method.
+type GcpCredentialsClientUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpPlanesClientBeginCreateOrUpdateOptions contains the optional parameters for the GcpPlanesClient.BeginCreateOrUpdate
+// method.
+type GcpPlanesClientBeginCreateOrUpdateOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// GcpPlanesClientBeginDeleteOptions contains the optional parameters for the GcpPlanesClient.BeginDelete method.
+type GcpPlanesClientBeginDeleteOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// GcpPlanesClientBeginUpdateOptions contains the optional parameters for the GcpPlanesClient.BeginUpdate method.
+type GcpPlanesClientBeginUpdateOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// GcpPlanesClientGetOptions contains the optional parameters for the GcpPlanesClient.Get method.
+type GcpPlanesClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpPlanesClientListOptions contains the optional parameters for the GcpPlanesClient.NewListPager method.
+type GcpPlanesClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientCreateOrUpdateOptions contains the optional parameters for the LocksClient.CreateOrUpdate method.
+type LocksClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientDeleteOptions contains the optional parameters for the LocksClient.Delete method.
+type LocksClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientGetOptions contains the optional parameters for the LocksClient.Get method.
+type LocksClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientListOptions contains the optional parameters for the LocksClient.NewListPager method.
+type LocksClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
 // PlanesClientListPlanesOptions contains the optional parameters for the PlanesClient.NewListPlanesPager method.
 type PlanesClientListPlanesOptions struct {
 	// placeholder for future optional parameters
@@ -151,17 +226,22 @@ type RadiusPlanesClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ResourceGroupsClientBeginDeleteOptions contains the optional parameters for the ResourceGroupsClient.BeginDelete method.
+type ResourceGroupsClientBeginDeleteOptions struct {
+	// When true, the resources contained in the resource group are deleted in dependency order before the resource group
+	// is deleted. When false or omitted, deleting a resource group that still contains resources fails.
+	Cascade *bool
+
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // ResourceGroupsClientCreateOrUpdateOptions contains the optional parameters for the ResourceGroupsClient.CreateOrUpdate
 // method.
 type ResourceGroupsClientCreateOrUpdateOptions struct {
 	// placeholder for future optional parameters
 }
 
-// ResourceGroupsClientDeleteOptions contains the optional parameters for the ResourceGroupsClient.Delete method.
-type ResourceGroupsClientDeleteOptions struct {
-	// placeholder for future optional parameters
-}
-
 // ResourceGroupsClientGetOptions contains the optional parameters for the ResourceGroupsClient.Get method.
 type ResourceGroupsClientGetOptions struct {
 	// placeholder for future optional parameters
@@ -172,6 +252,12 @@ type ResourceGroupsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ResourceGroupsClientMoveResourcesOptions contains the optional parameters for the ResourceGroupsClient.MoveResources
+// method.
+type ResourceGroupsClientMoveResourcesOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ResourceGroupsClientUpdateOptions contains the optional parameters for the ResourceGroupsClient.Update method.
 type ResourceGroupsClientUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -179,6 +265,28 @@ type ResourceGroupsClientUpdateOptions struct {
 
 // ResourcesClientListOptions contains the optional parameters for the ResourcesClient.NewListPager method.
 type ResourcesClientListOptions struct {
+	// Filters the resources by tag. Each value has the form 'key=value' and all of the given tags must match.
+	Tag []string
+}
+
+
+// RoleAssignmentsClientCreateOrUpdateOptions contains the optional parameters for the RoleAssignmentsClient.CreateOrUpdate
+// method.
+type RoleAssignmentsClientCreateOrUpdateOptions struct {
 	// placeholder for future optional parameters
 }
 
+// RoleAssignmentsClientDeleteOptions contains the optional parameters for the RoleAssignmentsClient.Delete method.
+type RoleAssignmentsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientGetOptions contains the optional parameters for the RoleAssignmentsClient.Get method.
+type RoleAssignmentsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientListOptions contains the optional parameters for the RoleAssignmentsClient.NewListPager method.
+type RoleAssignmentsClientListOptions struct {
+	// placeholder for future optional parameters
+}
@@ -21,6 +21,8 @@ func unmarshalAwsCredentialPropertiesClassification(rawMsg json.RawMessage) (Aws
 	switch m["kind"] {
 	case string(AWSCredentialKindAccessKey):
 		b = &AwsAccessKeyCredentialProperties{}
+	case string(AWSCredentialKindIRSA):
+		b = &AwsIRSACredentialProperties{}
 	default:
 		b = &AwsCredentialProperties{}
 	}
@@ -42,6 +44,8 @@ func unmarshalAzureCredentialPropertiesClassification(rawMsg json.RawMessage) (A
 	switch m["kind"] {
 	case string(AzureCredentialKindServicePrincipal):
 		b = &AzureServicePrincipalProperties{}
+	case string(AzureCredentialKindWorkloadIdentity):
+		b = &AzureWorkloadIdentityProperties{}
 	default:
 		b = &AzureCredentialProperties{}
 	}
@@ -63,6 +67,8 @@ func unmarshalCredentialStoragePropertiesClassification(rawMsg json.RawMessage)
 	switch m["kind"] {
 	case string(CredentialStorageKindInternal):
 		b = &InternalCredentialStorageProperties{}
+	case string(CredentialStorageKindVault):
+		b = &VaultCredentialStorageProperties{}
 	default:
 		b = &CredentialStorageProperties{}
 	}
@@ -72,3 +78,24 @@ func unmarshalCredentialStoragePropertiesClassification(rawMsg json.RawMessage)
 	return b, nil
 }
 
+func unmarshalGcpCredentialPropertiesClassification(rawMsg json.RawMessage) (GcpCredentialPropertiesClassification, error) {
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(rawMsg, &m); err != nil {
+		return nil, err
+	}
+	var b GcpCredentialPropertiesClassification
+	switch m["kind"] {
+	case string(GCPCredentialKindServiceAccountKey):
+		b = &GcpServiceAccountKeyCredentialProperties{}
+	default:
+		b = &GcpCredentialProperties{}
+	}
+	if err := json.Unmarshal(rawMsg, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
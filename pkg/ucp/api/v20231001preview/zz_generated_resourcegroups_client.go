@@ -16,6 +16,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -100,32 +101,52 @@ func (client *ResourceGroupsClient) createOrUpdateHandleResponse(resp *http.Resp
 	return result, nil
 }
 
-// Delete - Delete a resource group
+// BeginDelete - Delete a resource group
 // If the operation fails it returns an *azcore.ResponseError type.
 //
 // Generated from API version 2023-10-01-preview
 //   - planeName - The plane name.
 //   - resourceGroupName - The name of resource group
-//   - options - ResourceGroupsClientDeleteOptions contains the optional parameters for the ResourceGroupsClient.Delete method.
-func (client *ResourceGroupsClient) Delete(ctx context.Context, planeName string, resourceGroupName string, options *ResourceGroupsClientDeleteOptions) (ResourceGroupsClientDeleteResponse, error) {
+//   - options - ResourceGroupsClientBeginDeleteOptions contains the optional parameters for the ResourceGroupsClient.BeginDelete
+//     method.
+func (client *ResourceGroupsClient) BeginDelete(ctx context.Context, planeName string, resourceGroupName string, options *ResourceGroupsClientBeginDeleteOptions) (*runtime.Poller[ResourceGroupsClientDeleteResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.deleteOperation(ctx, planeName, resourceGroupName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[ResourceGroupsClientDeleteResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[ResourceGroupsClientDeleteResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// Delete - Delete a resource group
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *ResourceGroupsClient) deleteOperation(ctx context.Context, planeName string, resourceGroupName string, options *ResourceGroupsClientBeginDeleteOptions) (*http.Response, error) {
 	var err error
 	req, err := client.deleteCreateRequest(ctx, planeName, resourceGroupName, options)
 	if err != nil {
-		return ResourceGroupsClientDeleteResponse{}, err
+		return nil, err
 	}
 	httpResp, err := client.internal.Pipeline().Do(req)
 	if err != nil {
-		return ResourceGroupsClientDeleteResponse{}, err
+		return nil, err
 	}
-	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusNoContent) {
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted, http.StatusNoContent) {
 		err = runtime.NewResponseError(httpResp)
-		return ResourceGroupsClientDeleteResponse{}, err
+		return nil, err
 	}
-	return ResourceGroupsClientDeleteResponse{}, nil
+	return httpResp, nil
 }
 
 // deleteCreateRequest creates the Delete request.
-func (client *ResourceGroupsClient) deleteCreateRequest(ctx context.Context, planeName string, resourceGroupName string, options *ResourceGroupsClientDeleteOptions) (*policy.Request, error) {
+func (client *ResourceGroupsClient) deleteCreateRequest(ctx context.Context, planeName string, resourceGroupName string, options *ResourceGroupsClientBeginDeleteOptions) (*policy.Request, error) {
 	urlPath := "/planes/radius/{planeName}/resourcegroups/{resourceGroupName}"
 	if planeName == "" {
 		return nil, errors.New("parameter planeName cannot be empty")
@@ -141,6 +162,9 @@ func (client *ResourceGroupsClient) deleteCreateRequest(ctx context.Context, pla
 	}
 	reqQP := req.Raw().URL.Query()
 	reqQP.Set("api-version", "2023-10-01-preview")
+	if options != nil && options.Cascade != nil {
+		reqQP.Set("cascade", strconv.FormatBool(*options.Cascade))
+	}
 	req.Raw().URL.RawQuery = reqQP.Encode()
 	req.Raw().Header["Accept"] = []string{"application/json"}
 	return req, nil
@@ -262,6 +286,67 @@ func (client *ResourceGroupsClient) listHandleResponse(resp *http.Response) (Res
 	return result, nil
 }
 
+// MoveResources - Move resources from a resource group to another resource group in the same plane
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - resourceGroupName - The name of resource group
+//   - body - The content of the action request
+//   - options - ResourceGroupsClientMoveResourcesOptions contains the optional parameters for the ResourceGroupsClient.MoveResources
+//     method.
+func (client *ResourceGroupsClient) MoveResources(ctx context.Context, planeName string, resourceGroupName string, body MoveResourcesRequest, options *ResourceGroupsClientMoveResourcesOptions) (ResourceGroupsClientMoveResourcesResponse, error) {
+	var err error
+	req, err := client.moveResourcesCreateRequest(ctx, planeName, resourceGroupName, body, options)
+	if err != nil {
+		return ResourceGroupsClientMoveResourcesResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ResourceGroupsClientMoveResourcesResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ResourceGroupsClientMoveResourcesResponse{}, err
+	}
+	resp, err := client.moveResourcesHandleResponse(httpResp)
+	return resp, err
+}
+
+// moveResourcesCreateRequest creates the MoveResources request.
+func (client *ResourceGroupsClient) moveResourcesCreateRequest(ctx context.Context, planeName string, resourceGroupName string, body MoveResourcesRequest, options *ResourceGroupsClientMoveResourcesOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/resourcegroups/{resourceGroupName}/moveResources"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if resourceGroupName == "" {
+		return nil, errors.New("parameter resourceGroupName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceGroupName}", url.PathEscape(resourceGroupName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// moveResourcesHandleResponse handles the MoveResources response.
+func (client *ResourceGroupsClient) moveResourcesHandleResponse(resp *http.Response) (ResourceGroupsClientMoveResourcesResponse, error) {
+	result := ResourceGroupsClientMoveResourcesResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.MoveResourcesResult); err != nil {
+		return ResourceGroupsClientMoveResourcesResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update a resource group
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -90,6 +90,11 @@ func (client *ResourcesClient) listCreateRequest(ctx context.Context, planeName
 	}
 	reqQP := req.Raw().URL.Query()
 	reqQP.Set("api-version", "2023-10-01-preview")
+	if options != nil && options.Tag != nil {
+		for _, qv := range options.Tag {
+			reqQP.Add("tag", qv)
+		}
+	}
 	req.Raw().URL.RawQuery = reqQP.Encode()
 	req.Raw().Header["Accept"] = []string{"application/json"}
 	return req, nil
@@ -123,6 +123,87 @@ type AzurePlanesClientUpdateResponse struct {
 	AzurePlaneResource
 }
 
+// GcpCredentialsClientCreateOrUpdateResponse contains the response from method GcpCredentialsClient.CreateOrUpdate.
+type GcpCredentialsClientCreateOrUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// GcpCredentialsClientDeleteResponse contains the response from method GcpCredentialsClient.Delete.
+type GcpCredentialsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// GcpCredentialsClientGetResponse contains the response from method GcpCredentialsClient.Get.
+type GcpCredentialsClientGetResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// GcpCredentialsClientListResponse contains the response from method GcpCredentialsClient.NewListPager.
+type GcpCredentialsClientListResponse struct {
+	// The response of a GcpCredentialResource list operation.
+	GcpCredentialResourceListResult
+}
+
+// GcpCredentialsClientUpdateResponse contains the response from method GcpCredentialsClient.Update.
+type GcpCredentialsClientUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// GcpPlanesClientCreateOrUpdateResponse contains the response from method GcpPlanesClient.BeginCreateOrUpdate.
+type GcpPlanesClientCreateOrUpdateResponse struct {
+	// The GCP plane resource
+	GcpPlaneResource
+}
+
+// GcpPlanesClientDeleteResponse contains the response from method GcpPlanesClient.BeginDelete.
+type GcpPlanesClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// GcpPlanesClientGetResponse contains the response from method GcpPlanesClient.Get.
+type GcpPlanesClientGetResponse struct {
+	// The GCP plane resource
+	GcpPlaneResource
+}
+
+// GcpPlanesClientListResponse contains the response from method GcpPlanesClient.NewListPager.
+type GcpPlanesClientListResponse struct {
+	// The response of a GcpPlaneResource list operation.
+	GcpPlaneResourceListResult
+}
+
+// GcpPlanesClientUpdateResponse contains the response from method GcpPlanesClient.BeginUpdate.
+type GcpPlanesClientUpdateResponse struct {
+	// The GCP plane resource
+	GcpPlaneResource
+}
+
+// LocksClientCreateOrUpdateResponse contains the response from method LocksClient.CreateOrUpdate.
+type LocksClientCreateOrUpdateResponse struct {
+	// The management lock resource. A lock prevents a scope of a Radius plane from being deleted or modified.
+	LockResource
+}
+
+// LocksClientDeleteResponse contains the response from method LocksClient.Delete.
+type LocksClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// LocksClientGetResponse contains the response from method LocksClient.Get.
+type LocksClientGetResponse struct {
+	// The management lock resource. A lock prevents a scope of a Radius plane from being deleted or modified.
+	LockResource
+}
+
+// LocksClientListResponse contains the response from method LocksClient.NewListPager.
+type LocksClientListResponse struct {
+	// The response of a LockResource list operation.
+	LockResourceListResult
+}
+
 // PlanesClientListPlanesResponse contains the response from method PlanesClient.NewListPlanesPager.
 type PlanesClientListPlanesResponse struct {
 	// The response of a GenericPlaneResource list operation.
@@ -164,7 +245,7 @@ type ResourceGroupsClientCreateOrUpdateResponse struct {
 	ResourceGroupResource
 }
 
-// ResourceGroupsClientDeleteResponse contains the response from method ResourceGroupsClient.Delete.
+// ResourceGroupsClientDeleteResponse contains the response from method ResourceGroupsClient.BeginDelete.
 type ResourceGroupsClientDeleteResponse struct {
 	// placeholder for future response values
 }
@@ -181,6 +262,12 @@ type ResourceGroupsClientListResponse struct {
 	ResourceGroupResourceListResult
 }
 
+// ResourceGroupsClientMoveResourcesResponse contains the response from method ResourceGroupsClient.MoveResources.
+type ResourceGroupsClientMoveResourcesResponse struct {
+	// The result of moving resources from one resource group to another.
+	MoveResourcesResult
+}
+
 // ResourceGroupsClientUpdateResponse contains the response from method ResourceGroupsClient.Update.
 type ResourceGroupsClientUpdateResponse struct {
 	// The resource group resource
@@ -193,3 +280,26 @@ type ResourcesClientListResponse struct {
 	GenericResourceListResult
 }
 
+
+// RoleAssignmentsClientCreateOrUpdateResponse contains the response from method RoleAssignmentsClient.CreateOrUpdate.
+type RoleAssignmentsClientCreateOrUpdateResponse struct {
+	// The role assignment resource. A role assignment grants a built-in role to a principal at a scope of a Radius plane.
+	RoleAssignmentResource
+}
+
+// RoleAssignmentsClientDeleteResponse contains the response from method RoleAssignmentsClient.Delete.
+type RoleAssignmentsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// RoleAssignmentsClientGetResponse contains the response from method RoleAssignmentsClient.Get.
+type RoleAssignmentsClientGetResponse struct {
+	// The role assignment resource. A role assignment grants a built-in role to a principal at a scope of a Radius plane.
+	RoleAssignmentResource
+}
+
+// RoleAssignmentsClientListResponse contains the response from method RoleAssignmentsClient.NewListPager.
+type RoleAssignmentsClientListResponse struct {
+	// The response of a RoleAssignmentResource list operation.
+	RoleAssignmentResourceListResult
+}
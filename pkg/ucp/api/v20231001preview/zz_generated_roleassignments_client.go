@@ -0,0 +1,263 @@
+//go:build go1.18
+// +build go1.18
+
+// Licensed under the Apache License, Version 2.0 . See LICENSE in the repository root for license information.
+// Code generated by Microsoft (R) AutoRest Code Generator. DO NOT EDIT.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+package v20231001preview
+
+import (
+	"context"
+	"errors"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"net/http"
+	"net/url"
+		"strings"
+)
+
+// RoleAssignmentsClient contains the methods for the RoleAssignments group.
+// Don't use this type directly, use NewRoleAssignmentsClient() instead.
+type RoleAssignmentsClient struct {
+	internal *arm.Client
+}
+
+// NewRoleAssignmentsClient creates a new instance of RoleAssignmentsClient with the specified values.
+//   - credential - used to authorize requests. Usually a credential from azidentity.
+//   - options - pass nil to accept the default values.
+func NewRoleAssignmentsClient(credential azcore.TokenCredential, options *arm.ClientOptions) (*RoleAssignmentsClient, error) {
+	cl, err := arm.NewClient(moduleName+".RoleAssignmentsClient", moduleVersion, credential, options)
+	if err != nil {
+		return nil, err
+	}
+	client := &RoleAssignmentsClient{
+	internal: cl,
+	}
+	return client, nil
+}
+
+// CreateOrUpdate - Create or update a role assignment
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - roleAssignmentName - The role assignment name.
+//   - resource - Resource create parameters.
+//   - options - RoleAssignmentsClientCreateOrUpdateOptions contains the optional parameters for the RoleAssignmentsClient.CreateOrUpdate
+//     method.
+func (client *RoleAssignmentsClient) CreateOrUpdate(ctx context.Context, planeName string, roleAssignmentName string, resource RoleAssignmentResource, options *RoleAssignmentsClientCreateOrUpdateOptions) (RoleAssignmentsClientCreateOrUpdateResponse, error) {
+	var err error
+	req, err := client.createOrUpdateCreateRequest(ctx, planeName, roleAssignmentName, resource, options)
+	if err != nil {
+		return RoleAssignmentsClientCreateOrUpdateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return RoleAssignmentsClientCreateOrUpdateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusCreated) {
+		err = runtime.NewResponseError(httpResp)
+		return RoleAssignmentsClientCreateOrUpdateResponse{}, err
+	}
+	resp, err := client.createOrUpdateHandleResponse(httpResp)
+	return resp, err
+}
+
+// createOrUpdateCreateRequest creates the CreateOrUpdate request.
+func (client *RoleAssignmentsClient) createOrUpdateCreateRequest(ctx context.Context, planeName string, roleAssignmentName string, resource RoleAssignmentResource, options *RoleAssignmentsClientCreateOrUpdateOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/providers/System.Authorization/roleAssignments/{roleAssignmentName}"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if roleAssignmentName == "" {
+		return nil, errors.New("parameter roleAssignmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{roleAssignmentName}", url.PathEscape(roleAssignmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPut, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, resource); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// createOrUpdateHandleResponse handles the CreateOrUpdate response.
+func (client *RoleAssignmentsClient) createOrUpdateHandleResponse(resp *http.Response) (RoleAssignmentsClientCreateOrUpdateResponse, error) {
+	result := RoleAssignmentsClientCreateOrUpdateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RoleAssignmentResource); err != nil {
+		return RoleAssignmentsClientCreateOrUpdateResponse{}, err
+	}
+	return result, nil
+}
+
+// Delete - Delete a role assignment
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - roleAssignmentName - The role assignment name.
+//   - options - RoleAssignmentsClientDeleteOptions contains the optional parameters for the RoleAssignmentsClient.Delete method.
+func (client *RoleAssignmentsClient) Delete(ctx context.Context, planeName string, roleAssignmentName string, options *RoleAssignmentsClientDeleteOptions) (RoleAssignmentsClientDeleteResponse, error) {
+	var err error
+	req, err := client.deleteCreateRequest(ctx, planeName, roleAssignmentName, options)
+	if err != nil {
+		return RoleAssignmentsClientDeleteResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return RoleAssignmentsClientDeleteResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return RoleAssignmentsClientDeleteResponse{}, err
+	}
+	return RoleAssignmentsClientDeleteResponse{}, nil
+}
+
+// deleteCreateRequest creates the Delete request.
+func (client *RoleAssignmentsClient) deleteCreateRequest(ctx context.Context, planeName string, roleAssignmentName string, options *RoleAssignmentsClientDeleteOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/providers/System.Authorization/roleAssignments/{roleAssignmentName}"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if roleAssignmentName == "" {
+		return nil, errors.New("parameter roleAssignmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{roleAssignmentName}", url.PathEscape(roleAssignmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodDelete, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// Get - Get a role assignment
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - roleAssignmentName - The role assignment name.
+//   - options - RoleAssignmentsClientGetOptions contains the optional parameters for the RoleAssignmentsClient.Get method.
+func (client *RoleAssignmentsClient) Get(ctx context.Context, planeName string, roleAssignmentName string, options *RoleAssignmentsClientGetOptions) (RoleAssignmentsClientGetResponse, error) {
+	var err error
+	req, err := client.getCreateRequest(ctx, planeName, roleAssignmentName, options)
+	if err != nil {
+		return RoleAssignmentsClientGetResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return RoleAssignmentsClientGetResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return RoleAssignmentsClientGetResponse{}, err
+	}
+	resp, err := client.getHandleResponse(httpResp)
+	return resp, err
+}
+
+// getCreateRequest creates the Get request.
+func (client *RoleAssignmentsClient) getCreateRequest(ctx context.Context, planeName string, roleAssignmentName string, options *RoleAssignmentsClientGetOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/providers/System.Authorization/roleAssignments/{roleAssignmentName}"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if roleAssignmentName == "" {
+		return nil, errors.New("parameter roleAssignmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{roleAssignmentName}", url.PathEscape(roleAssignmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// getHandleResponse handles the Get response.
+func (client *RoleAssignmentsClient) getHandleResponse(resp *http.Response) (RoleAssignmentsClientGetResponse, error) {
+	result := RoleAssignmentsClientGetResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RoleAssignmentResource); err != nil {
+		return RoleAssignmentsClientGetResponse{}, err
+	}
+	return result, nil
+}
+
+// NewListPager - List role assignments
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - options - RoleAssignmentsClientListOptions contains the optional parameters for the RoleAssignmentsClient.NewListPager method.
+func (client *RoleAssignmentsClient) NewListPager(planeName string, options *RoleAssignmentsClientListOptions) (*runtime.Pager[RoleAssignmentsClientListResponse]) {
+	return runtime.NewPager(runtime.PagingHandler[RoleAssignmentsClientListResponse]{
+		More: func(page RoleAssignmentsClientListResponse) bool {
+			return page.NextLink != nil && len(*page.NextLink) > 0
+		},
+		Fetcher: func(ctx context.Context, page *RoleAssignmentsClientListResponse) (RoleAssignmentsClientListResponse, error) {
+			var req *policy.Request
+			var err error
+			if page == nil {
+				req, err = client.listCreateRequest(ctx, planeName, options)
+			} else {
+				req, err = runtime.NewRequest(ctx, http.MethodGet, *page.NextLink)
+			}
+			if err != nil {
+				return RoleAssignmentsClientListResponse{}, err
+			}
+			resp, err := client.internal.Pipeline().Do(req)
+			if err != nil {
+				return RoleAssignmentsClientListResponse{}, err
+			}
+			if !runtime.HasStatusCode(resp, http.StatusOK) {
+				return RoleAssignmentsClientListResponse{}, runtime.NewResponseError(resp)
+			}
+			return client.listHandleResponse(resp)
+		},
+	})
+}
+
+// listCreateRequest creates the List request.
+func (client *RoleAssignmentsClient) listCreateRequest(ctx context.Context, planeName string, options *RoleAssignmentsClientListOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/providers/System.Authorization/roleAssignments"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// listHandleResponse handles the List response.
+func (client *RoleAssignmentsClient) listHandleResponse(resp *http.Response) (RoleAssignmentsClientListResponse, error) {
+	result := RoleAssignmentsClientListResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RoleAssignmentResourceListResult); err != nil {
+		return RoleAssignmentsClientListResponse{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	authenticationv1client "k8s.io/client-go/kubernetes/typed/authentication/v1"
+)
+
+const (
+	// RequestHeaderConfigMapNamespace is the namespace of the configmap in which the Kubernetes API server publishes the
+	// configuration of the request header authentication of aggregated API servers.
+	RequestHeaderConfigMapNamespace = "kube-system"
+
+	// RequestHeaderConfigMapName is the name of the configmap in which the Kubernetes API server publishes the
+	// configuration of the request header authentication of aggregated API servers.
+	RequestHeaderConfigMapName = "extension-apiserver-authentication"
+
+	requestHeaderClientCAKey        = "requestheader-client-ca-file"
+	requestHeaderAllowedNamesKey    = "requestheader-allowed-names"
+	requestHeaderUsernameHeadersKey = "requestheader-username-headers"
+	requestHeaderGroupHeadersKey    = "requestheader-group-headers"
+
+	// tokenReviewCacheTTL is the duration for which the result of a token review is reused.
+	tokenReviewCacheTTL = time.Minute
+)
+
+// RequestHeaderConfig is the configuration used to verify that the identity headers of a request were set by the
+// Kubernetes API server. The API server authenticates to the aggregated API servers with a client certificate signed by
+// the request header CA.
+type RequestHeaderConfig struct {
+	// ClientCAs is the pool of the request header CAs.
+	ClientCAs *x509.CertPool
+
+	// AllowedNames is the list of the common names allowed for the client certificate. Any common name is allowed when
+	// the list is empty.
+	AllowedNames []string
+
+	// UsernameHeaders is the list of the headers carrying the name of the user. The first header set is used.
+	UsernameHeaders []string
+
+	// GroupHeaders is the list of the headers carrying the groups of the user.
+	GroupHeaders []string
+}
+
+// LoadRequestHeaderConfig reads the request header configuration of the Kubernetes API server from the
+// extension-apiserver-authentication configmap.
+func LoadRequestHeaderConfig(ctx context.Context, client kubernetes.Interface) (*RequestHeaderConfig, error) {
+	configMap, err := client.CoreV1().ConfigMaps(RequestHeaderConfigMapNamespace).Get(ctx, RequestHeaderConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the request header configuration: %w", err)
+	}
+
+	ca := configMap.Data[requestHeaderClientCAKey]
+	if ca == "" {
+		return nil, fmt.Errorf("the configmap %s/%s has no %s", RequestHeaderConfigMapNamespace, RequestHeaderConfigMapName, requestHeaderClientCAKey)
+	}
+
+	config := &RequestHeaderConfig{ClientCAs: x509.NewCertPool()}
+	if !config.ClientCAs.AppendCertsFromPEM([]byte(ca)) {
+		return nil, fmt.Errorf("the %s of the configmap %s/%s has no valid certificate", requestHeaderClientCAKey, RequestHeaderConfigMapNamespace, RequestHeaderConfigMapName)
+	}
+
+	lists := map[string]*[]string{
+		requestHeaderAllowedNamesKey:    &config.AllowedNames,
+		requestHeaderUsernameHeadersKey: &config.UsernameHeaders,
+		requestHeaderGroupHeadersKey:    &config.GroupHeaders,
+	}
+	for key, list := range lists {
+		if value := configMap.Data[key]; value != "" {
+			if err := json.Unmarshal([]byte(value), list); err != nil {
+				return nil, fmt.Errorf("failed to parse the %s of the configmap %s/%s: %w", key, RequestHeaderConfigMapNamespace, RequestHeaderConfigMapName, err)
+			}
+		}
+	}
+
+	if len(config.UsernameHeaders) == 0 {
+		config.UsernameHeaders = []string{KubernetesUserHeader}
+	}
+	if len(config.GroupHeaders) == 0 {
+		config.GroupHeaders = []string{KubernetesGroupHeader}
+	}
+
+	return config, nil
+}
+
+// Verify returns an error unless the request was sent with a client certificate which chains to the request header CA
+// and, when allowed names are configured, has one of the allowed common names.
+func (c *RequestHeaderConfig) Verify(r *http.Request) error {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return errors.New("the request has no client certificate")
+	}
+
+	leaf := r.TLS.PeerCertificates[0]
+	intermediates := x509.NewCertPool()
+	for _, cert := range r.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         c.ClientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return fmt.Errorf("the client certificate is not signed by the request header CA: %w", err)
+	}
+
+	if len(c.AllowedNames) == 0 || contains(c.AllowedNames, leaf.Subject.CommonName) {
+		return nil
+	}
+
+	return fmt.Errorf("the common name %q of the client certificate is not allowed", leaf.Subject.CommonName)
+}
+
+// TokenReviewer authenticates the bearer tokens of the callers which send requests to UCP directly rather than through
+// the Kubernetes API server, such as the Radius components.
+type TokenReviewer interface {
+	// Review returns the identity of the owner of the token. It returns false if the token is not valid.
+	Review(ctx context.Context, token string) (Principal, bool, error)
+}
+
+var _ TokenReviewer = (*KubernetesTokenReviewer)(nil)
+
+// KubernetesTokenReviewer authenticates service account tokens with the TokenReview API of Kubernetes. The result of a
+// review is reused for a minute so that a caller does not trigger a review for each request.
+type KubernetesTokenReviewer struct {
+	client authenticationv1client.TokenReviewInterface
+	now    func() time.Time
+
+	mu      sync.Mutex
+	reviews map[[sha256.Size]byte]tokenReview
+}
+
+type tokenReview struct {
+	principal     Principal
+	authenticated bool
+	expiresAt     time.Time
+}
+
+// NewKubernetesTokenReviewer creates a new KubernetesTokenReviewer which uses the given Kubernetes client.
+func NewKubernetesTokenReviewer(client kubernetes.Interface) *KubernetesTokenReviewer {
+	return &KubernetesTokenReviewer{
+		client:  client.AuthenticationV1().TokenReviews(),
+		now:     time.Now,
+		reviews: map[[sha256.Size]byte]tokenReview{},
+	}
+}
+
+// Review returns the identity of the owner of the token as reported by the TokenReview API.
+func (r *KubernetesTokenReviewer) Review(ctx context.Context, token string) (Principal, bool, error) {
+	key := sha256.Sum256([]byte(token))
+	now := r.now()
+
+	r.mu.Lock()
+	review, ok := r.reviews[key]
+	r.mu.Unlock()
+	if ok && now.Before(review.expiresAt) {
+		return review.principal, review.authenticated, nil
+	}
+
+	result, err := r.client.Create(ctx, &authenticationv1.TokenReview{Spec: authenticationv1.TokenReviewSpec{Token: token}}, metav1.CreateOptions{})
+	if err != nil {
+		return Principal{}, false, fmt.Errorf("failed to review the bearer token: %w", err)
+	}
+
+	review = tokenReview{authenticated: result.Status.Authenticated, expiresAt: now.Add(tokenReviewCacheTTL)}
+	if review.authenticated {
+		review.principal = Principal{User: result.Status.User.Username, Groups: result.Status.User.Groups}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, v := range r.reviews {
+		if !now.Before(v.expiresAt) {
+			delete(r.reviews, k)
+		}
+	}
+	r.reviews[key] = review
+
+	return review.principal, review.authenticated, nil
+}
+
+// bearerToken returns the token of the Authorization header of the request.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
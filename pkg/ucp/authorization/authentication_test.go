@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+func (ca *testCA) pem() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}))
+}
+
+// issue issues a client certificate with the given common name.
+func (ca *testCA) issue(t *testing.T, name string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func Test_LoadRequestHeaderConfig(t *testing.T) {
+	ca := newTestCA(t, "request-header-ca")
+
+	newConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: RequestHeaderConfigMapNamespace, Name: RequestHeaderConfigMapName},
+			Data:       data,
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		client := fake.NewSimpleClientset(newConfigMap(map[string]string{
+			"requestheader-client-ca-file":   ca.pem(),
+			"requestheader-allowed-names":    `["front-proxy-client"]`,
+			"requestheader-username-headers": `["X-Remote-User"]`,
+			"requestheader-group-headers":    `["X-Remote-Group"]`,
+		}))
+
+		config, err := LoadRequestHeaderConfig(context.Background(), client)
+		require.NoError(t, err)
+		require.Equal(t, []string{"front-proxy-client"}, config.AllowedNames)
+		require.Equal(t, []string{"X-Remote-User"}, config.UsernameHeaders)
+		require.Equal(t, []string{"X-Remote-Group"}, config.GroupHeaders)
+
+		_, err = ca.issue(t, "front-proxy-client").Verify(x509.VerifyOptions{Roots: config.ClientCAs, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+		require.NoError(t, err)
+	})
+
+	t.Run("default headers", func(t *testing.T) {
+		client := fake.NewSimpleClientset(newConfigMap(map[string]string{"requestheader-client-ca-file": ca.pem()}))
+
+		config, err := LoadRequestHeaderConfig(context.Background(), client)
+		require.NoError(t, err)
+		require.Empty(t, config.AllowedNames)
+		require.Equal(t, []string{KubernetesUserHeader}, config.UsernameHeaders)
+		require.Equal(t, []string{KubernetesGroupHeader}, config.GroupHeaders)
+	})
+
+	t.Run("missing configmap", func(t *testing.T) {
+		_, err := LoadRequestHeaderConfig(context.Background(), fake.NewSimpleClientset())
+		require.ErrorContains(t, err, "failed to read the request header configuration")
+	})
+
+	t.Run("missing CA", func(t *testing.T) {
+		client := fake.NewSimpleClientset(newConfigMap(map[string]string{}))
+
+		_, err := LoadRequestHeaderConfig(context.Background(), client)
+		require.EqualError(t, err, "the configmap kube-system/extension-apiserver-authentication has no requestheader-client-ca-file")
+	})
+
+	t.Run("invalid allowed names", func(t *testing.T) {
+		client := fake.NewSimpleClientset(newConfigMap(map[string]string{
+			"requestheader-client-ca-file": ca.pem(),
+			"requestheader-allowed-names":  "front-proxy-client",
+		}))
+
+		_, err := LoadRequestHeaderConfig(context.Background(), client)
+		require.ErrorContains(t, err, "failed to parse the requestheader-allowed-names")
+	})
+}
+
+func Test_KubernetesTokenReviewer(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	reviews := 0
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		if review.Spec.Token == "valid" {
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User: authenticationv1.UserInfo{
+					Username: "system:serviceaccount:radius-system:bicep-de",
+					Groups:   []string{"system:serviceaccounts", "system:serviceaccounts:radius-system"},
+				},
+			}
+		}
+		return true, review, nil
+	})
+
+	now := time.Now()
+	reviewer := NewKubernetesTokenReviewer(client)
+	reviewer.now = func() time.Time { return now }
+
+	principal, ok, err := reviewer.Review(context.Background(), "valid")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, Principal{
+		User:   "system:serviceaccount:radius-system:bicep-de",
+		Groups: []string{"system:serviceaccounts", "system:serviceaccounts:radius-system"},
+	}, principal)
+
+	_, ok, err = reviewer.Review(context.Background(), "invalid")
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, 2, reviews)
+
+	// The reviews are reused until they expire.
+	_, ok, err = reviewer.Review(context.Background(), "valid")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 2, reviews)
+
+	now = now.Add(tokenReviewCacheTTL)
+	_, ok, err = reviewer.Review(context.Background(), "valid")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 3, reviews)
+}
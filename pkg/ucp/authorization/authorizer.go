@@ -0,0 +1,254 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// Principal represents the identity of the caller of a request.
+type Principal struct {
+	// User is the name of the user.
+	User string
+
+	// Groups is the list of groups the user is a member of.
+	Groups []string
+}
+
+// Authorizer decides whether a caller is allowed to perform a request by evaluating the role assignments of the Radius
+// plane the request targets.
+type Authorizer struct {
+	options       Options
+	storageClient store.StorageClient
+	requestHeader *RequestHeaderConfig
+	tokenReviewer TokenReviewer
+}
+
+// NewAuthorizer creates a new Authorizer which reads the role assignments from the given storage client.
+//
+// The identity headers of the Kubernetes API server are only trusted when they are verified with the request header
+// configuration, so requestHeader must be set when the identity source is 'kubernetes'. The bearer tokens of the callers
+// which send requests to UCP directly are authenticated with the token reviewer if it is set.
+func NewAuthorizer(options Options, storageClient store.StorageClient, requestHeader *RequestHeaderConfig, tokenReviewer TokenReviewer) *Authorizer {
+	return &Authorizer{options: options, storageClient: storageClient, requestHeader: requestHeader, tokenReviewer: tokenReviewer}
+}
+
+// Authenticate returns the identity of the caller of the request. A bearer token is authenticated with the token
+// reviewer, otherwise the identity is read from the headers of the configured identity source. It returns false if the
+// request carries no identity, and an error if the request carries an identity which can't be verified.
+func (a *Authorizer) Authenticate(r *http.Request) (Principal, bool, error) {
+	if token, ok := bearerToken(r); ok && a.tokenReviewer != nil {
+		principal, ok, err := a.tokenReviewer.Review(r.Context(), token)
+		if err != nil {
+			return Principal{}, false, err
+		} else if !ok {
+			return Principal{}, false, errors.New("the bearer token is not valid")
+		}
+		return principal, true, nil
+	}
+
+	principal := Principal{}
+
+	switch a.options.IdentitySource {
+	case IdentitySourceHeader:
+		principalHeader := a.options.PrincipalHeader
+		if principalHeader == "" {
+			principalHeader = DefaultPrincipalHeader
+		}
+		groupsHeader := a.options.GroupsHeader
+		if groupsHeader == "" {
+			groupsHeader = DefaultGroupsHeader
+		}
+
+		principal.User = r.Header.Get(principalHeader)
+		for _, group := range strings.Split(r.Header.Get(groupsHeader), ",") {
+			if group = strings.TrimSpace(group); group != "" {
+				principal.Groups = append(principal.Groups, group)
+			}
+		}
+
+	default:
+		config := a.requestHeader
+		if config == nil {
+			config = &RequestHeaderConfig{UsernameHeaders: []string{KubernetesUserHeader}, GroupHeaders: []string{KubernetesGroupHeader}}
+		}
+
+		for _, header := range config.UsernameHeaders {
+			if principal.User = r.Header.Get(header); principal.User != "" {
+				break
+			}
+		}
+		if principal.User == "" {
+			return Principal{}, false, nil
+		}
+
+		// Any caller can set the identity headers, so they are only trusted when the request was forwarded by the
+		// Kubernetes API server.
+		if a.requestHeader == nil {
+			return Principal{}, false, errors.New("the identity headers can't be verified without the request header configuration")
+		}
+		if err := a.requestHeader.Verify(r); err != nil {
+			return Principal{}, false, err
+		}
+
+		for _, header := range config.GroupHeaders {
+			principal.Groups = append(principal.Groups, r.Header.Values(header)...)
+		}
+	}
+
+	return principal, principal.User != "", nil
+}
+
+// IsAdministrator returns true if the principal is one of the configured administrators or a member of one of the
+// configured administrator groups.
+func (a *Authorizer) IsAdministrator(principal Principal) bool {
+	for _, user := range a.options.AdminUsers {
+		if user == principal.User {
+			return true
+		}
+	}
+
+	for _, group := range a.options.AdminGroups {
+		if contains(principal.Groups, group) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Authorize returns true if the principal is allowed to perform a request with the given method on the given path. The
+// path must not include the path base of UCP.
+//
+// Administrators are allowed to perform any request. Other callers are allowed to perform a request on a Radius plane when
+// one of the role assignments of the plane grants them a role which allows the action at a scope containing the path.
+// Requests outside of a Radius plane, except listing the planes, are reserved for administrators.
+func (a *Authorizer) Authorize(ctx context.Context, principal Principal, method string, path string) (bool, error) {
+	if a.IsAdministrator(principal) {
+		return true, nil
+	}
+
+	action := ActionForRequest(method, path)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if !strings.EqualFold(segments[0], "planes") {
+		return false, nil
+	}
+
+	if len(segments) <= 2 {
+		// Any caller can list the planes.
+		return action == ActionRead, nil
+	}
+
+	if !strings.EqualFold(segments[1], "radius") {
+		return false, nil
+	}
+
+	if len(segments) == 3 && action != ActionRead {
+		// Creating, updating and deleting the plane itself is reserved for administrators.
+		return false, nil
+	}
+
+	if action == ActionRead && isOperationPath(segments) {
+		// The IDs of async operations can't be guessed, so the caller who started the operation can always poll it.
+		return true, nil
+	}
+
+	planeID := "/" + strings.Join(segments[:3], "/")
+	assignments, err := a.listRoleAssignments(ctx, planeID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, assignment := range assignments {
+		scope := assignment.Properties.Scope
+		if scope == "" {
+			scope = planeID
+		}
+
+		if assignedTo(assignment, principal) && ScopeContains(scope, path) && RoleAllows(assignment.Properties.RoleDefinitionName, action) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ScopeContains returns true if the resource ID is the scope itself or an ID nested within the scope. The comparison is
+// case-insensitive.
+func ScopeContains(scope string, id string) bool {
+	scope = strings.TrimSuffix(strings.ToLower(scope), "/")
+	id = strings.TrimSuffix(strings.ToLower(id), "/")
+	return id == scope || strings.HasPrefix(id, scope+"/")
+}
+
+func (a *Authorizer) listRoleAssignments(ctx context.Context, planeID string) ([]datamodel.RoleAssignment, error) {
+	result, err := a.storageClient.Query(ctx, store.Query{
+		RootScope:    planeID,
+		ResourceType: datamodel.RoleAssignmentResourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := []datamodel.RoleAssignment{}
+	for _, item := range result.Items {
+		assignment := datamodel.RoleAssignment{}
+		if err := item.As(&assignment); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments, nil
+}
+
+func assignedTo(assignment datamodel.RoleAssignment, principal Principal) bool {
+	switch assignment.Properties.PrincipalType {
+	case datamodel.PrincipalTypeUser:
+		return assignment.Properties.PrincipalID == principal.User
+	case datamodel.PrincipalTypeGroup:
+		return contains(principal.Groups, assignment.Properties.PrincipalID)
+	default:
+		return false
+	}
+}
+
+// isOperationPath returns true if the path segments address the status or result of an async operation, for example
+// 'providers/System.Resources/locations/global/operationStatuses/{operationId}'.
+func isOperationPath(segments []string) bool {
+	if len(segments) < 4 || !strings.EqualFold(segments[len(segments)-4], "locations") {
+		return false
+	}
+
+	kind := segments[len(segments)-2]
+	return strings.EqualFold(kind, "operationStatuses") || strings.EqualFold(kind, "operationResults")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
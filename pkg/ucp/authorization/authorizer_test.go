@@ -0,0 +1,398 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func newRoleAssignment(name string, principalType string, principalID string, role string, scope string) store.Object {
+	return store.Object{
+		Data: &datamodel.RoleAssignment{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   "/planes/radius/local/providers/System.Authorization/roleAssignments/" + name,
+					Name: name,
+					Type: datamodel.RoleAssignmentResourceType,
+				},
+			},
+			Properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:        principalID,
+				PrincipalType:      principalType,
+				RoleDefinitionName: role,
+				Scope:              scope,
+			},
+		},
+	}
+}
+
+type fakeTokenReviewer struct {
+	principals map[string]Principal
+}
+
+func (r *fakeTokenReviewer) Review(ctx context.Context, token string) (Principal, bool, error) {
+	principal, ok := r.principals[token]
+	return principal, ok, nil
+}
+
+func Test_Authorizer_Authenticate(t *testing.T) {
+	ca := newTestCA(t, "request-header-ca")
+	requestHeader := &RequestHeaderConfig{
+		ClientCAs:       ca.pool(),
+		AllowedNames:    []string{"front-proxy-client"},
+		UsernameHeaders: []string{KubernetesUserHeader},
+		GroupHeaders:    []string{KubernetesGroupHeader},
+	}
+
+	newKubernetesRequest := func(certs ...*x509.Certificate) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+		req.Header.Set(KubernetesUserHeader, "alice")
+		req.Header.Add(KubernetesGroupHeader, "developers")
+		req.Header.Add(KubernetesGroupHeader, "system:authenticated")
+		if len(certs) > 0 {
+			req.TLS = &tls.ConnectionState{PeerCertificates: certs}
+		}
+		return req
+	}
+
+	t.Run("kubernetes", func(t *testing.T) {
+		authorizer := NewAuthorizer(Options{Enabled: true}, nil, requestHeader, nil)
+
+		principal, ok, err := authorizer.Authenticate(newKubernetesRequest(ca.issue(t, "front-proxy-client")))
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, Principal{User: "alice", Groups: []string{"developers", "system:authenticated"}}, principal)
+	})
+
+	t.Run("kubernetes without client certificate", func(t *testing.T) {
+		authorizer := NewAuthorizer(Options{Enabled: true}, nil, requestHeader, nil)
+
+		_, ok, err := authorizer.Authenticate(newKubernetesRequest())
+		require.EqualError(t, err, "the request has no client certificate")
+		require.False(t, ok)
+	})
+
+	t.Run("kubernetes client certificate of another CA", func(t *testing.T) {
+		authorizer := NewAuthorizer(Options{Enabled: true}, nil, requestHeader, nil)
+
+		other := newTestCA(t, "other-ca")
+		_, ok, err := authorizer.Authenticate(newKubernetesRequest(other.issue(t, "front-proxy-client")))
+		require.ErrorContains(t, err, "the client certificate is not signed by the request header CA")
+		require.False(t, ok)
+	})
+
+	t.Run("kubernetes client certificate name not allowed", func(t *testing.T) {
+		authorizer := NewAuthorizer(Options{Enabled: true}, nil, requestHeader, nil)
+
+		_, ok, err := authorizer.Authenticate(newKubernetesRequest(ca.issue(t, "mallory")))
+		require.EqualError(t, err, "the common name \"mallory\" of the client certificate is not allowed")
+		require.False(t, ok)
+	})
+
+	t.Run("kubernetes without request header configuration", func(t *testing.T) {
+		authorizer := NewAuthorizer(Options{Enabled: true}, nil, nil, nil)
+
+		_, ok, err := authorizer.Authenticate(newKubernetesRequest(ca.issue(t, "front-proxy-client")))
+		require.Error(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("kubernetes no identity", func(t *testing.T) {
+		authorizer := NewAuthorizer(Options{Enabled: true}, nil, requestHeader, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+		req.Header.Set(DefaultPrincipalHeader, "alice")
+
+		_, ok, err := authorizer.Authenticate(req)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("bearer token", func(t *testing.T) {
+		reviewer := &fakeTokenReviewer{principals: map[string]Principal{
+			"token": {User: "system:serviceaccount:radius-system:bicep-de", Groups: []string{"system:serviceaccounts:radius-system"}},
+		}}
+		authorizer := NewAuthorizer(Options{Enabled: true}, nil, requestHeader, reviewer)
+
+		req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+		req.Header.Set("Authorization", "Bearer token")
+
+		principal, ok, err := authorizer.Authenticate(req)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "system:serviceaccount:radius-system:bicep-de", principal.User)
+
+		req.Header.Set("Authorization", "Bearer invalid")
+		_, ok, err = authorizer.Authenticate(req)
+		require.EqualError(t, err, "the bearer token is not valid")
+		require.False(t, ok)
+	})
+
+	t.Run("header defaults", func(t *testing.T) {
+		authorizer := NewAuthorizer(Options{Enabled: true, IdentitySource: IdentitySourceHeader}, nil, nil, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+		req.Header.Set(DefaultPrincipalHeader, "alice")
+		req.Header.Set(DefaultGroupsHeader, "developers, operators,")
+
+		principal, ok, err := authorizer.Authenticate(req)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, Principal{User: "alice", Groups: []string{"developers", "operators"}}, principal)
+	})
+
+	t.Run("header custom", func(t *testing.T) {
+		authorizer := NewAuthorizer(Options{Enabled: true, IdentitySource: IdentitySourceHeader, PrincipalHeader: "X-User", GroupsHeader: "X-Groups"}, nil, nil, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+		req.Header.Set("X-User", "bob")
+
+		principal, ok, err := authorizer.Authenticate(req)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, Principal{User: "bob"}, principal)
+	})
+}
+
+func Test_Authorizer_IsAdministrator(t *testing.T) {
+	authorizer := NewAuthorizer(Options{AdminUsers: []string{"admin"}, AdminGroups: []string{"system:masters"}}, nil, nil, nil)
+
+	require.True(t, authorizer.IsAdministrator(Principal{User: "admin"}))
+	require.True(t, authorizer.IsAdministrator(Principal{User: "alice", Groups: []string{"system:masters"}}))
+	require.False(t, authorizer.IsAdministrator(Principal{User: "alice", Groups: []string{"developers"}}))
+}
+
+func Test_Authorizer_Authorize(t *testing.T) {
+	assignments := []store.Object{
+		newRoleAssignment("alice-rg", datamodel.PrincipalTypeUser, "alice", datamodel.RoleContributor, "/planes/radius/local/resourceGroups/rg"),
+		newRoleAssignment("operators", datamodel.PrincipalTypeGroup, "operators", datamodel.RoleReader, ""),
+		newRoleAssignment("owners", datamodel.PrincipalTypeGroup, "owners", datamodel.RoleOwner, "/planes/radius/local"),
+	}
+
+	tests := []struct {
+		name      string
+		principal Principal
+		method    string
+		path      string
+		expected  bool
+	}{
+		{
+			name:      "contributor writes in scope",
+			principal: Principal{User: "alice"},
+			method:    http.MethodPut,
+			path:      "/planes/radius/local/resourcegroups/rg/providers/Applications.Core/containers/c",
+			expected:  true,
+		},
+		{
+			name:      "contributor writes the scope itself",
+			principal: Principal{User: "alice"},
+			method:    http.MethodDelete,
+			path:      "/planes/radius/local/resourcegroups/rg",
+			expected:  true,
+		},
+		{
+			name:      "contributor writes out of scope",
+			principal: Principal{User: "alice"},
+			method:    http.MethodPut,
+			path:      "/planes/radius/local/resourcegroups/rg2/providers/Applications.Core/containers/c",
+			expected:  false,
+		},
+		{
+			name:      "scope is not a prefix of a sibling name",
+			principal: Principal{User: "alice"},
+			method:    http.MethodGet,
+			path:      "/planes/radius/local/resourcegroups/rgx",
+			expected:  false,
+		},
+		{
+			name:      "contributor can't manage access",
+			principal: Principal{User: "alice"},
+			method:    http.MethodPut,
+			path:      "/planes/radius/local/providers/System.Authorization/roleAssignments/a",
+			expected:  false,
+		},
+		{
+			name:      "user assignment doesn't match group",
+			principal: Principal{User: "bob", Groups: []string{"alice"}},
+			method:    http.MethodGet,
+			path:      "/planes/radius/local/resourcegroups/rg",
+			expected:  false,
+		},
+		{
+			name:      "reader group reads the plane",
+			principal: Principal{User: "bob", Groups: []string{"operators"}},
+			method:    http.MethodGet,
+			path:      "/planes/radius/local/resourcegroups/rg2/providers/Applications.Core/containers/c",
+			expected:  true,
+		},
+		{
+			name:      "reader group can't write",
+			principal: Principal{User: "bob", Groups: []string{"operators"}},
+			method:    http.MethodPut,
+			path:      "/planes/radius/local/resourcegroups/rg2",
+			expected:  false,
+		},
+		{
+			name:      "owner manages access",
+			principal: Principal{User: "carol", Groups: []string{"owners"}},
+			method:    http.MethodPut,
+			path:      "/planes/radius/local/providers/System.Authorization/roleAssignments/a",
+			expected:  true,
+		},
+		{
+			name:      "no assignment",
+			principal: Principal{User: "mallory"},
+			method:    http.MethodGet,
+			path:      "/planes/radius/local/resourcegroups/rg",
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			storageClient := store.NewMockStorageClient(mctrl)
+			storageClient.EXPECT().
+				Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local", ResourceType: datamodel.RoleAssignmentResourceType}).
+				Return(&store.ObjectQueryResult{Items: assignments}, nil)
+
+			authorizer := NewAuthorizer(Options{Enabled: true}, storageClient, nil, nil)
+			allowed, err := authorizer.Authorize(context.Background(), tt.principal, tt.method, tt.path)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, allowed)
+		})
+	}
+}
+
+func Test_Authorizer_Authorize_WithoutRoleAssignments(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal Principal
+		method    string
+		path      string
+		expected  bool
+	}{
+		{
+			name:      "administrator",
+			principal: Principal{User: "alice", Groups: []string{"system:masters"}},
+			method:    http.MethodPut,
+			path:      "/planes/aws/aws/providers/System.AWS/credentials/default",
+			expected:  true,
+		},
+		{
+			name:      "list planes",
+			principal: Principal{User: "alice"},
+			method:    http.MethodGet,
+			path:      "/planes",
+			expected:  true,
+		},
+		{
+			name:      "list radius planes",
+			principal: Principal{User: "alice"},
+			method:    http.MethodGet,
+			path:      "/planes/radius",
+			expected:  true,
+		},
+		{
+			name:      "cloud plane",
+			principal: Principal{User: "alice"},
+			method:    http.MethodGet,
+			path:      "/planes/aws/aws/providers/System.AWS/credentials/default",
+			expected:  false,
+		},
+		{
+			name:      "write plane",
+			principal: Principal{User: "alice"},
+			method:    http.MethodPut,
+			path:      "/planes/radius/local",
+			expected:  false,
+		},
+		{
+			name:      "admin api",
+			principal: Principal{User: "alice"},
+			method:    http.MethodGet,
+			path:      "/admin/queues/ucp/deadletters",
+			expected:  false,
+		},
+		{
+			name:      "operation status",
+			principal: Principal{User: "alice"},
+			method:    http.MethodGet,
+			path:      "/planes/radius/local/providers/System.Resources/locations/global/operationStatuses/00000000-0000-0000-0000-000000000000",
+			expected:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorizer := NewAuthorizer(Options{Enabled: true, AdminGroups: []string{"system:masters"}}, nil, nil, nil)
+			allowed, err := authorizer.Authorize(context.Background(), tt.principal, tt.method, tt.path)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, allowed)
+		})
+	}
+}
+
+func Test_Authorizer_Authorize_QueryError(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(mctrl)
+	storageClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(nil, errors.New("store is down"))
+
+	authorizer := NewAuthorizer(Options{Enabled: true}, storageClient, nil, nil)
+	_, err := authorizer.Authorize(context.Background(), Principal{User: "alice"}, http.MethodGet, "/planes/radius/local/resourcegroups/rg")
+	require.EqualError(t, err, "store is down")
+}
+
+func Test_ScopeContains(t *testing.T) {
+	require.True(t, ScopeContains("/planes/radius/local", "/planes/radius/local"))
+	require.True(t, ScopeContains("/planes/radius/local/", "/planes/radius/local/resourcegroups/rg"))
+	require.True(t, ScopeContains("/planes/radius/local/resourceGroups/RG", "/planes/radius/local/resourcegroups/rg/providers/Applications.Core/containers/c"))
+	require.False(t, ScopeContains("/planes/radius/local/resourcegroups/rg", "/planes/radius/local/resourcegroups/rg2"))
+	require.False(t, ScopeContains("/planes/radius/local/resourcegroups/rg", "/planes/radius/local"))
+}
+
+func Test_AuthorizeFromContext(t *testing.T) {
+	// Requests without authorization in the context are allowed.
+	allowed, err := AuthorizeFromContext(context.Background(), http.MethodPut, "/planes/radius/local/resourcegroups/rg")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	authorizer := NewAuthorizer(Options{Enabled: true, AdminUsers: []string{"admin"}}, nil, nil, nil)
+
+	ctx := WithRequestAuthorization(context.Background(), authorizer, Principal{User: "admin"})
+	allowed, err = AuthorizeFromContext(ctx, http.MethodPut, "/planes/radius/local/resourcegroups/rg")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	ctx = WithRequestAuthorization(context.Background(), authorizer, Principal{User: "alice"})
+	allowed, err = AuthorizeFromContext(ctx, http.MethodPut, "/")
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
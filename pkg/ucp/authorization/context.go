@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+)
+
+type contextKey struct{}
+
+type callerContextKey struct{}
+
+// callerHolder holds the authenticated caller of a request. It is added to the context by the middlewares which run
+// before the authorization middleware and need the caller after the request is handled.
+type callerHolder struct {
+	principal *Principal
+}
+
+// requestAuthorization is the authorizer and the caller of an authorized request.
+type requestAuthorization struct {
+	authorizer *Authorizer
+	principal  Principal
+}
+
+// WithRequestAuthorization adds the authorizer and the caller of an authorized request to the context, so that the
+// controllers can authorize the actions of the request on other resources than the resource of the request path.
+func WithRequestAuthorization(ctx context.Context, authorizer *Authorizer, principal Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, &requestAuthorization{authorizer: authorizer, principal: principal})
+}
+
+// AuthorizeFromContext returns true if the caller of the request is allowed to perform a request with the given method
+// on the given path. The path must not include the path base of UCP. It returns true if the context has no
+// authorization, which is the case when the authorization of requests is disabled or the caller is unauthenticated and
+// unauthenticated requests are allowed.
+func AuthorizeFromContext(ctx context.Context, method string, path string) (bool, error) {
+	auth, ok := ctx.Value(contextKey{}).(*requestAuthorization)
+	if !ok {
+		return true, nil
+	}
+
+	return auth.authorizer.Authorize(ctx, auth.principal, method, path)
+}
+
+// WithCallerHolder adds an empty holder of the authenticated caller to the context. The authorization middleware sets
+// the caller with RecordCaller, and CallerFromContext returns it from the returned context after the request is
+// handled.
+func WithCallerHolder(ctx context.Context) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, &callerHolder{})
+}
+
+// RecordCaller records the authenticated caller of the request in the holder of the context, if any. The caller is
+// recorded whether or not the request is allowed.
+func RecordCaller(ctx context.Context, principal Principal) {
+	if holder, ok := ctx.Value(callerContextKey{}).(*callerHolder); ok {
+		holder.principal = &principal
+	}
+}
+
+// CallerFromContext returns the authenticated caller of the request, or false if the caller was not authenticated.
+func CallerFromContext(ctx context.Context) (Principal, bool) {
+	if auth, ok := ctx.Value(contextKey{}).(*requestAuthorization); ok {
+		return auth.principal, true
+	}
+
+	if holder, ok := ctx.Value(callerContextKey{}).(*callerHolder); ok && holder.principal != nil {
+		return *holder.principal, true
+	}
+
+	return Principal{}, false
+}
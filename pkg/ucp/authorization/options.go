@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+const (
+	// IdentitySourceKubernetes identifies the caller using the headers set by the Kubernetes API server when it forwards
+	// a request to an aggregated API server. The headers are only trusted when the request carries the client
+	// certificate of the API server, and the callers which send requests to UCP directly are identified by their service
+	// account token.
+	IdentitySourceKubernetes = "kubernetes"
+
+	// IdentitySourceHeader identifies the caller using the configured request headers. It is intended for running UCP
+	// directly behind a trusted proxy that authenticates the caller.
+	IdentitySourceHeader = "header"
+
+	// KubernetesUserHeader is the header the Kubernetes API server uses to forward the name of the authenticated user.
+	KubernetesUserHeader = "X-Remote-User"
+
+	// KubernetesGroupHeader is the header the Kubernetes API server uses to forward the groups of the authenticated user.
+	// The header is repeated once for each group.
+	KubernetesGroupHeader = "X-Remote-Group"
+
+	// DefaultPrincipalHeader is the default header carrying the name of the caller when the identity source is 'header'.
+	DefaultPrincipalHeader = "X-Radius-Principal"
+
+	// DefaultGroupsHeader is the default header carrying the comma-separated groups of the caller when the identity
+	// source is 'header'.
+	DefaultGroupsHeader = "X-Radius-Groups"
+)
+
+// Options represents the configuration of the authorization of UCP requests.
+type Options struct {
+	// Enabled turns on the authorization of requests. When disabled, which is the default, every request is allowed.
+	Enabled bool `yaml:"enabled"`
+
+	// IdentitySource is the source of the caller identity, either 'kubernetes' or 'header'. Defaults to 'kubernetes'.
+	IdentitySource string `yaml:"identitySource,omitempty"`
+
+	// PrincipalHeader is the header carrying the name of the caller when the identity source is 'header'.
+	// Defaults to DefaultPrincipalHeader.
+	PrincipalHeader string `yaml:"principalHeader,omitempty"`
+
+	// GroupsHeader is the header carrying the comma-separated groups of the caller when the identity source is 'header'.
+	// Defaults to DefaultGroupsHeader.
+	GroupsHeader string `yaml:"groupsHeader,omitempty"`
+
+	// AdminUsers is the list of users which are allowed to perform any request, regardless of the role assignments.
+	AdminUsers []string `yaml:"adminUsers,omitempty"`
+
+	// AdminGroups is the list of groups whose members are allowed to perform any request, regardless of the role
+	// assignments. For example, 'system:masters' for the cluster administrators of Kubernetes.
+	AdminGroups []string `yaml:"adminGroups,omitempty"`
+
+	// AllowUnauthenticated allows requests which carry no caller identity, such as the requests of the Radius components
+	// which call UCP directly without a service account token. Defaults to false, which rejects them.
+	AllowUnauthenticated bool `yaml:"allowUnauthenticated,omitempty"`
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// Action represents the kind of operation a request performs.
+type Action string
+
+const (
+	// ActionRead represents reading resources.
+	ActionRead Action = "read"
+
+	// ActionWrite represents creating, updating, deleting resources and invoking actions on them.
+	ActionWrite Action = "write"
+
+	// ActionManageAccess represents creating, updating and deleting role assignments.
+	ActionManageAccess Action = "manageAccess"
+)
+
+// builtInRoles maps the name of each built-in role to the actions it allows.
+var builtInRoles = map[string][]Action{
+	datamodel.RoleReader:      {ActionRead},
+	datamodel.RoleContributor: {ActionRead, ActionWrite},
+	datamodel.RoleOwner:       {ActionRead, ActionWrite, ActionManageAccess},
+}
+
+// IsBuiltInRole returns true if the given role name is one of the built-in roles. Role names are case-insensitive.
+func IsBuiltInRole(role string) bool {
+	_, ok := lookupRole(role)
+	return ok
+}
+
+// RoleAllows returns true if the given role allows the given action. Unknown roles allow nothing.
+func RoleAllows(role string, action Action) bool {
+	actions, ok := lookupRole(role)
+	if !ok {
+		return false
+	}
+
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ActionForRequest returns the action performed by a request with the given method on the given path.
+func ActionForRequest(method string, path string) Action {
+	if method == http.MethodGet || method == http.MethodHead {
+		return ActionRead
+	}
+
+	if isRoleAssignmentPath(path) {
+		return ActionManageAccess
+	}
+
+	return ActionWrite
+}
+
+func lookupRole(role string) ([]Action, bool) {
+	for name, actions := range builtInRoles {
+		if strings.EqualFold(name, role) {
+			return actions, true
+		}
+	}
+
+	return nil, false
+}
+
+func isRoleAssignmentPath(path string) bool {
+	return strings.Contains(strings.ToLower(path), "/providers/"+strings.ToLower(datamodel.RoleAssignmentResourceType))
+}
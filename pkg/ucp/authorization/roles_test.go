@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RoleAllows(t *testing.T) {
+	tests := []struct {
+		role     string
+		action   Action
+		expected bool
+	}{
+		{role: "Reader", action: ActionRead, expected: true},
+		{role: "Reader", action: ActionWrite, expected: false},
+		{role: "Reader", action: ActionManageAccess, expected: false},
+		{role: "Contributor", action: ActionRead, expected: true},
+		{role: "Contributor", action: ActionWrite, expected: true},
+		{role: "Contributor", action: ActionManageAccess, expected: false},
+		{role: "Owner", action: ActionRead, expected: true},
+		{role: "Owner", action: ActionWrite, expected: true},
+		{role: "Owner", action: ActionManageAccess, expected: true},
+		{role: "owner", action: ActionManageAccess, expected: true},
+		{role: "Administrator", action: ActionRead, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role+"/"+string(tt.action), func(t *testing.T) {
+			require.Equal(t, tt.expected, RoleAllows(tt.role, tt.action))
+		})
+	}
+}
+
+func Test_IsBuiltInRole(t *testing.T) {
+	require.True(t, IsBuiltInRole("Reader"))
+	require.True(t, IsBuiltInRole("contributor"))
+	require.False(t, IsBuiltInRole("Administrator"))
+}
+
+func Test_ActionForRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		expected Action
+	}{
+		{
+			name:     "get",
+			method:   http.MethodGet,
+			path:     "/planes/radius/local/resourcegroups/rg",
+			expected: ActionRead,
+		},
+		{
+			name:     "head",
+			method:   http.MethodHead,
+			path:     "/planes/radius/local/resourcegroups/rg",
+			expected: ActionRead,
+		},
+		{
+			name:     "put",
+			method:   http.MethodPut,
+			path:     "/planes/radius/local/resourcegroups/rg",
+			expected: ActionWrite,
+		},
+		{
+			name:     "post",
+			method:   http.MethodPost,
+			path:     "/planes/radius/local/resourcegroups/rg/moveResources",
+			expected: ActionWrite,
+		},
+		{
+			name:     "get role assignment",
+			method:   http.MethodGet,
+			path:     "/planes/radius/local/providers/System.Authorization/roleAssignments/a",
+			expected: ActionRead,
+		},
+		{
+			name:     "put role assignment",
+			method:   http.MethodPut,
+			path:     "/planes/radius/local/providers/System.Authorization/roleAssignments/a",
+			expected: ActionManageAccess,
+		},
+		{
+			name:     "delete role assignment",
+			method:   http.MethodDelete,
+			path:     "/planes/radius/local/providers/system.authorization/roleassignments/a",
+			expected: ActionManageAccess,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, ActionForRequest(tt.method, tt.path))
+		})
+	}
+}
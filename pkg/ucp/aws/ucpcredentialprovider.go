@@ -20,9 +20,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -33,6 +36,13 @@ var _ aws.CredentialsProvider = (*UCPCredentialProvider)(nil)
 const (
 	// DefaultExpireDuration is the default access key expiry duration.
 	DefaultExpireDuration = time.Minute * time.Duration(15)
+
+	// webIdentityTokenFileEnv is the environment variable containing the path to the projected service account token
+	// used by IAM roles for service accounts (IRSA).
+	webIdentityTokenFileEnv = "AWS_WEB_IDENTITY_TOKEN_FILE"
+
+	// defaultSTSRegion is the region used for the STS client when AWS_REGION is not set.
+	defaultSTSRegion = "us-east-1"
 )
 
 // UCPCredentialProvider is the implementation of aws.CredentialsProvider
@@ -48,6 +58,9 @@ type UCPCredentialOptions struct {
 
 	// Duration is the duration for the secret keys.
 	Duration time.Duration
+
+	// stsClient is the client used to assume the IRSA role. If nil, a new STS client is created.
+	stsClient stscreds.AssumeRoleWithWebIdentityAPIClient
 }
 
 // NewUCPCredentialProvider creates UCPCredentialProvider provider to fetch Secret Access key using UCP credential APIs.
@@ -73,6 +86,10 @@ func (c *UCPCredentialProvider) Retrieve(ctx context.Context) (aws.Credentials,
 		return aws.Credentials{}, err
 	}
 
+	if s.IsIRSA() {
+		return c.retrieveIRSA(ctx, s)
+	}
+
 	if s.AccessKeyID == "" || s.SecretAccessKey == "" {
 		return aws.Credentials{}, errors.New("invalid access key info")
 	}
@@ -90,3 +107,37 @@ func (c *UCPCredentialProvider) Retrieve(ctx context.Context) (aws.Credentials,
 
 	return value, nil
 }
+
+// retrieveIRSA assumes the IAM role of the IRSA credential using the web identity token projected into the pod.
+func (c *UCPCredentialProvider) retrieveIRSA(ctx context.Context, s *sdk_cred.AWSCredential) (aws.Credentials, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if s.RoleARN == "" {
+		return aws.Credentials{}, errors.New("invalid IRSA role info")
+	}
+
+	tokenFile := os.Getenv(webIdentityTokenFileEnv)
+	if tokenFile == "" {
+		return aws.Credentials{}, fmt.Errorf("%s is not set", webIdentityTokenFileEnv)
+	}
+
+	client := c.options.stsClient
+	if client == nil {
+		region := os.Getenv("AWS_REGION")
+		if region == "" {
+			region = defaultSTSRegion
+		}
+		client = sts.New(sts.Options{Region: region})
+	}
+
+	logger.Info(fmt.Sprintf("Retreived AWS Credential - RoleARN: %s", s.RoleARN))
+
+	p := stscreds.NewWebIdentityRoleProvider(client, s.RoleARN, stscreds.IdentityTokenFile(tokenFile))
+	value, err := p.Retrieve(ctx)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	value.Source = "radiusucp"
+	return value, nil
+}
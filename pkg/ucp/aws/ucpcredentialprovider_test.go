@@ -19,12 +19,18 @@ package aws
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/stretchr/testify/require"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
 type mockProvider struct {
@@ -79,4 +85,71 @@ func TestRetrieve(t *testing.T) {
 		require.True(t, cred.CanExpire)
 		require.GreaterOrEqual(t, cred.Expires.Unix(), expectedExpiry.Unix())
 	})
+
+	t.Run("irsa credential", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenFile, []byte("token"), 0600))
+		t.Setenv(webIdentityTokenFileEnv, tokenFile)
+
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind:    datamodel.AWSIRSACredentialKind,
+				RoleARN: "arn:aws:iam::000000000000:role/radius",
+			},
+		}
+		cp := NewUCPCredentialProvider(p, DefaultExpireDuration)
+		expiry := time.Now().UTC().Add(time.Hour)
+		cp.options.stsClient = &mockSTSClient{expiry: expiry}
+
+		cred, err := cp.Retrieve(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, "fakeid", cred.AccessKeyID)
+		require.Equal(t, "fakesecretkey", cred.SecretAccessKey)
+		require.Equal(t, "faketoken", cred.SessionToken)
+		require.Equal(t, "radiusucp", cred.Source)
+		require.True(t, cred.CanExpire)
+		require.Equal(t, expiry.Unix(), cred.Expires.Unix())
+	})
+
+	t.Run("irsa credential without token file", func(t *testing.T) {
+		t.Setenv(webIdentityTokenFileEnv, "")
+
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind:    datamodel.AWSIRSACredentialKind,
+				RoleARN: "arn:aws:iam::000000000000:role/radius",
+			},
+		}
+		cp := NewUCPCredentialProvider(p, DefaultExpireDuration)
+
+		_, err := cp.Retrieve(context.TODO())
+		require.Error(t, err)
+	})
+
+	t.Run("irsa credential without role", func(t *testing.T) {
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind: datamodel.AWSIRSACredentialKind,
+			},
+		}
+		cp := NewUCPCredentialProvider(p, DefaultExpireDuration)
+
+		_, err := cp.Retrieve(context.TODO())
+		require.Error(t, err)
+	})
+}
+
+type mockSTSClient struct {
+	expiry time.Time
+}
+
+func (m *mockSTSClient) AssumeRoleWithWebIdentity(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	return &sts.AssumeRoleWithWebIdentityOutput{
+		Credentials: &types.Credentials{
+			AccessKeyId:     aws.String("fakeid"),
+			SecretAccessKey: aws.String("fakesecretkey"),
+			SessionToken:    aws.String("faketoken"),
+			Expiration:      aws.Time(m.expiry),
+		},
+	}, nil
 }
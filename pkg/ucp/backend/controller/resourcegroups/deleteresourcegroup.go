@@ -0,0 +1,289 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// deletePollInterval is the interval between checks for the completion of a resource deletion.
+	deletePollInterval = time.Second * 5
+)
+
+var _ ctrl.Controller = (*DeleteResourceGroupController)(nil)
+
+// DeleteResourceGroupController is the async operation controller to delete a resource group along with its resources.
+//
+// The resources are deleted in dependency order: a resource is deleted before the resources it references, eg: the
+// containers of an application are deleted before the application, which is deleted before its environment.
+type DeleteResourceGroupController struct {
+	ctrl.BaseController
+
+	// client is the HTTP client used to make requests to the downstream APIs.
+	client *http.Client
+
+	// pollInterval is the interval between checks for the completion of a resource deletion. This can be modified for testing.
+	pollInterval time.Duration
+}
+
+// NewDeleteResourceGroupController creates a new DeleteResourceGroupController which is used to delete resource groups asynchronously.
+func NewDeleteResourceGroupController(opts ctrl.Options) (ctrl.Controller, error) {
+	transport := otelhttp.NewTransport(http.DefaultTransport)
+	return &DeleteResourceGroupController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		client:         &http.Client{Transport: transport},
+		pollInterval:   deletePollInterval,
+	}, nil
+}
+
+// trackedResource is a resource of the resource group being deleted.
+type trackedResource struct {
+	id         resources.ID
+	trackingID resources.ID
+	apiVersion string
+	downstream *url.URL
+}
+
+// Run deletes the resources of the resource group in dependency order, reporting the progress of the operation as each
+// resource is deleted, and then deletes the resource group.
+func (c *DeleteResourceGroupController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	operationID, err := resources.ParseResource(request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	resourceGroupID, err := resourcegroups.ResourceGroupIDFromDeleteOperation(operationID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	_, err = c.StorageClient().Get(ctx, resourceGroupID.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		// Nothing to do, the resource group was already deleted.
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	tracked, bodies, err := c.listResources(ctx, resourceGroupID)
+	if errors.Is(err, &resourcegroups.NotFoundError{}) {
+		return ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeNotFound, Message: err.Error(), Target: resourceGroupID.String()}), nil
+	} else if errors.Is(err, &resourcegroups.InvalidError{}) {
+		return ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeInvalid, Message: err.Error(), Target: resourceGroupID.String()}), nil
+	} else if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	ids := []resources.ID{}
+	for _, resource := range tracked {
+		ids = append(ids, resource.id)
+	}
+
+	references := map[string][]resources.ID{}
+	for key, body := range bodies {
+		references[key] = trackedresource.FindReferences(body, ids)
+	}
+
+	order := trackedresource.DeletionOrder(ids, references)
+	logger.Info("Deleting resource group", "resourceGroupID", resourceGroupID.String(), "resourceCount", len(order))
+
+	for i, id := range order {
+		resource := tracked[strings.ToLower(id.String())]
+
+		logger.Info("Deleting resource", "resourceID", id.String())
+		err := c.deleteResource(ctx, resource)
+		if err != nil {
+			return ctrl.NewFailedResult(v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: fmt.Sprintf("failed to delete resource %q: %v", id.String(), err),
+				Target:  id.String(),
+			}), nil
+		}
+
+		err = c.StorageClient().Delete(ctx, resource.trackingID.String())
+		if err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+			return ctrl.Result{}, err
+		}
+
+		// The resource group itself is the last item to delete, so it's not included in the progress of the resources.
+		percentComplete := float64(i+1) * 100 / float64(len(order)+1)
+		if err := ctrl.ReportProgress(ctx, percentComplete); err != nil {
+			logger.Error(err, "failed to report the progress of the resource group deletion", "percentComplete", percentComplete)
+		}
+	}
+
+	err = c.StorageClient().Delete(ctx, resourceGroupID.String())
+	if err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Completed deleting resource group", "resourceGroupID", resourceGroupID.String())
+	return ctrl.Result{}, nil
+}
+
+// listResources lists the tracked resources of the resource group along with their current state as reported by the
+// downstream APIs. Both results are keyed by the lower-cased resource ID. Resources that no longer exist are untracked.
+func (c *DeleteResourceGroupController) listResources(ctx context.Context, resourceGroupID resources.ID) (map[string]trackedResource, map[string]any, error) {
+	query := store.Query{
+		RootScope:    resourceGroupID.String(),
+		ResourceType: v20231001preview.ResourceType,
+	}
+
+	result, err := c.StorageClient().Query(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tracked := map[string]trackedResource{}
+	bodies := map[string]any{}
+	for _, item := range result.Items {
+		entry := datamodel.GenericResource{}
+		if err := item.As(&entry); err != nil {
+			return nil, nil, err
+		}
+
+		id, err := resources.ParseResource(entry.Properties.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		downstream, err := resourcegroups.ValidateDownstream(ctx, c.StorageClient(), id)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resource := trackedResource{id: id, trackingID: trackedresource.IDFor(id), apiVersion: entry.Properties.APIVersion, downstream: downstream}
+		body, err := c.fetch(ctx, resource)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if body == nil {
+			// The resource no longer exists.
+			err = c.StorageClient().Delete(ctx, resource.trackingID.String())
+			if err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+				return nil, nil, err
+			}
+
+			continue
+		}
+
+		key := strings.ToLower(id.String())
+		tracked[key] = resource
+		bodies[key] = body
+	}
+
+	return tracked, bodies, nil
+}
+
+// deleteResource deletes the resource through its downstream API and waits for the deletion to complete.
+func (c *DeleteResourceGroupController) deleteResource(ctx context.Context, resource trackedResource) error {
+	response, err := c.send(ctx, http.MethodDelete, resource)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusNoContent {
+		return nil
+	} else if response.StatusCode >= 400 {
+		return reportRequestFailure(response)
+	}
+
+	// The deletion may be processed asynchronously so wait until the resource no longer exists.
+	for {
+		body, err := c.fetch(ctx, resource)
+		if err != nil {
+			return err
+		} else if body == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.pollInterval):
+		}
+	}
+}
+
+// fetch retrieves the resource through its downstream API. Returns nil if the resource does not exist.
+func (c *DeleteResourceGroupController) fetch(ctx context.Context, resource trackedResource) (any, error) {
+	response, err := c.send(ctx, http.MethodGet, resource)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, nil
+	} else if response.StatusCode >= 400 {
+		return nil, reportRequestFailure(response)
+	}
+
+	var body any
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (c *DeleteResourceGroupController) send(ctx context.Context, method string, resource trackedResource) (*http.Response, error) {
+	destination := resource.downstream.JoinPath(resource.id.String())
+	query := destination.Query()
+	query.Set("api-version", resource.apiVersion)
+	destination.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, method, destination.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.client.Do(request)
+}
+
+func reportRequestFailure(response *http.Response) error {
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	return fmt.Errorf("request failed with status code %s: %s", response.Status, body)
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDownstream is a fake resource provider that stores resources in memory and records deletions.
+type fakeDownstream struct {
+	mutex     sync.Mutex
+	resources map[string]any
+	deleted   []string
+}
+
+func (f *fakeDownstream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	key := strings.ToLower(r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	body, ok := f.resources[key]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{}`))
+		return
+	}
+
+	if r.Method == http.MethodDelete {
+		delete(f.resources, key)
+		f.deleted = append(f.deleted, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func Test_DeleteResourceGroup(t *testing.T) {
+	resourceGroupID := "/planes/radius/local/resourceGroups/test-rg"
+	environmentID := resourceGroupID + "/providers/Applications.Core/environments/test-env"
+	applicationID := resourceGroupID + "/providers/Applications.Core/applications/test-app"
+	containerID := resourceGroupID + "/providers/Applications.Core/containers/test-container"
+	goneID := resourceGroupID + "/providers/Applications.Core/containers/gone"
+	operationID := "/planes/radius/local/providers/System.Resources/resourceGroups/test-rg"
+
+	setup := func(t *testing.T) (*DeleteResourceGroupController, store.StorageClient, *fakeDownstream, *[]float64) {
+		db, err := boltstore.Open(filepath.Join(t.TempDir(), "test.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = boltstore.Close(db) })
+
+		storageClient, err := boltstore.NewBoltClient(db)
+		require.NoError(t, err)
+
+		downstream := &fakeDownstream{resources: map[string]any{}}
+		server := httptest.NewServer(downstream)
+		t.Cleanup(server.Close)
+
+		ctx := context.Background()
+		plane := datamodel.RadiusPlane{Properties: datamodel.RadiusPlaneProperties{ResourceProviders: map[string]string{"Applications.Core": server.URL}}}
+		require.NoError(t, storageClient.Save(ctx, &store.Object{Metadata: store.Metadata{ID: "/planes/radius/local"}, Data: plane}))
+		require.NoError(t, storageClient.Save(ctx, &store.Object{Metadata: store.Metadata{ID: resourceGroupID}, Data: datamodel.ResourceGroup{}}))
+
+		resources := map[string]map[string]any{
+			environmentID: {},
+			applicationID: {"environment": environmentID},
+			containerID:   {"application": applicationID},
+			goneID:        nil,
+		}
+		for id, properties := range resources {
+			if properties != nil {
+				downstream.resources[strings.ToLower(id)] = map[string]any{"id": id, "properties": properties}
+			}
+
+			saveTrackedResource(t, storageClient, id)
+		}
+
+		c, err := NewDeleteResourceGroupController(controller.Options{StorageClient: storageClient})
+		require.NoError(t, err)
+		c.(*DeleteResourceGroupController).pollInterval = 0
+
+		progress := []float64{}
+		return c.(*DeleteResourceGroupController), storageClient, downstream, &progress
+	}
+
+	run := func(t *testing.T, c *DeleteResourceGroupController, progress *[]float64) (controller.Result, error) {
+		ctx := controller.WithProgressReporter(testcontext.New(t), func(ctx context.Context, percentComplete float64) error {
+			*progress = append(*progress, percentComplete)
+			return nil
+		})
+
+		return c.Run(ctx, &controller.Request{ResourceID: operationID})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		c, storageClient, downstream, progress := setup(t)
+
+		result, err := run(t, c, progress)
+		require.NoError(t, err)
+		require.Equal(t, controller.Result{}, result)
+
+		require.Equal(t, []string{containerID, applicationID, environmentID}, downstream.deleted)
+		require.Equal(t, []float64{25, 50, 75}, *progress)
+
+		_, err = storageClient.Get(context.Background(), resourceGroupID)
+		require.ErrorIs(t, err, &store.ErrNotFound{})
+
+		for _, id := range []string{environmentID, applicationID, containerID, goneID} {
+			_, err = storageClient.Get(context.Background(), trackedresource.IDFor(resources.MustParse(id)).String())
+			require.ErrorIs(t, err, &store.ErrNotFound{})
+		}
+	})
+
+	t.Run("resource group not found", func(t *testing.T) {
+		c, storageClient, downstream, progress := setup(t)
+		require.NoError(t, storageClient.Delete(context.Background(), resourceGroupID))
+
+		result, err := run(t, c, progress)
+		require.NoError(t, err)
+		require.Equal(t, controller.Result{}, result)
+		require.Empty(t, downstream.deleted)
+	})
+
+	t.Run("delete failure", func(t *testing.T) {
+		c, storageClient, downstream, progress := setup(t)
+		c.client = &http.Client{Transport: &failDeleteTransport{inner: http.DefaultTransport, path: strings.ToLower(applicationID)}}
+
+		result, err := run(t, c, progress)
+		require.NoError(t, err)
+		require.NotNil(t, result.Error)
+		require.Equal(t, v1.CodeInternal, result.Error.Code)
+		require.Equal(t, applicationID, result.Error.Target)
+
+		require.Equal(t, []string{containerID}, downstream.deleted)
+		require.Equal(t, []float64{25}, *progress)
+
+		// The resource group and the remaining resources are kept.
+		_, err = storageClient.Get(context.Background(), resourceGroupID)
+		require.NoError(t, err)
+		_, err = storageClient.Get(context.Background(), trackedresource.IDFor(resources.MustParse(applicationID)).String())
+		require.NoError(t, err)
+	})
+}
+
+// failDeleteTransport fails DELETE requests for the given path.
+type failDeleteTransport struct {
+	inner http.RoundTripper
+	path  string
+}
+
+func (f *failDeleteTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method == http.MethodDelete && strings.ToLower(r.URL.Path) == f.path {
+		recorder := httptest.NewRecorder()
+		recorder.Header().Set("Content-Type", "application/json")
+		recorder.WriteHeader(http.StatusInternalServerError)
+		_, _ = recorder.WriteString(`{"error":{"code":"Internal","message":"failed"}}`)
+		return recorder.Result(), nil
+	}
+
+	return f.inner.RoundTrip(r)
+}
+
+func saveTrackedResource(t *testing.T, storageClient store.StorageClient, id string) {
+	parsed := resources.MustParse(id)
+	trackingID := trackedresource.IDFor(parsed)
+	entry := datamodel.GenericResourceFromID(parsed, trackingID)
+	entry.Properties.APIVersion = "2023-10-01-preview"
+
+	err := storageClient.Save(context.Background(), &store.Object{Metadata: store.Metadata{ID: trackingID.String()}, Data: entry})
+	require.NoError(t, err)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialvalidation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"golang.org/x/oauth2/google"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// azureManagementScope is the scope of the access token requested to test-authenticate Azure credentials.
+	azureManagementScope = "https://management.azure.com/.default"
+
+	// webIdentityTokenFileEnv is the environment variable containing the path to the projected service account token
+	// used by IRSA credentials.
+	webIdentityTokenFileEnv = "AWS_WEB_IDENTITY_TOKEN_FILE"
+
+	// defaultSTSRegion is the region used for the STS client when AWS_REGION is not set.
+	defaultSTSRegion = "us-east-1"
+
+	// gcpCloudPlatformScope is the scope of the access token requested to test-authenticate GCP credentials.
+	gcpCloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// Checker test-authenticates the credentials registered with UCP. The secret values of the credentials are resolved
+// from the credential storage before the checker is called.
+type Checker interface {
+	// CheckAzure test-authenticates the Azure credential and returns an error if the authentication fails.
+	CheckAzure(ctx context.Context, credential *datamodel.AzureCredentialProperties) error
+
+	// CheckAWS test-authenticates the AWS credential and returns an error if the authentication fails.
+	CheckAWS(ctx context.Context, credential *datamodel.AWSCredentialProperties) error
+
+	// CheckGCP test-authenticates the GCP credential and returns an error if the authentication fails.
+	CheckGCP(ctx context.Context, credential *datamodel.GCPCredentialProperties) error
+}
+
+var _ Checker = (*DefaultChecker)(nil)
+
+// DefaultChecker is the Checker which requests an Azure Resource Manager access token for Azure credentials, calls
+// STS GetCallerIdentity for AWS credentials and requests an OAuth2 access token for GCP credentials.
+type DefaultChecker struct{}
+
+// CheckAzure requests an Azure Resource Manager access token with the Azure service principal or workload identity
+// credential.
+func (c *DefaultChecker) CheckAzure(ctx context.Context, credential *datamodel.AzureCredentialProperties) error {
+	if credential.ClientID == "" || credential.TenantID == "" {
+		return errors.New("clientId and tenantId must be set")
+	}
+
+	var tokenCred azcore.TokenCredential
+	var err error
+	if credential.IsWorkloadIdentity() {
+		tokenCred, err = azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+			ClientID: credential.ClientID,
+			TenantID: credential.TenantID,
+		})
+	} else {
+		if credential.ClientSecret == "" {
+			return errors.New("clientSecret must be set")
+		}
+		tokenCred, err = azidentity.NewClientSecretCredential(credential.TenantID, credential.ClientID, credential.ClientSecret, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = tokenCred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{azureManagementScope}})
+	return err
+}
+
+// CheckAWS calls STS GetCallerIdentity with the AWS access key or IRSA credential.
+func (c *DefaultChecker) CheckAWS(ctx context.Context, credential *datamodel.AWSCredentialProperties) error {
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = defaultSTSRegion
+	}
+
+	var provider aws.CredentialsProvider
+	if credential.IsIRSA() {
+		if credential.RoleARN == "" {
+			return errors.New("roleARN must be set")
+		}
+
+		tokenFile := os.Getenv(webIdentityTokenFileEnv)
+		if tokenFile == "" {
+			return fmt.Errorf("%s is not set", webIdentityTokenFileEnv)
+		}
+
+		provider = stscreds.NewWebIdentityRoleProvider(sts.New(sts.Options{Region: region}), credential.RoleARN, stscreds.IdentityTokenFile(tokenFile))
+	} else {
+		if credential.AccessKeyID == "" || credential.SecretAccessKey == "" {
+			return errors.New("accessKeyId and secretAccessKey must be set")
+		}
+
+		provider = credentials.NewStaticCredentialsProvider(credential.AccessKeyID, credential.SecretAccessKey, "")
+	}
+
+	client := sts.New(sts.Options{Region: region, Credentials: aws.NewCredentialsCache(provider)})
+	_, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	return err
+}
+
+// CheckGCP requests an OAuth2 access token with the GCP service account key.
+func (c *DefaultChecker) CheckGCP(ctx context.Context, credential *datamodel.GCPCredentialProperties) error {
+	if credential.ServiceAccountKey == "" {
+		return errors.New("serviceAccountKey must be set")
+	}
+
+	creds, err := google.CredentialsFromJSON(ctx, []byte(credential.ServiceAccountKey), gcpCloudPlatformScope)
+	if err != nil {
+		return err
+	}
+
+	_, err = creds.TokenSource.Token()
+	return err
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialvalidation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultChecker_CheckAzure_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		credential *datamodel.AzureCredentialProperties
+		err        string
+	}{
+		{
+			name:       "missing client id",
+			credential: &datamodel.AzureCredentialProperties{Kind: datamodel.AzureCredentialKind, TenantID: "tenant-id", ClientSecret: "secret"},
+			err:        "clientId and tenantId must be set",
+		},
+		{
+			name:       "missing client secret",
+			credential: &datamodel.AzureCredentialProperties{Kind: datamodel.AzureCredentialKind, ClientID: "client-id", TenantID: "tenant-id"},
+			err:        "clientSecret must be set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&DefaultChecker{}).CheckAzure(context.Background(), tt.credential)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestDefaultChecker_CheckAWS_Invalid(t *testing.T) {
+	t.Setenv(webIdentityTokenFileEnv, "")
+
+	tests := []struct {
+		name       string
+		credential *datamodel.AWSCredentialProperties
+		err        string
+	}{
+		{
+			name:       "missing secret access key",
+			credential: &datamodel.AWSCredentialProperties{Kind: datamodel.AWSCredentialKind, AccessKeyID: "access-key-id"},
+			err:        "accessKeyId and secretAccessKey must be set",
+		},
+		{
+			name:       "missing role arn",
+			credential: &datamodel.AWSCredentialProperties{Kind: datamodel.AWSIRSACredentialKind},
+			err:        "roleARN must be set",
+		},
+		{
+			name:       "missing web identity token file",
+			credential: &datamodel.AWSCredentialProperties{Kind: datamodel.AWSIRSACredentialKind, RoleARN: "arn:aws:iam::000000000000:role/radius"},
+			err:        "AWS_WEB_IDENTITY_TOKEN_FILE is not set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&DefaultChecker{}).CheckAWS(context.Background(), tt.credential)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestDefaultChecker_CheckGCP_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		credential *datamodel.GCPCredentialProperties
+		err        string
+	}{
+		{
+			name:       "missing service account key",
+			credential: &datamodel.GCPCredentialProperties{Kind: datamodel.GCPCredentialKind},
+			err:        "serviceAccountKey must be set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&DefaultChecker{}).CheckGCP(context.Background(), tt.credential)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialvalidation
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ServiceOptions represents the options of the credential validation service.
+type ServiceOptions struct {
+	// Config is the configuration of the credential validation.
+	Config Options
+
+	// StorageProviderOptions is the options of the UCP data store.
+	StorageProviderOptions dataprovider.StorageProviderOptions
+
+	// SecretProviderOptions is the options of the internal secret store of UCP.
+	SecretProviderOptions provider.SecretProviderOptions
+
+	// Checker test-authenticates the credentials. Defaults to DefaultChecker.
+	Checker Checker
+}
+
+// Service is the hosting service which periodically validates the credentials registered with UCP.
+type Service struct {
+	Options ServiceOptions
+}
+
+// NewService creates a new credential validation service with the given options.
+func NewService(options ServiceOptions) *Service {
+	return &Service{
+		Options: options,
+	}
+}
+
+// Name returns the name of the credential validation service.
+func (s *Service) Name() string {
+	return "Credential Validator"
+}
+
+// Run validates the registered credentials when the service starts and then at every configured interval until the
+// context is cancelled. A failed validation is logged and does not stop the service.
+func (s *Service) Run(ctx context.Context) error {
+	storageProvider := dataprovider.NewStorageProvider(s.Options.StorageProviderOptions)
+	defer storageProvider.Close()
+
+	storageClient, err := storageProvider.GetStorageClient(ctx, "ucp")
+	if err != nil {
+		return err
+	}
+
+	secretProvider := provider.NewSecretProvider(s.Options.SecretProviderOptions)
+	defer secretProvider.Close()
+
+	secretClient, err := secretProvider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	checker := s.Options.Checker
+	if checker == nil {
+		checker = &DefaultChecker{}
+	}
+
+	interval := s.Options.Config.Interval
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+
+	validator := NewValidator(storageClient, secretClient, s.Options.SecretProviderOptions.Vault, checker, s.Options.Config.ExpiryWarningWindow)
+	return runPeriodically(ctx, interval, validator.ValidateAll)
+}
+
+func runPeriodically(ctx context.Context, interval time.Duration, fn func(ctx context.Context) error) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := fn(ctx); err != nil {
+			logger.Error(err, "failed to validate credentials")
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("Credential validator stopped...")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
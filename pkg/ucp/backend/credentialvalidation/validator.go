@@ -0,0 +1,327 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialvalidation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultInterval is the default interval between validations of the registered credentials.
+	DefaultInterval = time.Hour
+
+	// DefaultExpiryWarningWindow is the default duration before the expiry of a credential during which the
+	// credential is reported as expiring.
+	DefaultExpiryWarningWindow = 7 * 24 * time.Hour
+)
+
+// credentialTypes is the list of credential resource types validated by the Validator.
+var credentialTypes = []string{
+	v20231001preview.AzureCredentialType,
+	v20231001preview.AWSCredentialType,
+	v20231001preview.GCPCredentialType,
+}
+
+// healthStates is the list of the health states reported in the credential metrics.
+var healthStates = []string{
+	datamodel.CredentialHealthHealthy,
+	datamodel.CredentialHealthUnhealthy,
+	datamodel.CredentialHealthExpired,
+}
+
+// Options represents the options of the background credential validation.
+type Options struct {
+	// Enabled enables the background validation of the registered credentials.
+	Enabled bool `yaml:"enabled"`
+
+	// Interval is the interval between validations of the registered credentials. Defaults to DefaultInterval.
+	Interval time.Duration `yaml:"interval,omitempty"`
+
+	// ExpiryWarningWindow is the duration before the expiry of a credential during which the credential is reported
+	// as expiring. Defaults to DefaultExpiryWarningWindow.
+	ExpiryWarningWindow time.Duration `yaml:"expiryWarningWindow,omitempty"`
+}
+
+// Validator test-authenticates the credentials registered with UCP and records their health in the credential
+// resources.
+type Validator struct {
+	// StorageClient is the client of the UCP data store.
+	StorageClient store.StorageClient
+
+	// SecretClient is the client of the internal secret store of UCP.
+	SecretClient secret.Client
+
+	// Checker test-authenticates the credentials.
+	Checker Checker
+
+	// ExpiryWarningWindow is the duration before the expiry of a credential during which the credential is reported
+	// as expiring.
+	ExpiryWarningWindow time.Duration
+
+	now func() time.Time
+
+	// VaultOptions is the configuration of the Vault server the credentials may use.
+	VaultOptions vault.Options
+
+	// newVaultClient creates the secret client of the Vault server for the given address and mount.
+	newVaultClient func(options vault.Options, address string, mount string) (secret.Client, error)
+}
+
+// NewValidator creates a new Validator which reads the credentials from the given data store and secret store, and
+// from the Vault server configured by vaultOptions.
+func NewValidator(storageClient store.StorageClient, secretClient secret.Client, vaultOptions vault.Options, checker Checker, expiryWarningWindow time.Duration) *Validator {
+	if expiryWarningWindow == 0 {
+		expiryWarningWindow = DefaultExpiryWarningWindow
+	}
+
+	return &Validator{
+		StorageClient:       storageClient,
+		SecretClient:        secretClient,
+		Checker:             checker,
+		ExpiryWarningWindow: expiryWarningWindow,
+		VaultOptions:        vaultOptions,
+		now:                 time.Now,
+		newVaultClient:      vault.NewClient,
+	}
+}
+
+// ValidateAll validates all the registered credentials, records their health in the credential resources and records
+// the credential metrics. The validation of a credential does not stop the validation of the others; the errors are
+// returned together once all credentials are processed.
+func (v *Validator) ValidateAll(ctx context.Context) error {
+	now := v.now().UTC()
+
+	var errs []error
+	for _, resourceType := range credentialTypes {
+		if err := v.validateResourceType(ctx, resourceType, now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (v *Validator) validateResourceType(ctx context.Context, resourceType string, now time.Time) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	counts := map[string]int{}
+	expiring := 0
+
+	var errs []error
+	query := store.Query{RootScope: "/planes", ScopeRecursive: true, ResourceType: resourceType}
+	token := ""
+	for {
+		result, err := v.StorageClient.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", resourceType, err)
+		}
+
+		for i := range result.Items {
+			obj := &result.Items[i]
+			status, expiresAt, err := v.validate(ctx, resourceType, obj, now)
+			if err != nil {
+				logger.Error(err, "failed to validate credential", "id", obj.ID)
+				errs = append(errs, fmt.Errorf("failed to validate credential %s: %w", obj.ID, err))
+				continue
+			}
+
+			counts[status.Health]++
+			if status.Health != datamodel.CredentialHealthExpired && expiresAt != nil && expiresAt.Before(now.Add(v.ExpiryWarningWindow)) {
+				expiring++
+			}
+			metrics.DefaultCredentialMetrics.RecordCredentialValidation(ctx, resourceType, status.Health)
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		token = result.PaginationToken
+	}
+
+	for _, health := range healthStates {
+		metrics.DefaultCredentialMetrics.RecordCredentialCount(ctx, resourceType, health, counts[health])
+	}
+	metrics.DefaultCredentialMetrics.RecordExpiringCredentialCount(ctx, resourceType, expiring)
+
+	return errors.Join(errs...)
+}
+
+// validate validates the credential stored in the given object and saves its status. It returns the new status and
+// the expiry of the credential.
+func (v *Validator) validate(ctx context.Context, resourceType string, obj *store.Object, now time.Time) (*datamodel.CredentialStatus, *time.Time, error) {
+	var resource any
+	var status **datamodel.CredentialStatus
+	var expiresAt *time.Time
+	var check func() error
+
+	switch resourceType {
+	case v20231001preview.AzureCredentialType:
+		cred := &datamodel.AzureCredential{}
+		if err := obj.As(cred); err != nil {
+			return nil, nil, err
+		}
+		if cred.Properties == nil {
+			return nil, nil, errors.New("credential properties are not set")
+		}
+		resource, status, expiresAt = cred, &cred.Properties.Status, cred.Properties.ExpiresAt
+		check = func() error { return v.checkAzure(ctx, cred.Properties) }
+	case v20231001preview.AWSCredentialType:
+		cred := &datamodel.AWSCredential{}
+		if err := obj.As(cred); err != nil {
+			return nil, nil, err
+		}
+		if cred.Properties == nil {
+			return nil, nil, errors.New("credential properties are not set")
+		}
+		resource, status, expiresAt = cred, &cred.Properties.Status, cred.Properties.ExpiresAt
+		check = func() error { return v.checkAWS(ctx, cred.Properties) }
+	case v20231001preview.GCPCredentialType:
+		cred := &datamodel.GCPCredential{}
+		if err := obj.As(cred); err != nil {
+			return nil, nil, err
+		}
+		if cred.Properties == nil {
+			return nil, nil, errors.New("credential properties are not set")
+		}
+		resource, status, expiresAt = cred, &cred.Properties.Status, cred.Properties.ExpiresAt
+		check = func() error { return v.checkGCP(ctx, cred.Properties) }
+	default:
+		return nil, nil, fmt.Errorf("unsupported credential type %s", resourceType)
+	}
+
+	health, message := datamodel.CredentialHealthHealthy, ""
+	if datamodel.IsExpired(expiresAt, now) {
+		// Expired credentials are not test-authenticated.
+		health, message = datamodel.CredentialHealthExpired, fmt.Sprintf("The credential expired at %s.", expiresAt.UTC().Format(time.RFC3339))
+	} else if err := check(); err != nil {
+		health, message = datamodel.CredentialHealthUnhealthy, err.Error()
+	}
+
+	updated := &datamodel.CredentialStatus{}
+	if *status != nil {
+		*updated = **status
+	}
+	updated.Health = health
+	updated.Message = message
+	updated.LastCheckedAt = &now
+	*status = updated
+
+	// A concurrency error means that the credential was updated during the validation. The updated credential is
+	// validated in the next run.
+	err := v.StorageClient.Save(ctx, &store.Object{Metadata: store.Metadata{ID: obj.ID}, Data: resource}, store.WithETag(obj.ETag))
+	if err != nil && !errors.Is(err, &store.ErrConcurrency{}) {
+		return nil, nil, err
+	}
+
+	return updated, expiresAt, nil
+}
+
+func (v *Validator) checkAzure(ctx context.Context, props *datamodel.AzureCredentialResourceProperties) error {
+	if props.AzureCredential == nil {
+		return errors.New("azure credential properties are not set")
+	}
+
+	credential := &datamodel.AzureCredentialProperties{
+		ClientID: props.AzureCredential.ClientID,
+		TenantID: props.AzureCredential.TenantID,
+	}
+	if props.Kind == datamodel.AzureCredentialKind {
+		if err := v.resolveSecret(ctx, props.Storage, credential); err != nil {
+			return err
+		}
+	}
+	credential.Kind = props.Kind
+
+	return v.Checker.CheckAzure(ctx, credential)
+}
+
+func (v *Validator) checkAWS(ctx context.Context, props *datamodel.AWSCredentialResourceProperties) error {
+	if props.AWSCredential == nil {
+		return errors.New("aws credential properties are not set")
+	}
+
+	credential := &datamodel.AWSCredentialProperties{
+		AccessKeyID: props.AWSCredential.AccessKeyID,
+		RoleARN:     props.AWSCredential.RoleARN,
+	}
+	if props.Kind == datamodel.AWSCredentialKind {
+		if err := v.resolveSecret(ctx, props.Storage, credential); err != nil {
+			return err
+		}
+	}
+	credential.Kind = props.Kind
+
+	return v.Checker.CheckAWS(ctx, credential)
+}
+
+func (v *Validator) checkGCP(ctx context.Context, props *datamodel.GCPCredentialResourceProperties) error {
+	credential := &datamodel.GCPCredentialProperties{}
+	if err := v.resolveSecret(ctx, props.Storage, credential); err != nil {
+		return err
+	}
+	credential.Kind = props.Kind
+
+	return v.Checker.CheckGCP(ctx, credential)
+}
+
+// resolveSecret reads the secret values of the credential from the credential storage into out.
+func (v *Validator) resolveSecret(ctx context.Context, storage *datamodel.CredentialStorageProperties, out any) error {
+	var client secret.Client
+	var name string
+
+	switch {
+	case storage == nil || storage.Kind == "" || storage.Kind == datamodel.InternalStorageKind:
+		if storage == nil || storage.InternalCredential == nil || storage.InternalCredential.SecretName == "" {
+			return errors.New("unspecified SecretName for internal storage")
+		}
+		client, name = v.SecretClient, storage.InternalCredential.SecretName
+	case storage.Kind == datamodel.VaultStorageKind:
+		if storage.VaultCredential == nil {
+			return errors.New("unspecified address or path for vault storage")
+		}
+		vaultClient, err := v.newVaultClient(v.VaultOptions, storage.VaultCredential.Address, storage.VaultCredential.Mount)
+		if err != nil {
+			return err
+		}
+		client, name = vaultClient, storage.VaultCredential.Path
+	default:
+		return fmt.Errorf("unsupported credential storage kind %s", storage.Kind)
+	}
+
+	data, err := client.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to get credential info: %w", err)
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to parse credential info: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialvalidation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testNow     = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	testCreated = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type fakeChecker struct {
+	azureErr error
+	awsErr   error
+	gcpErr   error
+
+	azure []*datamodel.AzureCredentialProperties
+	aws   []*datamodel.AWSCredentialProperties
+	gcp   []*datamodel.GCPCredentialProperties
+}
+
+func (c *fakeChecker) CheckAzure(ctx context.Context, credential *datamodel.AzureCredentialProperties) error {
+	c.azure = append(c.azure, credential)
+	return c.azureErr
+}
+
+func (c *fakeChecker) CheckAWS(ctx context.Context, credential *datamodel.AWSCredentialProperties) error {
+	c.aws = append(c.aws, credential)
+	return c.awsErr
+}
+
+func (c *fakeChecker) CheckGCP(ctx context.Context, credential *datamodel.GCPCredentialProperties) error {
+	c.gcp = append(c.gcp, credential)
+	return c.gcpErr
+}
+
+// toObject converts the resource to a store object with the data decoded as the data store does.
+func toObject(t *testing.T, id string, resource any) store.Object {
+	b, err := json.Marshal(resource)
+	require.NoError(t, err)
+	data := map[string]any{}
+	require.NoError(t, json.Unmarshal(b, &data))
+	return store.Object{Metadata: store.Metadata{ID: id, ETag: "etag"}, Data: data}
+}
+
+func newAzureCredential(id string) *datamodel.AzureCredential {
+	return &datamodel.AzureCredential{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: id, Name: "default", Type: v20231001preview.AzureCredentialType},
+		},
+		Properties: &datamodel.AzureCredentialResourceProperties{
+			Kind: datamodel.AzureCredentialKind,
+			AzureCredential: &datamodel.AzureCredentialProperties{
+				Kind:     datamodel.AzureCredentialKind,
+				ClientID: "client-id",
+				TenantID: "tenant-id",
+			},
+			Storage: &datamodel.CredentialStorageProperties{
+				Kind:               datamodel.InternalStorageKind,
+				InternalCredential: &datamodel.InternalCredentialStorageProperties{SecretName: "azure-azurecloud-default"},
+			},
+			Status: &datamodel.CredentialStatus{
+				CreatedAt: &testCreated,
+				RotatedAt: &testCreated,
+				Health:    datamodel.CredentialHealthUnknown,
+			},
+		},
+	}
+}
+
+func newAWSCredential(id string) *datamodel.AWSCredential {
+	return &datamodel.AWSCredential{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: id, Name: "default", Type: v20231001preview.AWSCredentialType},
+		},
+		Properties: &datamodel.AWSCredentialResourceProperties{
+			Kind: datamodel.AWSCredentialKind,
+			AWSCredential: &datamodel.AWSCredentialProperties{
+				Kind:        datamodel.AWSCredentialKind,
+				AccessKeyID: "access-key-id",
+			},
+			Storage: &datamodel.CredentialStorageProperties{
+				Kind:               datamodel.InternalStorageKind,
+				InternalCredential: &datamodel.InternalCredentialStorageProperties{SecretName: "aws-aws-default"},
+			},
+		},
+	}
+}
+
+func newGCPCredential(id string) *datamodel.GCPCredential {
+	return &datamodel.GCPCredential{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: id, Name: "default", Type: v20231001preview.GCPCredentialType},
+		},
+		Properties: &datamodel.GCPCredentialResourceProperties{
+			Kind:          datamodel.GCPCredentialKind,
+			GCPCredential: &datamodel.GCPCredentialProperties{Kind: datamodel.GCPCredentialKind},
+			Storage: &datamodel.CredentialStorageProperties{
+				Kind:               datamodel.InternalStorageKind,
+				InternalCredential: &datamodel.InternalCredentialStorageProperties{SecretName: "gcp-gcp-default"},
+			},
+		},
+	}
+}
+
+func setupQuery(mockStorageClient *store.MockStorageClient, items map[string][]store.Object) {
+	mockStorageClient.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			return &store.ObjectQueryResult{Items: items[query.ResourceType]}, nil
+		}).Times(len(credentialTypes))
+}
+
+func newTestValidator(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient, checker Checker) *Validator {
+	v := NewValidator(mockStorageClient, mockSecretClient, vault.Options{Address: "https://vault.example.com:8200"}, checker, 0)
+	v.now = func() time.Time { return testNow }
+	return v
+}
+
+func Test_ValidateAll(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mockStorageClient := store.NewMockStorageClient(mctrl)
+	mockSecretClient := secret.NewMockClient(mctrl)
+
+	azureID := "/planes/azure/azurecloud/providers/System.Azure/credentials/default"
+	awsID := "/planes/aws/aws/providers/System.AWS/credentials/default"
+	expiredID := "/planes/aws/expired/providers/System.AWS/credentials/default"
+	gcpID := "/planes/gcp/gcp/providers/System.GCP/credentials/default"
+
+	expired := newAWSCredential(expiredID)
+	expired.Properties.ExpiresAt = to.Ptr(testNow.Add(-time.Hour))
+
+	setupQuery(mockStorageClient, map[string][]store.Object{
+		v20231001preview.AzureCredentialType: {toObject(t, azureID, newAzureCredential(azureID))},
+		v20231001preview.AWSCredentialType: {
+			toObject(t, awsID, newAWSCredential(awsID)),
+			toObject(t, expiredID, expired),
+		},
+		v20231001preview.GCPCredentialType: {toObject(t, gcpID, newGCPCredential(gcpID))},
+	})
+
+	mockSecretClient.EXPECT().Get(gomock.Any(), "azure-azurecloud-default").
+		Return([]byte(`{"clientId":"client-id","tenantId":"tenant-id","clientSecret":"secret"}`), nil)
+	mockSecretClient.EXPECT().Get(gomock.Any(), "aws-aws-default").
+		Return([]byte(`{"accessKeyId":"access-key-id","secretAccessKey":"secret-access-key"}`), nil)
+	mockSecretClient.EXPECT().Get(gomock.Any(), "gcp-gcp-default").
+		Return([]byte(`{"serviceAccountKey":"{\"type\":\"service_account\"}"}`), nil)
+
+	saved := map[string]*store.Object{}
+	mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			saved[obj.ID] = obj
+			return nil
+		}).Times(4)
+
+	checker := &fakeChecker{awsErr: errors.New("InvalidClientTokenId")}
+	err := newTestValidator(mockStorageClient, mockSecretClient, checker).ValidateAll(context.Background())
+	require.NoError(t, err)
+
+	// The secret values are resolved from the credential storage before the check.
+	require.Len(t, checker.azure, 1)
+	require.Equal(t, "secret", checker.azure[0].ClientSecret)
+	require.Equal(t, datamodel.AzureCredentialKind, checker.azure[0].Kind)
+	require.Len(t, checker.aws, 1, "expired credentials must not be checked")
+	require.Equal(t, "secret-access-key", checker.aws[0].SecretAccessKey)
+	require.Len(t, checker.gcp, 1)
+	require.Equal(t, `{"type":"service_account"}`, checker.gcp[0].ServiceAccountKey)
+	require.Equal(t, datamodel.GCPCredentialKind, checker.gcp[0].Kind)
+
+	azure := saved[azureID].Data.(*datamodel.AzureCredential)
+	require.Equal(t, &datamodel.CredentialStatus{
+		CreatedAt:     &testCreated,
+		RotatedAt:     &testCreated,
+		Health:        datamodel.CredentialHealthHealthy,
+		LastCheckedAt: &testNow,
+	}, azure.Properties.Status)
+
+	aws := saved[awsID].Data.(*datamodel.AWSCredential)
+	require.Equal(t, datamodel.CredentialHealthUnhealthy, aws.Properties.Status.Health)
+	require.Equal(t, "InvalidClientTokenId", aws.Properties.Status.Message)
+	require.Equal(t, testNow, *aws.Properties.Status.LastCheckedAt)
+
+	expiredAWS := saved[expiredID].Data.(*datamodel.AWSCredential)
+	require.Equal(t, datamodel.CredentialHealthExpired, expiredAWS.Properties.Status.Health)
+	require.Equal(t, "The credential expired at 2024-05-31T23:00:00Z.", expiredAWS.Properties.Status.Message)
+
+	gcp := saved[gcpID].Data.(*datamodel.GCPCredential)
+	require.Equal(t, datamodel.CredentialHealthHealthy, gcp.Properties.Status.Health)
+}
+
+func Test_ValidateAll_WorkloadIdentity(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mockStorageClient := store.NewMockStorageClient(mctrl)
+	mockSecretClient := secret.NewMockClient(mctrl)
+
+	id := "/planes/azure/azurecloud/providers/System.Azure/credentials/default"
+	cred := newAzureCredential(id)
+	cred.Properties.Kind = datamodel.AzureWorkloadIdentityCredentialKind
+	cred.Properties.AzureCredential.Kind = datamodel.AzureWorkloadIdentityCredentialKind
+
+	setupQuery(mockStorageClient, map[string][]store.Object{
+		v20231001preview.AzureCredentialType: {toObject(t, id, cred)},
+	})
+	mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	// Workload identity credentials have no secret to resolve.
+	checker := &fakeChecker{}
+	err := newTestValidator(mockStorageClient, mockSecretClient, checker).ValidateAll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, checker.azure, 1)
+	require.True(t, checker.azure[0].IsWorkloadIdentity())
+}
+
+func Test_ValidateAll_Vault(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mockStorageClient := store.NewMockStorageClient(mctrl)
+	mockSecretClient := secret.NewMockClient(mctrl)
+	mockVaultClient := secret.NewMockClient(mctrl)
+
+	id := "/planes/azure/azurecloud/providers/System.Azure/credentials/default"
+	cred := newAzureCredential(id)
+	cred.Properties.Storage = &datamodel.CredentialStorageProperties{
+		Kind: datamodel.VaultStorageKind,
+		VaultCredential: &datamodel.VaultCredentialStorageProperties{
+			Address: "https://vault.example.com:8200",
+			Mount:   datamodel.DefaultVaultMount,
+			Path:    "azure/credentials",
+		},
+	}
+
+	setupQuery(mockStorageClient, map[string][]store.Object{
+		v20231001preview.AzureCredentialType: {toObject(t, id, cred)},
+	})
+	mockVaultClient.EXPECT().Get(gomock.Any(), "azure/credentials").Return([]byte(`{"clientSecret":"vault-secret"}`), nil)
+	mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	checker := &fakeChecker{}
+	v := newTestValidator(mockStorageClient, mockSecretClient, checker)
+	v.newVaultClient = func(options vault.Options, address string, mount string) (secret.Client, error) {
+		require.Equal(t, "https://vault.example.com:8200", address)
+		require.NoError(t, options.Validate(address, mount))
+		return mockVaultClient, nil
+	}
+
+	err := v.ValidateAll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, checker.azure, 1)
+	require.Equal(t, "client-id", checker.azure[0].ClientID)
+	require.Equal(t, "vault-secret", checker.azure[0].ClientSecret)
+}
+
+func Test_ValidateAll_VaultNotAllowed(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mockStorageClient := store.NewMockStorageClient(mctrl)
+	mockSecretClient := secret.NewMockClient(mctrl)
+
+	id := "/planes/azure/azurecloud/providers/System.Azure/credentials/default"
+	cred := newAzureCredential(id)
+	cred.Properties.Storage = &datamodel.CredentialStorageProperties{
+		Kind: datamodel.VaultStorageKind,
+		VaultCredential: &datamodel.VaultCredentialStorageProperties{
+			Address: "https://attacker.example.com",
+			Path:    "azure/credentials",
+		},
+	}
+
+	setupQuery(mockStorageClient, map[string][]store.Object{
+		v20231001preview.AzureCredentialType: {toObject(t, id, cred)},
+	})
+
+	var saved *datamodel.AzureCredential
+	mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			saved = obj.Data.(*datamodel.AzureCredential)
+			return nil
+		})
+
+	// The credential is not read from a Vault server other than the configured one.
+	checker := &fakeChecker{}
+	err := newTestValidator(mockStorageClient, mockSecretClient, checker).ValidateAll(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, checker.azure)
+	require.Equal(t, datamodel.CredentialHealthUnhealthy, saved.Properties.Status.Health)
+	require.Contains(t, saved.Properties.Status.Message, "is not the configured Vault address")
+}
+
+func Test_ValidateAll_SecretNotFound(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mockStorageClient := store.NewMockStorageClient(mctrl)
+	mockSecretClient := secret.NewMockClient(mctrl)
+
+	id := "/planes/aws/aws/providers/System.AWS/credentials/default"
+	setupQuery(mockStorageClient, map[string][]store.Object{
+		v20231001preview.AWSCredentialType: {toObject(t, id, newAWSCredential(id))},
+	})
+	mockSecretClient.EXPECT().Get(gomock.Any(), "aws-aws-default").Return(nil, &secret.ErrNotFound{})
+
+	var saved *datamodel.AWSCredential
+	mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			saved = obj.Data.(*datamodel.AWSCredential)
+			return nil
+		})
+
+	checker := &fakeChecker{}
+	err := newTestValidator(mockStorageClient, mockSecretClient, checker).ValidateAll(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, checker.aws)
+	require.Equal(t, datamodel.CredentialHealthUnhealthy, saved.Properties.Status.Health)
+	require.Equal(t, "failed to get credential info: "+(&secret.ErrNotFound{}).Error(), saved.Properties.Status.Message)
+	// The registration time of credentials created before the status was recorded is unknown.
+	require.Nil(t, saved.Properties.Status.CreatedAt)
+}
+
+func Test_ValidateAll_Errors(t *testing.T) {
+	t.Run("concurrent update is ignored", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mockStorageClient := store.NewMockStorageClient(mctrl)
+
+		id := "/planes/azure/azurecloud/providers/System.Azure/credentials/default"
+		cred := newAzureCredential(id)
+		cred.Properties.ExpiresAt = to.Ptr(testNow)
+
+		setupQuery(mockStorageClient, map[string][]store.Object{
+			v20231001preview.AzureCredentialType: {toObject(t, id, cred)},
+		})
+		mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.ErrConcurrency{})
+
+		err := newTestValidator(mockStorageClient, nil, &fakeChecker{}).ValidateAll(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("query failure", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mockStorageClient := store.NewMockStorageClient(mctrl)
+		mockStorageClient.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("query failed")).Times(len(credentialTypes))
+
+		err := newTestValidator(mockStorageClient, nil, &fakeChecker{}).ValidateAll(context.Background())
+		require.ErrorContains(t, err, "failed to list System.Azure/credentials: query failed")
+		require.ErrorContains(t, err, "failed to list System.AWS/credentials: query failed")
+		require.ErrorContains(t, err, "failed to list System.GCP/credentials: query failed")
+	})
+
+	t.Run("save failure", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mockStorageClient := store.NewMockStorageClient(mctrl)
+
+		id := "/planes/azure/azurecloud/providers/System.Azure/credentials/default"
+		cred := newAzureCredential(id)
+		cred.Properties.ExpiresAt = to.Ptr(testNow)
+
+		setupQuery(mockStorageClient, map[string][]store.Object{
+			v20231001preview.AzureCredentialType: {toObject(t, id, cred)},
+		})
+		mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("save failed"))
+
+		err := newTestValidator(mockStorageClient, nil, &fakeChecker{}).ValidateAll(context.Background())
+		require.ErrorContains(t, err, "failed to validate credential "+id+": save failed")
+	})
+}
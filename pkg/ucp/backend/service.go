@@ -65,6 +65,9 @@ func (w *Service) Run(ctx context.Context) error {
 		if w.Options.Config.WorkerServer.MaxOperationConcurrency != nil {
 			workerOpts.MaxOperationConcurrency = *w.Options.Config.WorkerServer.MaxOperationConcurrency
 		}
+		if w.Options.Config.WorkerServer.MaxOperationConcurrencyPerTenant != nil {
+			workerOpts.MaxOperationConcurrencyPerTenant = *w.Options.Config.WorkerServer.MaxOperationConcurrencyPerTenant
+		}
 		if w.Options.Config.WorkerServer.MaxOperationRetryCount != nil {
 			workerOpts.MaxOperationRetryCount = *w.Options.Config.WorkerServer.MaxOperationRetryCount
 		}
@@ -89,5 +92,10 @@ func RegisterControllers(ctx context.Context, registry *worker.ControllerRegistr
 		return err
 	}
 
+	err = registry.Register(ctx, v20231001preview.ResourceGroupType, v1.OperationDelete, resourcegroups.NewDeleteResourceGroupController, opts)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
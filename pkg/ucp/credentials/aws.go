@@ -18,6 +18,7 @@ package credentials
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -25,16 +26,18 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
 	ucpapi "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/pkg/ucp/secret/provider"
 )
 
 var _ CredentialProvider[AWSCredential] = (*AWSCredentialProvider)(nil)
 
-// AWSCredentialProvider is UCP credential provider for Azure.
+// AWSCredentialProvider is UCP credential provider for AWS.
 type AWSCredentialProvider struct {
 	secretProvider *provider.SecretProvider
 	client         *ucpapi.AwsCredentialsClient
+	external       *externalStorage
 }
 
 // NewAWSCredentialProvider creates a new AWSCredentialProvider struct using the given SecretProvider, UCP connection and
@@ -48,39 +51,65 @@ func NewAWSCredentialProvider(provider *provider.SecretProvider, ucpConn sdk.Con
 	return &AWSCredentialProvider{
 		secretProvider: provider,
 		client:         cli,
+		external:       newExternalStorage(provider.VaultOptions()),
 	}, nil
 }
 
-// Fetch fetches the AWS IAM access keys from UCP and then from an internal storage (e.g.
-// Kubernetes secret store). It returns an AWSCredential struct or an error if the fetch fails.
+// Fetch fetches the AWS IAM access keys or IRSA role from UCP and then from the credential storage (e.g. Kubernetes
+// secret store or HashiCorp Vault). Credentials read from an external secret store are cached for the configured TTL.
+// It returns an AWSCredential struct or an error if the fetch fails.
 func (p *AWSCredentialProvider) Fetch(ctx context.Context, planeName, name string) (*AWSCredential, error) {
-	// 1. Fetch the secret name of AWS IAM access keys from UCP.
+	// 1. Fetch the storage properties of AWS credentials from UCP.
 	cred, err := p.client.Get(ctx, planeName, name, &ucpapi.AwsCredentialsClientGetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	// We support only kubernetes secret, but we may support multiple secret stores.
-	var storage *ucpapi.InternalCredentialStorageProperties
+	var base *AWSCredential
+	var storage ucpapi.CredentialStoragePropertiesClassification
 
-	switch p := cred.Properties.(type) {
+	switch c := cred.Properties.(type) {
 	case *ucpapi.AwsAccessKeyCredentialProperties:
-		switch c := p.Storage.(type) {
-		case *ucpapi.InternalCredentialStorageProperties:
-			storage = c
-		default:
-			return nil, errors.New("invalid AWSAccessKeyCredentialProperties")
+		base = &AWSCredential{
+			Kind:        ucp_dm.AWSCredentialKind,
+			AccessKeyID: to.String(c.AccessKeyID),
 		}
+		storage = c.Storage
+	case *ucpapi.AwsIRSACredentialProperties:
+		base = &AWSCredential{
+			Kind:    ucp_dm.AWSIRSACredentialKind,
+			RoleARN: to.String(c.RoleARN),
+		}
+		storage = c.Storage
 	default:
-		return nil, errors.New("invalid InternalCredentialStorageProperties")
+		return nil, errors.New("invalid AWSCredentialProperties")
 	}
 
+	switch c := storage.(type) {
+	case *ucpapi.InternalCredentialStorageProperties:
+		// 2. Fetch the credential from internal storage (e.g. Kubernetes secret store)
+		return p.fetchInternal(ctx, c)
+	case *ucpapi.VaultCredentialStorageProperties:
+		// 2. Fetch the secret values from the external secret store and merge them with the properties from UCP.
+		data, err := p.external.fetch(ctx, c)
+		if err != nil {
+			return nil, errors.New("failed to get credential info: " + err.Error())
+		}
+		if err := json.Unmarshal(data, base); err != nil {
+			return nil, errors.New("failed to parse credential info: " + err.Error())
+		}
+		return base, nil
+	default:
+		return nil, errors.New("invalid CredentialStorageProperties")
+	}
+}
+
+func (p *AWSCredentialProvider) fetchInternal(ctx context.Context, storage *ucpapi.InternalCredentialStorageProperties) (*AWSCredential, error) {
 	secretName := to.String(storage.SecretName)
 	if secretName == "" {
 		return nil, errors.New("unspecified SecretName for internal storage")
 	}
 
-	// 2. Fetch the credential from internal storage (e.g. Kubernetes secret store)
 	secretClient, err := p.secretProvider.GetClient(ctx)
 	if err != nil {
 		return nil, err
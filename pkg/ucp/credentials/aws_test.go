@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/to"
+	ucpapi "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAWSCredentialProvider_Fetch_Vault(t *testing.T) {
+	ctx := testcontext.New(t)
+	storage := testVaultStorage()
+	storage.Path = to.Ptr("radius/aws")
+	conn := newTestUCPServer(t, &ucpapi.AwsCredentialResource{
+		Location: to.Ptr("global"),
+		Properties: &ucpapi.AwsAccessKeyCredentialProperties{
+			Kind:        to.Ptr(ucpapi.AWSCredentialKindAccessKey),
+			AccessKeyID: to.Ptr("access-key-id"),
+			Storage:     storage,
+		},
+	})
+
+	p, err := NewAWSCredentialProvider(nil, conn, &tokencredentials.AnonymousCredential{})
+	require.NoError(t, err)
+
+	client := secret.NewMockClient(gomock.NewController(t))
+	client.EXPECT().Get(gomock.Any(), "radius/aws").Return([]byte(`{"secretAccessKey":"secret"}`), nil).Times(1)
+	p.external, _ = newTestExternalStorage(t, client)
+
+	cred, err := p.Fetch(ctx, AWSPublic, "default")
+	require.NoError(t, err)
+	require.Equal(t, &AWSCredential{
+		Kind:            ucp_dm.AWSCredentialKind,
+		AccessKeyID:     "access-key-id",
+		SecretAccessKey: "secret",
+	}, cred)
+}
@@ -18,6 +18,7 @@ package credentials
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -25,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
 	ucpapi "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/pkg/ucp/secret/provider"
 )
@@ -35,6 +37,7 @@ var _ CredentialProvider[AzureCredential] = (*AzureCredentialProvider)(nil)
 type AzureCredentialProvider struct {
 	secretProvider *provider.SecretProvider
 	client         *ucpapi.AzureCredentialsClient
+	external       *externalStorage
 }
 
 // NewAzureCredentialProvider creates a new AzureCredentialProvider by creating a new AzureCredentialClient with the given
@@ -48,39 +51,67 @@ func NewAzureCredentialProvider(provider *provider.SecretProvider, ucpConn sdk.C
 	return &AzureCredentialProvider{
 		secretProvider: provider,
 		client:         cli,
+		external:       newExternalStorage(provider.VaultOptions()),
 	}, nil
 }
 
-// Fetch fetches the Azure service principal credentials from UCP and the internal storage (e.g.
-// Kubernetes secret store) and returns an AzureCredential struct. If an error occurs, an error is returned.
+// Fetch fetches the Azure service principal or workload identity credentials from UCP and the credential storage
+// (e.g. Kubernetes secret store or HashiCorp Vault) and returns an AzureCredential struct. Credentials read from an
+// external secret store are cached for the configured TTL. If an error occurs, an error is returned.
 func (p *AzureCredentialProvider) Fetch(ctx context.Context, planeName, name string) (*AzureCredential, error) {
-	// 1. Fetch the secret name of Azure service principal credentials from UCP.
+	// 1. Fetch the storage properties of Azure credentials from UCP.
 	cred, err := p.client.Get(ctx, planeName, name, &ucpapi.AzureCredentialsClientGetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	// We support only kubernetes secret, but we may support multiple secret stores.
-	var storage *ucpapi.InternalCredentialStorageProperties
+	var base *AzureCredential
+	var storage ucpapi.CredentialStoragePropertiesClassification
 
-	switch p := cred.Properties.(type) {
+	switch c := cred.Properties.(type) {
 	case *ucpapi.AzureServicePrincipalProperties:
-		switch c := p.Storage.(type) {
-		case *ucpapi.InternalCredentialStorageProperties:
-			storage = c
-		default:
-			return nil, errors.New("invalid AzureServicePrincipalProperties")
+		base = &AzureCredential{
+			Kind:     ucp_dm.AzureCredentialKind,
+			ClientID: to.String(c.ClientID),
+			TenantID: to.String(c.TenantID),
 		}
+		storage = c.Storage
+	case *ucpapi.AzureWorkloadIdentityProperties:
+		base = &AzureCredential{
+			Kind:     ucp_dm.AzureWorkloadIdentityCredentialKind,
+			ClientID: to.String(c.ClientID),
+			TenantID: to.String(c.TenantID),
+		}
+		storage = c.Storage
 	default:
-		return nil, errors.New("invalid InternalCredentialStorageProperties")
+		return nil, errors.New("invalid AzureCredentialProperties")
 	}
 
+	switch c := storage.(type) {
+	case *ucpapi.InternalCredentialStorageProperties:
+		// 2. Fetch the credential from internal storage (e.g. Kubernetes secret store)
+		return p.fetchInternal(ctx, c)
+	case *ucpapi.VaultCredentialStorageProperties:
+		// 2. Fetch the secret values from the external secret store and merge them with the properties from UCP.
+		data, err := p.external.fetch(ctx, c)
+		if err != nil {
+			return nil, errors.New("failed to get credential info: " + err.Error())
+		}
+		if err := json.Unmarshal(data, base); err != nil {
+			return nil, errors.New("failed to parse credential info: " + err.Error())
+		}
+		return base, nil
+	default:
+		return nil, errors.New("invalid CredentialStorageProperties")
+	}
+}
+
+func (p *AzureCredentialProvider) fetchInternal(ctx context.Context, storage *ucpapi.InternalCredentialStorageProperties) (*AzureCredential, error) {
 	secretName := to.String(storage.SecretName)
 	if secretName == "" {
 		return nil, errors.New("unspecified SecretName for internal storage")
 	}
 
-	// 2. Fetch the credential from internal storage (e.g. Kubernetes secret store)
 	secretClient, err := p.secretProvider.GetClient(ctx)
 	if err != nil {
 		return nil, err
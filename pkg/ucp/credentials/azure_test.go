@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	ucpapi "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newTestUCPServer returns a UCP stand-in serving the given credential resource for every request.
+func newTestUCPServer(t *testing.T, resource any) sdk.Connection {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resource)
+	}))
+	t.Cleanup(server.Close)
+
+	conn, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+	return conn
+}
+
+func TestAzureCredentialProvider_Fetch_Vault(t *testing.T) {
+	ctx := testcontext.New(t)
+	conn := newTestUCPServer(t, &ucpapi.AzureCredentialResource{
+		Location: to.Ptr("global"),
+		Properties: &ucpapi.AzureServicePrincipalProperties{
+			Kind:     to.Ptr(ucpapi.AzureCredentialKindServicePrincipal),
+			ClientID: to.Ptr("client-id"),
+			TenantID: to.Ptr("tenant-id"),
+			Storage:  testVaultStorage(),
+		},
+	})
+
+	p, err := NewAzureCredentialProvider(nil, conn, &tokencredentials.AnonymousCredential{})
+	require.NoError(t, err)
+
+	client := secret.NewMockClient(gomock.NewController(t))
+	client.EXPECT().Get(gomock.Any(), "radius/azure").Return([]byte(`{"clientSecret":"secret"}`), nil).Times(1)
+	p.external, _ = newTestExternalStorage(t, client)
+
+	cred, err := p.Fetch(ctx, AzureCloud, "default")
+	require.NoError(t, err)
+	require.Equal(t, &AzureCredential{
+		Kind:         ucp_dm.AzureCredentialKind,
+		ClientID:     "client-id",
+		TenantID:     "tenant-id",
+		ClientSecret: "secret",
+	}, cred)
+
+	// The second fetch is served from the cache.
+	_, err = p.Fetch(ctx, AzureCloud, "default")
+	require.NoError(t, err)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/to"
+	ucpapi "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
+)
+
+const (
+	// DefaultExternalStorageCacheTTL is the default duration for which credentials read from an external secret
+	// store are cached before they are read again.
+	DefaultExternalStorageCacheTTL = time.Minute
+)
+
+// externalStorage fetches the secret values of credentials from external secret stores (e.g. HashiCorp Vault) and
+// caches them so that every token refresh does not result in a call to the secret store. Rotating the secret in the
+// external store takes effect after the cache entry expires.
+type externalStorage struct {
+	ttl time.Duration
+	now func() time.Time
+
+	// vaultOptions is the configuration of the Vault server the credentials may use.
+	vaultOptions vault.Options
+
+	// newVaultClient creates the secret client of the Vault server for the given address and mount.
+	newVaultClient func(options vault.Options, address string, mount string) (secret.Client, error)
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value  []byte
+	expiry time.Time
+}
+
+func newExternalStorage(vaultOptions vault.Options) *externalStorage {
+	return &externalStorage{
+		ttl:            DefaultExternalStorageCacheTTL,
+		now:            time.Now,
+		vaultOptions:   vaultOptions,
+		newVaultClient: vault.NewClient,
+		cache:          map[string]cacheEntry{},
+	}
+}
+
+// fetch returns the secret values of the credential as a JSON object, reading them from the external secret store
+// if the cached value is missing or expired.
+func (e *externalStorage) fetch(ctx context.Context, storage ucpapi.CredentialStoragePropertiesClassification) ([]byte, error) {
+	var client secret.Client
+	var key, name string
+
+	switch c := storage.(type) {
+	case *ucpapi.VaultCredentialStorageProperties:
+		name = to.String(c.Path)
+		if to.String(c.Address) == "" || name == "" {
+			return nil, errors.New("unspecified address or path for vault storage")
+		}
+		key = strings.Join([]string{string(ucpapi.CredentialStorageKindVault), to.String(c.Address), to.String(c.Mount), name}, "|")
+
+		var err error
+		client, err = e.newVaultClient(e.vaultOptions, to.String(c.Address), to.String(c.Mount))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("unsupported external credential storage")
+	}
+
+	e.mu.Lock()
+	entry, ok := e.cache[key]
+	e.mu.Unlock()
+	if ok && e.now().Before(entry.expiry) {
+		return entry.value, nil
+	}
+
+	value, err := client.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	e.cache[key] = cacheEntry{value: value, expiry: e.now().Add(e.ttl)}
+	e.mu.Unlock()
+
+	return value, nil
+}
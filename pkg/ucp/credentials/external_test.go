@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/to"
+	ucpapi "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestExternalStorage(t *testing.T, client secret.Client) (*externalStorage, *time.Time) {
+	now := time.Now()
+	e := newExternalStorage(vault.Options{Address: "https://vault.example.com:8200"})
+	e.now = func() time.Time { return now }
+	e.newVaultClient = func(options vault.Options, address string, mount string) (secret.Client, error) {
+		if err := options.Validate(address, mount); err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+	return e, &now
+}
+
+func testVaultStorage() *ucpapi.VaultCredentialStorageProperties {
+	return &ucpapi.VaultCredentialStorageProperties{
+		Kind:    to.Ptr(ucpapi.CredentialStorageKindVault),
+		Address: to.Ptr("https://vault.example.com:8200"),
+		Mount:   to.Ptr("secret"),
+		Path:    to.Ptr("radius/azure"),
+	}
+}
+
+func TestExternalStorage_Fetch(t *testing.T) {
+	t.Run("caches until ttl expires", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		ctrl := gomock.NewController(t)
+		client := secret.NewMockClient(ctrl)
+		e, now := newTestExternalStorage(t, client)
+
+		client.EXPECT().Get(gomock.Any(), "radius/azure").Return([]byte(`{"clientSecret":"first"}`), nil).Times(1)
+		value, err := e.fetch(ctx, testVaultStorage())
+		require.NoError(t, err)
+		require.JSONEq(t, `{"clientSecret":"first"}`, string(value))
+
+		// Served from the cache.
+		*now = now.Add(DefaultExternalStorageCacheTTL / 2)
+		value, err = e.fetch(ctx, testVaultStorage())
+		require.NoError(t, err)
+		require.JSONEq(t, `{"clientSecret":"first"}`, string(value))
+
+		// The secret was rotated in the vault and the cache entry expired.
+		*now = now.Add(DefaultExternalStorageCacheTTL)
+		client.EXPECT().Get(gomock.Any(), "radius/azure").Return([]byte(`{"clientSecret":"second"}`), nil).Times(1)
+		value, err = e.fetch(ctx, testVaultStorage())
+		require.NoError(t, err)
+		require.JSONEq(t, `{"clientSecret":"second"}`, string(value))
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		ctrl := gomock.NewController(t)
+		client := secret.NewMockClient(ctrl)
+		e, _ := newTestExternalStorage(t, client)
+
+		client.EXPECT().Get(gomock.Any(), "radius/azure").Return(nil, &secret.ErrNotFound{}).Times(1)
+		_, err := e.fetch(ctx, testVaultStorage())
+		require.ErrorIs(t, err, &secret.ErrNotFound{})
+
+		client.EXPECT().Get(gomock.Any(), "radius/azure").Return([]byte(`{"clientSecret":"secret"}`), nil).Times(1)
+		_, err = e.fetch(ctx, testVaultStorage())
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid storage", func(t *testing.T) {
+		e, _ := newTestExternalStorage(t, nil)
+
+		storage := testVaultStorage()
+		storage.Path = nil
+		_, err := e.fetch(context.Background(), storage)
+		require.Error(t, err)
+
+		_, err = e.fetch(context.Background(), &ucpapi.InternalCredentialStorageProperties{})
+		require.Error(t, err)
+	})
+
+	t.Run("vault not allowed", func(t *testing.T) {
+		e, _ := newTestExternalStorage(t, nil)
+
+		storage := testVaultStorage()
+		storage.Address = to.Ptr("https://attacker.example.com")
+		_, err := e.fetch(context.Background(), storage)
+		require.ErrorIs(t, err, &secret.ErrInvalid{})
+
+		storage = testVaultStorage()
+		storage.Mount = to.Ptr("sys")
+		_, err = e.fetch(context.Background(), storage)
+		require.ErrorIs(t, err, &secret.ErrInvalid{})
+	})
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	ucpapi "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/provider"
+)
+
+var _ CredentialProvider[GCPCredential] = (*GCPCredentialProvider)(nil)
+
+// GCPCredentialProvider is UCP credential provider for GCP.
+type GCPCredentialProvider struct {
+	secretProvider *provider.SecretProvider
+	client         *ucpapi.GcpCredentialsClient
+	external       *externalStorage
+}
+
+// NewGCPCredentialProvider creates a new GCPCredentialProvider struct using the given SecretProvider, UCP connection and
+// TokenCredential, and returns it or an error if one occurs.
+func NewGCPCredentialProvider(provider *provider.SecretProvider, ucpConn sdk.Connection, credential azcore.TokenCredential) (*GCPCredentialProvider, error) {
+	cli, err := ucpapi.NewGcpCredentialsClient(credential, sdk.NewClientOptions(ucpConn))
+	if err != nil {
+		return nil, err
+	}
+
+	return &GCPCredentialProvider{
+		secretProvider: provider,
+		client:         cli,
+		external:       newExternalStorage(provider.VaultOptions()),
+	}, nil
+}
+
+// Fetch fetches the GCP service account key from UCP and then from the credential storage (e.g. Kubernetes secret
+// store or HashiCorp Vault). Credentials read from an external secret store are cached for the configured TTL.
+// It returns a GCPCredential struct or an error if the fetch fails.
+func (p *GCPCredentialProvider) Fetch(ctx context.Context, planeName, name string) (*GCPCredential, error) {
+	// 1. Fetch the storage properties of GCP credentials from UCP.
+	cred, err := p.client.Get(ctx, planeName, name, &ucpapi.GcpCredentialsClientGetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var storage ucpapi.CredentialStoragePropertiesClassification
+	switch c := cred.Properties.(type) {
+	case *ucpapi.GcpServiceAccountKeyCredentialProperties:
+		storage = c.Storage
+	default:
+		return nil, errors.New("invalid GCPCredentialProperties")
+	}
+
+	switch c := storage.(type) {
+	case *ucpapi.InternalCredentialStorageProperties:
+		// 2. Fetch the credential from internal storage (e.g. Kubernetes secret store)
+		return p.fetchInternal(ctx, c)
+	case *ucpapi.VaultCredentialStorageProperties:
+		// 2. Fetch the service account key from the external secret store.
+		data, err := p.external.fetch(ctx, c)
+		if err != nil {
+			return nil, errors.New("failed to get credential info: " + err.Error())
+		}
+		base := &GCPCredential{Kind: ucp_dm.GCPCredentialKind}
+		if err := json.Unmarshal(data, base); err != nil {
+			return nil, errors.New("failed to parse credential info: " + err.Error())
+		}
+		return base, nil
+	default:
+		return nil, errors.New("invalid CredentialStorageProperties")
+	}
+}
+
+func (p *GCPCredentialProvider) fetchInternal(ctx context.Context, storage *ucpapi.InternalCredentialStorageProperties) (*GCPCredential, error) {
+	secretName := to.String(storage.SecretName)
+	if secretName == "" {
+		return nil, errors.New("unspecified SecretName for internal storage")
+	}
+
+	secretClient, err := p.secretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := secret.GetSecret[GCPCredential](ctx, secretClient, secretName)
+	if err != nil {
+		return nil, errors.New("failed to get credential info: " + err.Error())
+	}
+
+	return &s, nil
+}
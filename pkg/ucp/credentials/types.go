@@ -28,6 +28,9 @@ const (
 
 	// AWSPublic represents the aws public cloud plane name for UCP.
 	AWSPublic = "aws"
+
+	// GCPPublic represents the gcp public cloud plane name for UCP.
+	GCPPublic = "gcp"
 )
 
 type (
@@ -35,6 +38,8 @@ type (
 	AzureCredential = ucp_dm.AzureCredentialProperties
 	// AWSCredential represents a credential for AWS IAM.
 	AWSCredential = ucp_dm.AWSCredentialProperties
+	// GCPCredential represents a credential for a GCP service account.
+	GCPCredential = ucp_dm.GCPCredentialProperties
 )
 
 // CredentialProvider is an UCP credential provider interface.
@@ -16,15 +16,38 @@ limitations under the License.
 
 package datamodel
 
-import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
 
 const (
 	// InternalStorageKind represents ucp credential storage type for internal credential type
 	InternalStorageKind = "Internal"
+	// VaultStorageKind represents ucp credential storage type for HashiCorp Vault KV version 2.
+	VaultStorageKind = "Vault"
+	// DefaultVaultMount is the default mount path of the Vault KV version 2 secret engine.
+	DefaultVaultMount = "secret"
 	// AzureCredentialKind represents ucp credential kind for azure credentials.
 	AzureCredentialKind = "ServicePrincipal"
+	// AzureWorkloadIdentityCredentialKind represents ucp credential kind for azure workload identity credentials.
+	AzureWorkloadIdentityCredentialKind = "WorkloadIdentity"
 	// AWSCredentialKind represents ucp credential kind for aws credentials.
 	AWSCredentialKind = "AccessKey"
+	// AWSIRSACredentialKind represents ucp credential kind for aws IAM roles for service accounts.
+	AWSIRSACredentialKind = "IRSA"
+	// GCPCredentialKind represents ucp credential kind for gcp service account key credentials.
+	GCPCredentialKind = "ServiceAccountKey"
+
+	// CredentialHealthUnknown represents the health of a credential which has not been validated yet.
+	CredentialHealthUnknown = "Unknown"
+	// CredentialHealthHealthy represents the health of a credential which authenticated successfully.
+	CredentialHealthHealthy = "Healthy"
+	// CredentialHealthUnhealthy represents the health of a credential which failed to authenticate.
+	CredentialHealthUnhealthy = "Unhealthy"
+	// CredentialHealthExpired represents the health of a credential which has expired.
+	CredentialHealthExpired = "Expired"
 )
 
 // Credential represents UCP Credential.
@@ -51,6 +74,18 @@ func (c *AWSCredential) ResourceTypeName() string {
 	return c.Type
 }
 
+// Credential represents UCP Credential.
+type GCPCredential struct {
+	v1.BaseResource
+
+	Properties *GCPCredentialResourceProperties `json:"properties,omitempty"`
+}
+
+// ResourceTypeName gives the type of ucp resource.
+func (c *GCPCredential) ResourceTypeName() string {
+	return c.Type
+}
+
 // Azure Credential Properties represents UCP Credential Properties.
 type AzureCredentialResourceProperties struct {
 	// Kind is the kind of azure credential resource.
@@ -59,6 +94,10 @@ type AzureCredentialResourceProperties struct {
 	AzureCredential *AzureCredentialProperties `json:"azureCredential,omitempty"`
 	// Storage contains the properties of the storage associated with the kind.
 	Storage *CredentialStorageProperties `json:"storage,omitempty"`
+	// ExpiresAt is the time at which the credential expires.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Status contains the rotation, expiry and health status of the credential.
+	Status *CredentialStatus `json:"status,omitempty"`
 }
 
 // AWS Credential Properties represents UCP Credential Properties.
@@ -69,24 +108,85 @@ type AWSCredentialResourceProperties struct {
 	AWSCredential *AWSCredentialProperties `json:"awsCredential,omitempty"`
 	// Storage contains the properties of the storage associated with the kind.
 	Storage *CredentialStorageProperties `json:"storage,omitempty"`
+	// ExpiresAt is the time at which the credential expires.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Status contains the rotation, expiry and health status of the credential.
+	Status *CredentialStatus `json:"status,omitempty"`
+}
+
+// GCP Credential Properties represents UCP Credential Properties.
+type GCPCredentialResourceProperties struct {
+	// Kind is the kind of gcp credential resource.
+	Kind string `json:"kind,omitempty"`
+	// GCPCredential is the gcp service account credentials.
+	GCPCredential *GCPCredentialProperties `json:"gcpCredential,omitempty"`
+	// Storage contains the properties of the storage associated with the kind.
+	Storage *CredentialStorageProperties `json:"storage,omitempty"`
+	// ExpiresAt is the time at which the credential expires.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Status contains the rotation, expiry and health status of the credential.
+	Status *CredentialStatus `json:"status,omitempty"`
 }
 
 // AzureCredentialProperties contains ucp Azure credential properties.
 type AzureCredentialProperties struct {
-	// TenantID represents the tenantId of azure service principal.
+	// Kind is the kind of azure credential. Credentials stored without a kind are service principals.
+	Kind string `json:"kind,omitempty"`
+	// TenantID represents the tenantId of azure service principal or workload identity.
 	TenantID string `json:"tenantId"`
-	// ClientID represents the clientId of azure service principal.
+	// ClientID represents the clientId of azure service principal or workload identity.
 	ClientID string `json:"clientId"`
 	// ClientSecret represents the client secret of service principal.
 	ClientSecret string `json:"clientSecret,omitempty"`
 }
 
+// IsWorkloadIdentity returns true if the credential is an azure workload identity credential.
+func (p *AzureCredentialProperties) IsWorkloadIdentity() bool {
+	return p.Kind == AzureWorkloadIdentityCredentialKind
+}
+
 // AWSCredentialProperties contains ucp AWS credential properties.
 type AWSCredentialProperties struct {
+	// Kind is the kind of aws credential. Credentials stored without a kind are access keys.
+	Kind string `json:"kind,omitempty"`
 	// AccessKeyID contains aws access key for iam.
 	AccessKeyID string `json:"accessKeyId"`
 	// SecretAccessKey contains secret access key for iam.
 	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+	// RoleARN contains the ARN of the IAM role assumed with the web identity token for IRSA.
+	RoleARN string `json:"roleARN,omitempty"`
+}
+
+// IsIRSA returns true if the credential is an aws IAM roles for service accounts credential.
+func (p *AWSCredentialProperties) IsIRSA() bool {
+	return p.Kind == AWSIRSACredentialKind
+}
+
+// GCPCredentialProperties contains ucp GCP credential properties.
+type GCPCredentialProperties struct {
+	// Kind is the kind of gcp credential.
+	Kind string `json:"kind,omitempty"`
+	// ServiceAccountKey contains the JSON key file contents of the gcp service account.
+	ServiceAccountKey string `json:"serviceAccountKey"`
+}
+
+// CredentialStatus contains the rotation, expiry and health status of ucp credential.
+type CredentialStatus struct {
+	// CreatedAt is the time at which the credential was first registered.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// RotatedAt is the time at which the credential was last registered or rotated.
+	RotatedAt *time.Time `json:"rotatedAt,omitempty"`
+	// Health is the health of the credential as of the last validation.
+	Health string `json:"health,omitempty"`
+	// LastCheckedAt is the time of the last validation.
+	LastCheckedAt *time.Time `json:"lastCheckedAt,omitempty"`
+	// Message is the reason for the health of the credential, if it is not healthy.
+	Message string `json:"message,omitempty"`
+}
+
+// IsExpired returns true if the credential expires at or before the given time.
+func IsExpired(expiresAt *time.Time, now time.Time) bool {
+	return expiresAt != nil && !now.Before(*expiresAt)
 }
 
 // CredentialStorageProperties contains ucp credential storage properties.
@@ -95,6 +195,8 @@ type CredentialStorageProperties struct {
 	Kind string `json:"kind"`
 	// InternalCredential represents ucp internal credential storage properties.
 	InternalCredential *InternalCredentialStorageProperties `json:"internalCredential,omitempty"`
+	// VaultCredential represents ucp HashiCorp Vault credential storage properties.
+	VaultCredential *VaultCredentialStorageProperties `json:"vaultCredential,omitempty"`
 }
 
 // InternalCredentialStorageProperties contains ucp internal credential storage properties.
@@ -102,3 +204,13 @@ type InternalCredentialStorageProperties struct {
 	// SecretName is the name of secret stored in ucp for the crendentials.
 	SecretName string `json:"secretName"`
 }
+
+// VaultCredentialStorageProperties contains ucp HashiCorp Vault credential storage properties.
+type VaultCredentialStorageProperties struct {
+	// Address is the address of the Vault server.
+	Address string `json:"address"`
+	// Mount is the mount path of the KV version 2 secret engine.
+	Mount string `json:"mount,omitempty"`
+	// Path is the path of the secret within the secret engine.
+	Path string `json:"path"`
+}
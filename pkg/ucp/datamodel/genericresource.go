@@ -66,6 +66,10 @@ type GenericResourceProperties struct {
 	// APIVersion is the version of the API that can be used to query the resource.
 	APIVersion string `json:"apiVersion"`
 
+	// Tags are the user-defined tags of the resource. Tags can be queried with the store filters using
+	// the "properties.tags.<key>" field.
+	Tags map[string]string `json:"tags,omitempty"`
+
 	// OperationID is the last operation that updated this entry. This is used when an operation
 	// is enqueued as a way to force a different Etag to be returned. This data doesn't need to be
 	// read or used, it's just acting as a "salt" for the Etag.
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// LockResourceType is the resource type of a management lock.
+	LockResourceType = "System.Authorization/locks"
+
+	// LockLevelCanNotDelete is the lock level which prevents the locked scope from being deleted.
+	LockLevelCanNotDelete = "CanNotDelete"
+	// LockLevelReadOnly is the lock level which prevents the locked scope from being modified or deleted.
+	LockLevelReadOnly = "ReadOnly"
+)
+
+// LockProperties is the properties of a management lock.
+type LockProperties struct {
+	// Level is the level of the lock.
+	Level string `json:"level"`
+
+	// Scope is the ID of the plane, resource group or resource the lock applies to.
+	Scope string `json:"scope"`
+
+	// Notes is a free-form description of why the lock exists.
+	Notes string `json:"notes,omitempty"`
+}
+
+// Lock is the representation of a management lock. Locks are stored at the plane level.
+type Lock struct {
+	v1.BaseResource
+
+	// Properties is the properties of the resource.
+	Properties LockProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the type of the lock as a string.
+func (l Lock) ResourceTypeName() string {
+	return LockResourceType
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// RoleAssignmentResourceType is the resource type of a role assignment.
+	RoleAssignmentResourceType = "System.Authorization/roleAssignments"
+
+	// RoleReader is the built-in role which allows reading resources.
+	RoleReader = "Reader"
+	// RoleContributor is the built-in role which allows reading, creating, updating and deleting resources.
+	RoleContributor = "Contributor"
+	// RoleOwner is the built-in role which allows all operations, including managing role assignments.
+	RoleOwner = "Owner"
+
+	// PrincipalTypeUser represents a role assignment to a user.
+	PrincipalTypeUser = "User"
+	// PrincipalTypeGroup represents a role assignment to a group of users.
+	PrincipalTypeGroup = "Group"
+)
+
+// RoleAssignmentProperties is the properties of a role assignment.
+type RoleAssignmentProperties struct {
+	// PrincipalID is the name of the user or group the role is assigned to.
+	PrincipalID string `json:"principalId"`
+
+	// PrincipalType is the kind of principal the role is assigned to.
+	PrincipalType string `json:"principalType"`
+
+	// RoleDefinitionName is the name of the built-in role which is assigned.
+	RoleDefinitionName string `json:"roleDefinitionName"`
+
+	// Scope is the ID of the plane, resource group or resource the role assignment applies to.
+	Scope string `json:"scope"`
+}
+
+// RoleAssignment is the representation of a role assignment. Role assignments are stored at the plane level.
+type RoleAssignment struct {
+	v1.BaseResource
+
+	// Properties is the properties of the resource.
+	Properties RoleAssignmentProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the type of the role assignment as a string.
+func (r RoleAssignment) ResourceTypeName() string {
+	return RoleAssignmentResourceType
+}
@@ -20,11 +20,13 @@ import (
 	context "context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/kubeutil"
 	store "github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/store/apiserverstore"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"github.com/radius-project/radius/pkg/ucp/store/cosmosdb"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -38,6 +40,7 @@ var storageClientFactory = map[StorageProviderType]storageFactoryFunc{
 	TypeAPIServer: initAPIServerClient,
 	TypeCosmosDB:  initCosmosDBClient,
 	TypeETCD:      InitETCDClient,
+	TypeBolt:      InitBoltClient,
 }
 
 func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
@@ -69,7 +72,8 @@ func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ stri
 		},
 	}
 
-	rc, err := runtimeclient.New(cfg, options)
+	// The watch-capable client allows the store to watch for changes natively.
+	rc, err := runtimeclient.NewWithWatch(cfg, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize APIServer client: %w", err)
 	}
@@ -78,11 +82,19 @@ func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ stri
 	return client, nil
 }
 
-func initCosmosDBClient(ctx context.Context, opt StorageProviderOptions, collectionName string) (store.StorageClient, error) {
+// cosmosDBCollectionName returns the name of the CosmosDB collection of the given resource type. The resource types of a
+// provider namespace, including its operation statuses, share a collection so that a resource and its operation status
+// can be committed in a single transaction.
+func cosmosDBCollectionName(resourceType string) string {
+	namespace, _, _ := strings.Cut(resourceType, "/")
+	return namespace
+}
+
+func initCosmosDBClient(ctx context.Context, opt StorageProviderOptions, resourceType string) (store.StorageClient, error) {
 	sopt := &cosmosdb.ConnectionOptions{
 		Url:                  opt.CosmosDB.Url,
 		DatabaseName:         opt.CosmosDB.Database,
-		CollectionName:       collectionName,
+		CollectionName:       cosmosDBCollectionName(resourceType),
 		MasterKey:            opt.CosmosDB.MasterKey,
 		CollectionThroughput: opt.CosmosDB.CollectionThroughput,
 	}
@@ -117,3 +129,18 @@ func InitETCDClient(ctx context.Context, opt StorageProviderOptions, _ string) (
 	etcdClient := etcdstore.NewETCDClient(client)
 	return etcdClient, nil
 }
+
+// InitBoltClient opens the embedded bbolt database configured by the options and returns a BoltClient.
+func InitBoltClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	db, err := boltstore.Open(opt.Bolt.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	client, err := boltstore.NewBoltClient(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	return client, nil
+}
@@ -40,6 +40,44 @@ func (m *MockDataStorageProvider) EXPECT() *MockDataStorageProviderMockRecorder
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockDataStorageProvider) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockDataStorageProviderMockRecorder) Close() *MockDataStorageProviderCloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDataStorageProvider)(nil).Close))
+	return &MockDataStorageProviderCloseCall{Call: call}
+}
+
+// MockDataStorageProviderCloseCall wrap *gomock.Call
+type MockDataStorageProviderCloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDataStorageProviderCloseCall) Return(arg0 error) *MockDataStorageProviderCloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDataStorageProviderCloseCall) Do(f func() error) *MockDataStorageProviderCloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDataStorageProviderCloseCall) DoAndReturn(f func() error) *MockDataStorageProviderCloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetStorageClient mocks base method.
 func (m *MockDataStorageProvider) GetStorageClient(arg0 context.Context, arg1 string) (store.StorageClient, error) {
 	m.ctrl.T.Helper()
@@ -34,6 +34,9 @@ type StorageProviderOptions struct {
 
 	// ETCD configures options for the etcd store. Will be ignored if another store is configured.
 	ETCD ETCDOptions `yaml:"etcd,omitempty"`
+
+	// Bolt configures options for the embedded bbolt store. Will be ignored if another store is configured.
+	Bolt BoltOptions `yaml:"bolt,omitempty"`
 }
 
 // APIServerOptions represents options for the configuring the Kubernetes APIServer store.
@@ -65,3 +68,12 @@ type ETCDOptions struct {
 	// We need a way to share state between the etcd service and the things that want to consume it. This is that.
 	Client *hosting.AsyncValue[etcdclient.Client] `yaml:"-"`
 }
+
+// BoltOptions represents options for the configuring the embedded bbolt store. This is not suitable for production use.
+type BoltOptions struct {
+	// Path configures the path of the database file. The file will be created if it does not exist.
+	//
+	// NOTE: the database file can only be opened once per process, the store, queue and secret clients
+	// configured with the same path share the same database.
+	Path string `yaml:"path"`
+}
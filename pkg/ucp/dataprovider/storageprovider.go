@@ -19,6 +19,7 @@ package dataprovider
 import (
 	"context"
 	"errors"
+	"io"
 	"sync"
 
 	"github.com/radius-project/radius/pkg/ucp/store"
@@ -86,3 +87,22 @@ func (p *storageProvider) GetStorageClient(ctx context.Context, resourceType str
 
 	return c, err
 }
+
+// Close closes the storage clients that hold resources, such as the file of the bolt database, and removes every client
+// from the provider. It is called when the service that owns the provider shuts down.
+func (p *storageProvider) Close() error {
+	p.clientsMu.Lock()
+	defer p.clientsMu.Unlock()
+
+	var errs []error
+	for cn, c := range p.clients {
+		if closer, ok := c.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		delete(p.clients, cn)
+	}
+
+	return errors.Join(errs...)
+}
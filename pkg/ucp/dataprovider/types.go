@@ -34,6 +34,9 @@ const (
 
 	// TypeETCD represents the etcd provider.
 	TypeETCD StorageProviderType = "etcd"
+
+	// TypeBolt represents the embedded bbolt file database provider.
+	TypeBolt StorageProviderType = "bolt"
 )
 
 //go:generate mockgen -typed -destination=./mock_datastorage_provider.go -package=dataprovider -self_package github.com/radius-project/radius/pkg/ucp/dataprovider github.com/radius-project/radius/pkg/ucp/dataprovider DataStorageProvider
@@ -42,4 +45,7 @@ const (
 type DataStorageProvider interface {
 	// GetStorageClient creates or gets storage client.
 	GetStorageClient(context.Context, string) (store.StorageClient, error)
+
+	// Close closes the storage clients created by the provider.
+	Close() error
 }
@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// authorizedPathPrefixes are the prefixes of the paths whose requests are authorized. Other paths, such as the discovery
+// documents required by the Kubernetes API server, are served to any caller.
+var authorizedPathPrefixes = []string{"/planes", "/admin"}
+
+// AuthorizeRequests is the middleware which authorizes the requests for UCP resources using the role assignments
+// evaluated by the authorizer. It responds with 401 Unauthorized when the request carries no caller identity or an
+// identity which can't be verified, and with 403 Forbidden when the caller is not allowed to perform the request.
+func AuthorizeRequests(pathBase string, options authorization.Options, authorizer *authorization.Authorizer) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			logger := ucplog.FromContextOrDiscard(ctx)
+
+			path, ok := trimPathBase(r.URL.Path, pathBase)
+			if !ok || !isAuthorizedPath(path) {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			var resp rest.Response
+			principal, ok, err := authorizer.Authenticate(r)
+			if err != nil {
+				logger.Info(fmt.Sprintf("failed to authenticate the caller of %s %s: %v", r.Method, path, err))
+				resp = rest.NewClientAuthenticationFailedARMResponse()
+			} else if !ok {
+				if options.AllowUnauthenticated {
+					h.ServeHTTP(w, r)
+					return
+				}
+				resp = rest.NewClientAuthenticationFailedARMResponse()
+			} else {
+				authorization.RecordCaller(ctx, principal)
+				allowed, err := authorizer.Authorize(ctx, principal, r.Method, path)
+				if err != nil {
+					logger.Error(err, "failed to authorize request")
+					resp = rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+						Error: v1.ErrorDetails{
+							Code:    v1.CodeInternal,
+							Message: err.Error(),
+						},
+					})
+				} else if !allowed {
+					logger.Info(fmt.Sprintf("principal %q is not authorized to %s %s", principal.User, r.Method, path))
+					resp = rest.NewForbiddenResponse(fmt.Sprintf("The principal %q does not have a role assignment which allows %s on %q.", principal.User, r.Method, path))
+				}
+			}
+
+			if resp == nil {
+				h.ServeHTTP(w, r.WithContext(authorization.WithRequestAuthorization(ctx, authorizer, principal)))
+				return
+			}
+
+			if err := resp.Apply(ctx, w, r); err != nil {
+				logger.Error(err, "failed to write response")
+			}
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+func trimPathBase(path string, pathBase string) (string, bool) {
+	if pathBase == "" {
+		return path, true
+	}
+
+	if !strings.HasPrefix(strings.ToLower(path), strings.ToLower(pathBase)) {
+		return path, false
+	}
+
+	return path[len(pathBase):], true
+}
+
+func isAuthorizedPath(path string) bool {
+	lower := strings.ToLower(path)
+	for _, prefix := range authorizedPathPrefixes {
+		if lower == prefix || strings.HasPrefix(lower, prefix+"/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func Test_AuthorizeRequests(t *testing.T) {
+	readerAssignment := store.Object{
+		Data: &datamodel.RoleAssignment{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   "/planes/radius/local/providers/System.Authorization/roleAssignments/reader",
+					Name: "reader",
+					Type: datamodel.RoleAssignmentResourceType,
+				},
+			},
+			Properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:        "alice",
+				PrincipalType:      datamodel.PrincipalTypeUser,
+				RoleDefinitionName: datamodel.RoleReader,
+				Scope:              "/planes/radius/local/resourceGroups/test-rg",
+			},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		method               string
+		path                 string
+		user                 string
+		allowUnauthenticated bool
+		queryErr             error
+		expectQuery          bool
+		expectedStatusCode   int
+		expectedCode         string
+	}{
+		{
+			name:               "unauthorized path is not checked",
+			method:             http.MethodGet,
+			path:               "/apis/api.ucp.dev/v1alpha3",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "missing identity",
+			method:             http.MethodGet,
+			path:               "/apis/api.ucp.dev/v1alpha3/planes/radius/local/resourceGroups/test-rg",
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedCode:       v1.CodeInvalidAuthenticationInfo,
+		},
+		{
+			name:                 "missing identity allowed",
+			method:               http.MethodGet,
+			path:                 "/apis/api.ucp.dev/v1alpha3/planes/radius/local/resourceGroups/test-rg",
+			allowUnauthenticated: true,
+			expectedStatusCode:   http.StatusOK,
+		},
+		{
+			name:               "administrator",
+			method:             http.MethodPut,
+			path:               "/apis/api.ucp.dev/v1alpha3/planes/radius/local/resourceGroups/test-rg",
+			user:               "admin",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "allowed by role assignment",
+			method:             http.MethodGet,
+			path:               "/apis/api.ucp.dev/v1alpha3/planes/radius/local/resourceGroups/test-rg",
+			user:               "alice",
+			expectQuery:        true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "denied by role assignment",
+			method:             http.MethodPut,
+			path:               "/apis/api.ucp.dev/v1alpha3/planes/radius/local/resourceGroups/test-rg",
+			user:               "alice",
+			expectQuery:        true,
+			expectedStatusCode: http.StatusForbidden,
+			expectedCode:       v1.CodeAuthorizationFailed,
+		},
+		{
+			name:               "storage failure",
+			method:             http.MethodGet,
+			path:               "/apis/api.ucp.dev/v1alpha3/planes/radius/local/resourceGroups/test-rg",
+			user:               "alice",
+			queryErr:           errors.New("store is down"),
+			expectQuery:        true,
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedCode:       v1.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			storageClient := store.NewMockStorageClient(ctrl)
+			if tt.expectQuery {
+				storageClient.EXPECT().
+					Query(gomock.Any(), gomock.Any()).
+					Return(&store.ObjectQueryResult{Items: []store.Object{readerAssignment}}, tt.queryErr)
+			}
+
+			options := authorization.Options{
+				Enabled:              true,
+				IdentitySource:       authorization.IdentitySourceHeader,
+				AdminUsers:           []string{"admin"},
+				AllowUnauthenticated: tt.allowUnauthenticated,
+			}
+			authorizer := authorization.NewAuthorizer(options, storageClient, nil, nil)
+
+			handler := AuthorizeRequests("/apis/api.ucp.dev/v1alpha3", options, authorizer)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.user != "" {
+				req.Header.Set(authorization.DefaultPrincipalHeader, tt.user)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedCode != "" {
+				require.Contains(t, w.Body.String(), tt.expectedCode)
+			}
+		})
+	}
+}
+
+func Test_AuthorizeRequests_UnverifiedIdentity(t *testing.T) {
+	// The identity headers of the Kubernetes API server are rejected unless they are verified, even when the requests
+	// without identity are allowed.
+	options := authorization.Options{Enabled: true, AllowUnauthenticated: true}
+	authorizer := authorization.NewAuthorizer(options, nil, &authorization.RequestHeaderConfig{
+		ClientCAs:       x509.NewCertPool(),
+		UsernameHeaders: []string{authorization.KubernetesUserHeader},
+	}, nil)
+
+	handler := AuthorizeRequests("", options, authorizer)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodPut, "/planes/radius/local/resourceGroups/test-rg", nil)
+	req.Header.Set(authorization.KubernetesUserHeader, "system:admin")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Contains(t, w.Body.String(), v1.CodeInvalidAuthenticationInfo)
+}
+
+type testAuditSink struct {
+	records []audit.Record
+}
+
+func (s *testAuditSink) Write(ctx context.Context, record audit.Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func Test_AuditRequests_AuthenticatedCaller(t *testing.T) {
+	// The audit middleware wraps the authorization middleware, and records the caller it authenticated even when the
+	// request is denied.
+	tests := []struct {
+		name           string
+		user           string
+		expectedCode   int
+		expectedCaller string
+	}{
+		{name: "allowed", user: "admin", expectedCode: http.StatusOK, expectedCaller: "admin"},
+		{name: "denied", user: "alice", expectedCode: http.StatusForbidden, expectedCaller: "alice"},
+		{name: "unauthenticated", expectedCode: http.StatusOK, expectedCaller: "unauthenticated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			storageClient := store.NewMockStorageClient(ctrl)
+			storageClient.EXPECT().
+				Query(gomock.Any(), gomock.Any()).
+				Return(&store.ObjectQueryResult{}, nil).
+				AnyTimes()
+
+			options := authorization.Options{
+				Enabled:              true,
+				IdentitySource:       authorization.IdentitySourceHeader,
+				AdminUsers:           []string{"admin"},
+				AllowUnauthenticated: true,
+			}
+			authorizer := authorization.NewAuthorizer(options, storageClient, nil, nil)
+
+			sink := &testAuditSink{}
+			handler := AuthorizeRequests("", options, authorizer)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			handler = servicecontext.ARMRequestCtx("", "global")(servicecontext.AuditRequests("ucp", sink)(handler))
+
+			req := httptest.NewRequest(http.MethodPut, "/planes/radius/local/resourceGroups/test-rg?api-version=2023-10-01-preview", nil)
+			if tt.user != "" {
+				req.Header.Set(authorization.DefaultPrincipalHeader, tt.user)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedCode, w.Code)
+			require.Len(t, sink.records, 1)
+			require.Equal(t, tt.expectedCaller, sink.records[0].Caller)
+		})
+	}
+}
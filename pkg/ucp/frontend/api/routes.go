@@ -26,6 +26,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	deadletters_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/deadletters"
 	kubernetes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/kubernetes"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
@@ -34,8 +35,9 @@ import (
 )
 
 const (
-	planeCollectionPath     = "/planes"
-	planeTypeCollectionPath = "/planes/{planeType}"
+	planeCollectionPath      = "/planes"
+	planeTypeCollectionPath  = "/planes/{planeType}"
+	deadLetterCollectionPath = "/admin/queues/{" + deadletters_ctrl.QueueNameParam + "}/deadletters"
 
 	// OperationTypeKubernetesOpenAPIV2Doc is the operation type for the required OpenAPI v2 discovery document.
 	//
@@ -52,6 +54,12 @@ const (
 
 	// OperationTypePlanes is the operation type for the planes (all types) collection.
 	OperationTypePlanes = "PLANES"
+
+	// OperationTypeDeadLetters is the operation type for the dead-letter queue admin APIs.
+	OperationTypeDeadLetters = "DEADLETTERS"
+
+	// OperationRequeue is the operation method for requeuing a message from the dead-letter queue.
+	OperationRequeue v1.OperationMethod = "REQUEUE"
 )
 
 func initModules(ctx context.Context, mods []modules.Initializer) (map[string]http.Handler, []string, error) {
@@ -138,6 +146,47 @@ func Register(ctx context.Context, router chi.Router, planeModules []modules.Ini
 		},
 	}...)
 
+	// Configures the admin routes to inspect and replay the dead-lettered async operations of the named queue.
+	if options.QueueProvider != nil {
+		deadLetterRouter := server.NewSubrouter(router, options.PathBase+deadLetterCollectionPath)
+		handlerOptions = append(handlerOptions, []server.HandlerOptions{
+			{
+				ParentRouter:  deadLetterRouter,
+				Method:        v1.OperationList,
+				OperationType: &v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationList},
+				ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+					return deadletters_ctrl.NewListDeadLetters(opts, options.QueueProvider)
+				},
+			},
+			{
+				ParentRouter:  deadLetterRouter,
+				Method:        v1.OperationDelete,
+				OperationType: &v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationDelete},
+				ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+					return deadletters_ctrl.NewPurgeDeadLetters(opts, options.QueueProvider)
+				},
+			},
+			{
+				ParentRouter:  deadLetterRouter,
+				Path:          "/{" + deadletters_ctrl.MessageIDParam + "}",
+				Method:        v1.OperationGet,
+				OperationType: &v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationGet},
+				ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+					return deadletters_ctrl.NewGetDeadLetter(opts, options.QueueProvider)
+				},
+			},
+			{
+				ParentRouter:  deadLetterRouter,
+				Path:          "/{" + deadletters_ctrl.MessageIDParam + "}/requeue",
+				Method:        OperationRequeue,
+				OperationType: &v1.OperationType{Type: OperationTypeDeadLetters, Method: OperationRequeue},
+				ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+					return deadletters_ctrl.NewRequeueDeadLetter(opts, options.QueueProvider)
+				},
+			},
+		}...)
+	}
+
 	ctrlOptions := controller.Options{
 		Address:      options.Address,
 		PathBase:     options.PathBase,
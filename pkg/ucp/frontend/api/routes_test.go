@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
+	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -56,6 +57,26 @@ func Test_Routes(t *testing.T) {
 			Method:        http.MethodGet,
 			Path:          "/planes",
 		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/admin/queues/applications.core/deadletters",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/admin/queues/applications.core/deadletters",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/admin/queues/applications.core/deadletters/00000000000000000001",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetters, Method: OperationRequeue},
+			Method:        http.MethodPost,
+			Path:          "/admin/queues/applications.core/deadletters/00000000000000000001/requeue",
+		},
 		{
 			// Should be passed to the module.
 			Method: http.MethodGet,
@@ -83,9 +104,10 @@ func Test_Routes(t *testing.T) {
 	dataProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 	options := modules.Options{
-		Address:      "localhost",
-		PathBase:     pathBase,
-		DataProvider: dataProvider,
+		Address:       "localhost",
+		PathBase:      pathBase,
+		DataProvider:  dataProvider,
+		QueueProvider: queueprovider.New(queueprovider.QueueProviderOptions{Name: "ucp", Provider: queueprovider.TypeInmemory}),
 	}
 
 	rpctest.AssertRouters(t, tests, pathBase, "", func(ctx context.Context) (chi.Router, error) {
@@ -19,6 +19,7 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -27,21 +28,26 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
+	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	aws_frontend "github.com/radius-project/radius/pkg/ucp/frontend/aws"
 	azure_frontend "github.com/radius-project/radius/pkg/ucp/frontend/azure"
+	gcp_frontend "github.com/radius-project/radius/pkg/ucp/frontend/gcp"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	radius_frontend "github.com/radius-project/radius/pkg/ucp/frontend/radius"
 	"github.com/radius-project/radius/pkg/ucp/frontend/versions"
 	"github.com/radius-project/radius/pkg/ucp/hosting"
 	"github.com/radius-project/radius/pkg/ucp/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp/locks"
 	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/rest"
@@ -53,6 +59,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
+	"k8s.io/client-go/kubernetes"
 )
 
 const (
@@ -94,6 +101,7 @@ func DefaultModules(options modules.Options) []modules.Initializer {
 	return []modules.Initializer{
 		aws_frontend.NewModule(options),
 		azure_frontend.NewModule(options),
+		gcp_frontend.NewModule(options),
 		radius_frontend.NewModule(options),
 	}
 }
@@ -163,6 +171,41 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 	}
 
 	app := http.Handler(r)
+
+	lockStorageClient, err := s.storageProvider.GetStorageClient(ctx, "ucp")
+	if err != nil {
+		return nil, err
+	}
+
+	// The lock middleware runs after the authorization middleware so that only the authorized requests are checked
+	// against the locks.
+	app = servicecontext.EnforceLocks(locks.NewChecker(lockStorageClient))(app)
+
+	var tlsConfig *tls.Config
+	if s.options.Config != nil && s.options.Config.Authorization.Enabled {
+		authorizer, err := s.newAuthorizer(ctx)
+		if err != nil {
+			return nil, err
+		}
+		app = AuthorizeRequests(s.options.PathBase, s.options.Config.Authorization, authorizer)(app)
+
+		// The Kubernetes API server authenticates with a client certificate, which the authorizer verifies before
+		// trusting the identity headers. The other callers don't have to present a certificate.
+		tlsConfig = &tls.Config{ClientAuth: tls.RequestClientCert}
+	}
+
+	// The audit middleware wraps the authorization middleware so that the denied requests are audited as well.
+	if s.options.Config != nil {
+		auditSink, err := audit.NewSink(ctx, s.options.Config.Audit, s.storageProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		if auditSink != nil {
+			app = servicecontext.AuditRequests("ucp", auditSink)(app)
+		}
+	}
+
 	app = servicecontext.ARMRequestCtx(s.options.PathBase, "global")(app)
 	app = middleware.WithLogger(app)
 
@@ -182,7 +225,8 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		// AWS SDK is case sensitive. Therefore, cannot use lowercase middleware. Therefore, introducing a new middleware that translates
 		// the path for only these segments and preserves the case for the other parts of the path.
 		// TODO: https://github.com/radius-project/radius/issues/5921
-		Handler: app,
+		Handler:   app,
+		TLSConfig: tlsConfig,
 		BaseContext: func(ln net.Listener) context.Context {
 			return ctx
 		},
@@ -190,6 +234,42 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 	return server, nil
 }
 
+// newAuthorizer creates the authorizer of the requests. When the identity source is 'kubernetes', the request header
+// configuration of the Kubernetes API server is loaded to verify the identity headers, and the bearer tokens of the
+// Radius components which call UCP directly are authenticated with the TokenReview API.
+func (s *Service) newAuthorizer(ctx context.Context) (*authorization.Authorizer, error) {
+	options := s.options.Config.Authorization
+
+	storageClient, err := s.storageProvider.GetStorageClient(ctx, "ucp")
+	if err != nil {
+		return nil, err
+	}
+
+	if options.IdentitySource == authorization.IdentitySourceHeader {
+		return authorization.NewAuthorizer(options, storageClient, nil, nil), nil
+	}
+
+	cfg, err := kubeutil.NewClientConfig(&kubeutil.ConfigOptions{
+		QPS:   kubeutil.DefaultServerQPS,
+		Burst: kubeutil.DefaultServerBurst,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubernetes config: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	requestHeader, err := authorization.LoadRequestHeaderConfig(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorization.NewAuthorizer(options, storageClient, requestHeader, authorization.NewKubernetesTokenReviewer(client)), nil
+}
+
 // configureDefaultPlanes reads the configuration file specified by the env var to configure default planes into UCP
 func (s *Service) configureDefaultPlanes(ctx context.Context) error {
 	for _, plane := range s.options.InitialPlanes {
@@ -242,6 +322,13 @@ func (s *Service) createPlane(ctx context.Context, plane rest.Plane) error {
 				ResponseConverter: converter.AzurePlaneDataModelToVersioned,
 			})
 
+	case "gcp":
+		ctrl, err = defaultoperation.NewDefaultSyncPut(opts,
+			armrpc_controller.ResourceOptions[datamodel.GCPPlane]{
+				RequestConverter:  converter.GCPPlaneDataModelFromVersioned,
+				ResponseConverter: converter.GCPPlaneDataModelToVersioned,
+			})
+
 	case "radius":
 		ctrl, err = defaultoperation.NewDefaultSyncPut(opts,
 			armrpc_controller.ResourceOptions[datamodel.RadiusPlane]{
@@ -280,11 +367,33 @@ func (s *Service) createPlane(ctx context.Context, plane rest.Plane) error {
 	return nil
 }
 
+// close closes the storage, queue and secret providers once the server has stopped, which releases the resources they
+// hold, such as the file of the bolt database.
+func (s *Service) close(ctx context.Context) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if s.storageProvider != nil {
+		if err := s.storageProvider.Close(); err != nil {
+			logger.Error(err, "failed to close the storage provider")
+		}
+	}
+	if s.queueProvider != nil {
+		if err := s.queueProvider.Close(); err != nil {
+			logger.Error(err, "failed to close the queue provider")
+		}
+	}
+	if s.secretProvider != nil {
+		if err := s.secretProvider.Close(); err != nil {
+			logger.Error(err, "failed to close the secret provider")
+		}
+	}
+}
+
 // Run sets up a server to listen on a given address, and shuts it down when the context is done. It returns an
 // error if the server fails to start or stops unexpectedly.
 func (s *Service) Run(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	service, err := s.Initialize(ctx)
+	defer s.close(ctx)
 	if err != nil {
 		return err
 	}
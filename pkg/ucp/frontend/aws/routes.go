@@ -267,7 +267,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			Method:       v1.OperationPut,
 			ResourceType: v20231001preview.AWSCredentialType,
 			ControllerFactory: func(o controller.Options) (controller.Controller, error) {
-				return aws_credential_ctrl.NewCreateOrUpdateAWSCredential(o, secretClient)
+				return aws_credential_ctrl.NewCreateOrUpdateAWSCredential(o, secretClient, m.options.SecretProvider.VaultOptions())
 			},
 		},
 		{
@@ -137,7 +137,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			Method:       v1.OperationPut,
 			ResourceType: v20231001preview.AzureCredentialType,
 			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
-				return azure_credential_ctrl.NewCreateOrUpdateAzureCredential(opt, secretClient)
+				return azure_credential_ctrl.NewCreateOrUpdateAzureCredential(opt, secretClient, m.options.SecretProvider.VaultOptions())
 			},
 		},
 		{
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditrecords
+
+import (
+	"context"
+	"fmt"
+	http "net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// ResourceIDQueryParameter is the query parameter used to pass the ID of the resource whose audit records are listed.
+	ResourceIDQueryParameter = "resourceId"
+)
+
+var _ armrpc_controller.Controller = (*ListAuditRecords)(nil)
+
+// ListAuditRecords is the controller implementation to list the audit records of a resource in a Radius plane.
+type ListAuditRecords struct {
+	armrpc_controller.BaseController
+}
+
+// NewListAuditRecords creates a new controller for listing the audit records of a resource.
+func NewListAuditRecords(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &ListAuditRecords{
+		BaseController: armrpc_controller.NewBaseController(opts),
+	}, nil
+}
+
+// Run returns the audit records of the resource passed in the resourceId query parameter, oldest first. The resource
+// must belong to the plane of the request.
+func (c *ListAuditRecords) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	planeID := serviceCtx.ResourceID.PlaneScope()
+
+	resourceID := req.URL.Query().Get(ResourceIDQueryParameter)
+	if resourceID == "" {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("The query parameter %q is required.", ResourceIDQueryParameter)), nil
+	}
+
+	if _, err := resources.Parse(resourceID); err != nil {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("The query parameter %q must be a valid resource ID: %v", ResourceIDQueryParameter, err)), nil
+	}
+
+	if !authorization.ScopeContains(planeID, resourceID) {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("The resource %q does not belong to the plane %q.", resourceID, planeID)), nil
+	}
+
+	records, err := audit.ListRecords(ctx, c.StorageClient(), resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := &v1.PaginatedList{Value: []any{}}
+	for _, record := range records {
+		items.Value = append(items.Value, record)
+	}
+
+	return armrpc_rest.NewOKResponse(items), nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditrecords
+
+import (
+	"context"
+	"encoding/json"
+	http "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	testCollectionPath = "/planes/radius/local/providers/System.Audit/auditRecords"
+	testResourceID     = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env"
+)
+
+func setup(t *testing.T, resourceID string) (*store.MockStorageClient, armrpc_controller.Controller, context.Context, *http.Request) {
+	ctrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(ctrl)
+
+	controller, err := NewListAuditRecords(armrpc_controller.Options{StorageClient: storageClient})
+	require.NoError(t, err)
+
+	id, err := resources.Parse(testCollectionPath)
+	require.NoError(t, err)
+	ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{ResourceID: id})
+
+	target := testCollectionPath + "?api-version=2023-10-01-preview"
+	if resourceID != "" {
+		target += "&" + ResourceIDQueryParameter + "=" + url.QueryEscape(resourceID)
+	}
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+
+	return storageClient, controller, ctx, req
+}
+
+func Test_ListAuditRecords(t *testing.T) {
+	storageClient, controller, ctx, req := setup(t, testResourceID)
+
+	record := audit.Record{
+		ID:         "00000000-0000-0000-0000-000000000001",
+		Timestamp:  time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+		Service:    "ucp",
+		Caller:     "alice",
+		Method:     http.MethodPut,
+		ResourceID: testResourceID,
+		StatusCode: http.StatusOK,
+		Result:     audit.ResultSucceeded,
+	}
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local/resourceGroups/test-rg", ResourceType: audit.RecordResourceType}).
+		Return(&store.ObjectQueryResult{Items: []store.Object{{Data: record}}}, nil)
+
+	w := httptest.NewRecorder()
+	response, err := controller.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	err = response.Apply(ctx, w, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	actual := struct {
+		Value []audit.Record `json:"value"`
+	}{}
+	err = json.Unmarshal(w.Body.Bytes(), &actual)
+	require.NoError(t, err)
+	require.Equal(t, []audit.Record{record}, actual.Value)
+}
+
+func Test_ListAuditRecords_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		resourceID string
+	}{
+		{
+			name:       "missing resource ID",
+			resourceID: "",
+		},
+		{
+			name:       "invalid resource ID",
+			resourceID: "not-a-resource-id",
+		},
+		{
+			name:       "resource in another plane",
+			resourceID: "/planes/radius/other/resourceGroups/test-rg",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, controller, ctx, req := setup(t, tt.resourceID)
+
+			w := httptest.NewRecorder()
+			response, err := controller.Run(ctx, w, req)
+			require.NoError(t, err)
+
+			err = response.Apply(ctx, w, req)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		})
+	}
+}
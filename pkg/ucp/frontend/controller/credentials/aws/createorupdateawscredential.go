@@ -17,7 +17,9 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -26,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
 	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
 )
 
 var _ armrpc_controller.Controller = (*CreateOrUpdateAWSCredential)(nil)
@@ -34,11 +37,13 @@ var _ armrpc_controller.Controller = (*CreateOrUpdateAWSCredential)(nil)
 type CreateOrUpdateAWSCredential struct {
 	armrpc_controller.Operation[*datamodel.AWSCredential, datamodel.AWSCredential]
 	secretClient secret.Client
+	vaultOptions vault.Options
+	now          func() time.Time
 }
 
 // NewCreateOrUpdateAWSCredential creates a new CreateOrUpdateAWSCredential controller which is used to create or update
 // AWS credentials in the secret store.
-func NewCreateOrUpdateAWSCredential(opts armrpc_controller.Options, secretClient secret.Client) (armrpc_controller.Controller, error) {
+func NewCreateOrUpdateAWSCredential(opts armrpc_controller.Options, secretClient secret.Client, vaultOptions vault.Options) (armrpc_controller.Controller, error) {
 	return &CreateOrUpdateAWSCredential{
 		Operation: armrpc_controller.NewOperation(opts,
 			armrpc_controller.ResourceOptions[datamodel.AWSCredential]{
@@ -47,11 +52,14 @@ func NewCreateOrUpdateAWSCredential(opts armrpc_controller.Options, secretClient
 			},
 		),
 		secretClient: secretClient,
+		vaultOptions: vaultOptions,
+		now:          time.Now,
 	}, nil
 }
 
 // CreateOrUpdateAWSCredential validates the request, saves the AWS credential secret, and saves the resource in the
-// metadata store. If an error occurs, it returns an error response.
+// metadata store. Credentials using external storage are not saved in the secret store. Every update is recorded as a
+// rotation of the credential. If an error occurs, it returns an error response.
 func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := c.GetResourceFromRequest(ctx, req)
@@ -59,7 +67,9 @@ func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWr
 		return nil, err
 	}
 
-	if newResource.Properties.Kind != datamodel.AWSCredentialKind {
+	switch newResource.Properties.Kind {
+	case datamodel.AWSCredentialKind, datamodel.AWSIRSACredentialKind:
+	default:
 		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind"), nil
 	}
 
@@ -72,20 +82,46 @@ func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWr
 		return r, err
 	}
 
-	secretName := credentials.GetSecretName(serviceCtx.ResourceID)
-	if newResource.Properties.Storage.Kind == datamodel.InternalStorageKind {
+	switch newResource.Properties.Storage.Kind {
+	case datamodel.InternalStorageKind:
+		secretName := credentials.GetSecretName(serviceCtx.ResourceID)
 		newResource.Properties.Storage.InternalCredential.SecretName = secretName
-	}
 
-	// Save the credential secret
-	err = secret.SaveSecret(ctx, c.secretClient, secretName, newResource.Properties.AWSCredential)
-	if err != nil {
-		return nil, err
+		// Save the credential secret
+		err = secret.SaveSecret(ctx, c.secretClient, secretName, newResource.Properties.AWSCredential)
+		if err != nil {
+			return nil, err
+		}
+	case datamodel.VaultStorageKind:
+		// UCP reads the secret values with its own Vault token, so only the configured Vault server and mounts are allowed.
+		vaultCredential := newResource.Properties.Storage.VaultCredential
+		if err := c.vaultOptions.Validate(vaultCredential.Address, vaultCredential.Mount); err != nil {
+			return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+		}
+
+		// The secret values are managed and rotated in the external secret store.
+		if newResource.Properties.AWSCredential.SecretAccessKey != "" {
+			return armrpc_rest.NewBadRequestResponse("The secret access key must be stored in the external secret store."), nil
+		}
+
+		// Remove the secret saved while the credential used the internal storage.
+		if old != nil && credentials.IsInternalStorage(old.Properties.Storage) {
+			err = c.secretClient.Delete(ctx, credentials.GetSecretName(serviceCtx.ResourceID))
+			if err != nil && !errors.Is(err, &secret.ErrNotFound{}) {
+				return nil, err
+			}
+		}
 	}
 
 	// Do not save the secret in metadata store.
 	newResource.Properties.AWSCredential.SecretAccessKey = ""
 
+	var oldStatus *datamodel.CredentialStatus
+	if old != nil {
+		oldStatus = old.Properties.Status
+	}
+	newResource.Properties.Status = credentials.NewCredentialStatus(oldStatus, c.now().UTC())
+
 	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
 	newEtag, err := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
 	if err != nil {
@@ -21,13 +21,16 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/test/testutil"
 
@@ -42,8 +45,9 @@ func Test_AWS_Credential(t *testing.T) {
 	mockStorageClient := store.NewMockStorageClient(mockCtrl)
 	mockSecretClient := secret.NewMockClient(mockCtrl)
 
-	credentialCtrl, err := NewCreateOrUpdateAWSCredential(armrpc_controller.Options{StorageClient: mockStorageClient}, mockSecretClient)
+	credentialCtrl, err := NewCreateOrUpdateAWSCredential(armrpc_controller.Options{StorageClient: mockStorageClient}, mockSecretClient, vault.Options{Address: "https://vault.example.com:8200"})
 	require.NoError(t, err)
+	credentialCtrl.(*CreateOrUpdateAWSCredential).now = func() time.Time { return testCredentialTime }
 
 	tests := []struct {
 		name       string
@@ -63,6 +67,24 @@ func Test_AWS_Credential(t *testing.T) {
 			fn:         setupCredentialSuccessMocks,
 			err:        nil,
 		},
+		{
+			name:       "test_irsa_credential_creation",
+			filename:   "aws-irsa-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/aws/awscloud/providers/System.AWS/credentials/default?api-version=2023-10-01-preview",
+			expected:   getAwsIRSAResponse(),
+			fn:         setupCredentialSuccessMocks,
+			err:        nil,
+		},
+		{
+			name:       "test_credential_rotation",
+			filename:   "aws-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/aws/awscloud/providers/System.AWS/credentials/default?api-version=2023-10-01-preview",
+			expected:   getAwsRotatedResponse(),
+			fn:         setupCredentialRotationMocks,
+			err:        nil,
+		},
 		{
 			name:       "test_invalid_version_credential_resource",
 			filename:   "aws-credential.json",
@@ -161,10 +183,47 @@ func getAwsResponse() armrpc_rest.Response {
 				Kind:       to.Ptr(v20231001preview.CredentialStorageKindInternal),
 				SecretName: to.Ptr("aws-awscloud-default"),
 			},
+			Status: &v20231001preview.CredentialStatus{
+				CreatedAt: to.Ptr(testCredentialTime),
+				RotatedAt: to.Ptr(testCredentialTime),
+				Health:    to.Ptr(v20231001preview.CredentialHealthStateUnknown),
+			},
 		},
 	}, map[string]string{"ETag": ""})
 }
 
+func getAwsIRSAResponse() armrpc_rest.Response {
+	return armrpc_rest.NewOKResponseWithHeaders(&v20231001preview.AwsCredentialResource{
+		Location: to.Ptr("West US"),
+		ID:       to.Ptr("/planes/aws/awscloud/providers/System.AWS/credentials/default"),
+		Name:     to.Ptr("default"),
+		Type:     to.Ptr("System.AWS/credentials"),
+		Tags: map[string]*string{
+			"env": to.Ptr("dev"),
+		},
+		Properties: &v20231001preview.AwsIRSACredentialProperties{
+			RoleARN: to.Ptr("arn:aws:iam::000000000000:role/radius"),
+			Kind:    to.Ptr(v20231001preview.AWSCredentialKindIRSA),
+			Storage: &v20231001preview.InternalCredentialStorageProperties{
+				Kind:       to.Ptr(v20231001preview.CredentialStorageKindInternal),
+				SecretName: to.Ptr("aws-awscloud-default"),
+			},
+			Status: &v20231001preview.CredentialStatus{
+				CreatedAt: to.Ptr(testCredentialTime),
+				RotatedAt: to.Ptr(testCredentialTime),
+				Health:    to.Ptr(v20231001preview.CredentialHealthStateUnknown),
+			},
+		},
+	}, map[string]string{"ETag": ""})
+}
+
+func getAwsRotatedResponse() armrpc_rest.Response {
+	response := getAwsResponse().(*armrpc_rest.OKResponse)
+	resource := response.Body.(*v20231001preview.AwsCredentialResource)
+	resource.Properties.(*v20231001preview.AwsAccessKeyCredentialProperties).Status.CreatedAt = to.Ptr(testCredentialCreatedTime)
+	return response
+}
+
 func setupCredentialSuccessMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
 	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 		return nil, &store.ErrNotFound{ID: id}
@@ -173,6 +232,41 @@ func setupCredentialSuccessMocks(mockStorageClient store.MockStorageClient, mock
 	mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 }
 
+func setupCredentialRotationMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
+	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+		return &store.Object{
+			Metadata: store.Metadata{ID: id},
+			Data: &datamodel.AWSCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   id,
+						Name: "default",
+						Type: "System.AWS/credentials",
+					},
+				},
+				Properties: &datamodel.AWSCredentialResourceProperties{
+					Kind: datamodel.AWSCredentialKind,
+					AWSCredential: &datamodel.AWSCredentialProperties{
+						Kind:        datamodel.AWSCredentialKind,
+						AccessKeyID: "00000000-0000-0000-0000-000000000000",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{SecretName: "aws-awscloud-default"},
+					},
+					Status: &datamodel.CredentialStatus{
+						CreatedAt: to.Ptr(testCredentialCreatedTime),
+						RotatedAt: to.Ptr(testCredentialCreatedTime),
+						Health:    datamodel.CredentialHealthHealthy,
+					},
+				},
+			},
+		}, nil
+	})
+	mockSecretClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+}
+
 func setupEmptyMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
 }
 
@@ -68,14 +68,15 @@ func (c *DeleteAWSCredential) Run(ctx context.Context, w http.ResponseWriter, re
 		return armrpcrest.NewNoContentResponse(), nil
 	}
 
-	secretName := credentials.GetSecretName(serviceCtx.ResourceID)
-
-	// Delete the credential secret.
-	err = c.secretClient.Delete(ctx, secretName)
-	if errors.Is(err, &secret.ErrNotFound{}) {
-		return armrpcrest.NewNoContentResponse(), nil
-	} else if err != nil {
-		return nil, err
+	// Delete the credential secret. Secrets in external secret stores are not managed by Radius.
+	if credentials.IsInternalStorage(old.Properties.Storage) {
+		secretName := credentials.GetSecretName(serviceCtx.ResourceID)
+		err = c.secretClient.Delete(ctx, secretName)
+		if errors.Is(err, &secret.ErrNotFound{}) {
+			return armrpcrest.NewNoContentResponse(), nil
+		} else if err != nil {
+			return nil, err
+		}
 	}
 
 	if r, err := c.PrepareResource(ctx, req, nil, old, etag); r != nil || err != nil {
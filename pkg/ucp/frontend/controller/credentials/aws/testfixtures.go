@@ -16,7 +16,11 @@ limitations under the License.
 
 package aws
 
+import "time"
+
 var (
 	testHeaderFile                  = "requestheaders20231001preview.json"
 	testHeaderFileWithBadAPIVersion = "requestheaders20231001preview_badapiversion.json"
+	testCredentialTime              = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	testCredentialCreatedTime       = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 )
@@ -17,7 +17,9 @@ package azure
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -26,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
 	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
 )
 
 var _ armrpc_controller.Controller = (*CreateOrUpdateAzureCredential)(nil)
@@ -34,11 +37,13 @@ var _ armrpc_controller.Controller = (*CreateOrUpdateAzureCredential)(nil)
 type CreateOrUpdateAzureCredential struct {
 	armrpc_controller.Operation[*datamodel.AzureCredential, datamodel.AzureCredential]
 	secretClient secret.Client
+	vaultOptions vault.Options
+	now          func() time.Time
 }
 
 // NewCreateOrUpdateAzureCredential creates a new CreateOrUpdateAzureCredential controller which is used to create or
 // update Azure credentials and returns it along with a nil error.
-func NewCreateOrUpdateAzureCredential(opts armrpc_controller.Options, secretClient secret.Client) (armrpc_controller.Controller, error) {
+func NewCreateOrUpdateAzureCredential(opts armrpc_controller.Options, secretClient secret.Client, vaultOptions vault.Options) (armrpc_controller.Controller, error) {
 	return &CreateOrUpdateAzureCredential{
 		Operation: armrpc_controller.NewOperation(opts,
 			armrpc_controller.ResourceOptions[datamodel.AzureCredential]{
@@ -47,13 +52,16 @@ func NewCreateOrUpdateAzureCredential(opts armrpc_controller.Options, secretClie
 			},
 		),
 		secretClient: secretClient,
+		vaultOptions: vaultOptions,
+		now:          time.Now,
 	}, nil
 }
 
 // CreateOrUpdateAzureCredential Run function saves an Azure credential secret in the secret store and updates the
 // metadata store with the new resource, setting the provisioning state to succeeded. If an invalid credential kind is
-// provided, a bad request response is returned. If an error occurs while saving the secret or the resource, an error is
-// returned.
+// provided, a bad request response is returned. Credentials using external storage are not saved in the secret store.
+// Every update is recorded as a rotation of the credential. If an error occurs while saving the secret or the resource,
+// an error is returned.
 func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := c.GetResourceFromRequest(ctx, req)
@@ -61,7 +69,9 @@ func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.Response
 		return nil, err
 	}
 
-	if newResource.Properties.Kind != datamodel.AzureCredentialKind {
+	switch newResource.Properties.Kind {
+	case datamodel.AzureCredentialKind, datamodel.AzureWorkloadIdentityCredentialKind:
+	default:
 		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind"), nil
 	}
 
@@ -74,20 +84,46 @@ func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.Response
 		return r, err
 	}
 
-	secretName := credentials.GetSecretName(serviceCtx.ResourceID)
-	if newResource.Properties.Storage.Kind == datamodel.InternalStorageKind {
+	switch newResource.Properties.Storage.Kind {
+	case datamodel.InternalStorageKind:
+		secretName := credentials.GetSecretName(serviceCtx.ResourceID)
 		newResource.Properties.Storage.InternalCredential.SecretName = secretName
-	}
 
-	// Save the credential secret
-	err = secret.SaveSecret(ctx, c.secretClient, secretName, newResource.Properties.AzureCredential)
-	if err != nil {
-		return nil, err
+		// Save the credential secret
+		err = secret.SaveSecret(ctx, c.secretClient, secretName, newResource.Properties.AzureCredential)
+		if err != nil {
+			return nil, err
+		}
+	case datamodel.VaultStorageKind:
+		// UCP reads the secret values with its own Vault token, so only the configured Vault server and mounts are allowed.
+		vaultCredential := newResource.Properties.Storage.VaultCredential
+		if err := c.vaultOptions.Validate(vaultCredential.Address, vaultCredential.Mount); err != nil {
+			return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+		}
+
+		// The secret values are managed and rotated in the external secret store.
+		if newResource.Properties.AzureCredential.ClientSecret != "" {
+			return armrpc_rest.NewBadRequestResponse("The client secret must be stored in the external secret store."), nil
+		}
+
+		// Remove the secret saved while the credential used the internal storage.
+		if old != nil && credentials.IsInternalStorage(old.Properties.Storage) {
+			err = c.secretClient.Delete(ctx, credentials.GetSecretName(serviceCtx.ResourceID))
+			if err != nil && !errors.Is(err, &secret.ErrNotFound{}) {
+				return nil, err
+			}
+		}
 	}
 
 	// Do not save the secret in metadata store.
 	newResource.Properties.AzureCredential.ClientSecret = ""
 
+	var oldStatus *datamodel.CredentialStatus
+	if old != nil {
+		oldStatus = old.Properties.Status
+	}
+	newResource.Properties.Status = credentials.NewCredentialStatus(oldStatus, c.now().UTC())
+
 	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
 	newEtag, err := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
 	if err != nil {
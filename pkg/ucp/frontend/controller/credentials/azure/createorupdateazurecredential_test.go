@@ -21,6 +21,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
@@ -28,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/test/testutil"
 
@@ -44,8 +46,9 @@ func Test_Azure_Credential(t *testing.T) {
 
 	credentialCtrl, err := NewCreateOrUpdateAzureCredential(armrpc_controller.Options{
 		StorageClient: mockStorageClient,
-	}, mockSecretClient)
+	}, mockSecretClient, vault.Options{Address: "https://vault.example.com:8200"})
 	require.NoError(t, err)
+	credentialCtrl.(*CreateOrUpdateAzureCredential).now = func() time.Time { return testCredentialTime }
 
 	tests := []struct {
 		name       string
@@ -65,6 +68,42 @@ func Test_Azure_Credential(t *testing.T) {
 			fn:         setupCredentialSuccessMocks,
 			err:        nil,
 		},
+		{
+			name:       "test_workload_identity_credential_creation",
+			filename:   "azure-workloadidentity-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/azure/azurecloud/providers/System.Azure/credentials/default?api-version=2023-10-01-preview",
+			expected:   getAzureWorkloadIdentityCredentialResponse(),
+			fn:         setupCredentialSuccessMocks,
+			err:        nil,
+		},
+		{
+			name:       "test_vault_credential_creation",
+			filename:   "azure-vault-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/azure/azurecloud/providers/System.Azure/credentials/default?api-version=2023-10-01-preview",
+			expected:   getAzureVaultCredentialResponse(),
+			fn:         setupVaultCredentialSuccessMocks,
+			err:        nil,
+		},
+		{
+			name:       "test_vault_credential_with_secret",
+			filename:   "azure-vault-credential-with-secret.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/azure/azurecloud/providers/System.Azure/credentials/default?api-version=2023-10-01-preview",
+			expected:   armrpc_rest.NewBadRequestResponse("The client secret must be stored in the external secret store."),
+			fn:         setupVaultCredentialWithSecretMocks,
+			err:        nil,
+		},
+		{
+			name:       "test_vault_credential_not_allowed",
+			filename:   "azure-vault-credential-not-allowed.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/azure/azurecloud/providers/System.Azure/credentials/default?api-version=2023-10-01-preview",
+			expected:   armrpc_rest.NewBadRequestResponse(`the Vault address "https://attacker.example.com" is not the configured Vault address "https://vault.example.com:8200"`),
+			fn:         setupVaultCredentialWithSecretMocks,
+			err:        nil,
+		},
 		{
 			name:       "test_invalid_version_credential_resource",
 			filename:   "azure-credential.json",
@@ -161,10 +200,84 @@ func getAzureCredentialResponse() armrpc_rest.Response {
 				Kind:       to.Ptr(v20231001preview.CredentialStorageKindInternal),
 				SecretName: to.Ptr("azure-azurecloud-default"),
 			},
+			Status: &v20231001preview.CredentialStatus{
+				CreatedAt: to.Ptr(testCredentialTime),
+				RotatedAt: to.Ptr(testCredentialTime),
+				Health:    to.Ptr(v20231001preview.CredentialHealthStateUnknown),
+			},
+		},
+	}, map[string]string{"ETag": ""})
+}
+
+func getAzureWorkloadIdentityCredentialResponse() armrpc_rest.Response {
+	return armrpc_rest.NewOKResponseWithHeaders(&v20231001preview.AzureCredentialResource{
+		Location: to.Ptr("West US"),
+		ID:       to.Ptr("/planes/azure/azurecloud/providers/System.Azure/credentials/default"),
+		Name:     to.Ptr("default"),
+		Type:     to.Ptr("System.Azure/credentials"),
+		Tags: map[string]*string{
+			"env": to.Ptr("dev"),
+		},
+		Properties: &v20231001preview.AzureWorkloadIdentityProperties{
+			ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+			TenantID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+			Kind:     to.Ptr(v20231001preview.AzureCredentialKindWorkloadIdentity),
+			Storage: &v20231001preview.InternalCredentialStorageProperties{
+				Kind:       to.Ptr(v20231001preview.CredentialStorageKindInternal),
+				SecretName: to.Ptr("azure-azurecloud-default"),
+			},
+			Status: &v20231001preview.CredentialStatus{
+				CreatedAt: to.Ptr(testCredentialTime),
+				RotatedAt: to.Ptr(testCredentialTime),
+				Health:    to.Ptr(v20231001preview.CredentialHealthStateUnknown),
+			},
 		},
 	}, map[string]string{"ETag": ""})
 }
 
+func getAzureVaultCredentialResponse() armrpc_rest.Response {
+	return armrpc_rest.NewOKResponseWithHeaders(&v20231001preview.AzureCredentialResource{
+		Location: to.Ptr("West US"),
+		ID:       to.Ptr("/planes/azure/azurecloud/providers/System.Azure/credentials/default"),
+		Name:     to.Ptr("default"),
+		Type:     to.Ptr("System.Azure/credentials"),
+		Tags: map[string]*string{
+			"env": to.Ptr("dev"),
+		},
+		Properties: &v20231001preview.AzureServicePrincipalProperties{
+			ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+			TenantID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+			Kind:     to.Ptr(v20231001preview.AzureCredentialKindServicePrincipal),
+			Storage: &v20231001preview.VaultCredentialStorageProperties{
+				Kind:    to.Ptr(v20231001preview.CredentialStorageKindVault),
+				Address: to.Ptr("https://vault.example.com:8200"),
+				Mount:   to.Ptr("secret"),
+				Path:    to.Ptr("radius/azure"),
+			},
+			Status: &v20231001preview.CredentialStatus{
+				CreatedAt: to.Ptr(testCredentialTime),
+				RotatedAt: to.Ptr(testCredentialTime),
+				Health:    to.Ptr(v20231001preview.CredentialHealthStateUnknown),
+			},
+		},
+	}, map[string]string{"ETag": ""})
+}
+
+func setupVaultCredentialSuccessMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
+	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+		return nil, &store.ErrNotFound{ID: id}
+	})
+	// The secret values are stored in the external secret store.
+	mockSecretClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+}
+
+func setupVaultCredentialWithSecretMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
+	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+		return nil, &store.ErrNotFound{ID: id}
+	})
+}
+
 func setupCredentialSuccessMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
 	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 		return nil, &store.ErrNotFound{ID: id}
@@ -59,6 +59,14 @@ func Test_Credential_Delete(t *testing.T) {
 			expected:   armrpc_rest.NewOKResponse(nil),
 			err:        nil,
 		},
+		{
+			name:       "test_vault_credential_deletion",
+			url:        "/planes/azure/azurecloud/providers/System.Azure/credentials/default?api-version=2023-10-01-preview",
+			headerfile: testHeaderFile,
+			fn:         setupVaultCredentialDeleteSuccessMocks,
+			expected:   armrpc_rest.NewOKResponse(nil),
+			err:        nil,
+		},
 		{
 			name:       "test_non_existent_credential_deletion",
 			url:        "/planes/azure/azurecloud/providers/System.Azure/credentials/default?api-version=2023-10-01-preview",
@@ -153,6 +161,28 @@ func setupCredentialDeleteSuccessMocks(mockStorageClient store.MockStorageClient
 	mockStorageClient.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 }
 
+func setupVaultCredentialDeleteSuccessMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
+	datamodelCredential := datamodel.AzureCredential{
+		Properties: &datamodel.AzureCredentialResourceProperties{
+			Kind: datamodel.AzureCredentialKind,
+			Storage: &datamodel.CredentialStorageProperties{
+				Kind: datamodel.VaultStorageKind,
+				VaultCredential: &datamodel.VaultCredentialStorageProperties{
+					Address: "https://vault.example.com:8200",
+					Mount:   datamodel.DefaultVaultMount,
+					Path:    "radius/azure",
+				},
+			},
+		},
+	}
+
+	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&store.Object{Data: &datamodelCredential}, nil).Times(1)
+	// The secret in the external secret store is not deleted.
+	mockSecretClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(0)
+	mockStorageClient.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+}
+
 func setupNonExistentCredentialDeleteMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
 	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &store.ErrNotFound{}).Times(1)
 }
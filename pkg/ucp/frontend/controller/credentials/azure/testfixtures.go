@@ -16,7 +16,10 @@ limitations under the License.
 
 package azure
 
+import "time"
+
 var (
 	testHeaderFile                  = "requestheaders20231001preview.json"
 	testHeaderFileWithBadAPIVersion = "requestheaders20231001preview_badapiversion.json"
+	testCredentialTime              = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
 )
@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
+)
+
+var _ armrpc_controller.Controller = (*CreateOrUpdateGCPCredential)(nil)
+
+// CreateOrUpdateGCPCredential is the controller implementation to create/update a UCP GCP credential.
+type CreateOrUpdateGCPCredential struct {
+	armrpc_controller.Operation[*datamodel.GCPCredential, datamodel.GCPCredential]
+	secretClient secret.Client
+	vaultOptions vault.Options
+	now          func() time.Time
+}
+
+// NewCreateOrUpdateGCPCredential creates a new CreateOrUpdateGCPCredential controller which is used to create or update
+// GCP credentials in the secret store.
+func NewCreateOrUpdateGCPCredential(opts armrpc_controller.Options, secretClient secret.Client, vaultOptions vault.Options) (armrpc_controller.Controller, error) {
+	return &CreateOrUpdateGCPCredential{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.GCPCredential]{
+				RequestConverter:  converter.GCPCredentialDataModelFromVersioned,
+				ResponseConverter: converter.GCPCredentialDataModelToVersioned,
+			},
+		),
+		secretClient: secretClient,
+		vaultOptions: vaultOptions,
+		now:          time.Now,
+	}, nil
+}
+
+// CreateOrUpdateGCPCredential validates the request, saves the GCP credential secret, and saves the resource in the
+// metadata store. Credentials using external storage are not saved in the secret store. Every update is recorded as a
+// rotation of the credential. If an error occurs, it returns an error response.
+func (c *CreateOrUpdateGCPCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	newResource, err := c.GetResourceFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch newResource.Properties.Kind {
+	case datamodel.GCPCredentialKind:
+	default:
+		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind"), nil
+	}
+
+	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r, err := c.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	switch newResource.Properties.Storage.Kind {
+	case datamodel.InternalStorageKind:
+		secretName := credentials.GetSecretName(serviceCtx.ResourceID)
+		newResource.Properties.Storage.InternalCredential.SecretName = secretName
+
+		// Save the credential secret
+		err = secret.SaveSecret(ctx, c.secretClient, secretName, newResource.Properties.GCPCredential)
+		if err != nil {
+			return nil, err
+		}
+	case datamodel.VaultStorageKind:
+		// UCP reads the secret values with its own Vault token, so only the configured Vault server and mounts are allowed.
+		vaultCredential := newResource.Properties.Storage.VaultCredential
+		if err := c.vaultOptions.Validate(vaultCredential.Address, vaultCredential.Mount); err != nil {
+			return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+		}
+
+		// The secret values are managed and rotated in the external secret store.
+		if newResource.Properties.GCPCredential.ServiceAccountKey != "" {
+			return armrpc_rest.NewBadRequestResponse("The service account key must be stored in the external secret store."), nil
+		}
+
+		// Remove the secret saved while the credential used the internal storage.
+		if old != nil && credentials.IsInternalStorage(old.Properties.Storage) {
+			err = c.secretClient.Delete(ctx, credentials.GetSecretName(serviceCtx.ResourceID))
+			if err != nil && !errors.Is(err, &secret.ErrNotFound{}) {
+				return nil, err
+			}
+		}
+	}
+
+	// Do not save the secret in metadata store.
+	newResource.Properties.GCPCredential.ServiceAccountKey = ""
+
+	var oldStatus *datamodel.CredentialStatus
+	if old != nil {
+		oldStatus = old.Properties.Status
+	}
+	newResource.Properties.Status = credentials.NewCredentialStatus(oldStatus, c.now().UTC())
+
+	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
+	newEtag, err := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpcrest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ armrpc_controller.Controller = (*DeleteGCPCredential)(nil)
+
+// DeleteGCPCredential is the controller implementation to delete a UCP GCP credential.
+type DeleteGCPCredential struct {
+	armrpc_controller.Operation[*datamodel.GCPCredential, datamodel.GCPCredential]
+	secretClient secret.Client
+}
+
+// NewDeleteGCPCredential creates a new DeleteGCPCredential controller which is used to delete GCP credentials from the
+// secret store, and returns it along with any errors that may have occurred.
+func NewDeleteGCPCredential(opts armrpc_controller.Options, secretClient secret.Client) (armrpc_controller.Controller, error) {
+	return &DeleteGCPCredential{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.GCPCredential]{
+				RequestConverter:  converter.GCPCredentialDataModelFromVersioned,
+				ResponseConverter: converter.GCPCredentialDataModelToVersioned,
+			}),
+		secretClient: secretClient,
+	}, nil
+}
+
+// Run() checks if the GCP Credential exists, deletes the associated secret, and then deletes the GCP Credential from storage.
+// If the GCP Credential does not exist, it returns a No Content response. If an error occurs, it returns an error.
+func (c *DeleteGCPCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpcrest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil {
+		return armrpcrest.NewNoContentResponse(), nil
+	}
+
+	// Delete the credential secret. Secrets in external secret stores are not managed by Radius.
+	if credentials.IsInternalStorage(old.Properties.Storage) {
+		secretName := credentials.GetSecretName(serviceCtx.ResourceID)
+		err = c.secretClient.Delete(ctx, secretName)
+		if errors.Is(err, &secret.ErrNotFound{}) {
+			return armrpcrest.NewNoContentResponse(), nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	if r, err := c.PrepareResource(ctx, req, nil, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	if err := c.StorageClient().Delete(ctx, serviceCtx.ResourceID.String()); err != nil {
+		if errors.Is(&store.ErrNotFound{ID: serviceCtx.ResourceID.String()}, err) {
+			return armrpcrest.NewNoContentResponse(), nil
+		}
+		return nil, err
+	}
+
+	logger.Info(fmt.Sprintf("Deleted GCP Credential %s successfully", serviceCtx.ResourceID))
+	return armrpcrest.NewOKResponse(nil), nil
+}
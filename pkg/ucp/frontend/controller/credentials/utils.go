@@ -17,8 +17,10 @@ package credentials
 
 import (
 	"strings"
+	"time"
 
 	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -28,3 +30,24 @@ func GetSecretName(id resources.ID) string {
 	planeNamespace = strings.ReplaceAll(planeNamespace, "/", "-")
 	return kubernetes.NormalizeResourceName(planeNamespace + "-" + id.Name())
 }
+
+// IsInternalStorage returns true if the credential secret is stored in the internal secret store of UCP. Credentials
+// stored before the storage kind was recorded are treated as internal.
+func IsInternalStorage(storage *datamodel.CredentialStorageProperties) bool {
+	return storage == nil || storage.Kind == "" || storage.Kind == datamodel.InternalStorageKind
+}
+
+// NewCredentialStatus returns the status of a credential registered or rotated at the given time. The registration time
+// of the existing credential is preserved, and the health is reset until the credential is validated again.
+func NewCredentialStatus(old *datamodel.CredentialStatus, now time.Time) *datamodel.CredentialStatus {
+	status := &datamodel.CredentialStatus{
+		CreatedAt: &now,
+		RotatedAt: &now,
+		Health:    datamodel.CredentialHealthUnknown,
+	}
+	if old != nil && old.CreatedAt != nil {
+		status.CreatedAt = old.CreatedAt
+	}
+
+	return status
+}
@@ -17,7 +17,9 @@ package credentials
 
 import (
 	"testing"
+	"time"
 
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,3 +31,40 @@ func Test_Credential(t *testing.T) {
 	secretName := GetSecretName(id)
 	assert.Equal(t, secretName, "azure-azurecloud-default")
 }
+
+func Test_IsInternalStorage(t *testing.T) {
+	require.True(t, IsInternalStorage(nil))
+	require.True(t, IsInternalStorage(&datamodel.CredentialStorageProperties{}))
+	require.True(t, IsInternalStorage(&datamodel.CredentialStorageProperties{Kind: datamodel.InternalStorageKind}))
+	require.False(t, IsInternalStorage(&datamodel.CredentialStorageProperties{Kind: datamodel.VaultStorageKind}))
+}
+
+func Test_NewCredentialStatus(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("new credential", func(t *testing.T) {
+		status := NewCredentialStatus(nil, now)
+		require.Equal(t, now, *status.CreatedAt)
+		require.Equal(t, now, *status.RotatedAt)
+		require.Equal(t, datamodel.CredentialHealthUnknown, status.Health)
+	})
+
+	t.Run("rotated credential", func(t *testing.T) {
+		created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		checked := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		old := &datamodel.CredentialStatus{
+			CreatedAt:     &created,
+			RotatedAt:     &created,
+			Health:        datamodel.CredentialHealthUnhealthy,
+			LastCheckedAt: &checked,
+			Message:       "invalid credential",
+		}
+
+		status := NewCredentialStatus(old, now)
+		require.Equal(t, created, *status.CreatedAt)
+		require.Equal(t, now, *status.RotatedAt)
+		require.Equal(t, datamodel.CredentialHealthUnknown, status.Health)
+		require.Nil(t, status.LastCheckedAt)
+		require.Empty(t, status.Message)
+	})
+}
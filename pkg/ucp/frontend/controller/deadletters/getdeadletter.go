@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	http "net/http"
+
+	"github.com/go-chi/chi/v5"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+)
+
+var _ armrpc_controller.Controller = (*GetDeadLetter)(nil)
+
+// GetDeadLetter is the controller implementation to peek a message in the dead-letter queue.
+type GetDeadLetter struct {
+	armrpc_controller.BaseController
+	queueProvider *queueprovider.QueueProvider
+}
+
+// NewGetDeadLetter creates a new controller for peeking a message in the dead-letter queue.
+func NewGetDeadLetter(opts armrpc_controller.Options, queueProvider *queueprovider.QueueProvider) (armrpc_controller.Controller, error) {
+	return &GetDeadLetter{
+		BaseController: armrpc_controller.NewBaseController(opts),
+		queueProvider:  queueProvider,
+	}, nil
+}
+
+// Run returns the message in the dead-letter queue without removing it. It returns NotFound if the message is not in
+// the dead-letter queue.
+func (e *GetDeadLetter) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	client, name, err := getQueueClient(ctx, req, e.queueProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	id := chi.URLParam(req, MessageIDParam)
+	msg, err := client.GetDeadLetterMessage(ctx, id)
+	if errors.Is(err, queue.ErrDeadLetterMessageNotFound) {
+		return armrpc_rest.NewNotFoundMessageResponse(fmt.Sprintf("the message %q was not found in the dead-letter queue of %q", id, name)), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewOKResponse(newDeadLetterMessage(msg)), nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDeadLetter(t *testing.T) {
+	p, cli, name := newTestQueue(t)
+	msgs := deadLetterTestMessages(t, cli, 1)
+
+	ctl, err := NewGetDeadLetter(armrpc_controller.Options{}, p)
+	require.NoError(t, err)
+
+	t.Run("found", func(t *testing.T) {
+		ctx, req := newTestRequest(t, http.MethodGet, name, msgs[0].ID)
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		err = resp.Apply(ctx, w, req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := &DeadLetterMessage{}
+		err = json.Unmarshal(w.Body.Bytes(), actual)
+		require.NoError(t, err)
+		require.Equal(t, msgs[0].ID, actual.ID)
+		require.Equal(t, 1, actual.DequeueCount)
+		require.NotNil(t, actual.Operation)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		ctx, req := newTestRequest(t, http.MethodGet, name, "not-found")
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		err = resp.Apply(ctx, w, req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+}
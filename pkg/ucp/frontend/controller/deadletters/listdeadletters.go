@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	http "net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+)
+
+var _ armrpc_controller.Controller = (*ListDeadLetters)(nil)
+
+// ListDeadLetters is the controller implementation to list the messages in the dead-letter queue.
+type ListDeadLetters struct {
+	armrpc_controller.BaseController
+	queueProvider *queueprovider.QueueProvider
+}
+
+// NewListDeadLetters creates a new controller for listing the messages in the dead-letter queue.
+func NewListDeadLetters(opts armrpc_controller.Options, queueProvider *queueprovider.QueueProvider) (armrpc_controller.Controller, error) {
+	return &ListDeadLetters{
+		BaseController: armrpc_controller.NewBaseController(opts),
+		queueProvider:  queueProvider,
+	}, nil
+}
+
+// Run returns the list of the messages in the dead-letter queue of the queue named in the request URL.
+func (e *ListDeadLetters) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	client, _, err := getQueueClient(ctx, req, e.queueProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := client.ListDeadLetterMessages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := &v1.PaginatedList{Value: []any{}}
+	for _, msg := range msgs {
+		items.Value = append(items.Value, newDeadLetterMessage(msg))
+	}
+
+	return armrpc_rest.NewOKResponse(items), nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDeadLetters(t *testing.T) {
+	p, cli, name := newTestQueue(t)
+	msgs := deadLetterTestMessages(t, cli, 2)
+
+	ctl, err := NewListDeadLetters(armrpc_controller.Options{}, p)
+	require.NoError(t, err)
+
+	ctx, req := newTestRequest(t, http.MethodGet, name, "")
+	w := httptest.NewRecorder()
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	err = resp.Apply(ctx, w, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	actual := struct {
+		Value []DeadLetterMessage `json:"value"`
+	}{}
+	err = json.Unmarshal(w.Body.Bytes(), &actual)
+	require.NoError(t, err)
+	require.Len(t, actual.Value, 2)
+	require.Equal(t, msgs[0].ID, actual.Value[0].ID)
+	require.Equal(t, "exceeded max retry count", actual.Value[0].Reason)
+
+	t.Run("empty dead-letter queue", func(t *testing.T) {
+		_, _, emptyName := newTestQueue(t)
+		ctx, req := newTestRequest(t, http.MethodGet, emptyName, "")
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		err = resp.Apply(ctx, w, req)
+		require.NoError(t, err)
+
+		list := &v1.PaginatedList{}
+		err = json.Unmarshal(w.Body.Bytes(), list)
+		require.NoError(t, err)
+		require.Empty(t, list.Value)
+	})
+}
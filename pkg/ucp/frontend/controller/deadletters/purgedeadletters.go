@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"fmt"
+	http "net/http"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ armrpc_controller.Controller = (*PurgeDeadLetters)(nil)
+
+// PurgeDeadLetters is the controller implementation to delete all messages in the dead-letter queue.
+type PurgeDeadLetters struct {
+	armrpc_controller.BaseController
+	queueProvider *queueprovider.QueueProvider
+}
+
+// NewPurgeDeadLetters creates a new controller for deleting all messages in the dead-letter queue.
+func NewPurgeDeadLetters(opts armrpc_controller.Options, queueProvider *queueprovider.QueueProvider) (armrpc_controller.Controller, error) {
+	return &PurgeDeadLetters{
+		BaseController: armrpc_controller.NewBaseController(opts),
+		queueProvider:  queueProvider,
+	}, nil
+}
+
+// Run deletes all messages in the dead-letter queue and returns the number of deleted messages.
+func (e *PurgeDeadLetters) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	client, name, err := getQueueClient(ctx, req, e.queueProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := client.PurgeDeadLetterMessages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Purged %d messages from the dead-letter queue of %s", n, name))
+	return armrpc_rest.NewOKResponse(&PurgeDeadLettersResponse{Purged: n}), nil
+}
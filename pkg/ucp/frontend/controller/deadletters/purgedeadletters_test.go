@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeDeadLetters(t *testing.T) {
+	p, cli, name := newTestQueue(t)
+	_ = deadLetterTestMessages(t, cli, 3)
+
+	ctl, err := NewPurgeDeadLetters(armrpc_controller.Options{}, p)
+	require.NoError(t, err)
+
+	ctx, req := newTestRequest(t, http.MethodDelete, name, "")
+	w := httptest.NewRecorder()
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	err = resp.Apply(ctx, w, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	actual := &PurgeDeadLettersResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), actual)
+	require.NoError(t, err)
+	require.Equal(t, 3, actual.Purged)
+
+	remaining, err := cli.ListDeadLetterMessages(testcontext.New(t))
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequeueDeadLetter(t *testing.T) {
+	p, cli, name := newTestQueue(t)
+	msgs := deadLetterTestMessages(t, cli, 1)
+
+	ctl, err := NewRequeueDeadLetter(armrpc_controller.Options{}, p)
+	require.NoError(t, err)
+
+	ctx, req := newTestRequest(t, http.MethodPost, name, msgs[0].ID)
+	w := httptest.NewRecorder()
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	err = resp.Apply(ctx, w, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+
+	requeued, err := cli.Dequeue(testcontext.New(t), queue.QueueClientConfig{})
+	require.NoError(t, err)
+	require.Equal(t, msgs[0].Data, requeued.Data)
+	require.Equal(t, 1, requeued.ReplayCount)
+
+	t.Run("not found", func(t *testing.T) {
+		ctx, req := newTestRequest(t, http.MethodPost, name, msgs[0].ID)
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		err = resp.Apply(ctx, w, req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+)
+
+const (
+	// QueueNameParam is the URL parameter of the queue name.
+	QueueNameParam = "queueName"
+
+	// MessageIDParam is the URL parameter of the dead-lettered message id.
+	MessageIDParam = "messageId"
+)
+
+// DeadLetterMessage is the representation of an async operation message in the dead-letter queue.
+type DeadLetterMessage struct {
+	// ID is the id of the message in the dead-letter queue.
+	ID string `json:"id"`
+	// DequeueCount is the number of times the message was dequeued before it was dead-lettered.
+	DequeueCount int `json:"dequeueCount"`
+	// ReplayCount is the number of times the message was requeued from the dead-letter queue.
+	ReplayCount int `json:"replayCount"`
+	// EnqueuedAt is the time when the message was enqueued.
+	EnqueuedAt time.Time `json:"enqueuedAt"`
+	// DeadLetteredAt is the time when the message was moved to the dead-letter queue.
+	DeadLetteredAt time.Time `json:"deadLetteredAt"`
+	// Reason is the reason why the message was moved to the dead-letter queue.
+	Reason string `json:"reason"`
+	// Operation is the original payload of the message, which is the async operation request.
+	Operation any `json:"operation"`
+}
+
+// PurgeDeadLettersResponse is the response of purging the dead-letter queue.
+type PurgeDeadLettersResponse struct {
+	// Purged is the number of deleted messages.
+	Purged int `json:"purged"`
+}
+
+func newDeadLetterMessage(msg *queue.Message) *DeadLetterMessage {
+	var operation any = string(msg.Data)
+	if json.Valid(msg.Data) {
+		operation = json.RawMessage(msg.Data)
+	}
+
+	return &DeadLetterMessage{
+		ID:             msg.ID,
+		DequeueCount:   msg.DequeueCount,
+		ReplayCount:    msg.ReplayCount,
+		EnqueuedAt:     msg.EnqueueAt,
+		DeadLetteredAt: msg.DeadLetteredAt,
+		Reason:         msg.DeadLetterReason,
+		Operation:      operation,
+	}
+}
+
+// getQueueClient returns the client of the queue named in the request URL.
+func getQueueClient(ctx context.Context, req *http.Request, queueProvider *queueprovider.QueueProvider) (queue.Client, string, error) {
+	name := chi.URLParam(req, QueueNameParam)
+	client, err := queueProvider.GetNamedClient(ctx, name)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, name, nil
+}
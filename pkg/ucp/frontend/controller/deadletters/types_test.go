@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQueue(t *testing.T) (*queueprovider.QueueProvider, queue.Client, string) {
+	name := "test." + uuid.NewString()
+	p := queueprovider.New(queueprovider.QueueProviderOptions{Name: "ucp", Provider: queueprovider.TypeInmemory})
+	cli, err := p.GetNamedClient(testcontext.New(t), name)
+	require.NoError(t, err)
+	return p, cli, name
+}
+
+func newTestRequest(t *testing.T, method string, queueName string, messageID string) (context.Context, *http.Request) {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(QueueNameParam, queueName)
+	if messageID != "" {
+		rctx.URLParams.Add(MessageIDParam, messageID)
+	}
+
+	ctx := context.WithValue(testcontext.New(t), chi.RouteCtxKey, rctx)
+	req := httptest.NewRequest(method, "/admin/queues/"+queueName+"/deadletters", nil).WithContext(ctx)
+	return ctx, req
+}
+
+func deadLetterTestMessages(t *testing.T, cli queue.Client, n int) []*queue.Message {
+	ctx := testcontext.New(t)
+	msgs := []*queue.Message{}
+	for i := 0; i < n; i++ {
+		err := cli.Enqueue(ctx, queue.NewMessage(map[string]any{"operationId": uuid.NewString()}))
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = cli.DeadLetterMessage(ctx, msg, "exceeded max retry count")
+		require.NoError(t, err)
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+func TestNewDeadLetterMessage(t *testing.T) {
+	t.Run("json payload", func(t *testing.T) {
+		msg := &queue.Message{Metadata: queue.Metadata{ID: "1", DeadLetterReason: "reason"}, Data: []byte(`{"operationId":"abc"}`)}
+		b, err := json.Marshal(newDeadLetterMessage(msg))
+		require.NoError(t, err)
+		require.Contains(t, string(b), `"operation":{"operationId":"abc"}`)
+		require.Contains(t, string(b), `"reason":"reason"`)
+	})
+
+	t.Run("non-json payload", func(t *testing.T) {
+		msg := &queue.Message{Metadata: queue.Metadata{ID: "1"}, Data: []byte("hello")}
+		b, err := json.Marshal(newDeadLetterMessage(msg))
+		require.NoError(t, err)
+		require.Contains(t, string(b), `"operation":"hello"`)
+	})
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+const (
+	// gcpServiceSuffix is the suffix of the host names of GCP service APIs.
+	gcpServiceSuffix = ".googleapis.com"
+)
+
+// gcpRequest is the parsed form of a request path for a GCP service API, like:
+//
+//	/planes/gcp/{planeName}/projects/{projectId}/providers/{service}/{path}
+type gcpRequest struct {
+	// PlaneName is the name of the GCP plane.
+	PlaneName string
+
+	// ProjectID is the GCP project which is billed for the request.
+	ProjectID string
+
+	// Service is the host name of the GCP service API, for example 'storage.googleapis.com'.
+	Service string
+
+	// Path is the path of the request on the GCP service API.
+	Path string
+}
+
+// parseGCPRequest parses the request path relative to the path base. It returns a bad request response if the path
+// does not address a GCP service API.
+func parseGCPRequest(path string) (*gcpRequest, armrpc_rest.Response) {
+	// Expected segments: planes, gcp, {planeName}, projects, {projectId}, providers, {service}, {path...}
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 8)
+	if len(segments) < 7 ||
+		!strings.EqualFold(segments[0], "planes") ||
+		!strings.EqualFold(segments[1], "gcp") ||
+		!strings.EqualFold(segments[3], "projects") ||
+		!strings.EqualFold(segments[5], "providers") ||
+		segments[2] == "" || segments[4] == "" || segments[6] == "" {
+		return nil, newBadRequestResponse("invalid GCP request path: expected /planes/gcp/{planeName}/projects/{projectId}/providers/{service}/{path}")
+	}
+
+	service := strings.ToLower(segments[6])
+	if !strings.HasSuffix(service, gcpServiceSuffix) || strings.ContainsAny(service, ":@") {
+		return nil, newBadRequestResponse(fmt.Sprintf("invalid GCP service %q: the service must be a host name ending with %q", segments[6], gcpServiceSuffix))
+	}
+
+	request := &gcpRequest{
+		PlaneName: segments[2],
+		ProjectID: segments[4],
+		Service:   service,
+		Path:      "/",
+	}
+	if len(segments) == 8 {
+		request.Path = "/" + segments[7]
+	}
+
+	return request, nil
+}
+
+func newBadRequestResponse(message string) armrpc_rest.Response {
+	return armrpc_rest.NewBadRequestARMResponse(v1.ErrorResponse{
+		Error: v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: message,
+		},
+	})
+}
+
+// removeAPIVersion removes the UCP api-version query parameter, which GCP service APIs reject.
+func removeAPIVersion(r *http.Request) {
+	query := r.URL.Query()
+	if !query.Has("api-version") {
+		return
+	}
+
+	query.Del("api-version")
+	r.URL.RawQuery = query.Encode()
+}
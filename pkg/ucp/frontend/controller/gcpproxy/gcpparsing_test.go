@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseGCPRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected *gcpRequest
+		invalid  bool
+	}{
+		{
+			name: "resource",
+			path: "/planes/gcp/gcp/projects/my-project/providers/storage.googleapis.com/storage/v1/b/my-bucket",
+			expected: &gcpRequest{
+				PlaneName: "gcp",
+				ProjectID: "my-project",
+				Service:   "storage.googleapis.com",
+				Path:      "/storage/v1/b/my-bucket",
+			},
+		},
+		{
+			name: "service root",
+			path: "/planes/gcp/gcp/projects/my-project/providers/Compute.googleapis.com",
+			expected: &gcpRequest{
+				PlaneName: "gcp",
+				ProjectID: "my-project",
+				Service:   "compute.googleapis.com",
+				Path:      "/",
+			},
+		},
+		{
+			name:    "missing provider",
+			path:    "/planes/gcp/gcp/projects/my-project",
+			invalid: true,
+		},
+		{
+			name:    "wrong plane type",
+			path:    "/planes/aws/aws/projects/my-project/providers/storage.googleapis.com/storage/v1/b",
+			invalid: true,
+		},
+		{
+			name:    "empty project",
+			path:    "/planes/gcp/gcp/projects//providers/storage.googleapis.com/storage/v1/b",
+			invalid: true,
+		},
+		{
+			name:    "not a GCP service",
+			path:    "/planes/gcp/gcp/projects/my-project/providers/example.com/storage/v1/b",
+			invalid: true,
+		},
+		{
+			name:    "service with port",
+			path:    "/planes/gcp/gcp/projects/my-project/providers/evil.com:443@storage.googleapis.com/storage/v1/b",
+			invalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, response := parseGCPRequest(tt.path)
+			if tt.invalid {
+				require.Nil(t, actual)
+				require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+				return
+			}
+
+			require.Nil(t, response)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_removeAPIVersion(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/storage/v1/b?api-version=2023-10-01-preview&project=my-project", nil)
+	removeAPIVersion(req)
+	require.Equal(t, "project=my-project", req.URL.RawQuery)
+
+	req = httptest.NewRequest(http.MethodGet, "/storage/v1/b?pageToken=a%2Fb", nil)
+	removeAPIVersion(req)
+	require.Equal(t, "pageToken=a%2Fb", req.URL.RawQuery)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+	"github.com/radius-project/radius/pkg/ucp/proxy"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// userProjectHeader is the header used to set the GCP project which is billed for the request.
+	userProjectHeader = "X-Goog-User-Project"
+)
+
+var _ armrpc_controller.Controller = (*ProxyGCPRequest)(nil)
+
+// ProxyGCPRequest is the controller implementation to proxy requests to GCP service APIs.
+//
+// Unlike AWS, GCP does not have a single API to manage resources of every type. Requests are forwarded to the
+// REST API of the GCP service named in the request path, authenticated with the GCP credential registered in UCP.
+// Long-running operations are returned by the GCP service APIs as operation resources, which can be polled through
+// the same proxy.
+type ProxyGCPRequest struct {
+	armrpc_controller.Operation[*datamodel.GCPPlane, datamodel.GCPPlane]
+	tokenProvider ucp_gcp.TokenProvider
+
+	// endpoint returns the URL of the GCP service API. This can be overridden by tests.
+	endpoint func(service string) *url.URL
+	// transport is used to send requests to the GCP service API. This can be overridden by tests.
+	transport http.RoundTripper
+}
+
+// NewProxyGCPRequest creates a new ProxyGCPRequest controller with the given options and token provider, and returns
+// it or an error if one occurs.
+func NewProxyGCPRequest(opts armrpc_controller.Options, tokenProvider ucp_gcp.TokenProvider) (armrpc_controller.Controller, error) {
+	return &ProxyGCPRequest{
+		Operation:     armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.GCPPlane]{}),
+		tokenProvider: tokenProvider,
+		endpoint: func(service string) *url.URL {
+			return &url.URL{Scheme: "https", Host: service}
+		},
+		transport: otelhttp.NewTransport(http.DefaultTransport),
+	}, nil
+}
+
+// Run() parses the GCP project and service from the request path, looks up the plane, acquires an access token
+// and proxies the request to the GCP service API.
+func (p *ProxyGCPRequest) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	gcpReq, errResponse := parseGCPRequest(middleware.GetRelativePath(p.Options().PathBase, req.URL.Path))
+	if errResponse != nil {
+		return errResponse, nil
+	}
+
+	planeID, err := resources.ParseScope("/planes/gcp/" + gcpReq.PlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	plane, _, err := p.GetResource(ctx, planeID)
+	if err != nil {
+		return nil, err
+	}
+	if plane == nil {
+		return armrpc_rest.NewNotFoundResponse(planeID), nil
+	}
+
+	token, err := p.tokenProvider.Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GCP access token: %w", err)
+	}
+
+	builder := proxy.ReverseProxyBuilder{
+		Downstream:    p.endpoint(gcpReq.Service),
+		EnableLogging: true,
+		Transport:     p.transport,
+		Directors: []proxy.DirectorFunc{
+			func(r *http.Request) {
+				r.URL.Path = gcpReq.Path
+				r.URL.RawPath = ""
+				removeAPIVersion(r)
+
+				r.Header.Set("Authorization", token.Type()+" "+token.AccessToken)
+				r.Header.Set(userProjectHeader, gcpReq.ProjectID)
+			},
+		},
+	}
+
+	logger.Info(fmt.Sprintf("proxying request to GCP service %s", gcpReq.Service))
+	builder.Build().ServeHTTP(w, req.WithContext(ctx))
+
+	// The upstream response has already been sent at this point. Therefore, return nil response here
+	return nil, nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/oauth2"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	testBucketPath = "/planes/gcp/gcp/projects/my-project/providers/storage.googleapis.com/storage/v1/b/my-bucket"
+)
+
+type fakeTokenProvider struct {
+	token *oauth2.Token
+	err   error
+}
+
+func (p *fakeTokenProvider) Token(ctx context.Context) (*oauth2.Token, error) {
+	return p.token, p.err
+}
+
+func setupProxy(t *testing.T, tokenProvider *fakeTokenProvider, plane *datamodel.GCPPlane, server *httptest.Server) *ProxyGCPRequest {
+	storageClient := store.NewMockStorageClient(gomock.NewController(t))
+	storageClient.EXPECT().Get(gomock.Any(), "/planes/gcp/gcp", gomock.Any()).DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+		if plane == nil {
+			return nil, &store.ErrNotFound{ID: id}
+		}
+		return &store.Object{Metadata: store.Metadata{ID: id}, Data: plane}, nil
+	}).AnyTimes()
+
+	ctrl, err := NewProxyGCPRequest(armrpc_controller.Options{StorageClient: storageClient}, tokenProvider)
+	require.NoError(t, err)
+
+	p := ctrl.(*ProxyGCPRequest)
+	if server != nil {
+		p.endpoint = func(service string) *url.URL {
+			u, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			return u
+		}
+		p.transport = server.Client().Transport
+	}
+	return p
+}
+
+func Test_ProxyGCPRequest(t *testing.T) {
+	plane := &datamodel.GCPPlane{}
+	tokenProvider := &fakeTokenProvider{token: &oauth2.Token{AccessToken: "access-token", TokenType: "Bearer"}}
+
+	t.Run("proxies request", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodGet, r.Method)
+			require.Equal(t, "/storage/v1/b/my-bucket", r.URL.Path)
+			require.Equal(t, "fields=name", r.URL.RawQuery)
+			require.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+			require.Equal(t, "my-project", r.Header.Get(userProjectHeader))
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"name":"my-bucket"}`))
+		}))
+		defer server.Close()
+
+		p := setupProxy(t, tokenProvider, plane, server)
+
+		req := httptest.NewRequest(http.MethodGet, testBucketPath+"?api-version=2023-10-01-preview&fields=name", nil)
+		w := httptest.NewRecorder()
+		response, err := p.Run(testcontext.New(t), w, req)
+		require.NoError(t, err)
+		require.Nil(t, response)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		body, err := io.ReadAll(w.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"name":"my-bucket"}`, string(body))
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		p := setupProxy(t, tokenProvider, plane, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/planes/gcp/gcp/projects/my-project/providers/example.com/b", nil)
+		response, err := p.Run(testcontext.New(t), httptest.NewRecorder(), req)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+
+	t.Run("plane not found", func(t *testing.T) {
+		p := setupProxy(t, tokenProvider, nil, nil)
+
+		req := httptest.NewRequest(http.MethodGet, testBucketPath, nil)
+		response, err := p.Run(testcontext.New(t), httptest.NewRecorder(), req)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.NotFoundResponse{}, response)
+	})
+
+	t.Run("token failure", func(t *testing.T) {
+		p := setupProxy(t, &fakeTokenProvider{err: errors.New("no credential")}, plane, nil)
+
+		req := httptest.NewRequest(http.MethodGet, testBucketPath, nil)
+		_, err := p.Run(testcontext.New(t), httptest.NewRecorder(), req)
+		require.EqualError(t, err, "failed to get GCP access token: no credential")
+	})
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateRequest validates the level and the scope of a lock. The scope defaults to the plane of the lock and must be
+// within it. It returns a BadRequestResponse if the lock is invalid.
+func ValidateRequest(ctx context.Context, newResource *datamodel.Lock, oldResource *datamodel.Lock, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	planeID := serviceCtx.ResourceID.RootScope()
+
+	if newResource.Properties.Level != datamodel.LockLevelCanNotDelete && newResource.Properties.Level != datamodel.LockLevelReadOnly {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The lock level %q is not supported. Supported lock levels are %q and %q.", newResource.Properties.Level, datamodel.LockLevelCanNotDelete, datamodel.LockLevelReadOnly)), nil
+	}
+
+	scope := newResource.Properties.Scope
+	if scope == "" {
+		scope = planeID
+	}
+
+	if _, err := resources.Parse(scope); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The scope %q is not a valid resource ID.", scope)), nil
+	}
+
+	if !authorization.ScopeContains(planeID, scope) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The scope %q must be within the plane %q of the lock.", scope, planeID)), nil
+	}
+
+	newResource.Properties.Scope = scope
+	return nil, nil
+}
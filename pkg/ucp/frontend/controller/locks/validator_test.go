@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const lockID = "/planes/radius/local/providers/System.Authorization/locks/prod"
+
+func Test_ValidateRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		properties    datamodel.LockProperties
+		expectedScope string
+		expectedError string
+	}{
+		{
+			name: "defaults scope to plane",
+			properties: datamodel.LockProperties{
+				Level: datamodel.LockLevelReadOnly,
+			},
+			expectedScope: "/planes/radius/local",
+		},
+		{
+			name: "resource scope",
+			properties: datamodel.LockProperties{
+				Level: datamodel.LockLevelCanNotDelete,
+				Scope: "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod",
+				Notes: "The production environment must not be deleted.",
+			},
+			expectedScope: "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod",
+		},
+		{
+			name: "unsupported level",
+			properties: datamodel.LockProperties{
+				Level: "NotSpecified",
+			},
+			expectedError: "The lock level \"NotSpecified\" is not supported. Supported lock levels are \"CanNotDelete\" and \"ReadOnly\".",
+		},
+		{
+			name: "invalid scope",
+			properties: datamodel.LockProperties{
+				Level: datamodel.LockLevelCanNotDelete,
+				Scope: "not-an-id",
+			},
+			expectedError: "The scope \"not-an-id\" is not a valid resource ID.",
+		},
+		{
+			name: "scope in another plane",
+			properties: datamodel.LockProperties{
+				Level: datamodel.LockLevelCanNotDelete,
+				Scope: "/planes/radius/other/resourceGroups/rg",
+			},
+			expectedError: "The scope \"/planes/radius/other/resourceGroups/rg\" must be within the plane \"/planes/radius/local\" of the lock.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := resources.ParseResource(lockID)
+			require.NoError(t, err)
+			ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{ResourceID: id})
+
+			lock := &datamodel.Lock{Properties: tt.properties}
+			resp, err := ValidateRequest(ctx, lock, nil, nil)
+			require.NoError(t, err)
+
+			if tt.expectedError != "" {
+				require.IsType(t, &rest.BadRequestResponse{}, resp)
+				require.Equal(t, tt.expectedError, resp.(*rest.BadRequestResponse).Body.Error.Message)
+				return
+			}
+
+			require.Nil(t, resp)
+			require.Equal(t, tt.expectedScope, lock.Properties.Scope)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	http "net/http"
+	"strconv"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// CascadeQueryParameter is the query parameter used to opt in to deleting the resources of a resource group
+	// along with the resource group.
+	CascadeQueryParameter = "cascade"
+
+	// CascadeDeleteOperationTimeout is the timeout for deleting a resource group and its resources in the background.
+	CascadeDeleteOperationTimeout = 2 * time.Hour
+
+	// CascadeDeleteOperationRetryAfter is the retry interval for deleting a resource group and its resources in the background.
+	CascadeDeleteOperationRetryAfter = 5 * time.Second
+)
+
+var _ armrpc_controller.Controller = (*DeleteResourceGroup)(nil)
+
+// DeleteResourceGroup is the controller implementation to delete a resource group.
+//
+// A resource group that still contains resources is only deleted when the caller opts in to a cascading delete. The
+// resources are then deleted by an async operation, followed by the resource group itself.
+type DeleteResourceGroup struct {
+	armrpc_controller.Operation[*datamodel.ResourceGroup, datamodel.ResourceGroup]
+}
+
+// NewDeleteResourceGroup creates a new controller for deleting a resource group.
+func NewDeleteResourceGroup(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &DeleteResourceGroup{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.ResourceGroup]{
+				RequestConverter:  converter.ResourceGroupDataModelFromVersioned,
+				ResponseConverter: converter.ResourceGroupDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run deletes the resource group when it is empty. Otherwise it returns a Conflict response, or queues an async
+// operation to delete the resources and the resource group when the cascade query parameter is set to true.
+func (r *DeleteResourceGroup) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	cascade := false
+	if value := req.URL.Query().Get(CascadeQueryParameter); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid value %q for query parameter %q", value, CascadeQueryParameter)), nil
+		}
+		cascade = parsed
+	}
+
+	existing, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		return armrpc_rest.NewNoContentResponse(), nil
+	}
+
+	query := store.Query{
+		RootScope:    serviceCtx.ResourceID.String(),
+		ResourceType: v20231001preview.ResourceType,
+	}
+
+	result, err := r.StorageClient().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Items) == 0 {
+		err = r.StorageClient().Delete(ctx, serviceCtx.ResourceID.String())
+		if errors.Is(err, &store.ErrNotFound{}) {
+			return armrpc_rest.NewNoContentResponse(), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		return armrpc_rest.NewOKResponse(nil), nil
+	}
+
+	if !cascade {
+		message := fmt.Sprintf("resource group %q contains %d resource(s). Delete the resources first, or set the %q query parameter to true to delete them along with the resource group", serviceCtx.ResourceID.String(), len(result.Items), CascadeQueryParameter)
+		return armrpc_rest.NewConflictResponse(message), nil
+	}
+
+	// Resource groups are not managed by a resource provider, so the operation is tracked using an ID in the
+	// System.Resources namespace.
+	operationCtx := *serviceCtx
+	operationCtx.ResourceID = DeleteOperationResourceID(serviceCtx.ResourceID)
+	operationCtx.OperationType = v1.OperationType{Type: v20231001preview.ResourceGroupType, Method: v1.OperationDelete}
+
+	err = r.StatusManager().QueueAsyncOperation(ctx, &operationCtx, statusmanager.QueueOperationOptions{OperationTimeout: CascadeDeleteOperationTimeout, RetryAfter: CascadeDeleteOperationRetryAfter})
+	if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewAsyncOperationResponse(nil, v1.LocationGlobal, http.StatusAccepted, operationCtx.ResourceID, operationCtx.OperationID, serviceCtx.APIVersion, operationCtx.ResourceID.PlaneScope(), r.Options().PathBase), nil
+}
+
+// DeleteOperationResourceID returns the ID used to track the async operation that deletes a resource group and its
+// resources.
+//
+// Example:
+//
+//	id: /planes/radius/local/resourceGroups/test-group
+//	operation resource ID: /planes/radius/local/providers/System.Resources/resourceGroups/test-group
+func DeleteOperationResourceID(id resources.ID) resources.ID {
+	return resources.MustParse(id.PlaneScope() + resources.SegmentSeparator + resources.ProvidersSegment + resources.SegmentSeparator + v20231001preview.ResourceGroupType + resources.SegmentSeparator + id.Name())
+}
+
+// ResourceGroupIDFromDeleteOperation returns the ID of the resource group being deleted by the async operation with
+// the given resource ID. This is the inverse of DeleteOperationResourceID.
+func ResourceGroupIDFromDeleteOperation(id resources.ID) (resources.ID, error) {
+	return resources.ParseScope(id.PlaneScope() + resources.SegmentSeparator + resources_radius.ScopeResourceGroups + resources.SegmentSeparator + id.Name())
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func Test_DeleteResourceGroup(t *testing.T) {
+	resourceGroupID := "/planes/radius/local/resourcegroups/test-rg"
+	resourceGroupDatamodel := datamodel.ResourceGroup{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   resourceGroupID,
+				Name: "test-rg",
+				Type: v20231001preview.ResourceGroupType,
+			},
+		},
+	}
+	entryDatamodel := datamodel.GenericResource{
+		Properties: datamodel.GenericResourceProperties{
+			ID:   resourceGroupID + "/providers/Applications.Core/applications/test-app",
+			Type: "Applications.Core/applications",
+			Name: "test-app",
+		},
+	}
+	expectedQuery := store.Query{RootScope: resourceGroupID, ResourceType: v20231001preview.ResourceType}
+
+	newRequest := func(t *testing.T, ctrl *DeleteResourceGroup, query string) (context.Context, *http.Request) {
+		request, err := http.NewRequest(http.MethodDelete, ctrl.Options().PathBase+resourceGroupID+"?api-version="+v20231001preview.Version+query, nil)
+		require.NoError(t, err)
+		return rpctest.NewARMRequestContext(request), request
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		storage, _, ctrl := setupDeleteResourceGroup(t)
+
+		storage.EXPECT().
+			Get(gomock.Any(), resourceGroupID).
+			Return(nil, &store.ErrNotFound{ID: resourceGroupID}).
+			Times(1)
+
+		ctx, request := newRequest(t, ctrl, "")
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewNoContentResponse(), response)
+	})
+
+	t.Run("empty resource group", func(t *testing.T) {
+		storage, _, ctrl := setupDeleteResourceGroup(t)
+
+		storage.EXPECT().
+			Get(gomock.Any(), resourceGroupID).
+			Return(&store.Object{Data: resourceGroupDatamodel}, nil).
+			Times(1)
+		storage.EXPECT().
+			Query(gomock.Any(), expectedQuery).
+			Return(&store.ObjectQueryResult{Items: []store.Object{}}, nil).
+			Times(1)
+		storage.EXPECT().
+			Delete(gomock.Any(), resourceGroupID).
+			Return(nil).
+			Times(1)
+
+		ctx, request := newRequest(t, ctrl, "")
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewOKResponse(nil), response)
+	})
+
+	t.Run("resource group with resources", func(t *testing.T) {
+		storage, _, ctrl := setupDeleteResourceGroup(t)
+
+		storage.EXPECT().
+			Get(gomock.Any(), resourceGroupID).
+			Return(&store.Object{Data: resourceGroupDatamodel}, nil).
+			Times(1)
+		storage.EXPECT().
+			Query(gomock.Any(), expectedQuery).
+			Return(&store.ObjectQueryResult{Items: []store.Object{{Data: entryDatamodel}}}, nil).
+			Times(1)
+
+		ctx, request := newRequest(t, ctrl, "")
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		conflict, ok := response.(*armrpc_rest.ConflictResponse)
+		require.True(t, ok)
+		require.Equal(t, v1.CodeConflict, conflict.Body.Error.Code)
+	})
+
+	t.Run("cascade", func(t *testing.T) {
+		storage, statusManager, ctrl := setupDeleteResourceGroup(t)
+
+		storage.EXPECT().
+			Get(gomock.Any(), resourceGroupID).
+			Return(&store.Object{Data: resourceGroupDatamodel}, nil).
+			Times(1)
+		storage.EXPECT().
+			Query(gomock.Any(), expectedQuery).
+			Return(&store.ObjectQueryResult{Items: []store.Object{{Data: entryDatamodel}}}, nil).
+			Times(1)
+
+		operationResourceID := resources.MustParse("/planes/radius/local/providers/System.Resources/resourceGroups/test-rg")
+		statusManager.EXPECT().
+			QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+				require.Equal(t, operationResourceID, sCtx.ResourceID)
+				require.Equal(t, v1.OperationType{Type: v20231001preview.ResourceGroupType, Method: v1.OperationDelete}, sCtx.OperationType)
+				require.Equal(t, CascadeDeleteOperationTimeout, options.OperationTimeout)
+				return nil
+			}).
+			Times(1)
+
+		ctx, request := newRequest(t, ctrl, "&cascade=true")
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		expected := armrpc_rest.NewAsyncOperationResponse(nil, v1.LocationGlobal, http.StatusAccepted, operationResourceID, serviceCtx.OperationID, v20231001preview.Version, "/planes/radius/local", ctrl.Options().PathBase)
+		require.Equal(t, expected, response)
+	})
+
+	t.Run("invalid cascade", func(t *testing.T) {
+		_, _, ctrl := setupDeleteResourceGroup(t)
+
+		ctx, request := newRequest(t, ctrl, "&cascade=maybe")
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		badRequest, ok := response.(*armrpc_rest.BadRequestResponse)
+		require.True(t, ok)
+		require.Equal(t, v1.CodeInvalid, badRequest.Body.Error.Code)
+	})
+}
+
+func Test_DeleteOperationResourceID(t *testing.T) {
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-rg")
+
+	operationID := DeleteOperationResourceID(id)
+	require.Equal(t, "/planes/radius/local/providers/System.Resources/resourceGroups/test-rg", operationID.String())
+	require.Equal(t, "System.Resources", operationID.ProviderNamespace())
+
+	resourceGroupID, err := ResourceGroupIDFromDeleteOperation(operationID)
+	require.NoError(t, err)
+	require.True(t, resourceGroupID.IsScope())
+	require.Equal(t, "test-rg", resourceGroupID.FindScope("resourcegroups"))
+}
+
+func setupDeleteResourceGroup(t *testing.T) (*store.MockStorageClient, *statusmanager.MockStatusManager, *DeleteResourceGroup) {
+	ctrl := gomock.NewController(t)
+	storage := store.NewMockStorageClient(ctrl)
+	statusManager := statusmanager.NewMockStatusManager(ctrl)
+
+	c, err := NewDeleteResourceGroup(armrpc_controller.Options{StorageClient: storage, StatusManager: statusManager, PathBase: "/" + uuid.New().String()})
+	require.NoError(t, err)
+
+	return storage, statusManager, c.(*DeleteResourceGroup)
+}
@@ -18,7 +18,9 @@ package resourcegroups
 import (
 	"context"
 	"errors"
+	"fmt"
 	http "net/http"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -31,6 +33,15 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
+const (
+	// TagQueryParameter is the query parameter used to filter the listed resources by tag. The parameter can be
+	// repeated and each value has the form 'key=value'.
+	TagQueryParameter = "tag"
+
+	// tagFilterFieldPrefix is the prefix of the store filter field used to match a tag of a tracked resource.
+	tagFilterFieldPrefix = "properties.tags."
+)
+
 var _ armrpc_controller.Controller = (*ListResources)(nil)
 
 // ListResources is the controller implementation to get the list of resources stored in a resource group.
@@ -58,6 +69,11 @@ func (r *ListResources) Run(ctx context.Context, w http.ResponseWriter, req *htt
 		return nil, err
 	}
 
+	filters, err := tagFilters(req.URL.Query()[TagQueryParameter])
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
 	// Cut off the "resources" part of the ID. The ID should be the ID of a resource group.
 	resourceGroupID := id.Truncate()
 
@@ -72,6 +88,7 @@ func (r *ListResources) Run(ctx context.Context, w http.ResponseWriter, req *htt
 	query := store.Query{
 		RootScope:    resourceGroupID.String(),
 		ResourceType: v20231001preview.ResourceType,
+		Filters:      filters,
 	}
 
 	result, err := r.StorageClient().Query(ctx, query)
@@ -87,6 +104,30 @@ func (r *ListResources) Run(ctx context.Context, w http.ResponseWriter, req *htt
 	return armrpc_rest.NewOKResponse(response), nil
 }
 
+// tagFilters converts the values of the tag query parameter to store filters on the tags of the tracked resources.
+func tagFilters(values []string) ([]store.QueryFilter, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	filters := []store.QueryFilter{}
+	for _, value := range values {
+		key, tagValue, found := strings.Cut(value, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid value %q for query parameter %q, the value must have the form 'key=value'", value, TagQueryParameter)
+		}
+
+		// The store uses '.' to separate nested fields, so keys containing a '.' cannot be queried.
+		if strings.Contains(key, ".") {
+			return nil, fmt.Errorf("invalid value %q for query parameter %q, the tag key must not contain '.'", value, TagQueryParameter)
+		}
+
+		filters = append(filters, store.QueryFilter{Field: tagFilterFieldPrefix + key, Value: tagValue})
+	}
+
+	return filters, nil
+}
+
 func (r *ListResources) createResponse(ctx context.Context, result *store.ObjectQueryResult) (*v1.PaginatedList, error) {
 	items := v1.PaginatedList{}
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -111,6 +111,60 @@ func Test_ListResources(t *testing.T) {
 		require.Equal(t, expected, response)
 	})
 
+	t.Run("success - tag filter", func(t *testing.T) {
+		storage, ctrl := setupListResources(t)
+
+		storage.EXPECT().
+			Get(gomock.Any(), resourceGroupID).
+			Return(&store.Object{Data: resourceGroupDatamodel}, nil).
+			Times(1)
+
+		expectedQuery := store.Query{
+			RootScope:    resourceGroupID,
+			ResourceType: v20231001preview.ResourceType,
+			Filters: []store.QueryFilter{
+				{Field: "properties.tags.team", Value: "payments"},
+				{Field: "properties.tags.env", Value: ""},
+			},
+		}
+		storage.EXPECT().
+			Query(gomock.Any(), expectedQuery).
+			Return(&store.ObjectQueryResult{Items: []store.Object{{Data: entryDatamodel}}}, nil).
+			Times(1)
+
+		expected := armrpc_rest.NewOKResponse(&v1.PaginatedList{
+			Value: []any{&entryResource},
+		})
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version+"&tag=team%3Dpayments&tag=env%3D", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
+	invalidTagCases := []struct {
+		name  string
+		value string
+	}{
+		{name: "missing value", value: "team"},
+		{name: "empty key", value: "%3Dpayments"},
+		{name: "nested key", value: "team.name%3Dpayments"},
+	}
+	for _, tc := range invalidTagCases {
+		t.Run("invalid tag filter - "+tc.name, func(t *testing.T) {
+			_, ctrl := setupListResources(t)
+
+			request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version+"&tag="+tc.value, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(request)
+			response, err := ctrl.Run(ctx, nil, request)
+			require.NoError(t, err)
+			require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+		})
+	}
+
 	t.Run("resource group not found", func(t *testing.T) {
 		storage, ctrl := setupListResources(t)
 
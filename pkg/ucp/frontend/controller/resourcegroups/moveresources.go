@@ -0,0 +1,412 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	http "net/http"
+	"strings"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ armrpc_controller.Controller = (*MoveResources)(nil)
+
+// MoveResources is the controller implementation to move resources from one resource group to another resource group
+// in the same plane.
+type MoveResources struct {
+	armrpc_controller.Operation[*datamodel.ResourceGroup, datamodel.ResourceGroup]
+}
+
+// NewMoveResources creates a new controller for moving resources between resource groups.
+func NewMoveResources(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &MoveResources{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.ResourceGroup]{
+				RequestConverter:  converter.ResourceGroupDataModelFromVersioned,
+				ResponseConverter: converter.ResourceGroupDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run validates the move request and moves each resource to the target resource group. Moving a resource rewrites
+// its ID in the data store, moves its tracked resource entry and rewrites the references to it in the other tracked
+// resources of the plane. All of these writes are committed in a single transaction, so a failed move leaves every
+// resource in the source resource group.
+func (r *MoveResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	relativePath := middleware.GetRelativePath(r.Options().PathBase, req.URL.Path)
+	id, err := resources.Parse(relativePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cut off the "moveResources" part of the ID. The ID should be the ID of a resource group.
+	sourceID := id.Truncate()
+
+	body, err := armrpc_controller.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	request := v20231001preview.MoveResourcesRequest{}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("failed to read request body: %v", err)), nil
+	}
+
+	_, err = r.StorageClient().Get(ctx, sourceID.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return armrpc_rest.NewNotFoundResponse(sourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if _, ok := r.StorageClient().(store.CollectionScoped); ok {
+		// The data and the tracked resource entries of the moved resources are kept in different collections, which
+		// can't be written in a single transaction.
+		return armrpc_rest.NewBadRequestResponse("moving resources is not supported by the data store of UCP"), nil
+	}
+
+	targetID, message := r.validateTarget(sourceID, to.String(request.TargetResourceGroup))
+	if message != "" {
+		return armrpc_rest.NewBadRequestResponse(message), nil
+	}
+
+	// The request path only covers the source resource group, so the write access to the target resource group is
+	// authorized here.
+	allowed, err := authorization.AuthorizeFromContext(ctx, http.MethodPut, targetID.String())
+	if err != nil {
+		return nil, err
+	} else if !allowed {
+		return armrpc_rest.NewForbiddenResponse(fmt.Sprintf("The caller is not allowed to write to the target resource group %q.", targetID.String())), nil
+	}
+
+	_, err = r.StorageClient().Get(ctx, targetID.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("target resource group %q does not exist", targetID.String())), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	moves := map[string]resources.ID{}
+	sources := []resources.ID{}
+	for _, value := range request.Resources {
+		resourceID, message := r.validateResource(sourceID, to.String(value))
+		if message != "" {
+			return armrpc_rest.NewBadRequestResponse(message), nil
+		}
+
+		_, err := r.StorageClient().Get(ctx, trackedresource.IDFor(resourceID).String())
+		if errors.Is(err, &store.ErrNotFound{}) {
+			return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("resource %q does not exist in resource group %q", resourceID.String(), sourceID.String())), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		key := strings.ToLower(resourceID.String())
+		if _, ok := moves[key]; ok {
+			continue
+		}
+
+		newID := resources.MustParse(resources.MakeUCPID(targetID.ScopeSegments(), resourceID.TypeSegments(), nil))
+		exists, err := r.exists(ctx, newID)
+		if err != nil {
+			return nil, err
+		} else if exists {
+			return armrpc_rest.NewConflictResponse(fmt.Sprintf("resource %q already exists in resource group %q", newID.String(), targetID.String())), nil
+		}
+
+		moves[key] = newID
+		sources = append(sources, resourceID)
+	}
+
+	if len(sources) == 0 {
+		return armrpc_rest.NewBadRequestResponse("at least one resource must be provided"), nil
+	}
+
+	// The lock middleware only checks the source resource group, so the locks of the moved resources and of the target
+	// resource group are checked here. A moved resource is deleted from the source resource group, so it is blocked by a
+	// lock of any level on the resource or its resource group, and it is created in the target resource group, so it is
+	// blocked by a ReadOnly lock on the target resource group.
+	checker := locks.CheckerFromContext(ctx)
+	for _, resourceID := range sources {
+		newID := moves[strings.ToLower(resourceID.String())]
+		checks := []struct {
+			method string
+			id     resources.ID
+		}{
+			{http.MethodDelete, resourceID},
+			{http.MethodPut, newID},
+		}
+		for _, check := range checks {
+			lock, err := checker.Check(ctx, check.method, check.id)
+			if err != nil {
+				return nil, err
+			} else if lock != nil {
+				message := locks.BlockedMessage(fmt.Sprintf("Moving %q to %q", resourceID.String(), targetID.String()), lock)
+				logger.Info(message)
+				return armrpc_rest.NewScopeLockedResponse(message), nil
+			}
+		}
+	}
+
+	tx := &store.Transaction{}
+	moved := []*string{}
+	for _, resourceID := range sources {
+		newID := moves[strings.ToLower(resourceID.String())]
+		logger.Info("Moving resource", "resourceID", resourceID.String(), "newResourceID", newID.String())
+
+		if err := r.moveResourceData(ctx, tx, resourceID, newID, sources, moves); err != nil {
+			return nil, err
+		}
+
+		if err := r.moveTrackedResource(ctx, tx, resourceID, newID); err != nil {
+			return nil, err
+		}
+
+		moved = append(moved, to.Ptr(newID.String()))
+	}
+
+	if err := r.rewriteReferences(ctx, tx, sourceID, sources, moves); err != nil {
+		return nil, err
+	}
+
+	err = store.Commit(ctx, r.StorageClient(), tx)
+	if errors.Is(err, &store.ErrConcurrency{}) {
+		return armrpc_rest.NewConflictResponse("the resources were modified during the move, please retry the request"), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewOKResponse(&v20231001preview.MoveResourcesResult{Resources: moved}), nil
+}
+
+// validateTarget parses and validates the ID of the target resource group. Returns a message describing the problem
+// if the target is invalid.
+func (r *MoveResources) validateTarget(sourceID resources.ID, value string) (resources.ID, string) {
+	targetID, err := resources.ParseScope(value)
+	if err != nil || targetID.FindScope(resources_radius.ScopeResourceGroups) == "" || len(targetID.ScopeSegments()) != len(sourceID.ScopeSegments()) {
+		return resources.ID{}, fmt.Sprintf("target resource group %q is not a valid resource group ID", value)
+	}
+
+	if !strings.EqualFold(targetID.PlaneScope(), sourceID.PlaneScope()) {
+		return resources.ID{}, fmt.Sprintf("target resource group %q must be in the plane %q", value, sourceID.PlaneScope())
+	}
+
+	if strings.EqualFold(targetID.String(), sourceID.String()) {
+		return resources.ID{}, fmt.Sprintf("target resource group %q must be different from the source resource group", value)
+	}
+
+	return targetID, ""
+}
+
+// validateResource parses and validates the ID of a resource to move. Returns a message describing the problem
+// if the resource is invalid.
+func (r *MoveResources) validateResource(sourceID resources.ID, value string) (resources.ID, string) {
+	resourceID, err := resources.ParseResource(value)
+	if err != nil {
+		return resources.ID{}, fmt.Sprintf("resource %q is not a valid resource ID", value)
+	}
+
+	if !strings.EqualFold(resourceID.RootScope(), sourceID.String()) {
+		return resources.ID{}, fmt.Sprintf("resource %q is not in resource group %q", value, sourceID.String())
+	}
+
+	if len(resourceID.TypeSegments()) != 1 {
+		return resources.ID{}, fmt.Sprintf("resource %q is not a top-level resource", value)
+	}
+
+	return resourceID, ""
+}
+
+// exists returns true if either the data or the tracked resource entry of the resource is stored.
+func (r *MoveResources) exists(ctx context.Context, id resources.ID) (bool, error) {
+	_, err := r.StorageClient().Get(ctx, trackedresource.IDFor(id).String())
+	if err == nil {
+		return true, nil
+	} else if !errors.Is(err, &store.ErrNotFound{}) {
+		return false, err
+	}
+
+	client, err := r.DataProvider().GetStorageClient(ctx, id.Type())
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.Get(ctx, id.String())
+	if err == nil {
+		return true, nil
+	} else if !errors.Is(err, &store.ErrNotFound{}) {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// moveResourceData adds to the transaction the save of the data of the resource under its new ID and the delete of the
+// data stored under its original ID. References to all of the moved resources are rewritten in the data.
+func (r *MoveResources) moveResourceData(ctx context.Context, tx *store.Transaction, oldID resources.ID, newID resources.ID, sources []resources.ID, moves map[string]resources.ID) error {
+	client, err := r.DataProvider().GetStorageClient(ctx, oldID.Type())
+	if err != nil {
+		return err
+	}
+
+	obj, err := client.Get(ctx, oldID.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		// The resource is tracked but its data is not stored by UCP's data store. Only the tracked resource
+		// entry will be moved.
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	data, err := decodeData(obj.Data)
+	if err != nil {
+		return err
+	}
+
+	for _, sourceID := range sources {
+		trackedresource.RewriteReferences(data, sourceID, moves[strings.ToLower(sourceID.String())])
+	}
+
+	tx.Save(&store.Object{Metadata: store.Metadata{ID: newID.String()}, Data: data})
+	tx.Delete(oldID.String(), store.WithETag(obj.ETag))
+
+	return nil
+}
+
+// moveTrackedResource adds to the transaction the replacement of the tracked resource entry of the resource with an
+// entry for its new ID.
+func (r *MoveResources) moveTrackedResource(ctx context.Context, tx *store.Transaction, oldID resources.ID, newID resources.ID) error {
+	oldTrackingID := trackedresource.IDFor(oldID)
+	obj, err := r.StorageClient().Get(ctx, oldTrackingID.String())
+	if err != nil {
+		return err
+	}
+
+	existing := &datamodel.GenericResource{}
+	if err := obj.As(existing); err != nil {
+		return err
+	}
+
+	newTrackingID := trackedresource.IDFor(newID)
+	entry := datamodel.GenericResourceFromID(newID, newTrackingID)
+	entry.Properties.APIVersion = existing.Properties.APIVersion
+	entry.Properties.Tags = existing.Properties.Tags
+	entry.AsyncProvisioningState = existing.AsyncProvisioningState
+
+	tx.Save(&store.Object{Metadata: store.Metadata{ID: newTrackingID.String()}, Data: entry})
+	tx.Delete(oldTrackingID.String(), store.WithETag(obj.ETag))
+
+	return nil
+}
+
+// rewriteReferences adds to the transaction the rewrite of the references to the moved resources in the data of the
+// other tracked resources of the plane.
+func (r *MoveResources) rewriteReferences(ctx context.Context, tx *store.Transaction, sourceID resources.ID, sources []resources.ID, moves map[string]resources.ID) error {
+	query := store.Query{
+		RootScope:      sourceID.PlaneScope(),
+		ScopeRecursive: true,
+		ResourceType:   v20231001preview.ResourceType,
+	}
+
+	result, err := r.StorageClient().Query(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range result.Items {
+		entry := datamodel.GenericResource{}
+		if err := item.As(&entry); err != nil {
+			return err
+		}
+
+		id, err := resources.ParseResource(entry.Properties.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := moves[strings.ToLower(id.String())]; ok {
+			// References in the moved resources were already rewritten.
+			continue
+		}
+
+		client, err := r.DataProvider().GetStorageClient(ctx, id.Type())
+		if err != nil {
+			return err
+		}
+
+		obj, err := client.Get(ctx, id.String())
+		if errors.Is(err, &store.ErrNotFound{}) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		data, err := decodeData(obj.Data)
+		if err != nil {
+			return err
+		}
+
+		changed := false
+		for _, oldID := range sources {
+			changed = trackedresource.RewriteReferences(data, oldID, moves[strings.ToLower(oldID.String())]) || changed
+		}
+
+		if !changed {
+			continue
+		}
+
+		obj.Data = data
+		tx.Save(obj, store.WithETag(obj.ETag))
+	}
+
+	return nil
+}
+
+// decodeData converts stored resource data into its generic JSON representation.
+func decodeData(data any) (any, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded any
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,461 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+)
+
+const (
+	moveSourceGroupID = "/planes/radius/local/resourceGroups/dev-a"
+	moveTargetGroupID = "/planes/radius/local/resourceGroups/dev-b"
+	moveOtherGroupID  = "/planes/radius/local/resourceGroups/shared"
+)
+
+func Test_MoveResources(t *testing.T) {
+	applicationID := moveSourceGroupID + "/providers/Applications.Core/applications/my-app"
+	containerID := moveSourceGroupID + "/providers/Applications.Core/containers/my-container"
+	gatewayID := moveOtherGroupID + "/providers/Applications.Core/gateways/my-gateway"
+	environmentID := moveOtherGroupID + "/providers/Applications.Core/environments/my-env"
+
+	newApplicationID := moveTargetGroupID + "/providers/Applications.Core/applications/my-app"
+	newContainerID := moveTargetGroupID + "/providers/Applications.Core/containers/my-container"
+
+	setup := func(t *testing.T) (store.StorageClient, *MoveResources) {
+		storage, ctrl := setupMoveResources(t)
+		saveMoveTestResourceGroup(t, storage, moveSourceGroupID)
+		saveMoveTestResourceGroup(t, storage, moveTargetGroupID)
+		saveMoveTestResourceGroup(t, storage, moveOtherGroupID)
+
+		saveMoveTestResource(t, storage, environmentID, map[string]any{})
+		saveMoveTestResource(t, storage, applicationID, map[string]any{"environment": environmentID})
+		saveMoveTestResource(t, storage, containerID, map[string]any{
+			"application": applicationID,
+			"connections": map[string]any{"self": map[string]any{"source": containerID + "/ports/web"}},
+		})
+		saveMoveTestResource(t, storage, gatewayID, map[string]any{
+			"application": applicationID,
+			"routes":      []any{map[string]any{"destination": containerID}},
+		})
+
+		return storage, ctrl
+	}
+
+	t.Run("success", func(t *testing.T) {
+		storage, ctrl := setup(t)
+
+		response, err := runMoveResources(t, ctrl, moveSourceGroupID, v20231001preview.MoveResourcesRequest{
+			TargetResourceGroup: to.Ptr(moveTargetGroupID),
+			Resources:           to.SliceOfPtrs(applicationID, containerID),
+		})
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewOKResponse(&v20231001preview.MoveResourcesResult{
+			Resources: to.SliceOfPtrs(newApplicationID, newContainerID),
+		}), response)
+
+		// The data is stored under the new IDs with references rewritten.
+		for _, id := range []string{applicationID, containerID} {
+			_, err := storage.Get(context.Background(), id)
+			require.ErrorIs(t, err, &store.ErrNotFound{})
+
+			_, err = storage.Get(context.Background(), trackedresource.IDFor(resources.MustParse(id)).String())
+			require.ErrorIs(t, err, &store.ErrNotFound{})
+		}
+
+		application := getMoveTestResource(t, storage, newApplicationID)
+		require.Equal(t, newApplicationID, application["id"])
+		require.Equal(t, environmentID, application["properties"].(map[string]any)["environment"])
+
+		container := getMoveTestResource(t, storage, newContainerID)
+		require.Equal(t, newContainerID, container["id"])
+		require.Equal(t, newApplicationID, container["properties"].(map[string]any)["application"])
+		require.Equal(t, newContainerID+"/ports/web", container["properties"].(map[string]any)["connections"].(map[string]any)["self"].(map[string]any)["source"])
+
+		// References from resources that were not moved are rewritten.
+		gateway := getMoveTestResource(t, storage, gatewayID)
+		require.Equal(t, newApplicationID, gateway["properties"].(map[string]any)["application"])
+		require.Equal(t, newContainerID, gateway["properties"].(map[string]any)["routes"].([]any)[0].(map[string]any)["destination"])
+
+		// The tracked resource entries are moved.
+		entry, err := store.GetResource[datamodel.GenericResource](context.Background(), storage, trackedresource.IDFor(resources.MustParse(newContainerID)).String())
+		require.NoError(t, err)
+		require.Equal(t, newContainerID, entry.Properties.ID)
+		require.Equal(t, "2023-10-01-preview", entry.Properties.APIVersion)
+		require.Equal(t, map[string]string{"team": "payments"}, entry.Properties.Tags)
+	})
+
+	invalidCases := []struct {
+		name    string
+		request v20231001preview.MoveResourcesRequest
+	}{
+		{
+			name:    "invalid target",
+			request: v20231001preview.MoveResourcesRequest{TargetResourceGroup: to.Ptr("not-an-id"), Resources: to.SliceOfPtrs(applicationID)},
+		},
+		{
+			name:    "target in another plane",
+			request: v20231001preview.MoveResourcesRequest{TargetResourceGroup: to.Ptr("/planes/radius/other/resourceGroups/dev-b"), Resources: to.SliceOfPtrs(applicationID)},
+		},
+		{
+			name:    "target is the source",
+			request: v20231001preview.MoveResourcesRequest{TargetResourceGroup: to.Ptr(moveSourceGroupID), Resources: to.SliceOfPtrs(applicationID)},
+		},
+		{
+			name:    "target does not exist",
+			request: v20231001preview.MoveResourcesRequest{TargetResourceGroup: to.Ptr("/planes/radius/local/resourceGroups/missing"), Resources: to.SliceOfPtrs(applicationID)},
+		},
+		{
+			name:    "resource in another group",
+			request: v20231001preview.MoveResourcesRequest{TargetResourceGroup: to.Ptr(moveTargetGroupID), Resources: to.SliceOfPtrs(gatewayID)},
+		},
+		{
+			name:    "resource does not exist",
+			request: v20231001preview.MoveResourcesRequest{TargetResourceGroup: to.Ptr(moveTargetGroupID), Resources: to.SliceOfPtrs(moveSourceGroupID + "/providers/Applications.Core/containers/missing")},
+		},
+		{
+			name:    "no resources",
+			request: v20231001preview.MoveResourcesRequest{TargetResourceGroup: to.Ptr(moveTargetGroupID), Resources: []*string{}},
+		},
+	}
+
+	for _, tc := range invalidCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storage, ctrl := setup(t)
+
+			response, err := runMoveResources(t, ctrl, moveSourceGroupID, tc.request)
+			require.NoError(t, err)
+			require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+
+			// Nothing was moved.
+			_, err = storage.Get(context.Background(), applicationID)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("target resource exists", func(t *testing.T) {
+		storage, ctrl := setup(t)
+		saveMoveTestResource(t, storage, newContainerID, map[string]any{})
+
+		response, err := runMoveResources(t, ctrl, moveSourceGroupID, v20231001preview.MoveResourcesRequest{
+			TargetResourceGroup: to.Ptr(moveTargetGroupID),
+			Resources:           to.SliceOfPtrs(applicationID, containerID),
+		})
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.ConflictResponse{}, response)
+
+		// Nothing was moved and the existing resource was not overwritten.
+		_, err = storage.Get(context.Background(), applicationID)
+		require.NoError(t, err)
+		_, err = storage.Get(context.Background(), newApplicationID)
+		require.ErrorIs(t, err, &store.ErrNotFound{})
+		container := getMoveTestResource(t, storage, newContainerID)
+		require.Equal(t, map[string]any{}, container["properties"])
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		storage, _ := setup(t)
+
+		// The client has no native transaction, so the writes are applied one at a time and the failure of the last
+		// write rolls back the others.
+		failing := &failingSaveClient{StorageClient: storage, failID: gatewayID}
+		ctrl := newMoveResources(t, failing)
+
+		_, err := runMoveResources(t, ctrl, moveSourceGroupID, v20231001preview.MoveResourcesRequest{
+			TargetResourceGroup: to.Ptr(moveTargetGroupID),
+			Resources:           to.SliceOfPtrs(applicationID, containerID),
+		})
+		require.ErrorContains(t, err, "save failed")
+
+		for _, id := range []string{applicationID, containerID} {
+			_, err := storage.Get(context.Background(), id)
+			require.NoError(t, err)
+
+			_, err = storage.Get(context.Background(), trackedresource.IDFor(resources.MustParse(id)).String())
+			require.NoError(t, err)
+		}
+
+		for _, id := range []string{newApplicationID, newContainerID} {
+			_, err := storage.Get(context.Background(), id)
+			require.ErrorIs(t, err, &store.ErrNotFound{})
+
+			_, err = storage.Get(context.Background(), trackedresource.IDFor(resources.MustParse(id)).String())
+			require.ErrorIs(t, err, &store.ErrNotFound{})
+		}
+
+		container := getMoveTestResource(t, storage, containerID)
+		require.Equal(t, applicationID, container["properties"].(map[string]any)["application"])
+		gateway := getMoveTestResource(t, storage, gatewayID)
+		require.Equal(t, applicationID, gateway["properties"].(map[string]any)["application"])
+	})
+
+	t.Run("collection scoped store", func(t *testing.T) {
+		storage, _ := setup(t)
+		ctrl := newMoveResources(t, &collectionScopedClient{StorageClient: storage})
+
+		response, err := runMoveResources(t, ctrl, moveSourceGroupID, v20231001preview.MoveResourcesRequest{
+			TargetResourceGroup: to.Ptr(moveTargetGroupID),
+			Resources:           to.SliceOfPtrs(applicationID),
+		})
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+
+	t.Run("target resource group not authorized", func(t *testing.T) {
+		storage, ctrl := setup(t)
+
+		// The caller can write to the source resource group but only read the target resource group.
+		saveMoveTestRoleAssignment(t, storage, "source", datamodel.RoleContributor, moveSourceGroupID)
+		saveMoveTestRoleAssignment(t, storage, "target", datamodel.RoleReader, moveTargetGroupID)
+		authorizer := authorization.NewAuthorizer(authorization.Options{Enabled: true}, storage, nil, nil)
+		ctx := authorization.WithRequestAuthorization(context.Background(), authorizer, authorization.Principal{User: "alice"})
+
+		response, err := runMoveResourcesWithContext(t, ctx, ctrl, moveSourceGroupID, v20231001preview.MoveResourcesRequest{
+			TargetResourceGroup: to.Ptr(moveTargetGroupID),
+			Resources:           to.SliceOfPtrs(applicationID),
+		})
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.ForbiddenResponse{}, response)
+
+		_, err = storage.Get(context.Background(), applicationID)
+		require.NoError(t, err)
+
+		// The move is allowed once the caller can write to the target resource group.
+		saveMoveTestRoleAssignment(t, storage, "target", datamodel.RoleContributor, moveTargetGroupID)
+		response, err = runMoveResourcesWithContext(t, ctx, ctrl, moveSourceGroupID, v20231001preview.MoveResourcesRequest{
+			TargetResourceGroup: to.Ptr(moveTargetGroupID),
+			Resources:           to.SliceOfPtrs(applicationID),
+		})
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.OKResponse{}, response)
+	})
+
+	lockCases := []struct {
+		name  string
+		level string
+		scope string
+	}{
+		{name: "locked resource", level: datamodel.LockLevelCanNotDelete, scope: containerID},
+		{name: "locked source resource group", level: datamodel.LockLevelCanNotDelete, scope: moveSourceGroupID},
+		{name: "read-only target resource group", level: datamodel.LockLevelReadOnly, scope: moveTargetGroupID},
+	}
+
+	for _, tc := range lockCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storage, ctrl := setup(t)
+			saveMoveTestLock(t, storage, tc.level, tc.scope)
+			ctx := locks.WithChecker(context.Background(), locks.NewChecker(storage))
+
+			response, err := runMoveResourcesWithContext(t, ctx, ctrl, moveSourceGroupID, v20231001preview.MoveResourcesRequest{
+				TargetResourceGroup: to.Ptr(moveTargetGroupID),
+				Resources:           to.SliceOfPtrs(applicationID, containerID),
+			})
+			require.NoError(t, err)
+			require.IsType(t, &armrpc_rest.ConflictResponse{}, response)
+
+			// Nothing was moved.
+			for _, id := range []string{applicationID, containerID} {
+				_, err := storage.Get(context.Background(), id)
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("CanNotDelete lock on target resource group", func(t *testing.T) {
+		storage, ctrl := setup(t)
+		saveMoveTestLock(t, storage, datamodel.LockLevelCanNotDelete, moveTargetGroupID)
+		ctx := locks.WithChecker(context.Background(), locks.NewChecker(storage))
+
+		response, err := runMoveResourcesWithContext(t, ctx, ctrl, moveSourceGroupID, v20231001preview.MoveResourcesRequest{
+			TargetResourceGroup: to.Ptr(moveTargetGroupID),
+			Resources:           to.SliceOfPtrs(applicationID),
+		})
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.OKResponse{}, response)
+	})
+
+	t.Run("source resource group not found", func(t *testing.T) {
+		_, ctrl := setup(t)
+
+		response, err := runMoveResources(t, ctrl, "/planes/radius/local/resourceGroups/missing", v20231001preview.MoveResourcesRequest{
+			TargetResourceGroup: to.Ptr(moveTargetGroupID),
+			Resources:           to.SliceOfPtrs(applicationID),
+		})
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.NotFoundResponse{}, response)
+	})
+}
+
+func runMoveResources(t *testing.T, ctrl *MoveResources, resourceGroupID string, body v20231001preview.MoveResourcesRequest) (armrpc_rest.Response, error) {
+	return runMoveResourcesWithContext(t, context.Background(), ctrl, resourceGroupID, body)
+}
+
+func runMoveResourcesWithContext(t *testing.T, ctx context.Context, ctrl *MoveResources, resourceGroupID string, body v20231001preview.MoveResourcesRequest) (armrpc_rest.Response, error) {
+	b, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, ctrl.Options().PathBase+resourceGroupID+"/moveResources?api-version="+v20231001preview.Version, bytes.NewBuffer(b))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+	armctx := v1.ARMRequestContextFromContext(rpctest.NewARMRequestContext(request))
+	return ctrl.Run(v1.WithARMRequestContext(ctx, armctx), nil, request)
+}
+
+func saveMoveTestRoleAssignment(t *testing.T, storage store.StorageClient, name string, role string, scope string) {
+	id := "/planes/radius/local/providers/System.Authorization/roleAssignments/" + name
+	assignment := datamodel.RoleAssignment{
+		Properties: datamodel.RoleAssignmentProperties{
+			PrincipalID:        "alice",
+			PrincipalType:      datamodel.PrincipalTypeUser,
+			RoleDefinitionName: role,
+			Scope:              scope,
+		},
+	}
+	assignment.ID = id
+	assignment.Name = name
+	assignment.Type = datamodel.RoleAssignmentResourceType
+
+	err := storage.Save(context.Background(), &store.Object{Metadata: store.Metadata{ID: id}, Data: assignment})
+	require.NoError(t, err)
+}
+
+func saveMoveTestLock(t *testing.T, storage store.StorageClient, level string, scope string) {
+	id := "/planes/radius/local/providers/System.Authorization/locks/" + uuid.New().String()
+	lock := datamodel.Lock{
+		Properties: datamodel.LockProperties{
+			Level: level,
+			Scope: scope,
+		},
+	}
+	lock.ID = id
+	lock.Name = resources.MustParse(id).Name()
+	lock.Type = datamodel.LockResourceType
+
+	err := storage.Save(context.Background(), &store.Object{Metadata: store.Metadata{ID: id}, Data: lock})
+	require.NoError(t, err)
+}
+
+func saveMoveTestResourceGroup(t *testing.T, storage store.StorageClient, id string) {
+	parsed := resources.MustParse(id)
+	group := datamodel.ResourceGroup{}
+	group.ID = id
+	group.Name = parsed.Name()
+	group.Type = v20231001preview.ResourceGroupType
+
+	err := storage.Save(context.Background(), &store.Object{Metadata: store.Metadata{ID: id}, Data: group})
+	require.NoError(t, err)
+}
+
+func saveMoveTestResource(t *testing.T, storage store.StorageClient, id string, properties map[string]any) {
+	parsed := resources.MustParse(id)
+	data := map[string]any{
+		"id":         id,
+		"name":       parsed.Name(),
+		"type":       parsed.Type(),
+		"properties": properties,
+	}
+
+	err := storage.Save(context.Background(), &store.Object{Metadata: store.Metadata{ID: id}, Data: data})
+	require.NoError(t, err)
+
+	trackingID := trackedresource.IDFor(parsed)
+	entry := datamodel.GenericResourceFromID(parsed, trackingID)
+	entry.Properties.APIVersion = "2023-10-01-preview"
+	entry.Properties.Tags = map[string]string{"team": "payments"}
+	err = storage.Save(context.Background(), &store.Object{Metadata: store.Metadata{ID: trackingID.String()}, Data: entry})
+	require.NoError(t, err)
+}
+
+func getMoveTestResource(t *testing.T, storage store.StorageClient, id string) map[string]any {
+	obj, err := storage.Get(context.Background(), id)
+	require.NoError(t, err)
+
+	data := map[string]any{}
+	err = obj.As(&data)
+	require.NoError(t, err)
+
+	return data
+}
+
+func setupMoveResources(t *testing.T) (store.StorageClient, *MoveResources) {
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = boltstore.Close(db) })
+
+	storage, err := boltstore.NewBoltClient(db)
+	require.NoError(t, err)
+
+	return storage, newMoveResources(t, storage)
+}
+
+func newMoveResources(t *testing.T, storage store.StorageClient) *MoveResources {
+	mctrl := gomock.NewController(t)
+	dataProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+	dataProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(storage, nil).AnyTimes()
+
+	c, err := NewMoveResources(armrpc_controller.Options{StorageClient: storage, DataProvider: dataProvider, PathBase: "/" + uuid.New().String()})
+	require.NoError(t, err)
+
+	return c.(*MoveResources)
+}
+
+// failingSaveClient is a storage client without native transactions which fails to save the object with the given ID.
+type failingSaveClient struct {
+	store.StorageClient
+	failID string
+}
+
+func (c *failingSaveClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if strings.EqualFold(obj.ID, c.failID) {
+		return errors.New("save failed")
+	}
+	return c.StorageClient.Save(ctx, obj, options...)
+}
+
+// collectionScopedClient is a storage client which keeps each resource type in a separate collection.
+type collectionScopedClient struct {
+	store.StorageClient
+}
+
+func (c *collectionScopedClient) Collection() string {
+	return "collection"
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roleassignments
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateRequest validates the role and the scope of a role assignment. The scope defaults to the plane of the role
+// assignment and must be within it. It returns a BadRequestResponse if the role assignment is invalid.
+func ValidateRequest(ctx context.Context, newResource *datamodel.RoleAssignment, oldResource *datamodel.RoleAssignment, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	planeID := serviceCtx.ResourceID.RootScope()
+
+	if newResource.Properties.PrincipalID == "" {
+		return rest.NewBadRequestResponse("The principalId of the role assignment must not be empty."), nil
+	}
+
+	if newResource.Properties.PrincipalType != datamodel.PrincipalTypeUser && newResource.Properties.PrincipalType != datamodel.PrincipalTypeGroup {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The principalType %q is not supported. Supported principal types are %q and %q.", newResource.Properties.PrincipalType, datamodel.PrincipalTypeUser, datamodel.PrincipalTypeGroup)), nil
+	}
+
+	if !authorization.IsBuiltInRole(newResource.Properties.RoleDefinitionName) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The role %q is not supported. Supported roles are %q, %q and %q.", newResource.Properties.RoleDefinitionName, datamodel.RoleReader, datamodel.RoleContributor, datamodel.RoleOwner)), nil
+	}
+
+	scope := newResource.Properties.Scope
+	if scope == "" {
+		scope = planeID
+	}
+
+	if _, err := resources.Parse(scope); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The scope %q is not a valid resource ID.", scope)), nil
+	}
+
+	if !authorization.ScopeContains(planeID, scope) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The scope %q must be within the plane %q of the role assignment.", scope, planeID)), nil
+	}
+
+	newResource.Properties.Scope = scope
+	return nil, nil
+}
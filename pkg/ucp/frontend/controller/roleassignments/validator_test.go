@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roleassignments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const roleAssignmentID = "/planes/radius/local/providers/System.Authorization/roleAssignments/alice"
+
+func Test_ValidateRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		properties    datamodel.RoleAssignmentProperties
+		expectedScope string
+		expectedError string
+	}{
+		{
+			name: "defaults scope to plane",
+			properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:        "alice",
+				PrincipalType:      datamodel.PrincipalTypeUser,
+				RoleDefinitionName: datamodel.RoleReader,
+			},
+			expectedScope: "/planes/radius/local",
+		},
+		{
+			name: "resource group scope",
+			properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:        "developers",
+				PrincipalType:      datamodel.PrincipalTypeGroup,
+				RoleDefinitionName: datamodel.RoleContributor,
+				Scope:              "/planes/radius/local/resourceGroups/rg",
+			},
+			expectedScope: "/planes/radius/local/resourceGroups/rg",
+		},
+		{
+			name: "empty principal",
+			properties: datamodel.RoleAssignmentProperties{
+				PrincipalType:      datamodel.PrincipalTypeUser,
+				RoleDefinitionName: datamodel.RoleReader,
+			},
+			expectedError: "The principalId of the role assignment must not be empty.",
+		},
+		{
+			name: "unsupported principal type",
+			properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:        "alice",
+				PrincipalType:      "ServicePrincipal",
+				RoleDefinitionName: datamodel.RoleReader,
+			},
+			expectedError: "The principalType \"ServicePrincipal\" is not supported. Supported principal types are \"User\" and \"Group\".",
+		},
+		{
+			name: "unsupported role",
+			properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:        "alice",
+				PrincipalType:      datamodel.PrincipalTypeUser,
+				RoleDefinitionName: "Administrator",
+			},
+			expectedError: "The role \"Administrator\" is not supported. Supported roles are \"Reader\", \"Contributor\" and \"Owner\".",
+		},
+		{
+			name: "invalid scope",
+			properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:        "alice",
+				PrincipalType:      datamodel.PrincipalTypeUser,
+				RoleDefinitionName: datamodel.RoleReader,
+				Scope:              "not-an-id",
+			},
+			expectedError: "The scope \"not-an-id\" is not a valid resource ID.",
+		},
+		{
+			name: "scope in another plane",
+			properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:        "alice",
+				PrincipalType:      datamodel.PrincipalTypeUser,
+				RoleDefinitionName: datamodel.RoleReader,
+				Scope:              "/planes/radius/other/resourceGroups/rg",
+			},
+			expectedError: "The scope \"/planes/radius/other/resourceGroups/rg\" must be within the plane \"/planes/radius/local\" of the role assignment.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := resources.ParseResource(roleAssignmentID)
+			require.NoError(t, err)
+			ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{ResourceID: id})
+
+			assignment := &datamodel.RoleAssignment{Properties: tt.properties}
+			resp, err := ValidateRequest(ctx, assignment, nil, nil)
+			require.NoError(t, err)
+
+			if tt.expectedError != "" {
+				require.IsType(t, &rest.BadRequestResponse{}, resp)
+				require.Equal(t, tt.expectedError, resp.(*rest.BadRequestResponse).Body.Error.Message)
+				return
+			}
+
+			require.Nil(t, resp)
+			require.Equal(t, tt.expectedScope, assignment.Properties.Scope)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	gcp_credential_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials/gcp"
+	gcpproxy_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/gcpproxy"
+	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+	"github.com/radius-project/radius/pkg/ucp/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"github.com/radius-project/radius/pkg/validator"
+)
+
+const (
+	planeCollectionPath = "/planes/gcp"
+	planeResourcePath   = "/planes/gcp/{planeName}"
+
+	serviceResourcePath = planeResourcePath + "/projects/{projectId}/providers/{service}"
+
+	credentialResourcePath   = planeResourcePath + "/providers/System.GCP/credentials/{credentialName}"
+	credentialCollectionPath = planeResourcePath + "/providers/System.GCP/credentials"
+
+	// OperationTypeGCPResource is the operation type for proxied requests to GCP APIs.
+	OperationTypeGCPResource = "GCPRESOURCE"
+)
+
+// Initialize initializes the GCP module.
+func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
+	secretClient, err := m.options.SecretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Support override of the token provider for testing.
+	if m.TokenProvider == nil {
+		m.TokenProvider, err = m.newTokenProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	baseRouter := server.NewSubrouter(m.router, m.options.PathBase)
+
+	apiValidator := validator.APIValidator(validator.Options{
+		SpecLoader:         m.options.SpecLoader,
+		ResourceTypeGetter: validator.UCPResourceTypeGetter,
+	})
+
+	planeResourceOptions := controller.ResourceOptions[datamodel.GCPPlane]{
+		RequestConverter:  converter.GCPPlaneDataModelFromVersioned,
+		ResponseConverter: converter.GCPPlaneDataModelToVersioned,
+	}
+
+	// URLs for lifecycle of planes
+	planeResourceType := "System.GCP/planes"
+	planeCollectionRouter := server.NewSubrouter(baseRouter, planeCollectionPath, apiValidator)
+	planeResourceRouter := server.NewSubrouter(baseRouter, planeResourcePath, apiValidator)
+
+	handlerOptions := []server.HandlerOptions{
+		{
+			// This is a scope query so we can't use the default operation.
+			ParentRouter:  planeCollectionRouter,
+			Method:        v1.OperationList,
+			OperationType: &v1.OperationType{Type: planeResourceType, Method: v1.OperationList},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return &planes_ctrl.ListPlanesByType[*datamodel.GCPPlane, datamodel.GCPPlane]{
+					Operation: controller.NewOperation(opts, planeResourceOptions),
+				}, nil
+			},
+		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationGet,
+			OperationType: &v1.OperationType{Type: planeResourceType, Method: v1.OperationGet},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opts, planeResourceOptions)
+			},
+		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationPut,
+			OperationType: &v1.OperationType{Type: planeResourceType, Method: v1.OperationPut},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncPut(opts, planeResourceOptions)
+			},
+		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationDelete,
+			OperationType: &v1.OperationType{Type: planeResourceType, Method: v1.OperationDelete},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncDelete(opts, planeResourceOptions)
+			},
+		},
+	}
+
+	// URLs for operations on GCP credential resources.
+	credentialCollectionRouter := server.NewSubrouter(baseRouter, credentialCollectionPath, apiValidator)
+	credentialResourceRouter := server.NewSubrouter(baseRouter, credentialResourcePath, apiValidator)
+
+	handlerOptions = append(handlerOptions, []server.HandlerOptions{
+		{
+			ParentRouter: credentialCollectionRouter,
+			ResourceType: v20231001preview.GCPCredentialType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources(opt,
+					controller.ResourceOptions[datamodel.GCPCredential]{
+						RequestConverter:  converter.GCPCredentialDataModelFromVersioned,
+						ResponseConverter: converter.GCPCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			ResourceType: v20231001preview.GCPCredentialType,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opt,
+					controller.ResourceOptions[datamodel.GCPCredential]{
+						RequestConverter:  converter.GCPCredentialDataModelFromVersioned,
+						ResponseConverter: converter.GCPCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationPut,
+			ResourceType: v20231001preview.GCPCredentialType,
+			ControllerFactory: func(o controller.Options) (controller.Controller, error) {
+				return gcp_credential_ctrl.NewCreateOrUpdateGCPCredential(o, secretClient, m.options.SecretProvider.VaultOptions())
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationDelete,
+			ResourceType: v20231001preview.GCPCredentialType,
+			ControllerFactory: func(o controller.Options) (controller.Controller, error) {
+				return gcp_credential_ctrl.NewDeleteGCPCredential(o, secretClient)
+			},
+		},
+	}...)
+
+	// URLs for GCP service APIs. GCP has no uniform resource lifecycle API, so requests are forwarded
+	// to the REST API of the service named in the URL.
+	//
+	// Note that the API validation is not applied for CatchAllPath(/*).
+	handlerOptions = append(handlerOptions, server.HandlerOptions{
+		ParentRouter:  server.NewSubrouter(baseRouter, serviceResourcePath),
+		Path:          server.CatchAllPath,
+		OperationType: &v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationProxy},
+		ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+			return gcpproxy_ctrl.NewProxyGCPRequest(opts, m.TokenProvider)
+		},
+	})
+
+	ctrlOpts := controller.Options{
+		Address:      m.options.Address,
+		PathBase:     m.options.PathBase,
+		DataProvider: m.options.DataProvider,
+	}
+
+	for _, h := range handlerOptions {
+		if err := server.RegisterHandler(ctx, h, ctrlOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.router, nil
+}
+
+func (m *Module) newTokenProvider(ctx context.Context) (ucp_gcp.TokenProvider, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	switch m.options.Config.Identity.AuthMethod {
+	case hostoptions.AuthUCPCredential:
+		provider, err := sdk_cred.NewGCPCredentialProvider(m.options.SecretProvider, m.options.UCPConnection, &aztoken.AnonymousCredential{})
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("Configuring 'UCPCredential' authentication mode using UCP Credential API")
+		return ucp_gcp.NewUCPTokenProvider(provider), nil
+
+	default:
+		logger.Info("Configuring default authentication mode with application default credentials.")
+		return ucp_gcp.NewDefaultTokenProvider(), nil
+	}
+}
@@ -21,23 +21,43 @@ import (
 	"net/http"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	auditrecords_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/auditrecords"
+	locks_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/locks"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	radius_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/radius"
 	resourcegroups_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	roleassignments_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/roleassignments"
 	"github.com/radius-project/radius/pkg/validator"
 )
 
 const (
-	planeCollectionPath         = "/planes/radius"
-	planeResourcePath           = "/planes/radius/{planeName}"
-	resourceGroupCollectionPath = planeResourcePath + "/resourcegroups"
-	resourceGroupResourcePath   = planeResourcePath + "/resourcegroups/{resourceGroupName}"
+	planeCollectionPath          = "/planes/radius"
+	planeResourcePath            = "/planes/radius/{planeName}"
+	resourceGroupCollectionPath  = planeResourcePath + "/resourcegroups"
+	resourceGroupResourcePath    = planeResourcePath + "/resourcegroups/{resourceGroupName}"
+	operationResultsPath         = planeResourcePath + "/providers/System.Resources/locations/{location}/operationResults/{operationId}"
+	operationStatusesPath        = planeResourcePath + "/providers/System.Resources/locations/{location}/operationStatuses/{operationId}"
+	roleAssignmentCollectionPath = planeResourcePath + "/providers/System.Authorization/roleAssignments"
+	roleAssignmentResourcePath   = planeResourcePath + "/providers/System.Authorization/roleAssignments/{roleAssignmentName}"
+	lockCollectionPath           = planeResourcePath + "/providers/System.Authorization/locks"
+	lockResourcePath             = planeResourcePath + "/providers/System.Authorization/locks/{lockName}"
+	auditRecordCollectionPath    = planeResourcePath + "/providers/System.Audit/auditRecords"
+
+	// OperationResultsResourceType is the resource type for the results of UCP async operations.
+	OperationResultsResourceType = "System.Resources/operationResults"
+
+	// OperationStatusResourceType is the resource type for the statuses of UCP async operations.
+	OperationStatusResourceType = "System.Resources/operationStatuses"
+
+	// OperationMoveResources is the operation method for moving resources between resource groups.
+	OperationMoveResources v1.OperationMethod = "MOVERESOURCES"
 
 	// OperationTypeUCPRadiusProxy is the operation type for proxying Radius API calls.
 	OperationTypeUCPRadiusProxy = "UCPRADIUSPROXY"
@@ -70,6 +90,33 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 	resourceGroupCollectionRouter := server.NewSubrouter(baseRouter, resourceGroupCollectionPath, apiValidator)
 	resourceGroupResourceRouter := server.NewSubrouter(baseRouter, resourceGroupResourcePath, apiValidator)
 
+	roleAssignmentResourceOptions := controller.ResourceOptions[datamodel.RoleAssignment]{
+		RequestConverter:  converter.RoleAssignmentDataModelFromVersioned,
+		ResponseConverter: converter.RoleAssignmentDataModelToVersioned,
+		UpdateFilters: []controller.UpdateFilter[datamodel.RoleAssignment]{
+			roleassignments_ctrl.ValidateRequest,
+		},
+	}
+
+	// URLs for lifecycle of role assignments
+	roleAssignmentCollectionRouter := server.NewSubrouter(baseRouter, roleAssignmentCollectionPath, apiValidator)
+	roleAssignmentResourceRouter := server.NewSubrouter(baseRouter, roleAssignmentResourcePath, apiValidator)
+
+	lockResourceOptions := controller.ResourceOptions[datamodel.Lock]{
+		RequestConverter:  converter.LockDataModelFromVersioned,
+		ResponseConverter: converter.LockDataModelToVersioned,
+		UpdateFilters: []controller.UpdateFilter[datamodel.Lock]{
+			locks_ctrl.ValidateRequest,
+		},
+	}
+
+	// URLs for lifecycle of locks
+	lockCollectionRouter := server.NewSubrouter(baseRouter, lockCollectionPath, apiValidator)
+	lockResourceRouter := server.NewSubrouter(baseRouter, lockResourcePath, apiValidator)
+
+	// URL for the audit records of the resources. The records are not ARM resources, so the API validation is not applied.
+	auditRecordCollectionRouter := server.NewSubrouter(baseRouter, auditRecordCollectionPath)
+
 	handlerOptions := []server.HandlerOptions{
 		{
 			// This is a scope query so we can't use the default operation.
@@ -129,12 +176,30 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			},
 		},
 		{
-			ParentRouter: resourceGroupResourceRouter,
-			ResourceType: v20231001preview.ResourceGroupType,
-			Method:       v1.OperationDelete,
-			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
-				return defaultoperation.NewDefaultSyncDelete(opts, resourceGroupResourceOptions)
-			},
+			ParentRouter:      resourceGroupResourceRouter,
+			ResourceType:      v20231001preview.ResourceGroupType,
+			Method:            v1.OperationDelete,
+			ControllerFactory: resourcegroups_ctrl.NewDeleteResourceGroup,
+		},
+		{
+			ParentRouter:      resourceGroupResourceRouter,
+			ResourceType:      v20231001preview.ResourceGroupType,
+			Path:              "/moveResources",
+			Method:            OperationMoveResources,
+			ControllerFactory: resourcegroups_ctrl.NewMoveResources,
+		},
+		{
+			// URLs for the async operations of resource groups, eg: cascading delete.
+			ParentRouter:      server.NewSubrouter(baseRouter, operationStatusesPath),
+			ResourceType:      OperationStatusResourceType,
+			Method:            v1.OperationGet,
+			ControllerFactory: defaultoperation.NewGetOperationStatus,
+		},
+		{
+			ParentRouter:      server.NewSubrouter(baseRouter, operationResultsPath),
+			ResourceType:      OperationResultsResourceType,
+			Method:            v1.OperationGet,
+			ControllerFactory: defaultoperation.NewGetOperationResult,
 		},
 		{
 			ParentRouter: resourceGroupResourceRouter,
@@ -145,6 +210,78 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 				return resourcegroups_ctrl.NewListResources(opt)
 			},
 		},
+		{
+			ParentRouter: roleAssignmentCollectionRouter,
+			ResourceType: v20231001preview.RoleAssignmentType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources(opts, roleAssignmentResourceOptions)
+			},
+		},
+		{
+			ParentRouter: roleAssignmentResourceRouter,
+			ResourceType: v20231001preview.RoleAssignmentType,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opts, roleAssignmentResourceOptions)
+			},
+		},
+		{
+			ParentRouter: roleAssignmentResourceRouter,
+			ResourceType: v20231001preview.RoleAssignmentType,
+			Method:       v1.OperationPut,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncPut(opts, roleAssignmentResourceOptions)
+			},
+		},
+		{
+			ParentRouter: roleAssignmentResourceRouter,
+			ResourceType: v20231001preview.RoleAssignmentType,
+			Method:       v1.OperationDelete,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncDelete(opts, roleAssignmentResourceOptions)
+			},
+		},
+		{
+			ParentRouter: lockCollectionRouter,
+			ResourceType: v20231001preview.LockType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources(opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: lockResourceRouter,
+			ResourceType: v20231001preview.LockType,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: lockResourceRouter,
+			ResourceType: v20231001preview.LockType,
+			Method:       v1.OperationPut,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncPut(opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: lockResourceRouter,
+			ResourceType: v20231001preview.LockType,
+			Method:       v1.OperationDelete,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncDelete(opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: auditRecordCollectionRouter,
+			ResourceType: audit.RecordResourceType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return auditrecords_ctrl.NewListAuditRecords(opts)
+			},
+		},
 		// Chi router uses radix tree so that it doesn't linear search the matched one. So, to catch all requests,
 		// we need to use CatchAllPath(/*) at the above matched routes path in chi router.
 		//
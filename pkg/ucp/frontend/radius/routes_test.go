@@ -23,6 +23,7 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
@@ -83,6 +84,54 @@ func Test_Routes(t *testing.T) {
 			OperationType: v1.OperationType{Type: v20231001preview.ResourceGroupType, Method: v1.OperationDelete},
 			Method:        http.MethodDelete,
 			Path:          "/planes/radius/local/resourcegroups/test-rg",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.ResourceGroupType, Method: OperationMoveResources},
+			Method:        http.MethodPost,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/moveResources",
+		}, {
+			OperationType: v1.OperationType{Type: OperationStatusResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Resources/locations/global/operationStatuses/00000000-0000-0000-0000-000000000000",
+		}, {
+			OperationType: v1.OperationType{Type: OperationResultsResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Resources/locations/global/operationResults/00000000-0000-0000-0000-000000000000",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.RoleAssignmentType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleAssignments",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.RoleAssignmentType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleAssignments/test-assignment",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.RoleAssignmentType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleAssignments/test-assignment",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.RoleAssignmentType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleAssignments/test-assignment",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/locks",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/providers/System.Authorization/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/providers/System.Authorization/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: audit.RecordResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Audit/auditRecords",
 		}, {
 			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
 			Method:                      http.MethodGet,
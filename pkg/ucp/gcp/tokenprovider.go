@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+)
+
+const (
+	// CloudPlatformScope is the OAuth2 scope used to access GCP APIs.
+	CloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// TokenProvider provides OAuth2 access tokens for GCP APIs.
+type TokenProvider interface {
+	// Token returns a valid access token.
+	Token(ctx context.Context) (*oauth2.Token, error)
+}
+
+var _ TokenProvider = (*UCPTokenProvider)(nil)
+
+// UCPTokenProvider is the implementation of TokenProvider to retrieve access tokens for GCP APIs via the service
+// account key registered with the UCP credential APIs.
+type UCPTokenProvider struct {
+	provider sdk_cred.CredentialProvider[sdk_cred.GCPCredential]
+
+	// newTokenSource creates the token source for a service account key. This can be overridden by tests.
+	newTokenSource func(key []byte) (oauth2.TokenSource, error)
+
+	mu     sync.Mutex
+	key    string
+	source oauth2.TokenSource
+}
+
+// NewUCPTokenProvider creates UCPTokenProvider to fetch access tokens using UCP credential APIs.
+func NewUCPTokenProvider(provider sdk_cred.CredentialProvider[sdk_cred.GCPCredential]) *UCPTokenProvider {
+	return &UCPTokenProvider{
+		provider:       provider,
+		newTokenSource: newServiceAccountTokenSource,
+	}
+}
+
+// Token fetches the service account key from UCP and returns an access token for it. The token source is reused
+// until the service account key is rotated so that tokens are only refreshed when they expire.
+func (p *UCPTokenProvider) Token(ctx context.Context) (*oauth2.Token, error) {
+	s, err := p.provider.Fetch(ctx, sdk_cred.GCPPublic, "default")
+	if err != nil {
+		return nil, err
+	}
+
+	if s.ServiceAccountKey == "" {
+		return nil, errors.New("invalid service account key info")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.source == nil || p.key != s.ServiceAccountKey {
+		source, err := p.newTokenSource([]byte(s.ServiceAccountKey))
+		if err != nil {
+			return nil, err
+		}
+		p.key = s.ServiceAccountKey
+		p.source = source
+	}
+
+	return p.source.Token()
+}
+
+var _ TokenProvider = (*DefaultTokenProvider)(nil)
+
+// DefaultTokenProvider is the implementation of TokenProvider which uses the Application Default Credentials of the
+// environment, for example GOOGLE_APPLICATION_CREDENTIALS or the metadata server.
+type DefaultTokenProvider struct {
+	mu     sync.Mutex
+	source oauth2.TokenSource
+}
+
+// NewDefaultTokenProvider creates DefaultTokenProvider. The default credentials are looked up on first use so that
+// UCP can start in environments without GCP credentials.
+func NewDefaultTokenProvider() *DefaultTokenProvider {
+	return &DefaultTokenProvider{}
+}
+
+// Token returns an access token for the Application Default Credentials.
+func (p *DefaultTokenProvider) Token(ctx context.Context) (*oauth2.Token, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.source == nil {
+		// The token source outlives the request, so it must not be bound to the request context.
+		source, err := google.DefaultTokenSource(context.Background(), CloudPlatformScope)
+		if err != nil {
+			return nil, err
+		}
+		p.source = source
+	}
+
+	return p.source.Token()
+}
+
+func newServiceAccountTokenSource(key []byte) (oauth2.TokenSource, error) {
+	// The token source outlives the request, so it must not be bound to the request context.
+	creds, err := google.CredentialsFromJSON(context.Background(), key, CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+
+	return creds.TokenSource, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+)
+
+type mockProvider struct {
+	fakeCredential *sdk_cred.GCPCredential
+}
+
+// Fetch gets the GCP credentials from secret storage. It takes in a context, planeName and name and returns
+// a GCPCredential or an error if the fakeCredential is nil.
+func (p *mockProvider) Fetch(ctx context.Context, planeName, name string) (*sdk_cred.GCPCredential, error) {
+	if p.fakeCredential == nil {
+		return nil, errors.New("failed to fetch credential")
+	}
+	return p.fakeCredential, nil
+}
+
+func TestUCPTokenProvider_Token(t *testing.T) {
+	t.Run("fetch failure", func(t *testing.T) {
+		p := NewUCPTokenProvider(&mockProvider{})
+		_, err := p.Token(context.TODO())
+		require.Error(t, err)
+	})
+
+	t.Run("empty service account key", func(t *testing.T) {
+		p := NewUCPTokenProvider(&mockProvider{fakeCredential: &sdk_cred.GCPCredential{}})
+		_, err := p.Token(context.TODO())
+		require.EqualError(t, err, "invalid service account key info")
+	})
+
+	t.Run("invalid service account key", func(t *testing.T) {
+		p := NewUCPTokenProvider(&mockProvider{fakeCredential: &sdk_cred.GCPCredential{ServiceAccountKey: "not-json"}})
+		_, err := p.Token(context.TODO())
+		require.Error(t, err)
+	})
+
+	t.Run("token source is reused until the key is rotated", func(t *testing.T) {
+		mp := &mockProvider{fakeCredential: &sdk_cred.GCPCredential{ServiceAccountKey: "key-1"}}
+		p := NewUCPTokenProvider(mp)
+
+		created := []string{}
+		p.newTokenSource = func(key []byte) (oauth2.TokenSource, error) {
+			created = append(created, string(key))
+			return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token-for-" + string(key)}), nil
+		}
+
+		token, err := p.Token(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, "token-for-key-1", token.AccessToken)
+
+		_, err = p.Token(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, []string{"key-1"}, created)
+
+		mp.fakeCredential = &sdk_cred.GCPCredential{ServiceAccountKey: "key-2"}
+		token, err = p.Token(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, "token-for-key-2", token.AccessToken)
+		require.Equal(t, []string{"key-1", "key-2"}, created)
+	})
+}
@@ -17,9 +17,12 @@ limitations under the License.
 package hostoptions
 
 import (
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
 	profilerprovider "github.com/radius-project/radius/pkg/profiler/provider"
 	"github.com/radius-project/radius/pkg/trace"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/backend/credentialvalidation"
 	"github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
@@ -41,6 +44,15 @@ type UCPConfig struct {
 	Identity         Identity                                 `yaml:"identity,omitempty"`
 	UCP              config.UCPOptions                        `yaml:"ucp"`
 	Location         string                                   `yaml:"location"`
+
+	// CredentialValidation configures the background validation of the registered credentials.
+	CredentialValidation credentialvalidation.Options `yaml:"credentialValidation,omitempty"`
+
+	// Authorization configures the authorization of requests using role assignments.
+	Authorization authorization.Options `yaml:"authorization,omitempty"`
+
+	// Audit configures the audit log of the mutating requests.
+	Audit audit.Options `yaml:"audit,omitempty"`
 }
 
 const (
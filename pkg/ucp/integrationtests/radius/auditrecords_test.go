@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radius
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/ucp/frontend/api"
+	"github.com/radius-project/radius/pkg/ucp/integrationtests/testserver"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAuditRecordCollectionID = testRadiusPlaneID + "/providers/System.Audit/auditRecords"
+)
+
+func Test_RadiusPlane_AuditRecords(t *testing.T) {
+	ucp := testserver.StartWithETCD(t, api.DefaultModules)
+	createRadiusPlane(ucp, map[string]*string{})
+
+	storageClient, err := ucp.Clients.StorageProvider.GetStorageClient(context.Background(), audit.RecordResourceType)
+	require.NoError(t, err)
+	// The records are kept for long enough that the fixed timestamps below are not pruned.
+	sink := audit.NewStorageSink(storageClient, 0, 100*365*24*time.Hour)
+
+	records := []audit.Record{
+		{
+			ID:         "00000000-0000-0000-0000-000000000001",
+			Timestamp:  time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+			Service:    "ucp",
+			Caller:     "alice",
+			Method:     http.MethodPut,
+			ResourceID: testResourceID,
+			StatusCode: http.StatusOK,
+			Result:     audit.ResultSucceeded,
+		},
+		{
+			ID:         "00000000-0000-0000-0000-000000000002",
+			Timestamp:  time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+			Service:    "ucp",
+			Caller:     "bob",
+			Method:     http.MethodDelete,
+			ResourceID: testResourceID,
+			StatusCode: http.StatusAccepted,
+			Result:     audit.ResultAccepted,
+		},
+		{
+			ID:         "00000000-0000-0000-0000-000000000003",
+			Timestamp:  time.Date(2023, 10, 3, 0, 0, 0, 0, time.UTC),
+			Service:    "ucp",
+			Method:     http.MethodPut,
+			ResourceID: testResourceGroupID,
+			StatusCode: http.StatusOK,
+			Result:     audit.ResultSucceeded,
+		},
+	}
+	for _, record := range records {
+		require.NoError(t, sink.Write(context.Background(), record))
+	}
+
+	t.Run("LIST audit records of a resource", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testAuditRecordCollectionID+"?"+apiVersionParameter+"&resourceId="+url.QueryEscape(testResourceID), nil)
+		response.EqualsStatusCode(http.StatusOK)
+
+		actual := struct {
+			Value []audit.Record `json:"value"`
+		}{}
+		err := json.Unmarshal(response.Body.Bytes(), &actual)
+		require.NoError(t, err)
+		require.Equal(t, records[:2], actual.Value)
+	})
+
+	t.Run("LIST audit records of a resource group", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testAuditRecordCollectionID+"?"+apiVersionParameter+"&resourceId="+url.QueryEscape(testResourceGroupID), nil)
+		response.EqualsStatusCode(http.StatusOK)
+
+		actual := struct {
+			Value []audit.Record `json:"value"`
+		}{}
+		err := json.Unmarshal(response.Body.Bytes(), &actual)
+		require.NoError(t, err)
+		require.Equal(t, records[2:], actual.Value)
+	})
+
+	t.Run("LIST audit records without resource ID", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testAuditRecordCollectionID+"?"+apiVersionParameter, nil)
+		response.EqualsStatusCode(http.StatusBadRequest)
+	})
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radius
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/frontend/api"
+	"github.com/radius-project/radius/pkg/ucp/integrationtests/testserver"
+)
+
+const (
+	testLockCollectionID = testRadiusPlaneID + "/providers/System.Authorization/locks"
+	testLockID           = testLockCollectionID + "/test-rg-lock"
+)
+
+func Test_RadiusPlane_Lock_Lifecycle(t *testing.T) {
+	ucp := testserver.StartWithETCD(t, api.DefaultModules)
+	createRadiusPlane(ucp, map[string]*string{})
+	createResourceGroup(ucp, testResourceGroupID)
+
+	t.Run("PUT lock", func(t *testing.T) {
+		body := v20231001preview.LockResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.LockProperties{
+				Level: to.Ptr(v20231001preview.LockLevelCanNotDelete),
+				Scope: to.Ptr(testResourceGroupID),
+				Notes: to.Ptr("The test resource group must not be deleted."),
+			},
+		}
+		response := ucp.MakeTypedRequest(http.MethodPut, testLockID+"?"+apiVersionParameter, body)
+		response.EqualsStatusCode(http.StatusOK)
+	})
+
+	t.Run("PUT lock with invalid level", func(t *testing.T) {
+		body := map[string]any{
+			"location": v1.LocationGlobal,
+			"properties": map[string]any{
+				"level": "NotSpecified",
+			},
+		}
+		response := ucp.MakeTypedRequest(http.MethodPut, testLockCollectionID+"/invalid?"+apiVersionParameter, body)
+		response.EqualsErrorCode(http.StatusBadRequest, v1.CodeHTTPRequestPayloadAPISpecValidationFailed)
+	})
+
+	t.Run("GET lock", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testLockID+"?"+apiVersionParameter, nil)
+		response.EqualsStatusCode(http.StatusOK)
+
+		resource := v20231001preview.LockResource{}
+		err := json.Unmarshal(response.Body.Bytes(), &resource)
+		require.NoError(t, err)
+		require.Equal(t, v20231001preview.LockLevelCanNotDelete, *resource.Properties.Level)
+		require.Equal(t, testResourceGroupID, *resource.Properties.Scope)
+	})
+
+	t.Run("LIST locks", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testLockCollectionID+"?"+apiVersionParameter, nil)
+		response.EqualsStatusCode(http.StatusOK)
+
+		list := v20231001preview.LockResourceListResult{}
+		err := json.Unmarshal(response.Body.Bytes(), &list)
+		require.NoError(t, err)
+		require.Len(t, list.Value, 1)
+		require.Equal(t, testLockID, *list.Value[0].ID)
+	})
+
+	t.Run("PUT locked resource group", func(t *testing.T) {
+		createResourceGroup(ucp, testResourceGroupID)
+	})
+
+	t.Run("DELETE locked resource group", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodDelete, testResourceGroupID+"?"+apiVersionParameter, nil)
+		response.EqualsErrorCode(http.StatusConflict, v1.CodeScopeLocked)
+	})
+
+	t.Run("DELETE lock", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodDelete, testLockID+"?"+apiVersionParameter, nil)
+		response.EqualsStatusCode(http.StatusOK)
+
+		response = ucp.MakeRequest(http.MethodGet, testLockID+"?"+apiVersionParameter, nil)
+		response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+	})
+
+	t.Run("DELETE unlocked resource group", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodDelete, testResourceGroupID+"?"+apiVersionParameter, nil)
+		response.EqualsStatusCode(http.StatusOK)
+	})
+}
@@ -61,6 +61,70 @@ func Test_RadiusPlane_Proxy_ResourceGroupDoesNotExist(t *testing.T) {
 	require.Equal(t, "the resource with id '/planes/radius/test/resourceGroups/test-rg/providers/System.Test/testResources/test-resource' was not found", response.Error.Error.Message)
 }
 
+func Test_RadiusPlane_ResourceTags(t *testing.T) {
+	ucp := testserver.StartWithETCD(t, api.DefaultModules)
+	rp := testrp.Start(t)
+	rp.Handler = testrp.SyncResource(t, ucp, testResourceGroupID)
+
+	rps := map[string]*string{
+		testResourceNamespace: to.Ptr("http://" + rp.Address()),
+	}
+	createRadiusPlane(ucp, rps)
+
+	createResourceGroup(ucp, testResourceGroupID)
+
+	for name, team := range map[string]string{"payments-api": "payments", "orders-api": "orders"} {
+		data := testrp.TestResource{
+			Tags: map[string]*string{
+				"team": to.Ptr(team),
+			},
+			Properties: testrp.TestResourceProperties{
+				Message: to.Ptr("here is some test data"),
+			},
+		}
+		body, err := json.Marshal(data)
+		require.NoError(t, err)
+
+		response := ucp.MakeRequest(http.MethodPut, testResourceCollectionID+"/"+name+"?api-version="+testrp.Version, body)
+		response.EqualsStatusCode(http.StatusOK)
+	}
+
+	t.Run("List - Tracked Resources (tag filter)", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testResourceGroupID+"/resources?api-version="+v20231001preview.Version+"&tag=team%3Dpayments", nil)
+		response.EqualsStatusCode(http.StatusOK)
+
+		resources := &v20231001preview.GenericResourceListResult{}
+		err := json.Unmarshal(response.Body.Bytes(), resources)
+		require.NoError(t, err)
+		require.Len(t, resources.Value, 1)
+
+		expected := v20231001preview.GenericResource{
+			ID:   to.Ptr(testResourceCollectionID + "/payments-api"),
+			Name: to.Ptr("payments-api"),
+			Type: to.Ptr("System.Test/testResources"),
+			Tags: map[string]*string{
+				"team": to.Ptr("payments"),
+			},
+		}
+		require.Equal(t, expected, *resources.Value[0])
+	})
+
+	t.Run("List - Tracked Resources (tag filter no match)", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testResourceGroupID+"/resources?api-version="+v20231001preview.Version+"&tag=team%3Dinventory", nil)
+		response.EqualsStatusCode(http.StatusOK)
+
+		resources := &v20231001preview.GenericResourceListResult{}
+		err := json.Unmarshal(response.Body.Bytes(), resources)
+		require.NoError(t, err)
+		require.Empty(t, resources.Value)
+	})
+
+	t.Run("List - Tracked Resources (invalid tag filter)", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testResourceGroupID+"/resources?api-version="+v20231001preview.Version+"&tag=team", nil)
+		response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalid)
+	})
+}
+
 func Test_RadiusPlane_ResourceSync(t *testing.T) {
 	ucp := testserver.StartWithETCD(t, api.DefaultModules)
 	rp := testrp.Start(t)
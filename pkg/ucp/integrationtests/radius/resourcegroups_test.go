@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radius
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/frontend/api"
+	"github.com/radius-project/radius/pkg/ucp/integrationtests/testrp"
+	"github.com/radius-project/radius/pkg/ucp/integrationtests/testserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RadiusPlane_ResourceGroup_CascadeDelete(t *testing.T) {
+	ucp := testserver.StartWithETCD(t, api.DefaultModules)
+	rp := testrp.Start(t)
+	rp.Handler = testrp.SyncResource(t, ucp, testResourceGroupID)
+
+	rps := map[string]*string{
+		testResourceNamespace: to.Ptr("http://" + rp.Address()),
+	}
+	createRadiusPlane(ucp, rps)
+
+	createResourceGroup(ucp, testResourceGroupID)
+
+	t.Run("PUT resource", func(t *testing.T) {
+		data := testrp.TestResource{
+			Properties: testrp.TestResourceProperties{
+				Message: to.Ptr("here is some test data"),
+			},
+		}
+		response := ucp.MakeTypedRequest(http.MethodPut, testResourceID+"?api-version="+testrp.Version, data)
+		response.EqualsStatusCode(http.StatusOK)
+	})
+
+	t.Run("DELETE resource group without cascade", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodDelete, testResourceGroupID+"?"+apiVersionParameter, nil)
+		response.EqualsErrorCode(http.StatusConflict, v1.CodeConflict)
+	})
+
+	t.Run("DELETE resource group with cascade", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodDelete, testResourceGroupID+"?"+apiVersionParameter+"&cascade=true", nil)
+		response.EqualsStatusCode(http.StatusAccepted)
+
+		azureAsyncOperation := response.Raw.Header.Get("Azure-AsyncOperation")
+		require.True(t, strings.HasPrefix(azureAsyncOperation, ucp.BaseURL), "Azure-AsyncOperation starts with UCP URL")
+		operationStatus := strings.TrimPrefix(azureAsyncOperation, ucp.BaseURL)
+
+		require.EventuallyWithT(t, func(collect *assert.CollectT) {
+			response := ucp.MakeRequest(http.MethodGet, operationStatus, nil)
+			assert.Equal(collect, http.StatusOK, response.Raw.StatusCode)
+
+			status := v1.AsyncOperationStatus{}
+			err := json.Unmarshal(response.Body.Bytes(), &status)
+			assert.NoError(collect, err)
+			assert.Equal(collect, v1.ProvisioningStateSucceeded, status.Status)
+		}, assertTimeout, assertRetry)
+	})
+
+	t.Run("GET resource (after delete)", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testResourceID+"?api-version="+testrp.Version, nil)
+		response.EqualsStatusCode(http.StatusNotFound)
+	})
+
+	t.Run("GET resource group (after delete)", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testResourceGroupID+"?"+apiVersionParameter, nil)
+		response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+	})
+
+	t.Run("DELETE resource group (again)", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodDelete, testResourceGroupID+"?"+apiVersionParameter, nil)
+		response.EqualsStatusCode(http.StatusNoContent)
+	})
+}
+
+func Test_RadiusPlane_ResourceGroup_MoveResources(t *testing.T) {
+	ucp := testserver.StartWithETCD(t, api.DefaultModules)
+	rp := testrp.Start(t)
+	rp.Handler = testrp.SyncResource(t, ucp, testResourceGroupID)
+
+	rps := map[string]*string{
+		testResourceNamespace: to.Ptr("http://" + rp.Address()),
+	}
+	createRadiusPlane(ucp, rps)
+
+	targetResourceGroupID := testRadiusPlaneID + "/resourceGroups/target-rg"
+	createResourceGroup(ucp, testResourceGroupID)
+	createResourceGroup(ucp, targetResourceGroupID)
+
+	data := testrp.TestResource{
+		Properties: testrp.TestResourceProperties{
+			Message: to.Ptr("here is some test data"),
+		},
+	}
+	response := ucp.MakeTypedRequest(http.MethodPut, testResourceID+"?api-version="+testrp.Version, data)
+	response.EqualsStatusCode(http.StatusOK)
+
+	request := v20231001preview.MoveResourcesRequest{
+		TargetResourceGroup: to.Ptr(targetResourceGroupID),
+		Resources:           to.SliceOfPtrs(testResourceID),
+	}
+	response = ucp.MakeTypedRequest(http.MethodPost, testResourceGroupID+"/moveResources?"+apiVersionParameter, request)
+	response.EqualsStatusCode(http.StatusOK)
+
+	result := v20231001preview.MoveResourcesResult{}
+	err := json.Unmarshal(response.Body.Bytes(), &result)
+	require.NoError(t, err)
+	require.Equal(t, to.SliceOfPtrs(targetResourceGroupID+"/providers/System.Test/testResources/test-resource"), result.Resources)
+
+	response = ucp.MakeRequest(http.MethodGet, testResourceGroupID+"/resources?"+apiVersionParameter, nil)
+	response.EqualsStatusCode(http.StatusOK)
+
+	resources := &v20231001preview.GenericResourceListResult{}
+	err = json.Unmarshal(response.Body.Bytes(), resources)
+	require.NoError(t, err)
+	require.Empty(t, resources.Value)
+
+	response = ucp.MakeRequest(http.MethodGet, targetResourceGroupID+"/resources?"+apiVersionParameter, nil)
+	response.EqualsStatusCode(http.StatusOK)
+
+	resources = &v20231001preview.GenericResourceListResult{}
+	err = json.Unmarshal(response.Body.Bytes(), resources)
+	require.NoError(t, err)
+	require.Len(t, resources.Value, 1)
+	require.Equal(t, targetResourceGroupID+"/providers/System.Test/testResources/test-resource", *resources.Value[0].ID)
+}
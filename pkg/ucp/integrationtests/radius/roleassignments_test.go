@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radius
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/frontend/api"
+	"github.com/radius-project/radius/pkg/ucp/integrationtests/testserver"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRoleAssignmentCollectionID = testRadiusPlaneID + "/providers/System.Authorization/roleAssignments"
+	testRoleAssignmentID           = testRoleAssignmentCollectionID + "/alice-reader"
+)
+
+func Test_RadiusPlane_RoleAssignment_Lifecycle(t *testing.T) {
+	ucp := testserver.StartWithETCD(t, api.DefaultModules)
+	createRadiusPlane(ucp, map[string]*string{})
+
+	t.Run("PUT role assignment", func(t *testing.T) {
+		body := v20231001preview.RoleAssignmentResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.RoleAssignmentProperties{
+				PrincipalID:        to.Ptr("alice"),
+				PrincipalType:      to.Ptr(v20231001preview.PrincipalTypeUser),
+				RoleDefinitionName: to.Ptr(v20231001preview.RoleDefinitionNameReader),
+				Scope:              to.Ptr(testResourceGroupID),
+			},
+		}
+		response := ucp.MakeTypedRequest(http.MethodPut, testRoleAssignmentID+"?"+apiVersionParameter, body)
+		response.EqualsStatusCode(http.StatusOK)
+	})
+
+	t.Run("PUT role assignment with scope outside of the plane", func(t *testing.T) {
+		body := v20231001preview.RoleAssignmentResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.RoleAssignmentProperties{
+				PrincipalID:        to.Ptr("alice"),
+				PrincipalType:      to.Ptr(v20231001preview.PrincipalTypeUser),
+				RoleDefinitionName: to.Ptr(v20231001preview.RoleDefinitionNameReader),
+				Scope:              to.Ptr("/planes/radius/other/resourceGroups/test-rg"),
+			},
+		}
+		response := ucp.MakeTypedRequest(http.MethodPut, testRoleAssignmentCollectionID+"/invalid?"+apiVersionParameter, body)
+		response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalid)
+	})
+
+	t.Run("GET role assignment", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testRoleAssignmentID+"?"+apiVersionParameter, nil)
+		response.EqualsStatusCode(http.StatusOK)
+
+		resource := v20231001preview.RoleAssignmentResource{}
+		err := json.Unmarshal(response.Body.Bytes(), &resource)
+		require.NoError(t, err)
+		require.Equal(t, "alice", *resource.Properties.PrincipalID)
+		require.Equal(t, testResourceGroupID, *resource.Properties.Scope)
+	})
+
+	t.Run("LIST role assignments", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testRoleAssignmentCollectionID+"?"+apiVersionParameter, nil)
+		response.EqualsStatusCode(http.StatusOK)
+
+		list := v20231001preview.RoleAssignmentResourceListResult{}
+		err := json.Unmarshal(response.Body.Bytes(), &list)
+		require.NoError(t, err)
+		require.Len(t, list.Value, 1)
+		require.Equal(t, testRoleAssignmentID, *list.Value[0].ID)
+	})
+
+	t.Run("Authorize with stored role assignment", func(t *testing.T) {
+		storageClient, err := ucp.Clients.StorageProvider.GetStorageClient(context.Background(), "ucp")
+		require.NoError(t, err)
+
+		authorizer := authorization.NewAuthorizer(authorization.Options{Enabled: true}, storageClient, nil, nil)
+		principal := authorization.Principal{User: "alice"}
+
+		allowed, err := authorizer.Authorize(context.Background(), principal, http.MethodGet, testResourceGroupID)
+		require.NoError(t, err)
+		require.True(t, allowed)
+
+		allowed, err = authorizer.Authorize(context.Background(), principal, http.MethodPut, testResourceGroupID)
+		require.NoError(t, err)
+		require.False(t, allowed)
+
+		allowed, err = authorizer.Authorize(context.Background(), principal, http.MethodGet, testRadiusPlaneID+"/resourceGroups/other-rg")
+		require.NoError(t, err)
+		require.False(t, allowed)
+	})
+
+	t.Run("DELETE role assignment", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodDelete, testRoleAssignmentID+"?"+apiVersionParameter, nil)
+		response.EqualsStatusCode(http.StatusOK)
+
+		response = ucp.MakeRequest(http.MethodGet, testRoleAssignmentID+"?"+apiVersionParameter, nil)
+		response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+	})
+}
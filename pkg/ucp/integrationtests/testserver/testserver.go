@@ -50,6 +50,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	"github.com/radius-project/radius/pkg/ucp/hosting"
 	"github.com/radius-project/radius/pkg/ucp/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp/locks"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
 	"github.com/radius-project/radius/pkg/ucp/secret"
@@ -291,8 +292,12 @@ func StartWithETCD(t *testing.T, configureModules func(options modules.Options)
 		require.NoError(t, err)
 	}()
 
+	lockStorageClient, err := dataProvider.GetStorageClient(ctx, "ucp")
+	require.NoError(t, err)
+
 	router := chi.NewRouter()
 	router.Use(servicecontext.ARMRequestCtx(pathBase, "global"))
+	router.Use(servicecontext.EnforceLocks(locks.NewChecker(lockStorageClient)))
 
 	app := middleware.NormalizePath(router)
 	server := httptest.NewUnstartedServer(app)
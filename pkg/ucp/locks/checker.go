@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// authorizationNamespace is the provider namespace of the locks and the role assignments. Requests for these resources
+// are never blocked by a lock, so that a lock can always be removed.
+const authorizationNamespace = "System.Authorization"
+
+// readOnlyActions are the names of the POST actions, in lower case, which don't modify any resource. The cancel action
+// of an asynchronous operation is included so that an operation started before a lock was created can be canceled.
+var readOnlyActions = map[string]bool{
+	"listsecrets": true,
+	"getmetadata": true,
+	"planrecipe":  true,
+	"cancel":      true,
+}
+
+// Checker finds the management locks which block a request. Locks are stored at the plane level and apply to their
+// scope and everything within it.
+type Checker struct {
+	storageClient store.StorageClient
+}
+
+// NewChecker creates a new Checker which reads the locks from the given storage client.
+func NewChecker(storageClient store.StorageClient) *Checker {
+	return &Checker{storageClient: storageClient}
+}
+
+// Check returns the lock which blocks the request with the given HTTP method on the given resource ID, or nil if the
+// request is allowed. See Blocks for the rules.
+func (c *Checker) Check(ctx context.Context, method string, id resources.ID) (*datamodel.Lock, error) {
+	if c == nil || !isMutatingMethod(method) || !id.IsUCPQualified() || len(id.ScopeSegments()) == 0 {
+		return nil, nil
+	}
+
+	if strings.EqualFold(id.ProviderNamespace(), authorizationNamespace) {
+		return nil, nil
+	}
+
+	locks, err := c.listLocks(ctx, id.PlaneScope())
+	if err != nil {
+		return nil, err
+	}
+
+	target := id.String()
+	for i := range locks {
+		if Blocks(locks[i].Properties.Level, locks[i].Properties.Scope, method, target) {
+			return &locks[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Blocks returns true if a lock with the given level and scope blocks the request with the given HTTP method on the
+// given resource ID.
+//
+// A DELETE is blocked by a lock of any level whose scope contains the resource or is contained by it, since deleting a
+// scope deletes everything within it. A PUT, PATCH or POST is blocked by a ReadOnly lock whose scope contains the
+// resource. The ID of a POST is the ID of the resource the action is performed on; callers skip the read-only actions
+// (see IsReadOnlyAction) before calling Blocks.
+func Blocks(level string, scope string, method string, id string) bool {
+	switch method {
+	case http.MethodDelete:
+		return authorization.ScopeContains(scope, id) || authorization.ScopeContains(id, scope)
+	case http.MethodPut, http.MethodPatch, http.MethodPost:
+		return level == datamodel.LockLevelReadOnly && authorization.ScopeContains(scope, id)
+	default:
+		return false
+	}
+}
+
+// IsReadOnlyAction returns true if the POST action with the given name doesn't modify any resource, and so is not
+// blocked by a lock. The name is the last segment of the request path, for example "listSecrets".
+func IsReadOnlyAction(action string) bool {
+	return readOnlyActions[strings.ToLower(action)]
+}
+
+// BlockedMessage returns the message describing why the given operation is blocked by the lock, for example
+// BlockedMessage(`The DELETE request for "<id>"`, lock).
+func BlockedMessage(operation string, lock *datamodel.Lock) string {
+	message := fmt.Sprintf("%s is blocked by the %s lock %q on the scope %q.", operation, lock.Properties.Level, lock.Name, lock.Properties.Scope)
+	if lock.Properties.Notes != "" {
+		message += fmt.Sprintf(" Notes: %s", lock.Properties.Notes)
+	}
+	return message
+}
+
+func (c *Checker) listLocks(ctx context.Context, planeID string) ([]datamodel.Lock, error) {
+	result, err := c.storageClient.Query(ctx, store.Query{
+		RootScope:    planeID,
+		ResourceType: datamodel.LockResourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	locks := []datamodel.Lock{}
+	for _, item := range result.Items {
+		lock := datamodel.Lock{}
+		if err := item.As(&lock); err != nil {
+			return nil, err
+		}
+		locks = append(locks, lock)
+	}
+
+	return locks, nil
+}
+
+func isMutatingMethod(method string) bool {
+	return method == http.MethodPut || method == http.MethodPatch || method == http.MethodPost || method == http.MethodDelete
+}
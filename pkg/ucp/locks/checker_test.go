@@ -0,0 +1,235 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	environmentID = "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod"
+	databaseID    = "/planes/radius/local/resourceGroups/prod/providers/Applications.Datastores/sqlDatabases/db"
+)
+
+func newLock(name string, level string, scope string) store.Object {
+	return store.Object{
+		Data: &datamodel.Lock{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   "/planes/radius/local/providers/System.Authorization/locks/" + name,
+					Name: name,
+					Type: datamodel.LockResourceType,
+				},
+			},
+			Properties: datamodel.LockProperties{
+				Level: level,
+				Scope: scope,
+			},
+		},
+	}
+}
+
+func Test_Checker_Check(t *testing.T) {
+	tests := []struct {
+		name         string
+		locks        []store.Object
+		method       string
+		id           string
+		expectedLock string
+	}{
+		{
+			name:   "no locks",
+			method: http.MethodDelete,
+			id:     environmentID,
+		},
+		{
+			name:         "delete locked resource",
+			locks:        []store.Object{newLock("env", datamodel.LockLevelCanNotDelete, environmentID)},
+			method:       http.MethodDelete,
+			id:           environmentID,
+			expectedLock: "env",
+		},
+		{
+			name:         "delete resource in locked resource group",
+			locks:        []store.Object{newLock("prod", datamodel.LockLevelCanNotDelete, "/planes/radius/local/resourcegroups/PROD")},
+			method:       http.MethodDelete,
+			id:           environmentID,
+			expectedLock: "prod",
+		},
+		{
+			name:         "delete resource group containing locked resource",
+			locks:        []store.Object{newLock("db", datamodel.LockLevelCanNotDelete, databaseID)},
+			method:       http.MethodDelete,
+			id:           "/planes/radius/local/resourceGroups/prod",
+			expectedLock: "db",
+		},
+		{
+			name:   "delete sibling of locked resource",
+			locks:  []store.Object{newLock("db", datamodel.LockLevelReadOnly, databaseID)},
+			method: http.MethodDelete,
+			id:     environmentID,
+		},
+		{
+			name:   "update resource with delete lock",
+			locks:  []store.Object{newLock("env", datamodel.LockLevelCanNotDelete, environmentID)},
+			method: http.MethodPut,
+			id:     environmentID,
+		},
+		{
+			name:         "update resource with read-only lock",
+			locks:        []store.Object{newLock("plane", datamodel.LockLevelReadOnly, "/planes/radius/local")},
+			method:       http.MethodPatch,
+			id:           environmentID,
+			expectedLock: "plane",
+		},
+		{
+			name:         "action on resource with read-only lock",
+			locks:        []store.Object{newLock("prod", datamodel.LockLevelReadOnly, "/planes/radius/local/resourceGroups/prod")},
+			method:       http.MethodPost,
+			id:           environmentID,
+			expectedLock: "prod",
+		},
+		{
+			name:   "action on resource with delete lock",
+			locks:  []store.Object{newLock("env", datamodel.LockLevelCanNotDelete, environmentID)},
+			method: http.MethodPost,
+			id:     environmentID,
+		},
+		{
+			name:   "update resource group containing read-only resource",
+			locks:  []store.Object{newLock("db", datamodel.LockLevelReadOnly, databaseID)},
+			method: http.MethodPut,
+			id:     "/planes/radius/local/resourceGroups/prod",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			storageClient := store.NewMockStorageClient(mctrl)
+			storageClient.EXPECT().
+				Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local", ResourceType: datamodel.LockResourceType}).
+				Return(&store.ObjectQueryResult{Items: tt.locks}, nil)
+
+			id, err := resources.Parse(tt.id)
+			require.NoError(t, err)
+
+			lock, err := NewChecker(storageClient).Check(context.Background(), tt.method, id)
+			require.NoError(t, err)
+			if tt.expectedLock == "" {
+				require.Nil(t, lock)
+				return
+			}
+
+			require.NotNil(t, lock)
+			require.Equal(t, tt.expectedLock, lock.Name)
+		})
+	}
+}
+
+func Test_Checker_Check_Skipped(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		id     string
+	}{
+		{name: "read", method: http.MethodGet, id: environmentID},
+		{name: "lock", method: http.MethodDelete, id: "/planes/radius/local/providers/System.Authorization/locks/env"},
+		{name: "role assignment", method: http.MethodPut, id: "/planes/radius/local/providers/System.Authorization/roleAssignments/alice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The storage client is not expected to be called.
+			mctrl := gomock.NewController(t)
+			storageClient := store.NewMockStorageClient(mctrl)
+
+			id, err := resources.Parse(tt.id)
+			require.NoError(t, err)
+
+			lock, err := NewChecker(storageClient).Check(context.Background(), tt.method, id)
+			require.NoError(t, err)
+			require.Nil(t, lock)
+		})
+	}
+}
+
+func Test_Checker_Check_QueryError(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(mctrl)
+	storageClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(nil, errors.New("store is down"))
+
+	id, err := resources.Parse(environmentID)
+	require.NoError(t, err)
+
+	_, err = NewChecker(storageClient).Check(context.Background(), http.MethodDelete, id)
+	require.EqualError(t, err, "store is down")
+}
+
+func Test_IsReadOnlyAction(t *testing.T) {
+	for _, action := range []string{"listSecrets", "getMetadata", "planRecipe", "cancel", "LISTSECRETS"} {
+		require.True(t, IsReadOnlyAction(action), action)
+	}
+	for _, action := range []string{"moveResources", "deploy", ""} {
+		require.False(t, IsReadOnlyAction(action), action)
+	}
+}
+
+func Test_Blocks(t *testing.T) {
+	const (
+		group    = "/planes/radius/local/resourceGroups/prod"
+		resource = "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/containers/orders"
+		other    = "/planes/radius/local/resourceGroups/dev/providers/Applications.Core/containers/orders"
+	)
+
+	tests := []struct {
+		name     string
+		level    string
+		scope    string
+		method   string
+		id       string
+		expected bool
+	}{
+		{"delete in locked scope", datamodel.LockLevelCanNotDelete, group, http.MethodDelete, resource, true},
+		{"delete of scope containing lock", datamodel.LockLevelCanNotDelete, resource, http.MethodDelete, group, true},
+		{"delete outside of locked scope", datamodel.LockLevelCanNotDelete, group, http.MethodDelete, other, false},
+		{"put with CanNotDelete lock", datamodel.LockLevelCanNotDelete, group, http.MethodPut, resource, false},
+		{"put with ReadOnly lock", datamodel.LockLevelReadOnly, group, http.MethodPut, resource, true},
+		{"patch with ReadOnly lock", datamodel.LockLevelReadOnly, group, http.MethodPatch, resource, true},
+		{"put of scope containing ReadOnly lock", datamodel.LockLevelReadOnly, resource, http.MethodPut, group, false},
+		{"post with ReadOnly lock", datamodel.LockLevelReadOnly, group, http.MethodPost, resource, true},
+		{"post with CanNotDelete lock", datamodel.LockLevelCanNotDelete, group, http.MethodPost, resource, false},
+		{"get with ReadOnly lock", datamodel.LockLevelReadOnly, group, http.MethodGet, resource, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, Blocks(tt.level, tt.scope, tt.method, tt.id))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+)
+
+type contextKey struct{}
+
+// WithChecker adds the lock checker to the context, so that the controllers can check the locks of the resources a
+// request modifies other than the resource of the request path.
+func WithChecker(ctx context.Context, checker *Checker) context.Context {
+	return context.WithValue(ctx, contextKey{}, checker)
+}
+
+// CheckerFromContext returns the lock checker of the context, or nil if the context has no checker. A nil checker
+// allows every request.
+func CheckerFromContext(ctx context.Context) *Checker {
+	checker, _ := ctx.Value(contextKey{}).(*Checker)
+	return checker
+}
@@ -49,6 +49,12 @@ limitations under the License.
 // and checks if its dequeue count matches the dequeue count of Message Client A currently have. We are using DequeueCount as a
 // revision number of message here. If it is mismatched, it means that Client B already leased the message. In this case,
 // ExtendMessage returns ErrDequeuedMessage to prevent Client A from extending lock.
+//
+// Dead-lettered messages stay in the same QueueMessage CR with `ucp.dev/deadletter` label. Dequeue excludes the labeled
+// messages and the dead-letter reason and timestamp are stored in the CR annotations, so no CRD schema change is needed.
+//
+// The priority and fairness key of the message are stored in the CR annotations as well. Dequeue lists up to
+// dequeueCandidateLimit visible messages and leases the one selected by client.Scheduler.
 
 package apiserver
 
@@ -63,6 +69,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/queue/client"
 
 	v1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -76,6 +83,22 @@ const (
 	LabelQueueName = "ucp.dev/queuename"
 	// LabelNextVisibleAt is the label representing the time when message is visible in the queue or requeued.
 	LabelNextVisibleAt = "ucp.dev/nextvisibleat"
+	// LabelDeadLetter is the label representing that the message was moved to the dead-letter queue.
+	LabelDeadLetter = "ucp.dev/deadletter"
+
+	// AnnotationDeadLetterReason is the annotation representing the reason why the message was dead-lettered.
+	AnnotationDeadLetterReason = "ucp.dev/deadletterreason"
+	// AnnotationDeadLetteredAt is the annotation representing the time when the message was dead-lettered.
+	AnnotationDeadLetteredAt = "ucp.dev/deadletteredat"
+	// AnnotationReplayCount is the annotation representing the number of times the message was requeued from the dead-letter queue.
+	AnnotationReplayCount = "ucp.dev/replaycount"
+	// AnnotationPriority is the annotation representing the priority of the message.
+	AnnotationPriority = "ucp.dev/priority"
+	// AnnotationFairnessKey is the annotation representing the fairness key of the message.
+	AnnotationFairnessKey = "ucp.dev/fairnesskey"
+
+	// dequeueCandidateLimit is the maximum number of visible messages from which Dequeue selects the next message.
+	dequeueCandidateLimit = 100
 
 	defaultMessageLockDuration = time.Duration(5) * time.Minute
 	defaultExpiryDuration      = time.Duration(10) * time.Hour
@@ -87,6 +110,9 @@ var _ client.Client = (*Client)(nil)
 type Client struct {
 	client runtimeclient.Client
 
+	// scheduler selects the next message to dequeue.
+	scheduler client.Scheduler
+
 	opts Options
 }
 
@@ -124,6 +150,13 @@ func copyMessage(msg *client.Message, queueMessage *v1alpha1.QueueMessage) {
 		EnqueueAt:     queueMessage.Spec.EnqueueAt.Time,
 		ExpireAt:      queueMessage.Spec.ExpireAt.Time,
 		NextVisibleAt: getTimeFromString(queueMessage.Labels[LabelNextVisibleAt]),
+		Priority:      int(mustParseInt64(queueMessage.Annotations[AnnotationPriority])),
+		FairnessKey:   queueMessage.Annotations[AnnotationFairnessKey],
+		ReplayCount:   int(mustParseInt64(queueMessage.Annotations[AnnotationReplayCount])),
+	}
+	if _, ok := queueMessage.Labels[LabelDeadLetter]; ok {
+		msg.DeadLetterReason = queueMessage.Annotations[AnnotationDeadLetterReason]
+		msg.DeadLetteredAt = getTimeFromString(queueMessage.Annotations[AnnotationDeadLetteredAt])
 	}
 	msg.ContentType = client.JSONContentType
 	msg.Data = make([]byte, len(queueMessage.Spec.Data.Raw))
@@ -170,6 +203,15 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 		return err
 	}
 
+	cfg := client.NewEnqueueConfig(options...)
+	annotations := map[string]string{}
+	if cfg.Priority != 0 {
+		annotations[AnnotationPriority] = strconv.Itoa(cfg.Priority)
+	}
+	if cfg.FairnessKey != "" {
+		annotations[AnnotationFairnessKey] = cfg.FairnessKey
+	}
+
 	resource := &v1alpha1.QueueMessage{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      id,
@@ -178,6 +220,7 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 				LabelNextVisibleAt: int64toa(now.UnixNano()),
 				LabelQueueName:     c.opts.Name,
 			},
+			Annotations: annotations,
 		},
 		Spec: v1alpha1.QueueMessageSpec{
 			DequeueCount: 0,
@@ -209,13 +252,32 @@ func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error
 		return nil, err
 	}
 
-	return selector.Add(*nameLabel), nil
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return selector.Add(*nameLabel, *deadLetterLabel), nil
+}
+
+func newDeadLetterLabelSelector(name string) (labels.Selector, error) {
+	nameLabel, err := labels.NewRequirement(LabelQueueName, selection.Equals, []string{name})
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return labels.NewSelector().Add(*nameLabel, *deadLetterLabel), nil
 }
 
-// getQueueMessage fetches the first item which is the message in the current queue. We can
-// determine whether the message is leased by another client by checking if `NextVisibleAt“
-// value is less than `now`.
-func (c *Client) getQueueMessage(ctx context.Context, now time.Time) (*v1alpha1.QueueMessage, error) {
+// getQueueMessage fetches the message to dequeue next in the current queue. We can determine whether the message
+// is leased by another client by checking if `NextVisibleAt“ value is less than `now`. The message is selected by
+// the scheduler among the first dequeueCandidateLimit visible messages.
+func (c *Client) getQueueMessage(ctx context.Context, now time.Time, cfg client.QueueClientConfig) (*v1alpha1.QueueMessage, error) {
 	ql := &v1alpha1.QueueMessageList{}
 
 	selector, err := newMessageLabelSelector(now, c.opts.Name)
@@ -227,13 +289,20 @@ func (c *Client) getQueueMessage(ctx context.Context, now time.Time) (*v1alpha1.
 		ctx, ql,
 		runtimeclient.InNamespace(c.opts.Namespace),
 		runtimeclient.MatchingLabelsSelector{Selector: selector},
-		runtimeclient.Limit(1))
+		runtimeclient.Limit(dequeueCandidateLimit))
 	if err != nil {
 		return nil, err
 	}
 
-	if len(ql.Items) > 0 {
-		return &ql.Items[0], nil
+	candidates := make([]client.Metadata, len(ql.Items))
+	for i := range ql.Items {
+		msg := &client.Message{}
+		copyMessage(msg, &ql.Items[i])
+		candidates[i] = msg.Metadata
+	}
+
+	if i := c.scheduler.Next(candidates, cfg); i >= 0 {
+		return &ql.Items[i], nil
 	}
 
 	return nil, client.ErrMessageNotFound
@@ -297,7 +366,7 @@ func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*c
 	retryErr := retry.OnError(retry.DefaultRetry, DequeuedMessageError, func() error {
 		// Since multiple clients can get the same message, it tries to get the next queue
 		// message whenever extendItem is failed.
-		item, err := c.getQueueMessage(ctx, now)
+		item, err := c.getQueueMessage(ctx, now, opts)
 		if err != nil {
 			return err
 		}
@@ -314,6 +383,7 @@ func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*c
 
 	msg := &client.Message{}
 	copyMessage(msg, result)
+	c.scheduler.Served(msg.FairnessKey)
 
 	return msg, nil
 }
@@ -356,3 +426,135 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
 	copyMessage(msg, result)
 	return nil
 }
+
+// DeadLetterMessage moves the leased message to the dead-letter queue by labeling the message.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *client.Message, reason string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	result := &v1alpha1.QueueMessage{}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		getErr := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: msg.ID}, result)
+		if apierrors.IsNotFound(getErr) {
+			return client.ErrInvalidMessage
+		} else if getErr != nil {
+			return getErr
+		}
+
+		if result.Spec.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		if result.Annotations == nil {
+			result.Annotations = map[string]string{}
+		}
+		result.Labels[LabelDeadLetter] = "true"
+		result.Annotations[AnnotationDeadLetterReason] = reason
+		result.Annotations[AnnotationDeadLetteredAt] = int64toa(time.Now().UnixNano())
+
+		return c.client.Update(ctx, result)
+	})
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter queue.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*client.Message, error) {
+	selector, err := newDeadLetterLabelSelector(c.opts.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ql := &v1alpha1.QueueMessageList{}
+	err = c.client.List(ctx, ql, runtimeclient.InNamespace(c.opts.Namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := []*client.Message{}
+	for i := range ql.Items {
+		msg := &client.Message{}
+		copyMessage(msg, &ql.Items[i])
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// GetDeadLetterMessage gets the message in the dead-letter queue by id.
+func (c *Client) GetDeadLetterMessage(ctx context.Context, id string) (*client.Message, error) {
+	result, err := c.getDeadLetterItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &client.Message{}
+	copyMessage(msg, result)
+	return msg, nil
+}
+
+// RequeueDeadLetterMessage moves the message from the dead-letter queue back to the queue by removing the
+// dead-letter label and resetting the message lease.
+func (c *Client) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		result, err := c.getDeadLetterItem(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		replayCount := mustParseInt64(result.Annotations[AnnotationReplayCount]) + 1
+
+		delete(result.Labels, LabelDeadLetter)
+		delete(result.Annotations, AnnotationDeadLetterReason)
+		delete(result.Annotations, AnnotationDeadLetteredAt)
+		result.Annotations[AnnotationReplayCount] = int64toa(replayCount)
+		result.Labels[LabelNextVisibleAt] = int64toa(now.UnixNano())
+		result.Spec.DequeueCount = 0
+		result.Spec.ExpireAt = metav1.Time{Time: now.Add(c.opts.ExpiryDuration).UTC()}
+
+		return c.client.Update(ctx, result)
+	})
+}
+
+// PurgeDeadLetterMessages deletes all messages in the dead-letter queue.
+func (c *Client) PurgeDeadLetterMessages(ctx context.Context) (int, error) {
+	selector, err := newDeadLetterLabelSelector(c.opts.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	ql := &v1alpha1.QueueMessageList{}
+	err = c.client.List(ctx, ql, runtimeclient.InNamespace(c.opts.Namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for i := range ql.Items {
+		err := c.client.Delete(ctx, &ql.Items[i])
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	return n, nil
+}
+
+func (c *Client) getDeadLetterItem(ctx context.Context, id string) (*v1alpha1.QueueMessage, error) {
+	result := &v1alpha1.QueueMessage{}
+	err := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: id}, result)
+	if apierrors.IsNotFound(err) {
+		return nil, client.ErrDeadLetterMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if _, ok := result.Labels[LabelDeadLetter]; !ok || result.Labels[LabelQueueName] != c.opts.Name {
+		return nil, client.ErrDeadLetterMessageNotFound
+	}
+
+	return result, nil
+}
@@ -87,6 +87,40 @@ func TestCopyMessage(t *testing.T) {
 	require.Equal(t, getTimeFromString(queueM.ObjectMeta.Labels[LabelNextVisibleAt]), msg.NextVisibleAt)
 }
 
+func TestCopyDeadLetterMessage(t *testing.T) {
+	msg := &client.Message{}
+	now := time.Now()
+	queueM := &v1alpha1.QueueMessage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "applications.core.10101010",
+			Namespace: "radius-test",
+			Labels: map[string]string{
+				LabelNextVisibleAt: int64toa(now.UnixNano()),
+				LabelQueueName:     "applications.core",
+				LabelDeadLetter:    "true",
+			},
+			Annotations: map[string]string{
+				AnnotationDeadLetterReason: "poison message",
+				AnnotationDeadLetteredAt:   int64toa(now.UnixNano()),
+				AnnotationReplayCount:      "2",
+			},
+		},
+		Spec: v1alpha1.QueueMessageSpec{
+			DequeueCount: 4,
+			EnqueueAt:    metav1.Time{Time: now.UTC()},
+			ExpireAt:     metav1.Time{Time: now.Add(10 * time.Second).UTC()},
+			ContentType:  client.JSONContentType,
+			Data:         &runtime.RawExtension{Raw: []byte("hello world")},
+		},
+	}
+
+	copyMessage(msg, queueM)
+
+	require.Equal(t, "poison message", msg.DeadLetterReason)
+	require.Equal(t, now.UnixNano(), msg.DeadLetteredAt.UnixNano())
+	require.Equal(t, 2, msg.ReplayCount)
+}
+
 func TestGenerateID(t *testing.T) {
 	cli, err := New(nil, Options{Name: "applications.core", Namespace: "test"})
 	require.NoError(t, err)
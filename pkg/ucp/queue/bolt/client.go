@@ -0,0 +1,411 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bolt is an embedded queue implementation backed by a bbolt database file. Each named queue is stored in its
+// own bucket and messages are keyed by the bucket sequence, so a cursor scan returns messages in FIFO order.
+// Dead-lettered messages are moved to a separate bucket per queue, keyed by their original message id.
+//
+// bbolt serializes write transactions, so a Dequeue that finds a visible message and leases it happens atomically and
+// two clients sharing the same database can never lease the same message.
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	bucketPrefix           = "queue|"
+	deadLetterBucketPrefix = "deadletter|"
+
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+)
+
+var _ client.Client = (*Client)(nil)
+
+// Client is the queue client backed by an embedded bbolt database.
+type Client struct {
+	db        *bbolt.DB
+	closeOnce sync.Once
+
+	// scheduler selects the next message to dequeue.
+	scheduler client.Scheduler
+
+	opts Options
+}
+
+// Options is the options to create bolt queue client.
+type Options struct {
+	// Name represents the name of queue.
+	Name string
+
+	// MessageLockDuration represents the duration of message lock.
+	MessageLockDuration time.Duration
+	// ExpiryDuration represents the duration of the expiry.
+	ExpiryDuration time.Duration
+}
+
+// New creates the queue backed by the given bbolt database. name is unique name for each service which will consume the queue.
+func New(db *bbolt.DB, options Options) (*Client, error) {
+	if db == nil || options.Name == "" {
+		return nil, errors.New("db and Name are required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	c := &Client{db: db, opts: options}
+	err := db.Update(func(tx *bbolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(c.bucketName()); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(c.deadLetterBucketName())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Client) bucketName() []byte {
+	return []byte(bucketPrefix + c.opts.Name)
+}
+
+func (c *Client) deadLetterBucketName() []byte {
+	return []byte(deadLetterBucketPrefix + c.opts.Name)
+}
+
+// Close releases the reference of the client to its database. The database file is closed when no other client uses it.
+func (c *Client) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		err = boltstore.Close(c.db)
+	})
+	return err
+}
+
+// DeleteAll deletes all messages in the queue and the dead-letter queue.
+func (c *Client) DeleteAll() error {
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		if _, err := deleteAll(tx.Bucket(c.bucketName())); err != nil {
+			return err
+		}
+		_, err := deleteAll(tx.Bucket(c.deadLetterBucketName()))
+		return err
+	})
+}
+
+// Enqueue enqueues message to the queue.
+func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...client.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return client.ErrEmptyMessage
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		cfg := client.NewEnqueueConfig(options...)
+		stored := *msg
+		stored.Priority = cfg.Priority
+		stored.FairnessKey = cfg.FairnessKey
+		if err := c.push(tx.Bucket(c.bucketName()), &stored, 0); err != nil {
+			return err
+		}
+
+		msg.Metadata = stored.Metadata
+		return nil
+	})
+}
+
+// push stores the message at the end of the queue with new metadata. The priority and fairness key of the message are kept.
+func (c *Client) push(bucket *bbolt.Bucket, msg *client.Message, replayCount int) error {
+	seq, err := bucket.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	msg.Metadata = client.Metadata{
+		// The zero-padded sequence keeps the byte-order of keys the same as the enqueue order.
+		ID:            fmt.Sprintf("%020d", seq),
+		DequeueCount:  0,
+		EnqueueAt:     now,
+		ExpireAt:      now.Add(c.opts.ExpiryDuration),
+		NextVisibleAt: now,
+		Priority:      msg.Priority,
+		FairnessKey:   msg.FairnessKey,
+		ReplayCount:   replayCount,
+	}
+
+	return put(bucket, msg)
+}
+
+// Dequeue leases the visible message selected by the scheduler. Expired messages are removed while scanning.
+func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*client.Message, error) {
+	var found *client.Message
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		now := time.Now().UTC()
+
+		expired := [][]byte{}
+		visible := []*client.Message{}
+		candidates := []client.Metadata{}
+		cursor := bucket.Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			msg := &client.Message{}
+			if err := json.Unmarshal(v, msg); err != nil {
+				return err
+			}
+
+			if msg.ExpireAt.Before(now) {
+				expired = append(expired, append([]byte{}, k...))
+				continue
+			}
+
+			if msg.NextVisibleAt.After(now) {
+				continue
+			}
+
+			visible = append(visible, msg)
+			candidates = append(candidates, msg.Metadata)
+		}
+
+		// Keys cannot be deleted while iterating with the cursor.
+		for _, k := range expired {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		i := c.scheduler.Next(candidates, opts)
+		if i < 0 {
+			return nil
+		}
+
+		found = visible[i]
+		found.DequeueCount++
+		found.NextVisibleAt = now.Add(c.opts.MessageLockDuration)
+		return put(bucket, found)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, client.ErrMessageNotFound
+	}
+
+	c.scheduler.Served(found.FairnessKey)
+	return found, nil
+}
+
+// FinishMessage finishes or deletes the message in the queue.
+func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		if bucket.Get([]byte(msg.ID)) == nil {
+			return client.ErrInvalidMessage
+		}
+
+		return bucket.Delete([]byte(msg.ID))
+	})
+}
+
+// ExtendMessage extends the message lock. The message lock can be extended only by the client which currently
+// holds the lease, which is verified by DequeueCount.
+func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		v := bucket.Get([]byte(msg.ID))
+		if v == nil {
+			return client.ErrInvalidMessage
+		}
+
+		stored := &client.Message{}
+		if err := json.Unmarshal(v, stored); err != nil {
+			return err
+		}
+
+		if stored.NextVisibleAt.Before(time.Now().UTC()) || stored.DequeueCount != msg.DequeueCount {
+			return client.ErrInvalidMessage
+		}
+
+		stored.NextVisibleAt = stored.NextVisibleAt.Add(c.opts.MessageLockDuration)
+		if err := put(bucket, stored); err != nil {
+			return err
+		}
+
+		msg.NextVisibleAt = stored.NextVisibleAt
+		return nil
+	})
+}
+
+// DeadLetterMessage moves the message to the dead-letter queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *client.Message, reason string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		stored, err := get(bucket, msg.ID)
+		if err != nil {
+			return err
+		}
+		if stored == nil {
+			return client.ErrInvalidMessage
+		}
+
+		if err := bucket.Delete([]byte(msg.ID)); err != nil {
+			return err
+		}
+
+		stored.DeadLetterReason = reason
+		stored.DeadLetteredAt = time.Now().UTC()
+		return put(tx.Bucket(c.deadLetterBucketName()), stored)
+	})
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter queue.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*client.Message, error) {
+	msgs := []*client.Message{}
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(c.deadLetterBucketName()).ForEach(func(k, v []byte) error {
+			msg := &client.Message{}
+			if err := json.Unmarshal(v, msg); err != nil {
+				return err
+			}
+			msgs = append(msgs, msg)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return msgs, nil
+}
+
+// GetDeadLetterMessage gets the message in the dead-letter queue by id.
+func (c *Client) GetDeadLetterMessage(ctx context.Context, id string) (*client.Message, error) {
+	var msg *client.Message
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		msg, err = get(tx.Bucket(c.deadLetterBucketName()), id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if msg == nil {
+		return nil, client.ErrDeadLetterMessageNotFound
+	}
+
+	return msg, nil
+}
+
+// RequeueDeadLetterMessage moves the message from the dead-letter queue to the end of the queue. The requeued
+// message is assigned a new id.
+func (c *Client) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		deadLetters := tx.Bucket(c.deadLetterBucketName())
+		msg, err := get(deadLetters, id)
+		if err != nil {
+			return err
+		}
+		if msg == nil {
+			return client.ErrDeadLetterMessageNotFound
+		}
+
+		if err := deadLetters.Delete([]byte(id)); err != nil {
+			return err
+		}
+
+		return c.push(tx.Bucket(c.bucketName()), msg, msg.ReplayCount+1)
+	})
+}
+
+// PurgeDeadLetterMessages deletes all messages in the dead-letter queue.
+func (c *Client) PurgeDeadLetterMessages(ctx context.Context) (int, error) {
+	n := 0
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		var err error
+		n, err = deleteAll(tx.Bucket(c.deadLetterBucketName()))
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func get(bucket *bbolt.Bucket, id string) (*client.Message, error) {
+	v := bucket.Get([]byte(id))
+	if v == nil {
+		return nil, nil
+	}
+
+	msg := &client.Message{}
+	if err := json.Unmarshal(v, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func deleteAll(bucket *bbolt.Bucket) (int, error) {
+	n := 0
+	cursor := bucket.Cursor()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.First() {
+		if err := bucket.Delete(k); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+func put(bucket *bbolt.Bucket, msg *client.Message) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return bucket.Put([]byte(msg.ID), b)
+}
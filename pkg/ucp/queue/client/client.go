@@ -39,6 +39,9 @@ var (
 
 	// ErrEmptyMessage represents nil or empty Message.
 	ErrEmptyMessage = errors.New("message must not be nil or message is empty")
+
+	// ErrDeadLetterMessageNotFound represents the error when the message is not found in the dead-letter queue.
+	ErrDeadLetterMessageNotFound = errors.New("message was not found in the dead-letter queue")
 )
 
 //go:generate mockgen -typed -destination=./mock_client.go -package=client -self_package github.com/radius-project/radius/pkg/ucp/queue/client github.com/radius-project/radius/pkg/ucp/queue/client Client
@@ -56,6 +59,22 @@ type Client interface {
 
 	// ExtendMessage extends the message lock.
 	ExtendMessage(ctx context.Context, msg *Message) error
+
+	// DeadLetterMessage moves the leased message to the dead-letter queue with the reason why it could not be processed.
+	// Dead-lettered messages are never dequeued until they are requeued with RequeueDeadLetterMessage.
+	DeadLetterMessage(ctx context.Context, msg *Message, reason string) error
+
+	// ListDeadLetterMessages lists the messages in the dead-letter queue.
+	ListDeadLetterMessages(ctx context.Context) ([]*Message, error)
+
+	// GetDeadLetterMessage gets the message in the dead-letter queue by id without removing it.
+	GetDeadLetterMessage(ctx context.Context, id string) (*Message, error)
+
+	// RequeueDeadLetterMessage moves the message from the dead-letter queue back to the queue so that it can be processed again.
+	RequeueDeadLetterMessage(ctx context.Context, id string) error
+
+	// PurgeDeadLetterMessages deletes all messages in the dead-letter queue and returns the number of deleted messages.
+	PurgeDeadLetterMessages(ctx context.Context) (int, error)
 }
 
 // StartDequeuer starts a dequeuer to consume the message from the queue and return the output channel.
@@ -46,6 +46,16 @@ type Metadata struct {
 	ExpireAt time.Time
 	// NextVisibleAt represents the next visible time after dequeuing the message.
 	NextVisibleAt time.Time
+	// Priority represents the priority of the message. The messages with the higher priority are dequeued first.
+	Priority int
+	// FairnessKey represents the key to group the messages for fair scheduling.
+	FairnessKey string
+	// ReplayCount represents the number of times the message has been requeued from the dead-letter queue.
+	ReplayCount int
+	// DeadLetterReason represents the reason why the message was moved to the dead-letter queue.
+	DeadLetterReason string
+	// DeadLetteredAt represents the time when the message was moved to the dead-letter queue.
+	DeadLetteredAt time.Time
 }
 
 // NewMessage creates Message.
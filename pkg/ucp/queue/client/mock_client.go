@@ -39,6 +39,44 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// DeadLetterMessage mocks base method.
+func (m *MockClient) DeadLetterMessage(arg0 context.Context, arg1 *Message, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetterMessage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeadLetterMessage indicates an expected call of DeadLetterMessage.
+func (mr *MockClientMockRecorder) DeadLetterMessage(arg0, arg1, arg2 any) *MockClientDeadLetterMessageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetterMessage", reflect.TypeOf((*MockClient)(nil).DeadLetterMessage), arg0, arg1, arg2)
+	return &MockClientDeadLetterMessageCall{Call: call}
+}
+
+// MockClientDeadLetterMessageCall wrap *gomock.Call
+type MockClientDeadLetterMessageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientDeadLetterMessageCall) Return(arg0 error) *MockClientDeadLetterMessageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientDeadLetterMessageCall) Do(f func(context.Context, *Message, string) error) *MockClientDeadLetterMessageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientDeadLetterMessageCall) DoAndReturn(f func(context.Context, *Message, string) error) *MockClientDeadLetterMessageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Dequeue mocks base method.
 func (m *MockClient) Dequeue(arg0 context.Context, arg1 QueueClientConfig) (*Message, error) {
 	m.ctrl.T.Helper()
@@ -196,3 +234,158 @@ func (c *MockClientFinishMessageCall) DoAndReturn(f func(context.Context, *Messa
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetDeadLetterMessage mocks base method.
+func (m *MockClient) GetDeadLetterMessage(arg0 context.Context, arg1 string) (*Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterMessage", arg0, arg1)
+	ret0, _ := ret[0].(*Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterMessage indicates an expected call of GetDeadLetterMessage.
+func (mr *MockClientMockRecorder) GetDeadLetterMessage(arg0, arg1 any) *MockClientGetDeadLetterMessageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterMessage", reflect.TypeOf((*MockClient)(nil).GetDeadLetterMessage), arg0, arg1)
+	return &MockClientGetDeadLetterMessageCall{Call: call}
+}
+
+// MockClientGetDeadLetterMessageCall wrap *gomock.Call
+type MockClientGetDeadLetterMessageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientGetDeadLetterMessageCall) Return(arg0 *Message, arg1 error) *MockClientGetDeadLetterMessageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientGetDeadLetterMessageCall) Do(f func(context.Context, string) (*Message, error)) *MockClientGetDeadLetterMessageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientGetDeadLetterMessageCall) DoAndReturn(f func(context.Context, string) (*Message, error)) *MockClientGetDeadLetterMessageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListDeadLetterMessages mocks base method.
+func (m *MockClient) ListDeadLetterMessages(arg0 context.Context) ([]*Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetterMessages", arg0)
+	ret0, _ := ret[0].([]*Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetterMessages indicates an expected call of ListDeadLetterMessages.
+func (mr *MockClientMockRecorder) ListDeadLetterMessages(arg0 any) *MockClientListDeadLetterMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetterMessages", reflect.TypeOf((*MockClient)(nil).ListDeadLetterMessages), arg0)
+	return &MockClientListDeadLetterMessagesCall{Call: call}
+}
+
+// MockClientListDeadLetterMessagesCall wrap *gomock.Call
+type MockClientListDeadLetterMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientListDeadLetterMessagesCall) Return(arg0 []*Message, arg1 error) *MockClientListDeadLetterMessagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientListDeadLetterMessagesCall) Do(f func(context.Context) ([]*Message, error)) *MockClientListDeadLetterMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientListDeadLetterMessagesCall) DoAndReturn(f func(context.Context) ([]*Message, error)) *MockClientListDeadLetterMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PurgeDeadLetterMessages mocks base method.
+func (m *MockClient) PurgeDeadLetterMessages(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetterMessages", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetterMessages indicates an expected call of PurgeDeadLetterMessages.
+func (mr *MockClientMockRecorder) PurgeDeadLetterMessages(arg0 any) *MockClientPurgeDeadLetterMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetterMessages", reflect.TypeOf((*MockClient)(nil).PurgeDeadLetterMessages), arg0)
+	return &MockClientPurgeDeadLetterMessagesCall{Call: call}
+}
+
+// MockClientPurgeDeadLetterMessagesCall wrap *gomock.Call
+type MockClientPurgeDeadLetterMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientPurgeDeadLetterMessagesCall) Return(arg0 int, arg1 error) *MockClientPurgeDeadLetterMessagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientPurgeDeadLetterMessagesCall) Do(f func(context.Context) (int, error)) *MockClientPurgeDeadLetterMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientPurgeDeadLetterMessagesCall) DoAndReturn(f func(context.Context) (int, error)) *MockClientPurgeDeadLetterMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RequeueDeadLetterMessage mocks base method.
+func (m *MockClient) RequeueDeadLetterMessage(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDeadLetterMessage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequeueDeadLetterMessage indicates an expected call of RequeueDeadLetterMessage.
+func (mr *MockClientMockRecorder) RequeueDeadLetterMessage(arg0, arg1 any) *MockClientRequeueDeadLetterMessageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLetterMessage", reflect.TypeOf((*MockClient)(nil).RequeueDeadLetterMessage), arg0, arg1)
+	return &MockClientRequeueDeadLetterMessageCall{Call: call}
+}
+
+// MockClientRequeueDeadLetterMessageCall wrap *gomock.Call
+type MockClientRequeueDeadLetterMessageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientRequeueDeadLetterMessageCall) Return(arg0 error) *MockClientRequeueDeadLetterMessageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientRequeueDeadLetterMessageCall) Do(f func(context.Context, string) error) *MockClientRequeueDeadLetterMessageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientRequeueDeadLetterMessageCall) DoAndReturn(f func(context.Context, string) error) *MockClientRequeueDeadLetterMessageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
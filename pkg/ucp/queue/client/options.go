@@ -21,6 +21,8 @@ import "time"
 type (
 	// EnqueueOptions applies an option to Enqueue().
 	EnqueueOptions interface {
+		// ApplyEnqueueOption applies EnqueueOptions to EnqueueConfig.
+		ApplyEnqueueOption(EnqueueConfig) EnqueueConfig
 		// A private method to prevent users implementing the
 		// interface and so future additions to it will not
 		// violate compatibility.
@@ -41,6 +43,63 @@ type (
 type QueueClientConfig struct {
 	// DequeueIntervalDuration is the time duration between 2 successive dequeue attempts on the queue
 	DequeueIntervalDuration time.Duration
+
+	// FairnessKeyFilter reports whether the messages with the given fairness key can be dequeued. Dequeue skips
+	// the messages of which fairness key is rejected by the filter. All messages can be dequeued if it is nil.
+	FairnessKeyFilter func(fairnessKey string) bool
+
+	// QueueDepthObserver is called with the number of visible messages for each fairness key whenever Dequeue
+	// scans the queue. The backends which scan a part of the queue report the depth of the scanned messages.
+	QueueDepthObserver func(depths map[string]int)
+}
+
+// EnqueueConfig is a configuration for Enqueue().
+type EnqueueConfig struct {
+	// Priority is the priority of the message. The messages with the higher priority are dequeued first.
+	Priority int
+	// FairnessKey is the key to group the messages for fair scheduling, such as the resource group of the operation.
+	// The messages with the same priority are dequeued round-robin across fairness keys.
+	FairnessKey string
+}
+
+type enqueueOptions struct {
+	fn func(EnqueueConfig) EnqueueConfig
+}
+
+// ApplyEnqueueOption applies the configuration to the enqueued message.
+func (q *enqueueOptions) ApplyEnqueueOption(cfg EnqueueConfig) EnqueueConfig {
+	return q.fn(cfg)
+}
+
+func (q enqueueOptions) private() {}
+
+// WithPriority sets the priority of the message.
+func WithPriority(priority int) EnqueueOptions {
+	return &enqueueOptions{
+		fn: func(cfg EnqueueConfig) EnqueueConfig {
+			cfg.Priority = priority
+			return cfg
+		},
+	}
+}
+
+// WithFairnessKey sets the fairness key of the message.
+func WithFairnessKey(key string) EnqueueOptions {
+	return &enqueueOptions{
+		fn: func(cfg EnqueueConfig) EnqueueConfig {
+			cfg.FairnessKey = key
+			return cfg
+		},
+	}
+}
+
+// NewEnqueueConfig returns new enqueue config for Enqueue().
+func NewEnqueueConfig(opts ...EnqueueOptions) EnqueueConfig {
+	cfg := EnqueueConfig{}
+	for _, opt := range opts {
+		cfg = opt.ApplyEnqueueOption(cfg)
+	}
+	return cfg
 }
 
 type dequeueOptions struct {
@@ -62,6 +121,27 @@ func WithDequeueInterval(t time.Duration) DequeueOptions {
 	}
 }
 
+// WithFairnessKeyFilter sets the filter to skip the messages of which fairness key must not be dequeued,
+// for example because the fairness key already reached its concurrency limit.
+func WithFairnessKeyFilter(filter func(fairnessKey string) bool) DequeueOptions {
+	return &dequeueOptions{
+		fn: func(cfg QueueClientConfig) QueueClientConfig {
+			cfg.FairnessKeyFilter = filter
+			return cfg
+		},
+	}
+}
+
+// WithQueueDepthObserver sets the observer of the queue depth for each fairness key.
+func WithQueueDepthObserver(observer func(depths map[string]int)) DequeueOptions {
+	return &dequeueOptions{
+		fn: func(cfg QueueClientConfig) QueueClientConfig {
+			cfg.QueueDepthObserver = observer
+			return cfg
+		},
+	}
+}
+
 func (q dequeueOptions) private() {}
 
 // NewDequeueConfig returns new queue config for StartDequeuer().
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"sync"
+)
+
+const (
+	// maxTrackedFairnessKeys is the maximum number of fairness keys of which the last dequeue is tracked.
+	// The tracking state is reset once it is exceeded so that the memory usage stays bounded.
+	maxTrackedFairnessKeys = 10000
+)
+
+// Scheduler selects the next message to dequeue among the visible messages in the queue. The messages with the
+// higher priority are dequeued first. The messages with the same priority are dequeued round-robin across fairness
+// keys so that the key with many queued messages cannot starve the others, and the messages with the same fairness
+// key are dequeued in enqueue order. The zero value is ready to use.
+type Scheduler struct {
+	mu sync.Mutex
+
+	// seq is the sequence number of the last dequeue.
+	seq uint64
+	// lastServed is the sequence number of the last dequeue for each fairness key.
+	lastServed map[string]uint64
+}
+
+// Next returns the index of the message to dequeue next among the given candidates, or -1 if none of them can be
+// dequeued. The candidates must be visible messages in enqueue order.
+func (s *Scheduler) Next(candidates []Metadata, cfg QueueClientConfig) int {
+	if cfg.QueueDepthObserver != nil {
+		depths := map[string]int{}
+		for _, c := range candidates {
+			depths[c.FairnessKey]++
+		}
+		cfg.QueueDepthObserver(depths)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	selected := -1
+	var selectedServed uint64
+	for i, c := range candidates {
+		if cfg.FairnessKeyFilter != nil && !cfg.FairnessKeyFilter(c.FairnessKey) {
+			continue
+		}
+
+		served := s.lastServed[c.FairnessKey]
+		if selected < 0 {
+			selected, selectedServed = i, served
+			continue
+		}
+
+		// The higher priority message wins. For the same priority, the message of which fairness key was
+		// dequeued least recently wins. The earlier message wins ties to keep FIFO order.
+		sel := candidates[selected]
+		if c.Priority > sel.Priority || (c.Priority == sel.Priority && served < selectedServed) {
+			selected, selectedServed = i, served
+		}
+	}
+
+	return selected
+}
+
+// Served records that the message with the given fairness key has been dequeued.
+func (s *Scheduler) Served(fairnessKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lastServed == nil || len(s.lastServed) >= maxTrackedFairnessKeys {
+		s.lastServed = map[string]uint64{}
+	}
+
+	s.seq++
+	s.lastServed[fairnessKey] = s.seq
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_Next(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		s := &Scheduler{}
+		require.Equal(t, -1, s.Next(nil, QueueClientConfig{}))
+	})
+
+	t.Run("fifo without priority and fairness key", func(t *testing.T) {
+		s := &Scheduler{}
+		candidates := []Metadata{{ID: "1"}, {ID: "2"}}
+		require.Equal(t, 0, s.Next(candidates, QueueClientConfig{}))
+	})
+
+	t.Run("higher priority first", func(t *testing.T) {
+		s := &Scheduler{}
+		candidates := []Metadata{{ID: "1"}, {ID: "2", Priority: 1}, {ID: "3", Priority: 1}}
+		require.Equal(t, 1, s.Next(candidates, QueueClientConfig{}))
+	})
+
+	t.Run("round-robin across fairness keys", func(t *testing.T) {
+		s := &Scheduler{}
+		queue := []Metadata{
+			{ID: "a1", FairnessKey: "a"},
+			{ID: "a2", FairnessKey: "a"},
+			{ID: "a3", FairnessKey: "a"},
+			{ID: "b1", FairnessKey: "b"},
+			{ID: "c1", FairnessKey: "c"},
+		}
+
+		order := []string{}
+		for len(queue) > 0 {
+			i := s.Next(queue, QueueClientConfig{})
+			require.GreaterOrEqual(t, i, 0)
+			order = append(order, queue[i].ID)
+			s.Served(queue[i].FairnessKey)
+			queue = append(queue[:i], queue[i+1:]...)
+		}
+
+		require.Equal(t, []string{"a1", "b1", "c1", "a2", "a3"}, order)
+	})
+
+	t.Run("priority wins over fairness", func(t *testing.T) {
+		s := &Scheduler{}
+		s.Served("a")
+		candidates := []Metadata{{ID: "b1", FairnessKey: "b"}, {ID: "a1", FairnessKey: "a", Priority: 1}}
+		require.Equal(t, 1, s.Next(candidates, QueueClientConfig{}))
+	})
+
+	t.Run("filtered fairness key", func(t *testing.T) {
+		s := &Scheduler{}
+		candidates := []Metadata{{ID: "a1", FairnessKey: "a"}, {ID: "b1", FairnessKey: "b"}}
+		cfg := QueueClientConfig{FairnessKeyFilter: func(key string) bool { return key != "a" }}
+		require.Equal(t, 1, s.Next(candidates, cfg))
+
+		cfg = QueueClientConfig{FairnessKeyFilter: func(key string) bool { return false }}
+		require.Equal(t, -1, s.Next(candidates, cfg))
+	})
+
+	t.Run("queue depth observer", func(t *testing.T) {
+		s := &Scheduler{}
+		candidates := []Metadata{{ID: "a1", FairnessKey: "a"}, {ID: "a2", FairnessKey: "a"}, {ID: "b1", FairnessKey: "b"}}
+
+		var observed map[string]int
+		cfg := QueueClientConfig{QueueDepthObserver: func(depths map[string]int) { observed = depths }}
+		require.Equal(t, 0, s.Next(candidates, cfg))
+		require.Equal(t, map[string]int{"a": 2, "b": 1}, observed)
+	})
+}
@@ -55,13 +55,17 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
 		return client.ErrEmptyMessage
 	}
+
+	cfg := client.NewEnqueueConfig(options...)
+	msg.Metadata.Priority = cfg.Priority
+	msg.Metadata.FairnessKey = cfg.FairnessKey
 	c.queue.Enqueue(msg)
 	return nil
 }
 
 // Dequeue dequeues message from the in-memory queue.
 func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*client.Message, error) {
-	msg := c.queue.Dequeue()
+	msg := c.queue.Dequeue(opts)
 	if msg == nil {
 		return nil, client.ErrMessageNotFound
 	}
@@ -89,3 +93,36 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
 	}
 	return err
 }
+
+// DeadLetterMessage moves the leased message to the dead-letter queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *client.Message, reason string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.queue.DeadLetter(msg, reason)
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter queue.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*client.Message, error) {
+	return c.queue.DeadLetters(), nil
+}
+
+// GetDeadLetterMessage gets the message in the dead-letter queue by id.
+func (c *Client) GetDeadLetterMessage(ctx context.Context, id string) (*client.Message, error) {
+	msg := c.queue.GetDeadLetter(id)
+	if msg == nil {
+		return nil, client.ErrDeadLetterMessageNotFound
+	}
+	return msg, nil
+}
+
+// RequeueDeadLetterMessage moves the message from the dead-letter queue back to the queue.
+func (c *Client) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	return c.queue.RequeueDeadLetter(id)
+}
+
+// PurgeDeadLetterMessages deletes all messages in the dead-letter queue.
+func (c *Client) PurgeDeadLetterMessages(ctx context.Context) (int, error) {
+	return c.queue.PurgeDeadLetters(), nil
+}
@@ -40,7 +40,7 @@ func TestNamedQueue(t *testing.T) {
 
 	cli3 := NewNamedQueue("queue1")
 	require.Equal(t, 1, cli3.queue.Len())
-	require.Equal(t, "test1", string(cli3.queue.Dequeue().Data))
+	require.Equal(t, "test1", string(cli3.queue.Dequeue(client.QueueClientConfig{}).Data))
 }
 
 func TestClient(t *testing.T) {
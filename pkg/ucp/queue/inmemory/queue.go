@@ -43,12 +43,19 @@ type InmemQueue struct {
 	v   *list.List
 	vMu sync.Mutex
 
+	// deadLetters is the dead-letter queue. It is guarded by vMu.
+	deadLetters *list.List
+
+	// scheduler selects the next message to dequeue. It is guarded by vMu.
+	scheduler client.Scheduler
+
 	lockDuration time.Duration
 }
 
 func NewInMemQueue(lockDuration time.Duration) *InmemQueue {
 	return &InmemQueue{
 		v:            &list.List{},
+		deadLetters:  &list.List{},
 		lockDuration: lockDuration,
 	}
 }
@@ -63,6 +70,7 @@ func (q *InmemQueue) DeleteAll() {
 	q.vMu.Lock()
 	defer q.vMu.Unlock()
 	_ = q.v.Init()
+	_ = q.deadLetters.Init()
 }
 
 func (q *InmemQueue) Enqueue(msg *client.Message) {
@@ -79,23 +87,35 @@ func (q *InmemQueue) Enqueue(msg *client.Message) {
 	q.v.PushBack(&element{val: msg, visible: true})
 }
 
-func (q *InmemQueue) Dequeue() *client.Message {
+// Dequeue leases the next visible message selected by the scheduler.
+func (q *InmemQueue) Dequeue(cfg client.QueueClientConfig) *client.Message {
 	q.updateQueue()
 
-	var found *client.Message
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
 
-	q.elementRange(func(e *list.Element, elem *element) bool {
+	visible := []*element{}
+	candidates := []client.Metadata{}
+	for e := q.v.Front(); e != nil; e = e.Next() {
+		elem := e.Value.(*element)
 		if elem.visible {
-			elem.val.DequeueCount++
-			elem.val.NextVisibleAt = time.Now().Add(q.lockDuration)
-			elem.visible = false
-			found = elem.val
-			return true
+			visible = append(visible, elem)
+			candidates = append(candidates, elem.val.Metadata)
 		}
-		return false
-	})
+	}
+
+	i := q.scheduler.Next(candidates, cfg)
+	if i < 0 {
+		return nil
+	}
 
-	return found
+	elem := visible[i]
+	elem.val.DequeueCount++
+	elem.val.NextVisibleAt = time.Now().Add(q.lockDuration)
+	elem.visible = false
+	q.scheduler.Served(elem.val.FairnessKey)
+
+	return elem.val
 }
 
 func (q *InmemQueue) Complete(msg *client.Message) error {
@@ -141,6 +161,94 @@ func (q *InmemQueue) Extend(msg *client.Message) error {
 	return nil
 }
 
+// DeadLetter moves the message to the dead-letter queue.
+func (q *InmemQueue) DeadLetter(msg *client.Message, reason string) error {
+	found := false
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if elem.val.ID == msg.ID {
+			found = true
+			q.v.Remove(e)
+			elem.val.DeadLetterReason = reason
+			elem.val.DeadLetteredAt = time.Now().UTC()
+			q.deadLetters.PushBack(elem.val)
+			return true
+		}
+		return false
+	})
+
+	if !found {
+		return client.ErrInvalidMessage
+	}
+
+	return nil
+}
+
+// DeadLetters returns the copy of the messages in the dead-letter queue.
+func (q *InmemQueue) DeadLetters() []*client.Message {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	msgs := []*client.Message{}
+	for e := q.deadLetters.Front(); e != nil; e = e.Next() {
+		copied := *e.Value.(*client.Message)
+		msgs = append(msgs, &copied)
+	}
+	return msgs
+}
+
+// GetDeadLetter returns the copy of the message in the dead-letter queue.
+func (q *InmemQueue) GetDeadLetter(id string) *client.Message {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	if e := q.findDeadLetter(id); e != nil {
+		copied := *e.Value.(*client.Message)
+		return &copied
+	}
+	return nil
+}
+
+// RequeueDeadLetter moves the message from the dead-letter queue to the end of the queue.
+func (q *InmemQueue) RequeueDeadLetter(id string) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	e := q.findDeadLetter(id)
+	if e == nil {
+		return client.ErrDeadLetterMessageNotFound
+	}
+
+	msg := q.deadLetters.Remove(e).(*client.Message)
+	msg.Metadata.DequeueCount = 0
+	msg.Metadata.NextVisibleAt = time.Time{}
+	msg.Metadata.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
+	msg.Metadata.ReplayCount++
+	msg.Metadata.DeadLetterReason = ""
+	msg.Metadata.DeadLetteredAt = time.Time{}
+
+	q.v.PushBack(&element{val: msg, visible: true})
+	return nil
+}
+
+// PurgeDeadLetters deletes all messages in the dead-letter queue and returns the number of deleted messages.
+func (q *InmemQueue) PurgeDeadLetters() int {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	n := q.deadLetters.Len()
+	_ = q.deadLetters.Init()
+	return n
+}
+
+func (q *InmemQueue) findDeadLetter(id string) *list.Element {
+	for e := q.deadLetters.Front(); e != nil; e = e.Next() {
+		if e.Value.(*client.Message).ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
 func (q *InmemQueue) updateQueue() {
 	q.elementRange(func(e *list.Element, elem *element) bool {
 		now := time.Now().UTC()
@@ -35,7 +35,7 @@ func TestEnqueueDequeueMulti(t *testing.T) {
 	}
 
 	for i := 0; i < 10; i++ {
-		msg := q.Dequeue()
+		msg := q.Dequeue(client.QueueClientConfig{})
 		require.Equal(t, []byte(fmt.Sprintf("test%d", i)), msg.Data)
 
 		err := q.Complete(msg)
@@ -52,14 +52,14 @@ func TestMessageLock(t *testing.T) {
 		Data: []byte("test"),
 	})
 
-	msg := q.Dequeue()
+	msg := q.Dequeue(client.QueueClientConfig{})
 	require.Equal(t, []byte("test"), msg.Data)
 	require.Equal(t, 1, msg.DequeueCount)
 
 	// Message Lock duration is 2 ms, after 10 ms, mesage will be visible on the client.
 	time.Sleep(10 * time.Millisecond)
 
-	msg2 := q.Dequeue()
+	msg2 := q.Dequeue(client.QueueClientConfig{})
 	require.NotNil(t, msg2)
 	require.Equal(t, 2, msg2.DequeueCount)
 }
@@ -71,7 +71,7 @@ func TestExpiry(t *testing.T) {
 		Data: []byte("test"),
 	})
 
-	msg := q.Dequeue()
+	msg := q.Dequeue(client.QueueClientConfig{})
 	require.Equal(t, []byte("test"), msg.Data)
 	require.Equal(t, 1, msg.DequeueCount)
 
@@ -79,7 +79,7 @@ func TestExpiry(t *testing.T) {
 	msg.ExpireAt = time.Now().UTC()
 	time.Sleep(10 * time.Millisecond)
 
-	msg2 := q.Dequeue()
+	msg2 := q.Dequeue(client.QueueClientConfig{})
 	require.Nil(t, msg2)
 }
 
@@ -90,7 +90,7 @@ func TestComplete(t *testing.T) {
 		Data: []byte("test"),
 	})
 
-	msg := q.Dequeue()
+	msg := q.Dequeue(client.QueueClientConfig{})
 	err := q.Complete(msg)
 	require.NoError(t, err)
 
@@ -98,6 +98,6 @@ func TestComplete(t *testing.T) {
 	err = q.Complete(msg)
 	require.ErrorIs(t, client.ErrInvalidMessage, err)
 
-	msg2 := q.Dequeue()
+	msg2 := q.Dequeue(client.QueueClientConfig{})
 	require.Nil(t, msg2)
 }
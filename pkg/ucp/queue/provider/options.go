@@ -29,6 +29,9 @@ type QueueProviderOptions struct {
 
 	// APIServer configures options for the Kubernetes APIServer store. (Optional)
 	APIServer APIServerOptions `yaml:"apiserver,omitempty"`
+
+	// Bolt configures options for the embedded bbolt queue. (Optional)
+	Bolt BoltOptions `yaml:"bolt,omitempty"`
 }
 
 // InMemoryQueueOptions represents the inmemory queue options.
@@ -44,3 +47,9 @@ type APIServerOptions struct {
 	// Namespace configures the Kubernetes namespace used for data-storage. The namespace must already exist.
 	Namespace string `yaml:"namespace"`
 }
+
+// BoltOptions represents options for the configuring the embedded bbolt queue.
+type BoltOptions struct {
+	// Path configures the path of the database file. The file will be created if it does not exist.
+	Path string `yaml:"path"`
+}
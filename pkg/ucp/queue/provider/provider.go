@@ -19,6 +19,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"io"
 	"sync"
 
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
@@ -34,6 +35,9 @@ type QueueProvider struct {
 
 	queueClient queue.Client
 	once        sync.Once
+
+	namedClients map[string]queue.Client
+	namedMu      sync.Mutex
 }
 
 // New creates new QueueProvider instance.
@@ -64,3 +68,60 @@ func (p *QueueProvider) GetClient(ctx context.Context) (queue.Client, error) {
 func (p *QueueProvider) SetClient(client queue.Client) {
 	p.queueClient = client
 }
+
+// GetNamedClient creates or gets the client for the queue with the given name using the same provider options. This
+// is used to access the queues consumed by the other services, for example to manage their dead-letter queues.
+func (p *QueueProvider) GetNamedClient(ctx context.Context, name string) (queue.Client, error) {
+	if name == p.options.Name {
+		return p.GetClient(ctx)
+	}
+
+	p.namedMu.Lock()
+	defer p.namedMu.Unlock()
+
+	if client, ok := p.namedClients[name]; ok {
+		return client, nil
+	}
+
+	fn, ok := clientFactory[p.options.Provider]
+	if !ok {
+		return nil, ErrUnsupportedStorageProvider
+	}
+
+	opts := p.options
+	opts.Name = name
+	client, err := fn(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.namedClients == nil {
+		p.namedClients = map[string]queue.Client{}
+	}
+	p.namedClients[name] = client
+	return client, nil
+}
+
+// Close closes the queue clients created by the provider if they hold resources, such as the file of the bolt database.
+// It is called when the service that owns the provider shuts down.
+func (p *QueueProvider) Close() error {
+	p.namedMu.Lock()
+	defer p.namedMu.Unlock()
+
+	var errs []error
+	clients := []queue.Client{p.queueClient}
+	for name, client := range p.namedClients {
+		clients = append(clients, client)
+		delete(p.namedClients, name)
+	}
+
+	for _, client := range clients {
+		if closer, ok := client.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
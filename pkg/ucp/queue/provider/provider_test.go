@@ -47,3 +47,24 @@ func TestGetClient_InvalidQueue(t *testing.T) {
 	_, err := p.GetClient(context.TODO())
 	require.ErrorIs(t, ErrUnsupportedStorageProvider, err)
 }
+
+func TestGetNamedClient(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:     "ucp",
+		Provider: TypeInmemory,
+		InMemory: &InMemoryQueueOptions{},
+	})
+
+	defaultcli, err := p.GetClient(context.TODO())
+	require.NoError(t, err)
+	samecli, err := p.GetNamedClient(context.TODO(), "ucp")
+	require.NoError(t, err)
+	require.Equal(t, defaultcli, samecli)
+
+	namedcli, err := p.GetNamedClient(context.TODO(), "applications.core")
+	require.NoError(t, err)
+	require.NotSame(t, defaultcli, namedcli)
+	cachedcli, err := p.GetNamedClient(context.TODO(), "applications.core")
+	require.NoError(t, err)
+	require.Same(t, namedcli, cachedcli)
+}
@@ -372,12 +372,14 @@ func (ri ID) Type() string {
 		// /planes/{planeType} -> 'System.{planeType}/planes'
 
 		// For formatting we uppercase the first latter (title case) and need to special-case
-		// AWS because it's an initialism.
+		// AWS and GCP because they're initialisms.
 
 		// This is just cosmetic. Type names are case-insensitive.
 		planeType := ri.scopeSegments[0].Type
 		if strings.EqualFold(ri.scopeSegments[0].Type, "aws") {
 			planeType = "AWS"
+		} else if strings.EqualFold(ri.scopeSegments[0].Type, "gcp") {
+			planeType = "GCP"
 		} else {
 			planeType = cases.Title(language.English).String(planeType)
 		}
@@ -23,6 +23,10 @@ const (
 	KindDeployment = "Deployment"
 	// ResourceTypeDeployment is the resource type of a Kubernetes Deployment.
 	ResourceTypeDeployment = "apps/Deployment"
+	// KindHorizontalPodAutoscaler is the kind of a Kubernetes HorizontalPodAutoscaler.
+	KindHorizontalPodAutoscaler = "HorizontalPodAutoscaler"
+	// ResourceTypeHorizontalPodAutoscaler is the resource type of a Kubernetes HorizontalPodAutoscaler.
+	ResourceTypeHorizontalPodAutoscaler = "autoscaling/HorizontalPodAutoscaler"
 	// KindSecret is the kind of a Kubernetes Secret.
 	KindSecret = "Secret"
 	// ResourceTypeSecret is the resource type of a Kubernetes Secret.
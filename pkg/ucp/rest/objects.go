@@ -29,6 +29,7 @@ const (
 	PlaneKindUCPNative = "UCPNative"
 	PlaneKindAzure     = "Azure"
 	PlaneKindAWS       = "AWS"
+	PlaneKindGCP       = "GCP"
 )
 
 type Plane struct {
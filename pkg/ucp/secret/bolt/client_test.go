@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bolt
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	client := &Client{DB: db}
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+
+	_, err = client.Get(ctx, "azure-azurecloud-default")
+	require.ErrorIs(t, err, &secret.ErrNotFound{})
+
+	err = client.Delete(ctx, "azure-azurecloud-default")
+	require.ErrorIs(t, err, &secret.ErrNotFound{})
+
+	err = client.Save(ctx, "", []byte("test_secret"))
+	require.ErrorIs(t, err, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"})
+
+	err = client.Save(ctx, "azure-azurecloud-default", nil)
+	require.ErrorIs(t, err, &secret.ErrInvalid{Message: "invalid argument. 'value' is required"})
+
+	err = client.Save(ctx, "Azure-AzureCloud-Default", []byte("test_secret"))
+	require.NoError(t, err)
+
+	value, err := client.Get(ctx, "azure-azurecloud-default")
+	require.NoError(t, err)
+	require.Equal(t, []byte("test_secret"), value)
+
+	err = client.Delete(ctx, "azure-azurecloud-default")
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, "azure-azurecloud-default")
+	require.ErrorIs(t, err, &secret.ErrNotFound{})
+}
@@ -23,8 +23,10 @@ import (
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/secret"
+	secretbolt "github.com/radius-project/radius/pkg/ucp/secret/bolt"
 	"github.com/radius-project/radius/pkg/ucp/secret/etcd"
 	kubernetes_client "github.com/radius-project/radius/pkg/ucp/secret/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
 	"k8s.io/kubectl/pkg/scheme"
 	controller_runtime "sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +37,7 @@ type secretFactoryFunc func(context.Context, SecretProviderOptions) (secret.Clie
 var secretClientFactory = map[SecretProviderType]secretFactoryFunc{
 	TypeETCDSecret:       initETCDSecretClient,
 	TypeKubernetesSecret: initKubernetesSecretClient,
+	TypeBoltSecret:       initBoltSecretClient,
 }
 
 func initETCDSecretClient(ctx context.Context, opts SecretProviderOptions) (secret.Client, error) {
@@ -53,6 +56,15 @@ func initETCDSecretClient(ctx context.Context, opts SecretProviderOptions) (secr
 	return &etcd.Client{ETCDClient: secretClient.Client()}, nil
 }
 
+func initBoltSecretClient(ctx context.Context, opts SecretProviderOptions) (secret.Client, error) {
+	// The secret client shares the database file with the data provider when they are configured with the same path.
+	db, err := boltstore.Open(opts.Bolt.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &secretbolt.Client{DB: db}, nil
+}
+
 func initKubernetesSecretClient(ctx context.Context, opt SecretProviderOptions) (secret.Client, error) {
 	s := scheme.Scheme
 	cfg, err := kubeutil.NewClientConfig(&kubeutil.ConfigOptions{
@@ -16,7 +16,10 @@ limitations under the License.
 
 package provider
 
-import "github.com/radius-project/radius/pkg/ucp/dataprovider"
+import (
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
+)
 
 // SecretProviderOptions contains provider information of the secret.
 type SecretProviderOptions struct {
@@ -25,4 +28,10 @@ type SecretProviderOptions struct {
 
 	// ETCD configures options for the etcd secret store.
 	ETCD dataprovider.ETCDOptions `yaml:"etcd,omitempty"`
+
+	// Bolt configures options for the embedded bbolt secret store.
+	Bolt dataprovider.BoltOptions `yaml:"bolt,omitempty"`
+
+	// Vault configures the Vault server from which the secret values of the credentials stored in Vault are read.
+	Vault vault.Options `yaml:"vault,omitempty"`
 }
@@ -19,9 +19,11 @@ package provider
 import (
 	"context"
 	"errors"
+	"io"
 	"sync"
 
 	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
 )
 
 var (
@@ -65,3 +67,21 @@ func (p *SecretProvider) GetClient(ctx context.Context) (secret.Client, error) {
 
 	return p.client, err
 }
+
+// VaultOptions returns the configuration of the Vault server from which the secret values of the credentials stored in
+// Vault are read.
+func (p *SecretProvider) VaultOptions() vault.Options {
+	if p == nil {
+		return vault.Options{}
+	}
+	return p.options.Vault
+}
+
+// Close closes the secret client if it holds resources, such as the file of the bolt database. It is called when the
+// service that owns the provider shuts down.
+func (p *SecretProvider) Close() error {
+	if closer, ok := p.client.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
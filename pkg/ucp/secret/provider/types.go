@@ -25,4 +25,7 @@ const (
 
 	// TypeKubernetesSecret represents the Kubernetes secret provider.
 	TypeKubernetesSecret SecretProviderType = "kubernetes"
+
+	// TypeBoltSecret represents the embedded bbolt file database secret provider.
+	TypeBoltSecret SecretProviderType = "bolt"
 )
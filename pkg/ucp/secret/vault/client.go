@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+const (
+	// TokenEnvVar is the environment variable containing the token used to authenticate with Vault.
+	TokenEnvVar = "VAULT_TOKEN"
+
+	// DefaultMount is the default mount path of the KV version 2 secret engine.
+	DefaultMount = "secret"
+
+	tokenHeader = "X-Vault-Token"
+)
+
+var _ secret.Client = (*Client)(nil)
+
+// Client represents radius secret client to manage secrets in a HashiCorp Vault KV version 2 secret engine.
+// Secret values must be JSON objects, which are stored as the data of the secret.
+type Client struct {
+	// Address is the address of the Vault server, for example https://vault.example.com:8200.
+	Address string
+
+	// Mount is the mount path of the KV version 2 secret engine. DefaultMount is used if empty.
+	Mount string
+
+	// Token is the token used to authenticate with Vault.
+	Token string
+
+	// HTTPClient is the HTTP client used to call Vault. http.DefaultClient is used if nil.
+	HTTPClient *http.Client
+}
+
+type kvResponse struct {
+	Data struct {
+		Data json.RawMessage `json:"data"`
+	} `json:"data"`
+}
+
+type kvRequest struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// Save writes a new version of the secret with the given name. The value must be a JSON object.
+func (c *Client) Save(ctx context.Context, name string, value []byte) error {
+	if name == "" {
+		return &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}
+	}
+
+	if value == nil {
+		return &secret.ErrInvalid{Message: "invalid argument. 'value' is required"}
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal(value, &data); err != nil {
+		return &secret.ErrInvalid{Message: "invalid argument. 'value' must be a JSON object"}
+	}
+
+	body, err := json.Marshal(kvRequest{Data: value})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, http.MethodPost, "data", name, body)
+	return err
+}
+
+// Delete deletes all versions and the metadata of the secret with the given name.
+func (c *Client) Delete(ctx context.Context, name string) error {
+	if name == "" {
+		return &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}
+	}
+
+	// Vault returns 204 for the deletion of a missing secret, so check for existence first.
+	if _, err := c.Get(ctx, name); err != nil {
+		return err
+	}
+
+	_, err := c.do(ctx, http.MethodDelete, "metadata", name, nil)
+	return err
+}
+
+// Get reads the latest version of the secret with the given name and returns its data as a JSON object.
+func (c *Client) Get(ctx context.Context, name string) ([]byte, error) {
+	if name == "" {
+		return nil, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}
+	}
+
+	body, err := c.do(ctx, http.MethodGet, "data", name, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := kvResponse{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse the response from vault: %w", err)
+	}
+
+	// A deleted version of the secret has null data.
+	if len(resp.Data.Data) == 0 || string(resp.Data.Data) == "null" {
+		return nil, &secret.ErrNotFound{}
+	}
+
+	return resp.Data.Data, nil
+}
+
+func (c *Client) do(ctx context.Context, method, operation, name string, body []byte) ([]byte, error) {
+	mount := c.Mount
+	if mount == "" {
+		mount = DefaultMount
+	}
+
+	u, err := url.JoinPath(c.Address, "v1", strings.Trim(mount, "/"), operation, strings.Trim(name, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return nil, err
+	}
+	if c.Token != "" {
+		req.Header.Set(tokenHeader, c.Token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, &secret.ErrNotFound{}
+	case resp.StatusCode >= 400:
+		return nil, fmt.Errorf("vault returned status code %d for %s %s: %s", resp.StatusCode, method, u, strings.TrimSpace(string(respBody)))
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "test-token"
+
+// fakeVault is a minimal stand-in for the Vault KV version 2 secret engine mounted at /v1/secret.
+type fakeVault struct {
+	mu      sync.Mutex
+	secrets map[string]json.RawMessage
+}
+
+func newFakeVault(t *testing.T) *httptest.Server {
+	v := &fakeVault{secrets: map[string]json.RawMessage{}}
+	server := httptest.NewServer(v)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if r.Header.Get(tokenHeader) != testToken {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+		name := strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")
+		switch r.Method {
+		case http.MethodGet:
+			data, ok := v.secrets[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"errors":[]}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"data":     data,
+					"metadata": map[string]any{"version": 1},
+				},
+			})
+		case http.MethodPost:
+			req := kvRequest{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			v.secrets[name] = req.Data
+			_, _ = w.Write([]byte(`{"data":{"version":1}}`))
+		}
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/") && r.Method == http.MethodDelete:
+		delete(v.secrets, strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/"))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestClient(t *testing.T) {
+	ctx := testcontext.New(t)
+	server := newFakeVault(t)
+	client := &Client{Address: server.URL, Token: testToken}
+
+	_, err := client.Get(ctx, "radius/azure")
+	require.ErrorIs(t, err, &secret.ErrNotFound{})
+
+	err = client.Delete(ctx, "radius/azure")
+	require.ErrorIs(t, err, &secret.ErrNotFound{})
+
+	err = client.Save(ctx, "", []byte(`{"clientSecret":"secret"}`))
+	require.ErrorIs(t, err, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"})
+
+	err = client.Save(ctx, "radius/azure", nil)
+	require.ErrorIs(t, err, &secret.ErrInvalid{Message: "invalid argument. 'value' is required"})
+
+	err = client.Save(ctx, "radius/azure", []byte("test_secret"))
+	require.ErrorIs(t, err, &secret.ErrInvalid{Message: "invalid argument. 'value' must be a JSON object"})
+
+	err = client.Save(ctx, "radius/azure", []byte(`{"clientSecret":"secret"}`))
+	require.NoError(t, err)
+
+	value, err := client.Get(ctx, "radius/azure")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"clientSecret":"secret"}`, string(value))
+
+	err = client.Delete(ctx, "radius/azure")
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, "radius/azure")
+	require.ErrorIs(t, err, &secret.ErrNotFound{})
+}
+
+func TestClient_PermissionDenied(t *testing.T) {
+	ctx := testcontext.New(t)
+	server := newFakeVault(t)
+	client := &Client{Address: server.URL, Token: "wrong-token"}
+
+	_, err := client.Get(ctx, "radius/azure")
+	require.ErrorContains(t, err, "vault returned status code 403")
+}
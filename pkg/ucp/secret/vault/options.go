@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+// Options represents the configuration of the Vault server from which UCP reads the secret values of the credentials
+// stored in Vault. UCP authenticates with its own token, so the credentials may only refer to the configured server and
+// secret engines.
+type Options struct {
+	// Address is the address of the Vault server, for example https://vault.example.com:8200. Credentials stored in
+	// Vault are rejected if it is empty.
+	Address string `yaml:"address,omitempty"`
+
+	// AllowedMounts is the list of the mount paths of the KV version 2 secret engines the credentials may use.
+	// Defaults to DefaultMount.
+	AllowedMounts []string `yaml:"allowedMounts,omitempty"`
+}
+
+// Validate returns secret.ErrInvalid if the address is not the address of the configured Vault server or the mount is
+// not allowed. An empty mount is DefaultMount.
+func (o Options) Validate(address string, mount string) error {
+	if o.Address == "" {
+		return &secret.ErrInvalid{Message: "credentials stored in Vault are not enabled, the Vault address is not configured"}
+	}
+
+	if !strings.EqualFold(strings.TrimSuffix(address, "/"), strings.TrimSuffix(o.Address, "/")) {
+		return &secret.ErrInvalid{Message: fmt.Sprintf("the Vault address %q is not the configured Vault address %q", address, o.Address)}
+	}
+
+	if mount == "" {
+		mount = DefaultMount
+	}
+
+	allowed := o.AllowedMounts
+	if len(allowed) == 0 {
+		allowed = []string{DefaultMount}
+	}
+
+	for _, m := range allowed {
+		if strings.Trim(m, "/") == strings.Trim(mount, "/") {
+			return nil
+		}
+	}
+
+	return &secret.ErrInvalid{Message: fmt.Sprintf("the Vault mount %q is not allowed, the allowed mounts are %q", mount, allowed)}
+}
+
+// NewClient validates the address and the mount with the options, and creates a client of the Vault server
+// authenticated with the token in the VAULT_TOKEN environment variable.
+func NewClient(options Options, address string, mount string) (secret.Client, error) {
+	if err := options.Validate(address, mount); err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		Address: options.Address,
+		Mount:   mount,
+		Token:   os.Getenv(TokenEnvVar),
+	}, nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptions_Validate(t *testing.T) {
+	options := Options{Address: "https://vault.example.com:8200/", AllowedMounts: []string{"secret", "radius/"}}
+
+	tests := []struct {
+		name    string
+		options Options
+		address string
+		mount   string
+		valid   bool
+	}{
+		{name: "configured address and mount", options: options, address: "https://vault.example.com:8200", mount: "radius", valid: true},
+		{name: "default mount", options: options, address: "https://VAULT.example.com:8200/", valid: true},
+		{name: "other address", options: options, address: "https://attacker.example.com", mount: "secret"},
+		{name: "mount not allowed", options: options, address: "https://vault.example.com:8200", mount: "sys"},
+		{name: "only the default mount is allowed by default", options: Options{Address: "https://vault.example.com:8200"}, address: "https://vault.example.com:8200", mount: "radius"},
+		{name: "vault not configured", address: "https://vault.example.com:8200", mount: "secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.options.Validate(tt.address, tt.mount)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, &secret.ErrInvalid{})
+			}
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Setenv(TokenEnvVar, testToken)
+
+	client, err := NewClient(Options{Address: "https://vault.example.com:8200"}, "https://vault.example.com:8200/", "")
+	require.NoError(t, err)
+	require.Equal(t, &Client{Address: "https://vault.example.com:8200", Token: testToken}, client)
+
+	_, err = NewClient(Options{Address: "https://vault.example.com:8200"}, "https://attacker.example.com", "")
+	require.ErrorIs(t, err, &secret.ErrInvalid{})
+}
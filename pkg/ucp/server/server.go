@@ -32,6 +32,7 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/trace"
 	"github.com/radius-project/radius/pkg/ucp/backend"
+	"github.com/radius-project/radius/pkg/ucp/backend/credentialvalidation"
 	"github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/data"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
@@ -147,8 +148,8 @@ func NewServerOptionsFromEnvironment() (Options, error) {
 	}, nil
 }
 
-// NewServer creates a new hosting.Host instance with services for API, EmbeddedETCD, Metrics, Profiler and Backend (if
-// enabled) based on the given Options.
+// NewServer creates a new hosting.Host instance with services for API, EmbeddedETCD, Metrics, Profiler, Backend and
+// Credential Validator (if enabled) based on the given Options.
 func NewServer(options *Options) (*hosting.Host, error) {
 	hostingServices := []hosting.Service{
 		api.NewService(api.ServiceOptions{
@@ -203,6 +204,14 @@ func NewServer(options *Options) (*hosting.Host, error) {
 	}
 	hostingServices = append(hostingServices, backend.NewService(backendServiceOptions))
 
+	if options.Config.CredentialValidation.Enabled {
+		hostingServices = append(hostingServices, credentialvalidation.NewService(credentialvalidation.ServiceOptions{
+			Config:                 options.Config.CredentialValidation,
+			StorageProviderOptions: options.StorageProviderOptions,
+			SecretProviderOptions:  options.SecretProviderOptions,
+		}))
+	}
+
 	options.TracerProviderOptions.ServiceName = "ucp"
 	hostingServices = append(hostingServices, &trace.Service{Options: options.TracerProviderOptions})
 
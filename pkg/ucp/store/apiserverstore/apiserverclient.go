@@ -59,6 +59,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/watch"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -88,6 +89,8 @@ func NewAPIServerClient(client runtimeclient.Client, namespace string) *APIServe
 }
 
 var _ store.StorageClient = (*APIServerClient)(nil)
+var _ store.Watcher = (*APIServerClient)(nil)
+var _ store.Transactor = (*APIServerClient)(nil)
 
 type APIServerClient struct {
 	client    runtimeclient.Client
@@ -319,6 +322,8 @@ func (c *APIServerClient) Save(ctx context.Context, obj *store.Object, options .
 			return false, &store.ErrConcurrency{}
 		} else if index == nil {
 			resource.Entries = append(resource.Entries, *converted)
+		} else if config.CreateOnly {
+			return false, &store.ErrConcurrency{}
 		} else {
 			if config.ETag != "" && config.ETag != resource.Entries[*index].ETag {
 				return false, &store.ErrConcurrency{}
@@ -355,6 +360,189 @@ func (c *APIServerClient) Save(ctx context.Context, obj *store.Object, options .
 	return err
 }
 
+// Commit applies the writes of the transaction on a best-effort basis. Resources are spread across multiple Kubernetes
+// objects so the writes can't be applied atomically; instead the ETags are checked before the first write and applied
+// writes are rolled back if a later write fails.
+func (c *APIServerClient) Commit(ctx context.Context, tx *store.Transaction) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := tx.Validate(); err != nil {
+		return err
+	}
+
+	return store.CommitSequential(ctx, c, tx)
+}
+
+// Watch watches the Kubernetes objects matching the query and reports changes to the UCP resources stored in them. Since each
+// Kubernetes object can hold multiple UCP resources, the entries of each object are tracked so that a change to the object
+// can be reported as changes to the individual resources. The revision of each event is the Kubernetes resource version.
+//
+// Watch falls back to polling if the Kubernetes client does not support watch.
+func (c *APIServerClient) Watch(ctx context.Context, query store.Query, options ...store.WatchOptions) (<-chan store.StoreEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+
+	watchClient, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return store.NewPollingWatcher(c).Watch(ctx, query, options...)
+	}
+
+	selector, err := createLabelSelector(query)
+	if err != nil {
+		return nil, err
+	}
+
+	config := store.NewWatchConfig(options...)
+
+	// known tracks the matching entries of each Kubernetes object by object name, then by lowercased resource id.
+	known := map[string]map[string]store.Object{}
+
+	// When we're not resuming we list the objects first so that we know their entries, and then start watching from
+	// the resource version of the list.
+	resourceVersion := config.Revision
+	if resourceVersion == "" {
+		rs := ucpv1alpha1.ResourceList{}
+		err = c.client.List(ctx, &rs, runtimeclient.InNamespace(c.namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range rs.Items {
+			known[rs.Items[i].Name] = matchingEntries(ctx, &rs.Items[i], query)
+		}
+		resourceVersion = rs.ResourceVersion
+	}
+
+	listOptions := &runtimeclient.ListOptions{
+		LabelSelector: selector,
+		Namespace:     c.namespace,
+		Raw:           &v1.ListOptions{ResourceVersion: resourceVersion},
+	}
+	watcher, err := watchClient.Watch(ctx, &ucpv1alpha1.ResourceList{}, listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan store.StoreEvent)
+	go func() {
+		defer close(out)
+		defer watcher.Stop()
+		logger := ucplog.FromContextOrDiscard(ctx)
+
+		for {
+			var event watch.Event
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-watcher.ResultChan():
+				if !ok {
+					return
+				}
+				event = e
+			}
+
+			if event.Type == watch.Error {
+				logger.Error(apierrors.FromObject(event.Object), "kubernetes watch failed")
+				return
+			}
+
+			resource, ok := event.Object.(*ucpv1alpha1.Resource)
+			if !ok {
+				// Bookmarks and other object types don't describe a change.
+				continue
+			}
+
+			previous, found := known[resource.Name]
+			current := matchingEntries(ctx, resource, query)
+			if event.Type == watch.Deleted {
+				if !found {
+					previous = current
+				}
+				current = map[string]store.Object{}
+				delete(known, resource.Name)
+			} else {
+				known[resource.Name] = current
+			}
+
+			for _, converted := range diffEntries(previous, current, found || event.Type == watch.Added) {
+				converted.Revision = resource.ResourceVersion
+
+				select {
+				case out <- converted:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// matchingEntries returns the entries of the Kubernetes object that match the query keyed by lowercased resource id.
+func matchingEntries(ctx context.Context, resource *ucpv1alpha1.Resource, query store.Query) map[string]store.Object {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	results := map[string]store.Object{}
+	for _, entry := range resource.Entries {
+		id, err := resources.Parse(entry.ID)
+		if err != nil {
+			// Ignore invalid IDs when watching, we don't want a single piece of bad data to break the watch.
+			logger.Error(err, "found an invalid resource id as part of a watch", "name", resource.Name, "namespace", resource.Namespace)
+			continue
+		}
+
+		if !storeutil.IDMatchesQuery(id, query) {
+			continue
+		}
+
+		converted, err := readEntry(&entry)
+		if err != nil {
+			logger.Error(err, "failed to read resource entry as part of a watch", "id", entry.ID)
+			continue
+		}
+
+		match, err := converted.MatchesFilters(query.Filters)
+		if err != nil || !match {
+			continue
+		}
+
+		results[strings.ToLower(entry.ID)] = *converted
+	}
+
+	return results
+}
+
+// diffEntries compares the entries of a Kubernetes object before and after a change. New entries are reported as
+// created only when the previous entries of the object are known, otherwise they are reported as updated.
+func diffEntries(previous map[string]store.Object, current map[string]store.Object, knownPrevious bool) []store.StoreEvent {
+	events := []store.StoreEvent{}
+	for id, obj := range current {
+		old, ok := previous[id]
+		if !ok && knownPrevious {
+			events = append(events, store.StoreEvent{Type: store.EventCreated, Object: obj})
+		} else if !ok || old.ETag != obj.ETag {
+			events = append(events, store.StoreEvent{Type: store.EventUpdated, Object: obj})
+		}
+	}
+
+	for id, obj := range previous {
+		if _, ok := current[id]; !ok {
+			events = append(events, store.StoreEvent{Type: store.EventDeleted, Object: obj})
+		}
+	}
+
+	return events
+}
+
 func (c *APIServerClient) doWithRetry(action func() (bool, error)) error {
 	for i := 0; i < RetryCount; i++ {
 		retryable, err := action()
@@ -20,6 +20,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
@@ -130,6 +132,8 @@ func Test_APIServer_Client(t *testing.T) {
 
 	// The actual test logic lives in a shared package, we're just doing the setup here.
 	shared.RunTest(t, client, clear)
+	shared.RunWatchTest(t, client, clear)
+	shared.RunTransactionTest(t, client, clear)
 
 	// The APIServer implementation is complex enough that we have some of our tests in addition
 	// to the standard suite.
@@ -857,3 +861,76 @@ func Test_CreateLabelSelector_ScopeQuery(t *testing.T) {
 	set = assignLabels(&resource)
 	require.True(t, selector.Matches(set))
 }
+
+func Test_APIServer_Client_Watch(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	scheme := runtime.NewScheme()
+	err := ucpv1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	// The fake client supports watch so we can test the native watch without a Kubernetes test environment. The fake
+	// client does not support resuming from a resource version, that's covered by the shared tests.
+	rc := fake.NewClientBuilder().WithScheme(scheme).Build()
+	client := NewAPIServerClient(rc, "radius-test")
+
+	query := store.Query{RootScope: shared.RadiusScope, ScopeRecursive: true}
+	events, err := client.Watch(ctx, query)
+	require.NoError(t, err)
+
+	next := func() store.StoreEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "timed out waiting for watch event")
+			return store.StoreEvent{}
+		}
+	}
+
+	obj := store.Object{
+		Metadata: store.Metadata{ID: shared.Resource1ID.String()},
+		Data:     shared.Data1,
+	}
+	err = client.Save(ctx, &obj)
+	require.NoError(t, err)
+
+	event := next()
+	require.Equal(t, store.EventCreated, event.Type)
+	require.Equal(t, obj.ID, event.Object.ID)
+	require.Equal(t, obj.ETag, event.Object.ETag)
+	require.NotEmpty(t, event.Revision)
+
+	obj.Data = shared.Data2
+	err = client.Save(ctx, &obj)
+	require.NoError(t, err)
+
+	event = next()
+	require.Equal(t, store.EventUpdated, event.Type)
+	require.Equal(t, obj.ETag, event.Object.ETag)
+
+	err = client.Delete(ctx, obj.ID)
+	require.NoError(t, err)
+
+	event = next()
+	require.Equal(t, store.EventDeleted, event.Type)
+	require.Equal(t, obj.ID, event.Object.ID)
+}
+
+func Test_DiffEntries(t *testing.T) {
+	obj1 := store.Object{Metadata: store.Metadata{ID: "/planes/radius/local/resourceGroups/a", ETag: "1"}}
+	obj1Updated := store.Object{Metadata: store.Metadata{ID: "/planes/radius/local/resourceGroups/a", ETag: "2"}}
+	obj2 := store.Object{Metadata: store.Metadata{ID: "/planes/radius/local/resourceGroups/b", ETag: "1"}}
+
+	// A hash collision means that a second resource is added to the same Kubernetes object.
+	events := diffEntries(map[string]store.Object{"a": obj1}, map[string]store.Object{"a": obj1, "b": obj2}, true)
+	require.Equal(t, []store.StoreEvent{{Type: store.EventCreated, Object: obj2}}, events)
+
+	events = diffEntries(map[string]store.Object{"a": obj1, "b": obj2}, map[string]store.Object{"a": obj1Updated}, true)
+	require.ElementsMatch(t, []store.StoreEvent{{Type: store.EventUpdated, Object: obj1Updated}, {Type: store.EventDeleted, Object: obj2}}, events)
+
+	// When the previous state of the object is unknown we can't tell whether the resource is new.
+	events = diffEntries(map[string]store.Object{}, map[string]store.Object{"a": obj1}, false)
+	require.Equal(t, []store.StoreEvent{{Type: store.EventUpdated, Object: obj1}}, events)
+}
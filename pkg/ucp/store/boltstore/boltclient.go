@@ -0,0 +1,435 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package boltstore stores resources in an embedded bbolt database file. This allows UCP and the resource providers
+// to run as a single binary without Kubernetes or an etcd process, which is useful for local development and tests.
+//
+// The key scheme is the same as the one used by the etcd store: a key is built from the storage prefix (scope or
+// resource), the root scope and the routing scope separated by '|'.
+//
+//	scope|/planes/radius/local/|/resourceGroups/cool-group/
+//	resource|/planes/radius/local/resourceGroups/cool-group/|/Applications.Core/applications/cool-app/
+//
+// bbolt keeps keys sorted in byte-order, so queries are executed as a prefix scan with client-side filtering. Each
+// write allocates a new revision from the bucket sequence which is used to generate the ETag of the object.
+package boltstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// SectionSeparator is the separator used between the sections of a key.
+	SectionSeparator = "|"
+
+	// ResourcesBucket is the name of the bucket used to store resources.
+	ResourcesBucket = "resources"
+)
+
+var _ store.StorageClient = (*BoltClient)(nil)
+var _ store.Transactor = (*BoltClient)(nil)
+
+// BoltClient is a store.StorageClient backed by an embedded bbolt database.
+type BoltClient struct {
+	db        *bbolt.DB
+	closeOnce sync.Once
+}
+
+// NewBoltClient creates a new BoltClient instance with the given bbolt database.
+func NewBoltClient(db *bbolt.DB) (*BoltClient, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(ResourcesBucket))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoltClient{db: db}, nil
+}
+
+// DB returns the bbolt database used by the BoltClient.
+func (c *BoltClient) DB() *bbolt.DB {
+	return c.db
+}
+
+// Close releases the reference of the BoltClient to its database. The database file is closed when no other client
+// uses it.
+func (c *BoltClient) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		err = Close(c.db)
+	})
+	return err
+}
+
+// Query retrieves objects from the store that match the given query and filters, and returns them in a store.ObjectQueryResult.
+// When a maximum item count is provided the result is paginated and the pagination token can be used to resume the query.
+func (c *BoltClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+
+	config := store.NewQueryConfig(options...)
+
+	prefix := []byte(keyFromQuery(query))
+	start := prefix
+	if config.PaginationToken != "" {
+		token, err := base64.StdEncoding.DecodeString(config.PaginationToken)
+		if err != nil || !bytes.HasPrefix(token, prefix) {
+			return nil, &store.ErrInvalid{Message: "invalid argument. 'PaginationToken' is invalid"}
+		}
+		start = token
+	}
+
+	results := store.ObjectQueryResult{}
+	var lastKey []byte
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket([]byte(ResourcesBucket)).Cursor()
+		for k, v := cursor.Seek(start); k != nil && bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+			// The pagination token is the last key of the previous page.
+			if config.PaginationToken != "" && bytes.Equal(k, start) {
+				continue
+			}
+
+			if !keyMatchesQuery(k, query) {
+				continue
+			}
+
+			value := store.Object{}
+			if err := json.Unmarshal(v, &value); err != nil {
+				return err
+			}
+
+			match, err := value.MatchesFilters(query.Filters)
+			if err != nil {
+				return err
+			} else if !match {
+				continue
+			}
+
+			// There is at least one more matching item, so the page ends at the last key we returned.
+			if config.MaxQueryItemCount > 0 && len(results.Items) == config.MaxQueryItemCount {
+				results.PaginationToken = base64.StdEncoding.EncodeToString(lastKey)
+				return nil
+			}
+
+			results.Items = append(results.Items, value)
+
+			// Keys are only valid for the life of the transaction.
+			lastKey = append(lastKey[:0], k...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
+
+// Get checks if the provided context, id and options are valid, then retrieves the corresponding object from
+// the store and returns it, or an error if the object is not found or an error occurs.
+func (c *BoltClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseNamedID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	key := []byte(keyFromID(parsed))
+
+	var value *store.Object
+	err = c.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(ResourcesBucket)).Get(key)
+		if b == nil {
+			return &store.ErrNotFound{ID: id}
+		}
+
+		value = &store.Object{}
+		return json.Unmarshal(b, value)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Delete checks if the given resource ID is valid, and if so, deletes it from the store, returning an error if the
+// resource does not exist or if an ETag is provided and does not match.
+func (c *BoltClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseNamedID(id)
+	if err != nil {
+		return err
+	}
+
+	key := []byte(keyFromID(parsed))
+	config := store.NewDeleteConfig(options...)
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(ResourcesBucket))
+		existing := bucket.Get(key)
+		if err := checkETag(existing, config.ETag); err != nil {
+			return err
+		}
+
+		if existing == nil {
+			return &store.ErrNotFound{ID: id}
+		}
+
+		return bucket.Delete(key)
+	})
+}
+
+// Save checks the context and object parameters, parses the object ID, marshals the object into JSON, saves the object to
+// the store, and sets the object's ETag. If an ETag is provided, the write is rejected unless it matches the stored object.
+// A create-only write is rejected if the object exists.
+func (c *BoltClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	parsed, err := resources.Parse(obj.Metadata.ID)
+	if err != nil {
+		return err
+	}
+
+	key := []byte(keyFromID(parsed))
+	config := store.NewSaveConfig(options...)
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(ResourcesBucket))
+		if err := checkETag(bucket.Get(key), config.ETag); err != nil {
+			return err
+		}
+		if config.CreateOnly && bucket.Get(key) != nil {
+			return &store.ErrConcurrency{}
+		}
+
+		revision, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		// Copy the object so that the caller's object is only updated when the transaction succeeds.
+		copied := *obj
+		copied.ETag = etag.NewFromRevision(int64(revision))
+
+		b, err := json.Marshal(&copied)
+		if err != nil {
+			return err
+		}
+
+		if err := bucket.Put(key, b); err != nil {
+			return err
+		}
+
+		obj.ETag = copied.ETag
+		return nil
+	})
+}
+
+// Commit applies the writes of the transaction in a single bbolt write transaction.
+func (c *BoltClient) Commit(ctx context.Context, tx *store.Transaction) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := tx.Validate(); err != nil {
+		return err
+	}
+
+	keys := make([][]byte, len(tx.Operations))
+	for i, op := range tx.Operations {
+		parsed, err := resources.Parse(op.ID)
+		if err != nil {
+			return &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+		}
+		keys[i] = []byte(keyFromID(parsed))
+	}
+
+	etags := make([]store.ETag, len(tx.Operations))
+	err := c.db.Update(func(btx *bbolt.Tx) error {
+		bucket := btx.Bucket([]byte(ResourcesBucket))
+
+		// Check every precondition before the first write. Returning an error rolls back the bbolt transaction
+		// but there is no need to rely on that for the common failure cases.
+		for i, op := range tx.Operations {
+			existing := bucket.Get(keys[i])
+			if err := checkETag(existing, op.ETag); err != nil {
+				return err
+			}
+			if op.Kind == store.OperationDelete && existing == nil {
+				return &store.ErrNotFound{ID: op.ID}
+			}
+		}
+
+		for i, op := range tx.Operations {
+			switch op.Kind {
+			case store.OperationSave:
+				revision, err := bucket.NextSequence()
+				if err != nil {
+					return err
+				}
+
+				copied := *op.Object
+				copied.ETag = etag.NewFromRevision(int64(revision))
+				b, err := json.Marshal(&copied)
+				if err != nil {
+					return err
+				}
+
+				if err := bucket.Put(keys[i], b); err != nil {
+					return err
+				}
+				etags[i] = copied.ETag
+			case store.OperationDelete:
+				if err := bucket.Delete(keys[i]); err != nil {
+					return err
+				}
+			default:
+				return &store.ErrInvalid{Message: "invalid argument. unsupported operation kind " + string(op.Kind)}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Only update the caller's objects once the transaction has committed.
+	for i, op := range tx.Operations {
+		if op.Kind == store.OperationSave {
+			op.Object.ETag = etags[i]
+		}
+	}
+
+	return nil
+}
+
+// checkETag validates the ETag provided by the caller against the stored value. An empty ETag always matches.
+func checkETag(existing []byte, expected store.ETag) error {
+	if expected == "" {
+		return nil
+	}
+
+	// A mismatched ETag for a missing object is a concurrency failure, the same as the other stores.
+	if existing == nil {
+		return &store.ErrConcurrency{}
+	}
+
+	current := store.Object{}
+	if err := json.Unmarshal(existing, &current); err != nil {
+		return err
+	}
+
+	if current.ETag != expected {
+		return &store.ErrConcurrency{}
+	}
+
+	return nil
+}
+
+func parseNamedID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+func idFromKey(key []byte) (resources.ID, error) {
+	parts := strings.Split(string(key), SectionSeparator)
+	// sample valid key:
+	// scope|/planes/radius/local/resourceGroups/cool-group/|/Applications.Core/applications/cool-app/
+	if len(parts) != 3 {
+		return resources.ID{}, errors.New("the key is invalid because it does not have 3 sections")
+	}
+
+	switch parts[0] {
+	case storeutil.ScopePrefix:
+		return resources.Parse(parts[1] + strings.TrimPrefix(parts[2], resources.SegmentSeparator))
+	case storeutil.ResourcePrefix:
+		return resources.Parse(parts[1] + resources.ProvidersSegment + parts[2])
+	default:
+		return resources.ID{}, errors.New("the key is invalid because it has the wrong prefix")
+	}
+}
+
+// keyFromID returns the key to use for an ID. They key should be used as an exact match.
+func keyFromID(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := storeutil.ExtractStorageParts(id)
+	return prefix + SectionSeparator + rootScope + SectionSeparator + routingScope
+}
+
+// keyFromQuery returns the key to use for an for executing a query. The key should be used as a prefix.
+func keyFromQuery(query store.Query) string {
+	prefix := storeutil.ResourcePrefix
+	if query.IsScopeQuery {
+		prefix = storeutil.ScopePrefix
+	}
+
+	if query.ScopeRecursive {
+		return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope)
+	}
+
+	return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope) + SectionSeparator + storeutil.NormalizePart(query.RoutingScopePrefix)
+}
+
+func keyMatchesQuery(key []byte, query store.Query) bool {
+	// Ignore invalid keys, we don't expect to find them.
+	id, err := idFromKey(key)
+	if err != nil {
+		return false
+	}
+
+	return storeutil.IDMatchesQuery(id, query)
+}
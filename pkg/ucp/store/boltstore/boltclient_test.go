@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+
+	shared "github.com/radius-project/radius/test/ucp/storetest"
+)
+
+func newTestClient(t *testing.T) *BoltClient {
+	db, err := Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+
+	client, err := NewBoltClient(db)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+
+	return client
+}
+
+func Test_BoltClient(t *testing.T) {
+	client := newTestClient(t)
+
+	clear := func(t *testing.T) {
+		err := client.DB().Update(func(tx *bbolt.Tx) error {
+			if err := tx.DeleteBucket([]byte(ResourcesBucket)); err != nil {
+				return err
+			}
+			_, err := tx.CreateBucket([]byte(ResourcesBucket))
+			return err
+		})
+		require.NoError(t, err)
+	}
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	shared.RunTest(t, client, clear)
+	shared.RunWatchTest(t, client, clear)
+	shared.RunTransactionTest(t, client, clear)
+
+	// Hiding the native transaction support tests the best-effort fallback of store.Commit.
+	t.Run("sequential", func(t *testing.T) {
+		shared.RunTransactionTest(t, struct{ store.StorageClient }{client}, clear)
+	})
+}
+
+func Test_BoltClient_Query_Pagination(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := newTestClient(t)
+
+	for i := 0; i < 5; i++ {
+		obj := store.Object{
+			Metadata: store.Metadata{ID: fmt.Sprintf("/planes/radius/local/resourceGroups/group/providers/Applications.Core/applications/app%d", i)},
+			Data:     map[string]any{"index": i},
+		}
+		require.NoError(t, client.Save(ctx, &obj))
+	}
+
+	query := store.Query{RootScope: "/planes/radius/local/resourceGroups/group", ResourceType: "Applications.Core/applications"}
+
+	ids := []string{}
+	token := ""
+	pages := 0
+	for {
+		result, err := client.Query(ctx, query, store.WithMaxQueryItemCount(2), store.WithPaginationToken(token))
+		require.NoError(t, err)
+		pages++
+
+		for _, item := range result.Items {
+			ids = append(ids, item.ID)
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		token = result.PaginationToken
+	}
+
+	require.Equal(t, 3, pages)
+	require.Len(t, ids, 5)
+	require.Equal(t, "/planes/radius/local/resourceGroups/group/providers/Applications.Core/applications/app0", ids[0])
+	require.Equal(t, "/planes/radius/local/resourceGroups/group/providers/Applications.Core/applications/app4", ids[4])
+
+	_, err := client.Query(ctx, query, store.WithPaginationToken("invalid"))
+	require.ErrorIs(t, err, &store.ErrInvalid{})
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+type database struct {
+	db   *bbolt.DB
+	refs int
+}
+
+var (
+	databases   = map[string]*database{}
+	databasesMu sync.Mutex
+)
+
+// Open opens the bbolt database file at the given path, creating it if necessary.
+//
+// bbolt holds an exclusive lock on the database file, so the database is opened once per process and shared by
+// every client (store, queue and secret) that is configured with the same path. Every successful call to Open must be
+// paired with a call to Close.
+func Open(path string) (*bbolt.DB, error) {
+	if path == "" {
+		return nil, errors.New("failed to open bolt database: path is required")
+	}
+
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	databasesMu.Lock()
+	defer databasesMu.Unlock()
+
+	if d, ok := databases[path]; ok {
+		d.refs++
+		return d.db, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	databases[path] = &database{db: db, refs: 1}
+	return db, nil
+}
+
+// Close releases a reference to a database returned by Open. The database file is closed, and its lock released, when
+// the last reference is released. A database that was not opened by Open is closed immediately.
+func Close(db *bbolt.DB) error {
+	databasesMu.Lock()
+	defer databasesMu.Unlock()
+
+	d, ok := databases[db.Path()]
+	if !ok || d.db != db {
+		return db.Close()
+	}
+
+	d.refs--
+	if d.refs > 0 {
+		return nil
+	}
+
+	delete(databases, db.Path())
+	return db.Close()
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func Test_Open_Close(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "radius.db")
+
+	first, err := Open(path)
+	require.NoError(t, err)
+
+	// The database is shared by the clients configured with the same path.
+	second, err := Open(path)
+	require.NoError(t, err)
+	require.Same(t, first, second)
+
+	// The database stays open until the last reference is released.
+	require.NoError(t, Close(first))
+	require.NoError(t, second.View(func(tx *bbolt.Tx) error { return nil }))
+
+	require.NoError(t, Close(second))
+	require.ErrorIs(t, second.View(func(tx *bbolt.Tx) error { return nil }), bbolt.ErrDatabaseNotOpen)
+
+	// The file lock is released, so the database can be opened again.
+	reopened, err := Open(path)
+	require.NoError(t, err)
+	require.NotSame(t, first, reopened)
+	require.NoError(t, Close(reopened))
+}
+
+func Test_BoltClient_Close(t *testing.T) {
+	db, err := Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+
+	client, err := NewBoltClient(db)
+	require.NoError(t, err)
+
+	// Closing the client more than once releases its reference only once.
+	require.NoError(t, client.Close())
+	require.NoError(t, client.Close())
+	require.Empty(t, databases)
+}
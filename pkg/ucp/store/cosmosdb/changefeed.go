@@ -0,0 +1,280 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"github.com/vippsas/go-cosmosdb/cosmosapi"
+)
+
+const (
+	// changeFeedAIM is the A-IM header value used to read the change feed.
+	changeFeedAIM = "Incremental feed"
+
+	// changeFeedStartFromNow is the If-None-Match header value used to read changes made after the request.
+	changeFeedStartFromNow = "*"
+)
+
+var _ store.Watcher = (*CosmosDBStorageClient)(nil)
+
+// Watch reads the CosmosDB change feed of the collection at the configured polling interval and reports the changed
+// documents that match the query.
+//
+// The change feed only reports the latest version of created or updated documents, so the watch tracks the IDs of the
+// documents that match the query:
+//   - a changed document is reported as store.EventCreated when its ID is not known yet, and store.EventUpdated otherwise.
+//   - deleted documents are detected by querying the IDs of the matching documents after reading the change feed, and
+//     reporting the known IDs that are missing as store.EventDeleted. This costs a query per polling interval.
+//
+// The revision of each event encodes the change feed continuation of every partition key range. The known IDs are not
+// part of the revision, so the documents deleted before a watch is resumed are not reported.
+func (c *CosmosDBStorageClient) Watch(ctx context.Context, query store.Query, options ...store.WatchOptions) (<-chan store.StoreEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+
+	config := store.NewWatchConfig(options...)
+	interval := config.PollingInterval
+	if interval == 0 {
+		interval = store.DefaultPollingInterval
+	}
+
+	// continuations tracks the change feed position of each partition key range.
+	continuations := map[string]string{}
+	if config.Revision != "" {
+		b, err := base64.StdEncoding.DecodeString(config.Revision)
+		if err != nil {
+			return nil, &store.ErrInvalid{Message: "invalid argument. 'Revision' is invalid"}
+		}
+		if err := json.Unmarshal(b, &continuations); err != nil {
+			return nil, &store.ErrInvalid{Message: "invalid argument. 'Revision' is invalid"}
+		}
+	}
+
+	current, err := c.listDocuments(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	state := &watchState{known: current}
+
+	out := make(chan store.StoreEvent)
+	go func() {
+		defer close(out)
+		logger := ucplog.FromContextOrDiscard(ctx)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			events, err := c.poll(ctx, query, continuations, state)
+			if err != nil {
+				logger.Error(err, "failed to read the CosmosDB change feed")
+			}
+
+			for _, event := range events {
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// poll reads the change feed and the documents matching the query, and returns the changes since the previous poll.
+func (c *CosmosDBStorageClient) poll(ctx context.Context, query store.Query, continuations map[string]string, state *watchState) ([]store.StoreEvent, error) {
+	changed, err := c.readChangeFeed(ctx, query, continuations)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []store.StoreEvent{}
+	for _, obj := range changed {
+		events = append(events, state.changed(obj))
+	}
+
+	// The documents are listed after reading the change feed, so a document created in between is only present in the
+	// list and will be reported by the next read of the change feed.
+	current, err := c.listDocuments(ctx, query)
+	if err != nil {
+		return events, err
+	}
+	events = append(events, state.deleted(current)...)
+
+	revision, err := json.Marshal(continuations)
+	if err != nil {
+		return events, err
+	}
+	for i := range events {
+		events[i].Revision = base64.StdEncoding.EncodeToString(revision)
+	}
+
+	return events, nil
+}
+
+// readChangeFeed reads the changes of every partition key range since the last continuation and updates the continuations.
+// The continuations are only updated once all the changes of a partition key range are read.
+func (c *CosmosDBStorageClient) readChangeFeed(ctx context.Context, query store.Query, continuations map[string]string) ([]store.Object, error) {
+	ranges, err := c.client.GetPartitionKeyRanges(ctx, c.options.DatabaseName, c.options.CollectionName, &cosmosapi.GetPartitionKeyRangesOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	changed := []store.Object{}
+	for _, pkrange := range ranges.PartitionKeyRanges {
+		continuation, ok := continuations[pkrange.Id]
+		if !ok {
+			continuation = changeFeedStartFromNow
+		}
+
+		for {
+			entities := []ResourceEntity{}
+			resp, err := c.client.ListDocuments(ctx, c.options.DatabaseName, c.options.CollectionName, &cosmosapi.ListDocumentsOptions{
+				AIM:                 changeFeedAIM,
+				IfNoneMatch:         continuation,
+				PartitionKeyRangeId: pkrange.Id,
+			}, &entities)
+			if err != nil {
+				return changed, err
+			}
+
+			// Not modified responses don't have an ETag, in which case we keep the current continuation.
+			if resp.Etag != "" {
+				continuation = resp.Etag
+			}
+
+			for _, entity := range entities {
+				if !entityMatchesQuery(&entity, query) {
+					continue
+				}
+
+				obj := store.Object{
+					Metadata: store.Metadata{
+						ID:   entity.ResourceID,
+						ETag: entity.ETag,
+					},
+					Data: entity.Entity,
+				}
+				match, err := obj.MatchesFilters(query.Filters)
+				if err != nil || !match {
+					continue
+				}
+
+				changed = append(changed, obj)
+			}
+
+			if len(entities) == 0 {
+				break
+			}
+		}
+
+		continuations[pkrange.Id] = continuation
+	}
+
+	return changed, nil
+}
+
+// listDocuments returns the metadata of the documents that currently match the query, keyed by resource ID.
+func (c *CosmosDBStorageClient) listDocuments(ctx context.Context, query store.Query) (map[string]store.Metadata, error) {
+	current := map[string]store.Metadata{}
+	token := ""
+	for {
+		result, err := c.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			current[item.ID] = item.Metadata
+		}
+
+		if result.PaginationToken == "" {
+			return current, nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+// watchState tracks the documents that match the query of a watch, which the change feed can't provide.
+type watchState struct {
+	// known is the metadata of the matching documents, keyed by resource ID.
+	known map[string]store.Metadata
+}
+
+// changed records a document reported by the change feed and returns its event.
+func (s *watchState) changed(obj store.Object) store.StoreEvent {
+	eventType := store.EventUpdated
+	if _, ok := s.known[obj.ID]; !ok {
+		eventType = store.EventCreated
+	}
+
+	s.known[obj.ID] = obj.Metadata
+	return store.StoreEvent{Type: eventType, Object: obj}
+}
+
+// deleted compares the known documents with the documents that currently match the query and returns the events of
+// the documents that were deleted. The deleted documents are forgotten.
+func (s *watchState) deleted(current map[string]store.Metadata) []store.StoreEvent {
+	ids := []string{}
+	for id := range s.known {
+		if _, ok := current[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	events := []store.StoreEvent{}
+	for _, id := range ids {
+		events = append(events, store.StoreEvent{Type: store.EventDeleted, Object: store.Object{Metadata: s.known[id]}})
+		delete(s.known, id)
+	}
+
+	return events
+}
+
+func entityMatchesQuery(entity *ResourceEntity, query store.Query) bool {
+	id, err := resources.Parse(entity.ResourceID)
+	if err != nil {
+		return false
+	}
+
+	return storeutil.IDMatchesQuery(id, query)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdb
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WatchState(t *testing.T) {
+	existing := store.Metadata{ID: "existing", ETag: "1"}
+	state := &watchState{known: map[string]store.Metadata{"existing": existing}}
+
+	created := store.Object{Metadata: store.Metadata{ID: "created", ETag: "1"}}
+	require.Equal(t, store.StoreEvent{Type: store.EventCreated, Object: created}, state.changed(created))
+
+	updated := store.Object{Metadata: store.Metadata{ID: "created", ETag: "2"}}
+	require.Equal(t, store.StoreEvent{Type: store.EventUpdated, Object: updated}, state.changed(updated))
+
+	updatedExisting := store.Object{Metadata: store.Metadata{ID: "existing", ETag: "2"}}
+	require.Equal(t, store.EventUpdated, state.changed(updatedExisting).Type)
+
+	// Both documents still exist.
+	require.Empty(t, state.deleted(map[string]store.Metadata{"existing": updatedExisting.Metadata, "created": updated.Metadata}))
+
+	// The missing document is reported with its last known metadata, only once.
+	events := state.deleted(map[string]store.Metadata{"existing": updatedExisting.Metadata})
+	require.Equal(t, []store.StoreEvent{{Type: store.EventDeleted, Object: store.Object{Metadata: updated.Metadata}}}, events)
+	require.Empty(t, state.deleted(map[string]store.Metadata{"existing": updatedExisting.Metadata}))
+
+	// A deleted document that is created again is reported as created.
+	require.Equal(t, store.EventCreated, state.changed(created).Type)
+}
+
+func Test_CosmosDB_Watch(t *testing.T) {
+	client := mustGetTestClient(t)
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	rootScope := fmt.Sprintf("/planes/radius/local/resourcegroups/%s", uuid.New().String())
+	events, err := client.Watch(ctx, store.Query{RootScope: rootScope}, store.WithPollingInterval(100*time.Millisecond))
+	require.NoError(t, err)
+
+	model := getTestEnvironmentModel(rootScope, "watched")
+	obj := &store.Object{Metadata: store.Metadata{ID: model.ID}, Data: model}
+	require.NoError(t, client.Save(ctx, obj))
+
+	created := nextWatchEvent(t, events)
+	require.Equal(t, store.EventCreated, created.Type)
+	require.Equal(t, strings.ToLower(model.ID), created.Object.ID)
+	require.NotEmpty(t, created.Revision)
+
+	obj.ETag = ""
+	require.NoError(t, client.Save(ctx, obj))
+	require.Equal(t, store.EventUpdated, nextWatchEvent(t, events).Type)
+
+	require.NoError(t, client.Delete(ctx, model.ID))
+	deleted := nextWatchEvent(t, events)
+	require.Equal(t, store.EventDeleted, deleted.Type)
+	require.Equal(t, strings.ToLower(model.ID), deleted.Object.ID)
+}
+
+func nextWatchEvent(t *testing.T, events <-chan store.StoreEvent) store.StoreEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "watch channel was closed")
+		return event
+	case <-time.After(30 * time.Second):
+		require.Fail(t, "timed out waiting for watch event")
+		return store.StoreEvent{}
+	}
+}
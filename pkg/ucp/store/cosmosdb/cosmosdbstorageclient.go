@@ -19,6 +19,7 @@ package cosmosdb
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -41,6 +42,9 @@ const (
 	errEtagPreconditionMsgPrefix = "The operation specified an eTag"
 )
 
+// identifierPattern matches the field names that can be written in a query with the dot notation.
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 var _ store.StorageClient = (*CosmosDBStorageClient)(nil)
 
 // ResourceEntity represents the default envelope model to store resource metadata.
@@ -93,8 +97,8 @@ func NewCosmosDBStorageClient(options *ConnectionOptions) (*CosmosDBStorageClien
 	}, nil
 }
 
-// Init checks if the database and collection exist, and if not, creates them. It returns an error if
-// either of the checks or creations fail.
+// Init checks if the database, collection and stored procedures exist, and if not, creates them. It returns an error if
+// any of the checks or creations fail.
 func (c *CosmosDBStorageClient) Init(ctx context.Context) error {
 	if err := c.createDatabaseIfNotExists(ctx); err != nil {
 		return err
@@ -102,6 +106,9 @@ func (c *CosmosDBStorageClient) Init(ctx context.Context) error {
 	if err := c.createCollectionIfNotExists(ctx); err != nil {
 		return err
 	}
+	if err := c.createStoredProceduresIfNotExists(ctx); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -218,7 +225,9 @@ func constructCosmosDBQuery(query store.Query) (*cosmosapi.Query, error) {
 			whereParam += " and "
 		}
 		filterParam := fmt.Sprintf("filter%d", i)
-		whereParam += fmt.Sprintf("STRINGEQUALS(c.entity.%s, @%s, true)", filter.Field, filterParam)
+		field, fieldParams := filterFieldPath(filter.Field, filterParam)
+		whereParam += fmt.Sprintf("STRINGEQUALS(c.entity%s, @%s, true)", field, filterParam)
+		queryParams = append(queryParams, fieldParams...)
 		queryParams = append(queryParams, cosmosapi.QueryParam{
 			Name:  "@" + filterParam,
 			Value: filter.Value,
@@ -232,6 +241,25 @@ func constructCosmosDBQuery(query store.Query) (*cosmosapi.Query, error) {
 	return &cosmosapi.Query{Query: queryString + whereParam, Params: queryParams}, nil
 }
 
+// filterFieldPath returns the property path of the '.' separated filter field in a query. Field names that are not
+// identifiers, such as user-provided tag keys, are passed as query parameters so that they can't alter the query.
+func filterFieldPath(field string, filterParam string) (string, []cosmosapi.QueryParam) {
+	path := ""
+	params := []cosmosapi.QueryParam{}
+	for i, name := range strings.Split(field, ".") {
+		if identifierPattern.MatchString(name) {
+			path += "." + name
+			continue
+		}
+
+		param := fmt.Sprintf("@%sfield%d", filterParam, i)
+		path += "[" + param + "]"
+		params = append(params, cosmosapi.QueryParam{Name: param, Value: name})
+	}
+
+	return path, params
+}
+
 // Query builds and executes a CosmosDB query based on the provided store.Query and returns the results.
 func (c *CosmosDBStorageClient) Query(ctx context.Context, query store.Query, opts ...store.QueryOptions) (*store.ObjectQueryResult, error) {
 	if ctx == nil {
@@ -378,7 +406,7 @@ func (c *CosmosDBStorageClient) Delete(ctx context.Context, id string, opts ...s
 }
 
 // Save saves an object to the CosmosDB storage, returning an error if one occurs. If an ETag is provided, an error is
-// returned if the ETag does not match the existing ETag.
+// returned if the ETag does not match the existing ETag. A create-only save returns an error if the object exists.
 func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
 	if ctx == nil {
 		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
@@ -418,7 +446,15 @@ func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opt
 	}
 
 	var resp *cosmosapi.Resource
-	if ifMatch == "" {
+	if cfg.CreateOnly {
+		op := cosmosapi.CreateDocumentOptions{
+			PartitionKeyValue: partitionKey,
+		}
+		resp, _, err = c.client.CreateDocument(ctx, c.options.DatabaseName, c.options.CollectionName, entity, op)
+		if err != nil && strings.EqualFold(err.Error(), errIDConflictMsg) {
+			return &store.ErrConcurrency{}
+		}
+	} else if ifMatch == "" {
 		op := cosmosapi.CreateDocumentOptions{
 			PartitionKeyValue: partitionKey,
 			IsUpsert:          true,
@@ -226,6 +226,44 @@ func TestConstructCosmosDBQuery(t *testing.T) {
 			}},
 			err: nil,
 		},
+		{
+			desc: "filter-field-not-identifier",
+			storeQuery: store.Query{
+				RootScope:    "/planes/radius/local/resourcegroups/testgroup",
+				ResourceType: "system.resources/resources",
+				Filters: []store.QueryFilter{
+					{
+						Field: "properties.tags.cost-center",
+						Value: "payments",
+					},
+					{
+						Field: "properties.tags.x, @rootScope, true) or (true",
+						Value: "injected",
+					},
+				},
+			},
+			queryString: "SELECT * FROM c WHERE c.rootScope = @rootScope and STRINGEQUALS(c.entity.type, @rtype, true) and STRINGEQUALS(c.entity.properties.tags[@filter0field2], @filter0, true) and STRINGEQUALS(c.entity.properties.tags[@filter1field2], @filter1, true)",
+			params: []cosmosapi.QueryParam{{
+				Name:  "@rootScope",
+				Value: "/planes/radius/local/resourcegroups/testgroup",
+			}, {
+				Name:  "@rtype",
+				Value: "system.resources/resources",
+			}, {
+				Name:  "@filter0field2",
+				Value: "cost-center",
+			}, {
+				Name:  "@filter0",
+				Value: "payments",
+			}, {
+				Name:  "@filter1field2",
+				Value: "x, @rootScope, true) or (true",
+			}, {
+				Name:  "@filter1",
+				Value: "injected",
+			}},
+			err: nil,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
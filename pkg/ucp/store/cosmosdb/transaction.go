@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/vippsas/go-cosmosdb/cosmosapi"
+)
+
+const (
+	// commitSprocName is the name of the stored procedure used to commit transactions.
+	commitSprocName = "radiusCommitTransaction"
+
+	// errSprocConcurrencyMsg and errSprocNotFoundMsg are the error markers thrown by the commit stored procedure.
+	errSprocConcurrencyMsg = "radius-concurrency"
+	errSprocNotFoundMsg    = "radius-notfound"
+)
+
+// commitSprocBody is the body of the commit stored procedure. CosmosDB runs stored procedures as a transaction within
+// a single partition: throwing an error rolls back all of the writes made by the stored procedure.
+const commitSprocBody = `function commit(operations) {
+    var collection = getContext().getCollection();
+    var link = collection.getSelfLink();
+    var etags = [];
+
+    step(0);
+
+    function step(i) {
+        if (i >= operations.length) {
+            getContext().getResponse().setBody(etags);
+            return;
+        }
+
+        var op = operations[i];
+        var query = { query: "SELECT * FROM c WHERE c.id = @id", parameters: [{ name: "@id", value: op.id }] };
+        var accepted = collection.queryDocuments(link, query, {}, function (err, docs) {
+            if (err) throw err;
+
+            var existing = docs.length > 0 ? docs[0] : null;
+            if (op.etag && (!existing || existing._etag !== op.etag)) {
+                throw new Error("` + errSprocConcurrencyMsg + `:" + op.id);
+            }
+
+            if (op.kind === "Delete") {
+                if (!existing) {
+                    throw new Error("` + errSprocNotFoundMsg + `:" + op.id);
+                }
+                if (!collection.deleteDocument(existing._self, {}, function (err) {
+                    if (err) throw err;
+                    etags.push("");
+                    step(i + 1);
+                })) throw new Error("delete was not accepted");
+                return;
+            }
+
+            if (!collection.upsertDocument(link, op.entity, {}, function (err, doc) {
+                if (err) throw err;
+                etags.push(doc._etag);
+                step(i + 1);
+            })) throw new Error("upsert was not accepted");
+        });
+
+        if (!accepted) throw new Error("query was not accepted");
+    }
+}`
+
+var _ store.Transactor = (*CosmosDBStorageClient)(nil)
+var _ store.CollectionScoped = (*CosmosDBStorageClient)(nil)
+
+// Collection returns the name of the CosmosDB collection used by the client.
+func (c *CosmosDBStorageClient) Collection() string {
+	return c.options.CollectionName
+}
+
+// transactionOperation is the representation of a store.Operation passed to the commit stored procedure.
+type transactionOperation struct {
+	Kind   store.OperationKind `json:"kind"`
+	ID     string              `json:"id"`
+	ETag   string              `json:"etag,omitempty"`
+	Entity *ResourceEntity     `json:"entity,omitempty"`
+}
+
+// Commit applies the writes of the transaction atomically using a stored procedure when all of the objects share the
+// same partition key. Objects in different partitions can't be written in a single CosmosDB transaction, so in that case
+// the writes are applied on a best-effort basis using store.CommitSequential.
+//
+// Like the other CosmosDBStorageClient methods, Commit only writes to the collection the client was created for.
+func (c *CosmosDBStorageClient) Commit(ctx context.Context, tx *store.Transaction) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := tx.Validate(); err != nil {
+		return err
+	}
+
+	partitionKey := ""
+	operations := make([]transactionOperation, len(tx.Operations))
+	for i, op := range tx.Operations {
+		parsed, err := resources.Parse(op.ID)
+		if err != nil {
+			return &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+		}
+
+		pk, err := GetPartitionKey(parsed)
+		if err != nil {
+			return err
+		}
+		if i > 0 && pk != partitionKey {
+			return store.CommitSequential(ctx, c, tx)
+		}
+		partitionKey = pk
+
+		docID, err := GenerateCosmosDBKey(parsed)
+		if err != nil {
+			return err
+		}
+
+		operations[i] = transactionOperation{Kind: op.Kind, ID: docID, ETag: op.ETag}
+		if op.Kind == store.OperationSave {
+			operations[i].Entity = &ResourceEntity{
+				ID:           docID,
+				ResourceID:   strings.ToLower(parsed.String()),
+				RootScope:    strings.ToLower(parsed.RootScope()),
+				PartitionKey: partitionKey,
+				Entity:       op.Object.Data,
+			}
+		}
+	}
+
+	etags := []string{}
+	err := c.client.ExecuteStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, commitSprocName,
+		cosmosapi.ExecuteStoredProcedureOptions{PartitionKeyValue: partitionKey}, &etags, operations)
+	if err != nil {
+		return transactionError(tx, operations, err)
+	}
+
+	for i, op := range tx.Operations {
+		if op.Kind == store.OperationSave && i < len(etags) {
+			op.Object.ETag = etags[i]
+		}
+	}
+
+	return nil
+}
+
+// transactionError converts the error markers thrown by the commit stored procedure to store errors.
+func transactionError(tx *store.Transaction, operations []transactionOperation, err error) error {
+	msg := err.Error()
+	if strings.Contains(msg, errSprocConcurrencyMsg) {
+		return &store.ErrConcurrency{}
+	}
+
+	if strings.Contains(msg, errSprocNotFoundMsg) {
+		for i, op := range operations {
+			if strings.Contains(msg, errSprocNotFoundMsg+":"+op.ID) {
+				return &store.ErrNotFound{ID: tx.Operations[i].ID}
+			}
+		}
+		return &store.ErrNotFound{}
+	}
+
+	return err
+}
+
+func (c *CosmosDBStorageClient) createStoredProceduresIfNotExists(ctx context.Context) error {
+	_, err := c.client.GetStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, commitSprocName)
+	if err == nil {
+		return nil
+	}
+	if !strings.EqualFold(err.Error(), errResourceNotFoundMsg) {
+		return err
+	}
+
+	_, err = c.client.CreateStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, commitSprocName, commitSprocBody)
+	if err != nil && strings.EqualFold(err.Error(), errIDConflictMsg) {
+		return nil
+	}
+
+	return err
+}
@@ -54,6 +54,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"github.com/radius-project/radius/pkg/ucp/util/etag"
 	etcdclient "go.etcd.io/etcd/client/v3"
 )
@@ -68,6 +69,8 @@ func NewETCDClient(c *etcdclient.Client) *ETCDClient {
 }
 
 var _ store.StorageClient = (*ETCDClient)(nil)
+var _ store.Watcher = (*ETCDClient)(nil)
+var _ store.Transactor = (*ETCDClient)(nil)
 
 type ETCDClient struct {
 	client *etcdclient.Client
@@ -220,7 +223,8 @@ func (c *ETCDClient) Delete(ctx context.Context, id string, options ...store.Del
 }
 
 // Save checks the context and object parameters, parses the object ID, marshals the object into JSON, saves the object to
-// the store, and sets the object's ETag. If an ETag is provided, a transaction is executed to ensure concurrency.
+// the store, and sets the object's ETag. If an ETag is provided or the write is create-only, a transaction is executed to
+// ensure concurrency.
 func (c *ETCDClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
 	if ctx == nil {
 		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
@@ -243,16 +247,23 @@ func (c *ETCDClient) Save(ctx context.Context, obj *store.Object, options ...sto
 	key := keyFromID(parsed)
 	config := store.NewSaveConfig(options...)
 
-	// If we have an ETag then we do to execute a transaction.
-	if config.ETag != "" {
-		revision, err := etag.ParseRevision(config.ETag)
-		if err != nil {
-			// Treat an invalid ETag as a concurrency failure, since it will never match.
-			return &store.ErrConcurrency{}
+	// If we have an ETag or must not overwrite the object then we do to execute a transaction.
+	if config.ETag != "" || config.CreateOnly {
+		compares := []etcdclient.Cmp{}
+		if config.ETag != "" {
+			revision, err := etag.ParseRevision(config.ETag)
+			if err != nil {
+				// Treat an invalid ETag as a concurrency failure, since it will never match.
+				return &store.ErrConcurrency{}
+			}
+			compares = append(compares, etcdclient.Compare(etcdclient.ModRevision(key), "=", revision))
+		}
+		if config.CreateOnly {
+			compares = append(compares, etcdclient.Compare(etcdclient.CreateRevision(key), "=", 0))
 		}
 
 		txn, err := c.client.Txn(ctx).
-			If(etcdclient.Compare(etcdclient.ModRevision(key), "=", revision)).
+			If(compares...).
 			Then(etcdclient.OpPut(key, string(b))).
 			Commit()
 		if err != nil {
@@ -279,6 +290,188 @@ func (c *ETCDClient) Save(ctx context.Context, obj *store.Object, options ...sto
 	return nil
 }
 
+// Commit applies the writes of the transaction using a single etcd transaction. Each write with an ETag compares the
+// revision of its key, and each delete without an ETag requires its key to exist.
+func (c *ETCDClient) Commit(ctx context.Context, tx *store.Transaction) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := tx.Validate(); err != nil {
+		return err
+	}
+
+	keys := make([]string, len(tx.Operations))
+	compares := []etcdclient.Cmp{}
+	ops := []etcdclient.Op{}
+	for i, op := range tx.Operations {
+		parsed, err := resources.Parse(op.ID)
+		if err != nil {
+			return &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+		}
+		keys[i] = keyFromID(parsed)
+
+		if op.ETag != "" {
+			revision, err := etag.ParseRevision(op.ETag)
+			if err != nil {
+				// Treat an invalid ETag as a concurrency failure, since it will never match.
+				return &store.ErrConcurrency{}
+			}
+			compares = append(compares, etcdclient.Compare(etcdclient.ModRevision(keys[i]), "=", revision))
+		} else if op.Kind == store.OperationDelete {
+			compares = append(compares, etcdclient.Compare(etcdclient.CreateRevision(keys[i]), ">", 0))
+		}
+
+		switch op.Kind {
+		case store.OperationSave:
+			b, err := json.Marshal(op.Object)
+			if err != nil {
+				return err
+			}
+			ops = append(ops, etcdclient.OpPut(keys[i], string(b)))
+		case store.OperationDelete:
+			ops = append(ops, etcdclient.OpDelete(keys[i]))
+		default:
+			return &store.ErrInvalid{Message: "invalid argument. unsupported operation kind " + string(op.Kind)}
+		}
+	}
+
+	txn, err := c.client.Txn(ctx).If(compares...).Then(ops...).Commit()
+	if err != nil {
+		return err
+	}
+
+	if !txn.Succeeded {
+		return c.transactionFailure(ctx, tx, keys)
+	}
+
+	for _, op := range tx.Operations {
+		if op.Kind == store.OperationSave {
+			op.Object.ETag = etag.NewFromRevision(txn.Header.Revision)
+		}
+	}
+
+	return nil
+}
+
+// transactionFailure determines the error to report for a transaction whose comparisons failed.
+func (c *ETCDClient) transactionFailure(ctx context.Context, tx *store.Transaction, keys []string) error {
+	for i, op := range tx.Operations {
+		if op.Kind != store.OperationDelete || op.ETag != "" {
+			continue
+		}
+
+		response, err := c.client.Get(ctx, keys[i], etcdclient.WithCountOnly())
+		if err != nil {
+			return err
+		} else if response.Count == 0 {
+			return &store.ErrNotFound{ID: op.ID}
+		}
+	}
+
+	return &store.ErrConcurrency{}
+}
+
+// Watch watches the keys matching the query using an etcd watch and converts the etcd events into store events. The
+// revision of each event is the etcd revision of the change, so a watch can be resumed from any event it has reported.
+func (c *ETCDClient) Watch(ctx context.Context, query store.Query, options ...store.WatchOptions) (<-chan store.StoreEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+
+	config := store.NewWatchConfig(options...)
+
+	opts := []etcdclient.OpOption{etcdclient.WithPrefix(), etcdclient.WithPrevKV()}
+	if config.Revision != "" {
+		revision, err := etag.ParseRevision(config.Revision)
+		if err != nil {
+			return nil, &store.ErrInvalid{Message: "invalid argument. 'Revision' is invalid"}
+		}
+
+		// Resume after the revision we've already seen.
+		opts = append(opts, etcdclient.WithRev(revision+1))
+	}
+
+	watch := c.client.Watch(ctx, keyFromQuery(query), opts...)
+
+	out := make(chan store.StoreEvent)
+	go func() {
+		defer close(out)
+		logger := ucplog.FromContextOrDiscard(ctx)
+
+		for response := range watch {
+			if err := response.Err(); err != nil {
+				logger.Error(err, "etcd watch failed")
+				return
+			}
+
+			for _, event := range response.Events {
+				converted, err := convertEvent(event, query)
+				if err != nil {
+					logger.Error(err, "failed to read etcd watch event", "key", string(event.Kv.Key))
+					continue
+				} else if converted == nil {
+					continue
+				}
+
+				select {
+				case out <- *converted:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// convertEvent converts an etcd event to a store event. Returns nil if the event does not match the query.
+func convertEvent(event *etcdclient.Event, query store.Query) (*store.StoreEvent, error) {
+	if !keyMatchesQuery(event.Kv.Key, query) {
+		return nil, nil
+	}
+
+	kv := event.Kv
+	eventType := store.EventUpdated
+	if event.Type == etcdclient.EventTypeDelete {
+		// A delete event has no value, so we report the previous value of the key.
+		if event.PrevKv == nil {
+			return nil, nil
+		}
+		kv = event.PrevKv
+		eventType = store.EventDeleted
+	} else if event.IsCreate() {
+		eventType = store.EventCreated
+	}
+
+	value := store.Object{}
+	err := json.Unmarshal(kv.Value, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := value.MatchesFilters(query.Filters)
+	if err != nil {
+		return nil, err
+	} else if !match {
+		return nil, nil
+	}
+
+	value.ETag = etag.NewFromRevision(kv.ModRevision)
+
+	return &store.StoreEvent{
+		Type:     eventType,
+		Object:   value,
+		Revision: etag.NewFromRevision(event.Kv.ModRevision),
+	}, nil
+}
+
 // Client returns the etcdclient.Client instance stored in the ETCDClient struct.
 func (c *ETCDClient) Client() *etcdclient.Client {
 	return c.client
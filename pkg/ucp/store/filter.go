@@ -47,7 +47,17 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 		value := reflect.ValueOf(data)
 		fields := strings.Split(filter.Field, ".")
 		for i, field := range fields {
+			if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+				// The field is nested under a value that is not an object, it can't match.
+				return false, nil
+			}
+
 			value = value.MapIndex(reflect.ValueOf(field))
+			if !value.IsValid() {
+				// The field does not exist, it can't match.
+				return false, nil
+			}
+
 			if i < len(fields)-1 {
 				// Need to go further into the nested fields
 				value = reflect.ValueOf(value.Interface())
@@ -60,7 +70,7 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 			value = reflect.ValueOf(value.Interface())
 		}
 
-		if value.Type().Kind() != reflect.String {
+		if !value.IsValid() || value.Type().Kind() != reflect.String {
 			// not a string, can't compare!
 			return false, nil
 		}
@@ -113,6 +113,30 @@ func Test_MatchesFilters(t *testing.T) {
 			Filters:       []QueryFilter{{Field: "properties.value", Value: "warm"}},
 			ExpectedMatch: false,
 		},
+		{
+			Description:   "missing_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "another", Value: "cool"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "nested_missing_not_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{}}},
+			Filters:       []QueryFilter{{Field: "properties.tags.team", Value: "payments"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "nested_not_object_not_match",
+			Obj:           &Object{Data: map[string]any{"properties": "freezing"}},
+			Filters:       []QueryFilter{{Field: "properties.value", Value: "freezing"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "nested_nil_not_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"value": nil}}},
+			Filters:       []QueryFilter{{Field: "properties.value", Value: "freezing"}},
+			ExpectedMatch: false,
+		},
 	}
 
 	for _, testcase := range cases {
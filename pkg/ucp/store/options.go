@@ -16,6 +16,8 @@ limitations under the License.
 
 package store
 
+import "time"
+
 type (
 	// QueryOptions applies an option to Query().
 	QueryOptions interface {
@@ -55,6 +57,16 @@ type (
 		private()
 	}
 
+	// WatchOptions applies an option to Watch().
+	WatchOptions interface {
+		ApplyWatchOption(StoreConfig) StoreConfig
+
+		// A private method to prevent users implementing the
+		// interface and so future additions to it will not
+		// violate compatibility.
+		private()
+	}
+
 	// MutatingOptions applies an option to Delete() or Save().
 	MutatingOptions interface {
 		SaveOptions
@@ -72,6 +84,15 @@ type StoreConfig struct {
 
 	// ETag represents the entity tag for optimistic consistency control.
 	ETag ETag
+
+	// CreateOnly represents a save which fails if the object already exists.
+	CreateOnly bool
+
+	// Revision represents the revision after which a watch is resumed.
+	Revision string
+
+	// PollingInterval represents the interval between queries for stores that do not support watch natively.
+	PollingInterval time.Duration
 }
 
 // Query Options
@@ -140,6 +161,17 @@ func (s *saveOptions) ApplySaveOption(cfg StoreConfig) StoreConfig {
 
 func (s saveOptions) private() {}
 
+// WithCreateOnly makes Save() fail with ErrConcurrency if the object already exists, so that concurrent writers can't
+// overwrite an object created by another writer.
+func WithCreateOnly() SaveOptions {
+	return &saveOptions{
+		fn: func(cfg StoreConfig) StoreConfig {
+			cfg.CreateOnly = true
+			return cfg
+		},
+	}
+}
+
 // WithETag sets the ETag field in the StoreConfig struct.
 func WithETag(etag ETag) MutatingOptions {
 	return &mutatingOptions{
@@ -150,6 +182,40 @@ func WithETag(etag ETag) MutatingOptions {
 	}
 }
 
+// WatchOptions
+type watchOptions struct {
+	fn func(StoreConfig) StoreConfig
+}
+
+var _ WatchOptions = (*watchOptions)(nil)
+
+// ApplyWatchOption applies a watch option to a StoreConfig.
+func (w *watchOptions) ApplyWatchOption(cfg StoreConfig) StoreConfig {
+	return w.fn(cfg)
+}
+
+func (w watchOptions) private() {}
+
+// WithRevision sets the revision after which Watch() resumes. The revision is the value of StoreEvent.Revision.
+func WithRevision(revision string) WatchOptions {
+	return &watchOptions{
+		fn: func(cfg StoreConfig) StoreConfig {
+			cfg.Revision = revision
+			return cfg
+		},
+	}
+}
+
+// WithPollingInterval sets the polling interval for Watch() on stores that do not support watch natively.
+func WithPollingInterval(interval time.Duration) WatchOptions {
+	return &watchOptions{
+		fn: func(cfg StoreConfig) StoreConfig {
+			cfg.PollingInterval = interval
+			return cfg
+		},
+	}
+}
+
 // NewQueryConfig applies a set of QueryOptions to a StoreConfig and returns the modified StoreConfig for Query().
 func NewQueryConfig(opts ...QueryOptions) StoreConfig {
 	cfg := StoreConfig{}
@@ -176,3 +242,12 @@ func NewSaveConfig(opts ...SaveOptions) StoreConfig {
 	}
 	return cfg
 }
+
+// NewWatchConfig applies a set of WatchOptions to a StoreConfig and returns the modified StoreConfig for Watch().
+func NewWatchConfig(opts ...WatchOptions) StoreConfig {
+	cfg := StoreConfig{}
+	for _, opt := range opts {
+		cfg = opt.ApplyWatchOption(cfg)
+	}
+	return cfg
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// OperationKind represents the kind of a write in a transaction.
+type OperationKind string
+
+const (
+	// OperationSave saves an object.
+	OperationSave OperationKind = "Save"
+
+	// OperationDelete deletes an object.
+	OperationDelete OperationKind = "Delete"
+)
+
+// Operation is a single write in a Transaction.
+type Operation struct {
+	// Kind is the kind of the write.
+	Kind OperationKind
+
+	// ID is the resource id of the object being written.
+	ID string
+
+	// Object is the object to save. Object is nil for OperationDelete.
+	Object *Object
+
+	// ETag is the ETag the stored object must match for the transaction to commit. An empty ETag always matches.
+	ETag ETag
+}
+
+// Transaction is a set of writes to be committed atomically. All writes must refer to different objects.
+//
+// Each write can use an ETag for optimistic concurrency: if any of the ETags does not match the stored object
+// then no writes are applied and the commit returns ErrConcurrency.
+type Transaction struct {
+	Operations []Operation
+}
+
+// Save adds a save of the object to the transaction. The ETag of the object is updated when the transaction commits.
+func (t *Transaction) Save(obj *Object, options ...SaveOptions) {
+	config := NewSaveConfig(options...)
+	t.Operations = append(t.Operations, Operation{Kind: OperationSave, ID: obj.ID, Object: obj, ETag: config.ETag})
+}
+
+// Delete adds a delete of the object with the given id to the transaction.
+func (t *Transaction) Delete(id string, options ...DeleteOptions) {
+	config := NewDeleteConfig(options...)
+	t.Operations = append(t.Operations, Operation{Kind: OperationDelete, ID: id, ETag: config.ETag})
+}
+
+// Validate checks that the transaction is non-empty and that each object is written at most once.
+func (t *Transaction) Validate() error {
+	if t == nil || len(t.Operations) == 0 {
+		return &ErrInvalid{Message: "invalid argument. 'transaction' must contain at least one operation"}
+	}
+
+	seen := map[string]bool{}
+	for _, op := range t.Operations {
+		if op.Kind == OperationSave && op.Object == nil {
+			return &ErrInvalid{Message: "invalid argument. 'obj' is required"}
+		}
+
+		id := strings.ToLower(op.ID)
+		if seen[id] {
+			return &ErrInvalid{Message: "invalid argument. 'transaction' must not write the same object more than once"}
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// Transactor is an optional capability of a StorageClient that commits multiple writes atomically.
+//
+// A transaction can write objects of any resource type unless the client implements CollectionScoped.
+type Transactor interface {
+	// Commit applies all of the writes in the transaction or none of them.
+	Commit(ctx context.Context, tx *Transaction) error
+}
+
+// CollectionScoped is an optional capability of a StorageClient that stores each resource type in a separate collection.
+// Clients that implement CollectionScoped can only read and write the objects of their own collection, including in a
+// transaction.
+type CollectionScoped interface {
+	// Collection returns the name of the collection used by the client.
+	Collection() string
+}
+
+// Commit commits the transaction. The client's native transaction is used when the client implements Transactor.
+//
+// Otherwise the writes are applied one at a time on a best-effort basis: all ETags are checked before the first write
+// and if a write fails the writes that were already applied are rolled back. The rollback can itself fail or race with
+// a concurrent writer, so callers must still tolerate partially applied transactions on these stores.
+func Commit(ctx context.Context, client StorageClient, tx *Transaction) error {
+	if err := tx.Validate(); err != nil {
+		return err
+	}
+
+	if transactor, ok := client.(Transactor); ok {
+		return transactor.Commit(ctx, tx)
+	}
+
+	return CommitSequential(ctx, client, tx)
+}
+
+// CommitSequential applies the writes of the transaction one at a time using the Get, Save and Delete methods of the
+// client. Stores without native transactions can use CommitSequential to implement Transactor on a best-effort basis.
+func CommitSequential(ctx context.Context, client StorageClient, tx *Transaction) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Read the current state of each object so that we can check the ETags up-front and roll back later.
+	previous := make([]*Object, len(tx.Operations))
+	for i, op := range tx.Operations {
+		obj, err := client.Get(ctx, op.ID)
+		if errors.Is(err, &ErrNotFound{}) {
+			if op.ETag != "" {
+				return &ErrConcurrency{}
+			} else if op.Kind == OperationDelete {
+				return &ErrNotFound{ID: op.ID}
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if op.ETag != "" && op.ETag != obj.ETag {
+			return &ErrConcurrency{}
+		}
+		previous[i] = obj
+	}
+
+	for i, op := range tx.Operations {
+		var err error
+		switch op.Kind {
+		case OperationSave:
+			err = client.Save(ctx, op.Object, saveETagOption(previous[i])...)
+		case OperationDelete:
+			err = client.Delete(ctx, op.ID, WithETag(previous[i].ETag))
+		default:
+			err = &ErrInvalid{Message: "invalid argument. unsupported operation kind " + string(op.Kind)}
+		}
+
+		if err != nil {
+			rollback(ctx, client, tx.Operations[:i], previous[:i])
+			return err
+		}
+	}
+
+	logger.V(ucplog.LevelDebug).Info("committed transaction without native transaction support", "count", len(tx.Operations))
+	return nil
+}
+
+// rollback restores the objects written by the given operations to their previous state.
+func rollback(ctx context.Context, client StorageClient, operations []Operation, previous []*Object) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for i := len(operations) - 1; i >= 0; i-- {
+		var err error
+		if previous[i] == nil {
+			err = client.Delete(ctx, operations[i].ID)
+		} else {
+			restored := *previous[i]
+			err = client.Save(ctx, &restored)
+		}
+
+		if err != nil {
+			logger.Error(err, "failed to roll back transaction", "id", operations[i].ID)
+		}
+	}
+}
+
+// saveETagOption returns the options that ensure the object has not changed since it was read.
+func saveETagOption(previous *Object) []SaveOptions {
+	if previous == nil {
+		return nil
+	}
+
+	return []SaveOptions{WithETag(previous.ETag)}
+}
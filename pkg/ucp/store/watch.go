@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultPollingInterval is the default interval used by the polling watcher.
+	DefaultPollingInterval = 5 * time.Second
+)
+
+// EventType represents the type of change reported by a watch.
+type EventType string
+
+const (
+	// EventCreated is reported when an object is created.
+	EventCreated EventType = "Created"
+
+	// EventUpdated is reported when an existing object is updated.
+	EventUpdated EventType = "Updated"
+
+	// EventDeleted is reported when an object is deleted.
+	EventDeleted EventType = "Deleted"
+)
+
+// StoreEvent represents a change to an object in the store.
+type StoreEvent struct {
+	// Type is the type of the change.
+	Type EventType
+
+	// Object is the object after the change. For EventDeleted this is the last known state of the object.
+	Object Object
+
+	// Revision is an opaque token for the position of the event in the change stream. Passing it to WithRevision
+	// resumes a watch after this event. Revision is empty if the store does not support resuming a watch.
+	Revision string
+}
+
+// Watcher is an optional capability of a StorageClient that reports changes to the objects matching a query.
+//
+// Use Watch to watch any StorageClient, it will fall back to polling for stores that do not implement Watcher.
+type Watcher interface {
+	// Watch returns a channel of events for the objects matching the query. The channel is closed when the context
+	// is cancelled or the watch can no longer continue.
+	Watch(ctx context.Context, query Query, options ...WatchOptions) (<-chan StoreEvent, error)
+}
+
+// Watch watches the objects matching the query. The client's native watch is used when the client implements Watcher,
+// otherwise the store is polled.
+func Watch(ctx context.Context, client StorageClient, query Query, options ...WatchOptions) (<-chan StoreEvent, error) {
+	if watcher, ok := client.(Watcher); ok {
+		return watcher.Watch(ctx, query, options...)
+	}
+
+	return NewPollingWatcher(client).Watch(ctx, query, options...)
+}
+
+var _ Watcher = (*PollingWatcher)(nil)
+
+// PollingWatcher implements Watcher for stores without a native change feed by periodically querying the store and
+// comparing the results by ETag. PollingWatcher does not support resuming a watch from a revision.
+type PollingWatcher struct {
+	client StorageClient
+}
+
+// NewPollingWatcher creates a new PollingWatcher for the given client.
+func NewPollingWatcher(client StorageClient) *PollingWatcher {
+	return &PollingWatcher{client: client}
+}
+
+// Watch queries the store to take an initial snapshot and then polls the store at the configured interval, reporting the
+// differences between successive snapshots as events. Objects present in the initial snapshot are not reported.
+func (w *PollingWatcher) Watch(ctx context.Context, query Query, options ...WatchOptions) (<-chan StoreEvent, error) {
+	if ctx == nil {
+		return nil, &ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	config := NewWatchConfig(options...)
+	interval := config.PollingInterval
+	if interval == 0 {
+		interval = DefaultPollingInterval
+	}
+
+	// Take the initial snapshot synchronously so that invalid queries are reported to the caller.
+	snapshot, err := w.snapshot(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan StoreEvent)
+	go func() {
+		defer close(out)
+		logger := ucplog.FromContextOrDiscard(ctx)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			current, err := w.snapshot(ctx, query)
+			if err != nil {
+				logger.Error(err, "failed to poll the store for changes")
+				continue
+			}
+
+			for _, event := range diffSnapshots(snapshot, current) {
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			snapshot = current
+		}
+	}()
+
+	return out, nil
+}
+
+func (w *PollingWatcher) snapshot(ctx context.Context, query Query) (map[string]Object, error) {
+	snapshot := map[string]Object{}
+
+	token := ""
+	for {
+		result, err := w.client.Query(ctx, query, WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			snapshot[item.ID] = item
+		}
+
+		if result.PaginationToken == "" {
+			return snapshot, nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+func diffSnapshots(previous map[string]Object, current map[string]Object) []StoreEvent {
+	events := []StoreEvent{}
+	for id, obj := range current {
+		old, ok := previous[id]
+		if !ok {
+			events = append(events, StoreEvent{Type: EventCreated, Object: obj})
+		} else if old.ETag != obj.ETag {
+			events = append(events, StoreEvent{Type: EventUpdated, Object: obj})
+		}
+	}
+
+	for id, obj := range previous {
+		if _, ok := current[id]; !ok {
+			events = append(events, StoreEvent{Type: EventDeleted, Object: obj})
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DiffSnapshots(t *testing.T) {
+	unchanged := Object{Metadata: Metadata{ID: "unchanged", ETag: "1"}}
+	updated := Object{Metadata: Metadata{ID: "updated", ETag: "1"}}
+	updatedNew := Object{Metadata: Metadata{ID: "updated", ETag: "2"}}
+	deleted := Object{Metadata: Metadata{ID: "deleted", ETag: "1"}}
+	created := Object{Metadata: Metadata{ID: "created", ETag: "1"}}
+
+	previous := map[string]Object{"unchanged": unchanged, "updated": updated, "deleted": deleted}
+	current := map[string]Object{"unchanged": unchanged, "updated": updatedNew, "created": created}
+
+	events := diffSnapshots(previous, current)
+	require.ElementsMatch(t, []StoreEvent{
+		{Type: EventUpdated, Object: updatedNew},
+		{Type: EventDeleted, Object: deleted},
+		{Type: EventCreated, Object: created},
+	}, events)
+
+	require.Empty(t, diffSnapshots(current, current))
+}
+
+func Test_NewWatchConfig(t *testing.T) {
+	cfg := NewWatchConfig(WithRevision("abc"), WithPollingInterval(DefaultPollingInterval))
+	require.Equal(t, "abc", cfg.Revision)
+	require.Equal(t, DefaultPollingInterval, cfg.PollingInterval)
+}
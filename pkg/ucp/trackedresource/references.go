@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trackedresource
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// FindReferences returns the IDs from candidates that are referenced by the given resource body. The body is expected
+// to be the result of decoding a JSON document, eg: a map[string]any. A reference is any string value in the body
+// that is equal to the candidate ID, or that is the ID of a child of the candidate, compared case-insensitively.
+func FindReferences(body any, candidates []resources.ID) []resources.ID {
+	found := map[int]bool{}
+	walkStrings(body, func(value string) (string, bool) {
+		for i, candidate := range candidates {
+			if isReferenceTo(value, candidate) {
+				found[i] = true
+			}
+		}
+
+		return value, false
+	})
+
+	references := []resources.ID{}
+	for i, candidate := range candidates {
+		if found[i] {
+			references = append(references, candidate)
+		}
+	}
+
+	return references
+}
+
+// RewriteReferences replaces the references to oldID in the given resource body with newID. References to the children
+// of oldID are rewritten to be children of newID. The body is expected to be the result of decoding a JSON document,
+// eg: a map[string]any, and is updated in place. Returns true if any reference was rewritten.
+func RewriteReferences(body any, oldID resources.ID, newID resources.ID) bool {
+	old := oldID.String()
+	return walkStrings(body, func(value string) (string, bool) {
+		if !isReferenceTo(value, oldID) {
+			return value, false
+		}
+
+		return newID.String() + value[len(old):], true
+	})
+}
+
+// DeletionOrder returns the order in which the given resources should be deleted so that a resource is deleted before
+// the resources it references. The references map is keyed by the lower-cased ID of the referencing resource.
+//
+// Resources that are independent of each other are ordered by ID so that the order is deterministic. Reference cycles
+// are broken by deleting the resource with the lowest ID first.
+func DeletionOrder(ids []resources.ID, references map[string][]resources.ID) []resources.ID {
+	remaining := map[string]resources.ID{}
+	for _, id := range ids {
+		remaining[strings.ToLower(id.String())] = id
+	}
+
+	// referencedBy counts the remaining resources that reference each resource.
+	referencedBy := map[string]int{}
+	for key := range remaining {
+		for _, reference := range references[key] {
+			target := strings.ToLower(reference.String())
+			if _, ok := remaining[target]; ok && target != key {
+				referencedBy[target]++
+			}
+		}
+	}
+
+	ordered := []resources.ID{}
+	for len(remaining) > 0 {
+		keys := make([]string, 0, len(remaining))
+		for key := range remaining {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		// Pick the first resource that is not referenced by any remaining resource, or the first resource if there is a
+		// reference cycle.
+		next := keys[0]
+		for _, key := range keys {
+			if referencedBy[key] == 0 {
+				next = key
+				break
+			}
+		}
+
+		ordered = append(ordered, remaining[next])
+		delete(remaining, next)
+
+		for _, reference := range references[next] {
+			target := strings.ToLower(reference.String())
+			if _, ok := remaining[target]; ok {
+				referencedBy[target]--
+			}
+		}
+	}
+
+	return ordered
+}
+
+// isReferenceTo returns true if the value is the given ID or the ID of one of its children.
+func isReferenceTo(value string, id resources.ID) bool {
+	target := id.String()
+	if len(value) < len(target) || !strings.EqualFold(value[:len(target)], target) {
+		return false
+	}
+
+	return len(value) == len(target) || value[len(target)] == '/'
+}
+
+// walkStrings visits every string value in the body and replaces it with the value returned by visit when visit
+// returns true. Returns true if any value was replaced.
+func walkStrings(body any, visit func(value string) (string, bool)) bool {
+	changed := false
+	switch v := body.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if s, ok := value.(string); ok {
+				if replaced, ok := visit(s); ok {
+					v[key] = replaced
+					changed = true
+				}
+				continue
+			}
+
+			changed = walkStrings(value, visit) || changed
+		}
+	case []any:
+		for i, value := range v {
+			if s, ok := value.(string); ok {
+				if replaced, ok := visit(s); ok {
+					v[i] = replaced
+					changed = true
+				}
+				continue
+			}
+
+			changed = walkStrings(value, visit) || changed
+		}
+	}
+
+	return changed
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trackedresource
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testEnvironmentID = resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env")
+	testContainerID   = resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container")
+	testDatabaseID    = resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/test-redis")
+)
+
+func decode(t *testing.T, text string) any {
+	var body any
+	err := json.Unmarshal([]byte(text), &body)
+	require.NoError(t, err)
+	return body
+}
+
+func Test_FindReferences(t *testing.T) {
+	body := decode(t, `{
+		"id": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container",
+		"properties": {
+			"application": "/PLANES/radius/local/resourcegroups/test-group/providers/Applications.Core/applications/test-app",
+			"connections": {
+				"redis": {
+					"source": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/test-redis/child"
+				}
+			},
+			"tags": ["/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env2"]
+		}
+	}`)
+
+	references := FindReferences(body, []resources.ID{testEnvironmentID, testID, testDatabaseID})
+	require.Equal(t, []resources.ID{testID, testDatabaseID}, references)
+}
+
+func Test_RewriteReferences(t *testing.T) {
+	body := decode(t, `{
+		"properties": {
+			"application": "/planes/radius/local/resourcegroups/test-group/providers/Applications.Core/applications/test-app",
+			"routes": [
+				"/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app/routes/a",
+				"/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app2"
+			]
+		}
+	}`)
+
+	newID := resources.MustParse("/planes/radius/local/resourceGroups/other-group/providers/Applications.Core/applications/test-app")
+	changed := RewriteReferences(body, testID, newID)
+	require.True(t, changed)
+
+	expected := decode(t, `{
+		"properties": {
+			"application": "/planes/radius/local/resourceGroups/other-group/providers/Applications.Core/applications/test-app",
+			"routes": [
+				"/planes/radius/local/resourceGroups/other-group/providers/Applications.Core/applications/test-app/routes/a",
+				"/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app2"
+			]
+		}
+	}`)
+	require.Equal(t, expected, body)
+
+	changed = RewriteReferences(body, testID, newID)
+	require.False(t, changed)
+}
+
+func Test_DeletionOrder(t *testing.T) {
+	t.Run("references", func(t *testing.T) {
+		ids := []resources.ID{testEnvironmentID, testID, testDatabaseID, testContainerID}
+		references := map[string][]resources.ID{
+			"/planes/radius/local/resourcegroups/test-group/providers/applications.core/applications/test-app":             {testEnvironmentID},
+			"/planes/radius/local/resourcegroups/test-group/providers/applications.core/containers/test-container":         {testID, testDatabaseID},
+			"/planes/radius/local/resourcegroups/test-group/providers/applications.datastores/rediscaches/test-redis":      {testEnvironmentID, testID},
+			"/planes/radius/local/resourcegroups/test-group/providers/applications.core/environments/test-env":             {},
+			"/planes/radius/local/resourcegroups/test-group/providers/applications.core/environments/not-in-the-group-env": {testID},
+		}
+
+		order := DeletionOrder(ids, references)
+		require.Equal(t, []resources.ID{testContainerID, testDatabaseID, testID, testEnvironmentID}, order)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		ids := []resources.ID{testDatabaseID, testContainerID}
+		references := map[string][]resources.ID{
+			"/planes/radius/local/resourcegroups/test-group/providers/applications.core/containers/test-container":    {testDatabaseID},
+			"/planes/radius/local/resourcegroups/test-group/providers/applications.datastores/rediscaches/test-redis": {testContainerID},
+		}
+
+		order := DeletionOrder(ids, references)
+		require.Equal(t, []resources.ID{testContainerID, testDatabaseID}, order)
+	})
+}
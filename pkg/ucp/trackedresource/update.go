@@ -91,6 +91,7 @@ type trackedResourceState struct {
 	ID         string                         `json:"id"`
 	Name       string                         `json:"name"`
 	Type       string                         `json:"type"`
+	Tags       map[string]string              `json:"tags,omitempty"`
 	Properties trackedResourceStateProperties `json:"properties,omitempty"`
 }
 
@@ -201,6 +202,9 @@ func (u *Updater) run(ctx context.Context, id resources.ID, trackingID resources
 		entry.AsyncProvisioningState = *data.Properties.ProvisioningState
 	}
 
+	// Tags are owned by the downstream resource, so they replace whatever we stored before.
+	entry.Properties.Tags = data.Tags
+
 	obj = &store.Object{
 		Metadata: store.Metadata{
 			ID: trackingID.String(),
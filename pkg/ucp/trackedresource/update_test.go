@@ -255,15 +255,19 @@ func Test_run(t *testing.T) {
 		updater, storeClient, roundTripper := setupUpdater(t)
 
 		resource := map[string]any{
-			"id":         testID.String(),
-			"name":       testID.Name(),
-			"type":       testID.Type(),
+			"id":   testID.String(),
+			"name": testID.Name(),
+			"type": testID.Type(),
+			"tags": map[string]any{
+				"team": "payments",
+			},
 			"properties": map[string]any{},
 		}
 
 		etag := "some-etag"
 		dm := datamodel.GenericResourceFromID(testID, IDFor(testID))
 		dm.Properties.APIVersion = apiVersion
+		dm.Properties.Tags = map[string]string{"team": "orders", "env": "dev"}
 
 		storeClient.EXPECT().
 			Get(gomock.Any(), IDFor(testID).String()).
@@ -282,6 +286,7 @@ func Test_run(t *testing.T) {
 				require.Equal(t, IDFor(testID).String(), dm.ID)
 				require.Equal(t, testID.String(), dm.Properties.ID)
 				require.Equal(t, apiVersion, dm.Properties.APIVersion)
+				require.Equal(t, map[string]string{"team": "payments"}, dm.Properties.Tags)
 				return nil
 			}).
 			Times(1)
@@ -345,6 +350,9 @@ func Test_fetch(t *testing.T) {
 		"id":   testID.String(),
 		"name": testID.Name(),
 		"type": testID.Type(),
+		"tags": map[string]any{
+			"team": "payments",
+		},
 		"properties": map[string]any{
 			"provisioningState": v1.ProvisioningStateAccepted,
 		},
@@ -370,6 +378,9 @@ func Test_fetch(t *testing.T) {
 			ID:   testID.String(),
 			Name: testID.Name(),
 			Type: testID.Type(),
+			Tags: map[string]string{
+				"team": "payments",
+			},
 			Properties: trackedResourceStateProperties{
 				ProvisioningState: to.Ptr(v1.ProvisioningStateAccepted),
 			},
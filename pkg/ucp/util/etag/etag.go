@@ -20,8 +20,9 @@ import (
 	"crypto/sha1"
 	"encoding/binary"
 	"encoding/hex"
-	"errors"
 	"fmt"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
 // New generates a unique string based on the SHA1 hash of the input data.
@@ -37,11 +38,12 @@ func NewFromRevision(revision int64) string {
 	return hex.EncodeToString(b)
 }
 
-// ParseRevision decodes a hexadecimal string into an int64 value and returns it, or an error if the string is invalid.
+// ParseRevision decodes a hexadecimal string into an int64 value and returns it, or store.ErrInvalid if the string is
+// not the encoding of a revision.
 func ParseRevision(etag string) (int64, error) {
 	b, err := hex.DecodeString(etag)
-	if err != nil {
-		return 0, errors.New("etag value is invalid")
+	if err != nil || len(b) != 8 {
+		return 0, &store.ErrInvalid{Message: "etag value is invalid"}
 	}
 
 	return int64(binary.LittleEndian.Uint64(b)), nil
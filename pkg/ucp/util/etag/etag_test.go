@@ -19,6 +19,7 @@ package etag
 import (
 	"testing"
 
+	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/stretchr/testify/require"
 )
 
@@ -42,7 +43,9 @@ func Test_ParseRevision_Valid(t *testing.T) {
 }
 
 func Test_ParseRevision_Invalid(t *testing.T) {
-	result, err := ParseRevision("dfsfdsf2a00000000000000")
-	require.Error(t, err)
-	require.Equal(t, int64(0), result)
+	for _, value := range []string{"dfsfdsf2a00000000000000", "ab", "2a0000000000000000", ""} {
+		result, err := ParseRevision(value)
+		require.ErrorIs(t, err, &store.ErrInvalid{}, value)
+		require.Equal(t, int64(0), result)
+	}
 }
@@ -168,7 +168,10 @@ func Test_TrackedResources(t *testing.T) {
 	})
 
 	t.Run("Delete resource group", func(t *testing.T) {
-		_, err := rgc.Delete(ctx, "local", resourceGroupID.Name(), nil)
+		poller, err := rgc.BeginDelete(ctx, "local", resourceGroupID.Name(), nil)
+		require.NoError(t, err)
+
+		_, err = poller.PollUntilDone(ctx, nil)
 		require.NoError(t, err)
 	})
 }
@@ -57,9 +57,8 @@ func queueTestMessage(cli client.Client, num int) error {
 }
 
 // RunTest tests the client's Enqueue, FinishMessage, ExtendMessage, Dequeue methods by enqueuing and dequeuing messages,
-// and checking for errors when nil messages are passed. It also tests the StartDequeuer method by dequeuing messages via a
-//
-//	channel.
+// and checking for errors when nil messages are passed. It also tests the dead-letter methods and the StartDequeuer method
+// by dequeuing messages via a channel.
 func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 	ctx, cancel := testcontext.NewWithCancel(t)
 	t.Cleanup(cancel)
@@ -75,6 +74,8 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		require.ErrorIs(t, err, client.ErrEmptyMessage)
 		err = cli.ExtendMessage(ctx, nil)
 		require.ErrorIs(t, err, client.ErrEmptyMessage)
+		err = cli.DeadLetterMessage(ctx, nil, "reason")
+		require.ErrorIs(t, err, client.ErrEmptyMessage)
 	})
 
 	t.Run("enqueue and dequeue messages", func(t *testing.T) {
@@ -204,6 +205,135 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		require.ErrorIs(t, err, client.ErrInvalidMessage)
 	})
 
+	t.Run("dead-letter message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = cli.DeadLetterMessage(ctx, msg, "poison message")
+		require.NoError(t, err)
+
+		// Dead-lettered message must not be dequeued even after the message lock is expired.
+		time.Sleep(TestMessageLockTime * 2)
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		deadLetters, err := cli.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Len(t, deadLetters, 1)
+		require.Equal(t, msg.ID, deadLetters[0].ID)
+		require.Equal(t, msg.Data, deadLetters[0].Data)
+		require.Equal(t, "poison message", deadLetters[0].DeadLetterReason)
+		require.False(t, deadLetters[0].DeadLetteredAt.IsZero())
+
+		peeked, err := cli.GetDeadLetterMessage(ctx, msg.ID)
+		require.NoError(t, err)
+		require.Equal(t, msg.Data, peeked.Data)
+
+		_, err = cli.GetDeadLetterMessage(ctx, "not-found")
+		require.ErrorIs(t, err, client.ErrDeadLetterMessageNotFound)
+	})
+
+	t.Run("requeue dead-letter message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		err = cli.DeadLetterMessage(ctx, msg, "poison message")
+		require.NoError(t, err)
+
+		err = cli.RequeueDeadLetterMessage(ctx, msg.ID)
+		require.NoError(t, err)
+
+		err = cli.RequeueDeadLetterMessage(ctx, msg.ID)
+		require.ErrorIs(t, err, client.ErrDeadLetterMessageNotFound)
+
+		deadLetters, err := cli.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, deadLetters)
+
+		requeued, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, msg.Data, requeued.Data)
+		require.Equal(t, 1, requeued.DequeueCount)
+		require.Equal(t, 1, requeued.ReplayCount)
+		require.Empty(t, requeued.DeadLetterReason)
+
+		err = cli.FinishMessage(ctx, requeued)
+		require.NoError(t, err)
+	})
+
+	t.Run("purge dead-letter messages", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 2)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+			require.NoError(t, err)
+			err = cli.DeadLetterMessage(ctx, msg, "poison message")
+			require.NoError(t, err)
+		}
+
+		n, err := cli.PurgeDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+
+		deadLetters, err := cli.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, deadLetters)
+	})
+
+	t.Run("dequeue messages by priority and fairness key", func(t *testing.T) {
+		clear(t)
+
+		enqueue := func(id, key string, priority int) {
+			msg := &testQueueMessage{ID: id, Message: "hello world"}
+			err := cli.Enqueue(ctx, client.NewMessage(msg), client.WithFairnessKey(key), client.WithPriority(priority))
+			require.NoError(t, err)
+		}
+		enqueue("a1", "a", 0)
+		enqueue("a2", "a", 0)
+		enqueue("a3", "a", 0)
+		enqueue("b1", "b", 0)
+		enqueue("h1", "c", 1)
+
+		// Messages with fairness keys "a" and "c" are skipped by the filter.
+		filter := client.NewDequeueConfig(client.WithFairnessKeyFilter(func(key string) bool { return key != "a" && key != "c" }))
+		msg, err := cli.Dequeue(ctx, filter)
+		require.NoError(t, err)
+		require.Equal(t, "b", msg.FairnessKey)
+		err = cli.FinishMessage(ctx, msg)
+		require.NoError(t, err)
+		enqueue("b1", "b", 0)
+
+		order := []string{}
+		for i := 0; i < 5; i++ {
+			msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+			require.NoError(t, err)
+			result := &testQueueMessage{}
+			err = json.Unmarshal(msg.Data, result)
+			require.NoError(t, err)
+			order = append(order, result.ID)
+			err = cli.FinishMessage(ctx, msg)
+			require.NoError(t, err)
+		}
+
+		// The message with the higher priority is dequeued first and then fairness key "b" is served
+		// before the remaining messages of fairness key "a".
+		require.Equal(t, "h1", order[0])
+		require.Contains(t, order[1:3], "b1")
+		require.ElementsMatch(t, []string{"h1", "a1", "a2", "a3", "b1"}, order)
+	})
+
 	t.Run("StartDequeuer dequeues message via channel", func(t *testing.T) {
 		clear(t)
 		msgCh, err := client.StartDequeuer(ctx, cli, client.WithDequeueInterval(defaultTestDequeueInterval))
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// RunTransactionTest tests the store.Commit function against the StorageClient. The native transaction is used if the
+// client implements store.Transactor.
+func RunTransactionTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	t.Run("commit_empty_transaction", func(t *testing.T) {
+		err := store.Commit(ctx, client, &store.Transaction{})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+
+	t.Run("commit_duplicate_object", func(t *testing.T) {
+		obj1 := createObject(Resource1ID, Data1)
+
+		tx := &store.Transaction{}
+		tx.Save(&obj1)
+		tx.Delete(Resource1ID.String())
+
+		err := store.Commit(ctx, client, tx)
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+
+	t.Run("commit_saves", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		obj2 := createObject(Resource2ID, Data2)
+
+		tx := &store.Transaction{}
+		tx.Save(&obj1)
+		tx.Save(&obj2)
+
+		err := store.Commit(ctx, client, tx)
+		require.NoError(t, err)
+		require.NotEmpty(t, obj1.ETag)
+		require.NotEmpty(t, obj2.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+		require.Equal(t, obj1.ETag, obj1Get.ETag)
+
+		obj2Get, err := client.Get(ctx, Resource2ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj2, obj2Get)
+		require.Equal(t, obj2.ETag, obj2Get.ETag)
+	})
+
+	t.Run("commit_save_and_delete_with_matching_etags", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data1)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		obj1.Data = Data2
+
+		tx := &store.Transaction{}
+		tx.Save(&obj1, store.WithETag(obj1.ETag))
+		tx.Delete(Resource2ID.String(), store.WithETag(obj2.ETag))
+
+		err = store.Commit(ctx, client, tx)
+		require.NoError(t, err)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+
+		_, err = client.Get(ctx, Resource2ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource2ID.String()})
+	})
+
+	t.Run("commit_applies_nothing_with_non_matching_etag", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj1Updated := createObject(Resource1ID, Data2)
+		obj2 := createObject(Resource2ID, Data2)
+
+		tx := &store.Transaction{}
+		tx.Save(&obj2)
+		tx.Save(&obj1Updated, store.WithETag(etag.New(MarshalOrPanic(Data2))))
+
+		err = store.Commit(ctx, client, tx)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+
+		_, err = client.Get(ctx, Resource2ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource2ID.String()})
+	})
+
+	t.Run("commit_delete_missing_object", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+
+		tx := &store.Transaction{}
+		tx.Save(&obj1)
+		tx.Delete(Resource2ID.String())
+
+		err := store.Commit(ctx, client, tx)
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource2ID.String()})
+
+		_, err = client.Get(ctx, Resource1ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource1ID.String()})
+	})
+}
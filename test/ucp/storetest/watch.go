@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	watchPollingInterval = 10 * time.Millisecond
+	watchTimeout         = 10 * time.Second
+)
+
+// RunWatchTest tests the store.Watch function against the StorageClient by saving, updating and deleting objects and
+// checking the reported events. The native watch is used if the client implements store.Watcher. If the events reported
+// by the client have a revision, the test also checks that a watch can be resumed.
+func RunWatchTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	t.Run("watch_reports_changes", func(t *testing.T) {
+		clear(t)
+
+		ctx, cancel := testcontext.NewWithCancel(t)
+		t.Cleanup(cancel)
+
+		query := store.Query{RootScope: RadiusScope, ScopeRecursive: true}
+		events, err := store.Watch(ctx, client, query, store.WithPollingInterval(watchPollingInterval))
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		created := nextEvent(t, events)
+		require.Equal(t, store.EventCreated, created.Type)
+		compareObjects(t, &obj1, &created.Object)
+
+		obj1.Data = Data2
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		updated := nextEvent(t, events)
+		require.Equal(t, store.EventUpdated, updated.Type)
+		compareObjects(t, &obj1, &updated.Object)
+
+		err = client.Delete(ctx, Resource1ID.String())
+		require.NoError(t, err)
+
+		deleted := nextEvent(t, events)
+		require.Equal(t, store.EventDeleted, deleted.Type)
+		require.Equal(t, Resource1ID.String(), deleted.Object.ID)
+
+		if created.Revision == "" {
+			return
+		}
+
+		// Resuming after the created event replays the update and the delete.
+		resumed, err := store.Watch(ctx, client, query, store.WithRevision(created.Revision))
+		require.NoError(t, err)
+		require.Equal(t, store.EventUpdated, nextEvent(t, resumed).Type)
+		require.Equal(t, store.EventDeleted, nextEvent(t, resumed).Type)
+	})
+
+	t.Run("watch_ignores_non_matching_changes", func(t *testing.T) {
+		clear(t)
+
+		ctx, cancel := testcontext.NewWithCancel(t)
+		t.Cleanup(cancel)
+
+		query := store.Query{RootScope: ResourceGroup2Scope}
+		events, err := store.Watch(ctx, client, query, store.WithPollingInterval(watchPollingInterval))
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		created := nextEvent(t, events)
+		require.Equal(t, store.EventCreated, created.Type)
+		require.Equal(t, Resource2ID.String(), created.Object.ID)
+	})
+
+	t.Run("watch_stops_when_context_is_cancelled", func(t *testing.T) {
+		clear(t)
+
+		ctx, cancel := context.WithCancel(testcontext.New(t))
+		events, err := store.Watch(ctx, client, store.Query{RootScope: RadiusScope}, store.WithPollingInterval(watchPollingInterval))
+		require.NoError(t, err)
+
+		cancel()
+
+		require.Eventually(t, func() bool {
+			select {
+			case _, ok := <-events:
+				return !ok
+			default:
+				return false
+			}
+		}, watchTimeout, watchPollingInterval)
+	})
+}
+
+func nextEvent(t *testing.T, events <-chan store.StoreEvent) store.StoreEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "watch channel was closed")
+		return event
+	case <-time.After(watchTimeout):
+		require.Fail(t, "timed out waiting for watch event")
+		return store.StoreEvent{}
+	}
+}